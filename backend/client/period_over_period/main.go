@@ -21,12 +21,42 @@ func main() {
 		currentEnd    = flag.String("current-end", "", "Current period end date (format: 2006-01-02)")
 		previousStart = flag.String("previous-start", "", "Previous period start date (format: 2006-01-02)")
 		previousEnd   = flag.String("previous-end", "", "Previous period end date (format: 2006-01-02)")
+		points        = flag.Bool("points", false, "Report the change in percentage points instead of relative percent")
+		preset        = flag.String("preset", "wow", "Period preset used when no dates are given: wow, mom, qoq, yoy or sply")
+		anchor        = flag.String("anchor", "", "Date the preset is resolved against (format: 2006-01-02, default: today)")
+		timeZone      = flag.String("tz", "", "IANA time zone for preset calendar boundaries (default: UTC)")
 	)
 	flag.Parse()
 
-	currStart, currEnd, prevStart, prevEnd, err := parseDates(*currentStart, *currentEnd, *previousStart, *previousEnd)
-	if err != nil {
-		log.Fatalf("Error parsing dates: %v\n", err)
+	req := &proto.PeriodOverPeriodChangeRequest{}
+	if *currentStart == "" && *currentEnd == "" && *previousStart == "" && *previousEnd == "" {
+		// No dates: let the server resolve a calendar-aligned preset
+		p, ok := presets[*preset]
+		if !ok {
+			log.Fatalf("Unknown preset %q", *preset)
+		}
+		req.Preset = p
+		req.TimeZone = *timeZone
+		if *anchor != "" {
+			a, err := time.Parse("2006-01-02", *anchor)
+			if err != nil {
+				log.Fatalf("Invalid anchor date: %v", err)
+			}
+			req.Anchor = timestamppb.New(a)
+		}
+		fmt.Printf("No dates provided, using preset %s\n\n", p)
+	} else {
+		currStart, currEnd, prevStart, prevEnd, err := parseDates(*currentStart, *currentEnd, *previousStart, *previousEnd)
+		if err != nil {
+			log.Fatalf("Error parsing dates: %v\n", err)
+		}
+		req.CurrentStart = timestamppb.New(currStart)
+		req.CurrentEnd = timestamppb.New(currEnd)
+		req.PreviousStart = timestamppb.New(prevStart)
+		req.PreviousEnd = timestamppb.New(prevEnd)
+	}
+	if *points {
+		req.ChangeUnit = proto.ChangeUnit_CHANGE_UNIT_PERCENTAGE_POINTS
 	}
 
 	conn, err := grpc.NewClient(*serverAddr, grpc.WithTransportCredentials(insecure.NewCredentials()))
@@ -37,48 +67,31 @@ func main() {
 
 	client := proto.NewAnalyticsServiceClient(conn)
 
-	req := &proto.PeriodOverPeriodChangeRequest{
-		CurrentStart:  timestamppb.New(currStart),
-		CurrentEnd:    timestamppb.New(currEnd),
-		PreviousStart: timestamppb.New(prevStart),
-		PreviousEnd:   timestamppb.New(prevEnd),
-	}
-
 	ctx, cancel := context.WithTimeout(context.Background(), 10*time.Second)
 	defer cancel()
 
-	fmt.Printf("Requesting period over period change...\n")
-	fmt.Printf("Current period:  %s to %s\n", currStart.Format("2006-01-02"), currEnd.Format("2006-01-02"))
-	fmt.Printf("Previous period: %s to %s\n\n", prevStart.Format("2006-01-02"), prevEnd.Format("2006-01-02"))
+	fmt.Printf("Requesting period over period change...\n\n")
 
 	resp, err := client.GetPeriodOverPeriodChange(ctx, req)
 	if err != nil {
 		log.Fatalf("Failed to get period over period change: %v", err)
 	}
 
-	displayResults(resp, currStart, currEnd, prevStart, prevEnd)
+	displayResults(resp)
+}
+
+// presets maps the -preset flag to the server-side period presets
+var presets = map[string]proto.PeriodPreset{
+	"wow":  proto.PeriodPreset_PERIOD_PRESET_WEEK_OVER_WEEK,
+	"mom":  proto.PeriodPreset_PERIOD_PRESET_MONTH_OVER_MONTH,
+	"qoq":  proto.PeriodPreset_PERIOD_PRESET_QUARTER_OVER_QUARTER,
+	"yoy":  proto.PeriodPreset_PERIOD_PRESET_YEAR_OVER_YEAR,
+	"sply": proto.PeriodPreset_PERIOD_PRESET_SAME_PERIOD_LAST_YEAR,
 }
 
 func parseDates(currentStart, currentEnd, previousStart, previousEnd string) (time.Time, time.Time, time.Time, time.Time, error) {
 	const layout = "2006-01-02"
 
-	// If no dates provided, use default: current week vs previous week
-	if currentStart == "" && currentEnd == "" && previousStart == "" && previousEnd == "" {
-		now := time.Now()
-		// Current week: last 7 days
-		currEnd := now
-		currStart := now.AddDate(0, 0, -7)
-		// Previous week: 7 days before that
-		prevEnd := currStart
-		prevStart := currStart.AddDate(0, 0, -7)
-
-		fmt.Printf("No dates provided, using default:\n")
-		fmt.Printf("  Current week:  last 7 days\n")
-		fmt.Printf("  Previous week: 7 days before that\n\n")
-
-		return currStart, currEnd, prevStart, prevEnd, nil
-	}
-
 	// All four dates must be provided
 	if currentStart == "" || currentEnd == "" || previousStart == "" || previousEnd == "" {
 		return time.Time{}, time.Time{}, time.Time{}, time.Time{}, fmt.Errorf("all four dates required: current-start, current-end, previous-start, previous-end")
@@ -116,7 +129,11 @@ func parseDates(currentStart, currentEnd, previousStart, previousEnd string) (ti
 	return currStart, currEnd, prevStart, prevEnd, nil
 }
 
-func displayResults(resp *proto.PeriodOverPeriodChangeResponse, currStart, currEnd, prevStart, prevEnd time.Time) {
+func displayResults(resp *proto.PeriodOverPeriodChangeResponse) {
+	// The server echoes the ranges it used, which is the only place preset periods are visible
+	currStart, currEnd := resp.CurrentRange.GetStart().AsTime(), resp.CurrentRange.GetEnd().AsTime()
+	prevStart, prevEnd := resp.PreviousRange.GetStart().AsTime(), resp.PreviousRange.GetEnd().AsTime()
+
 	fmt.Printf("=== Period Over Period Score Change ===\n\n")
 
 	// Current period
@@ -149,7 +166,7 @@ func displayResults(resp *proto.PeriodOverPeriodChangeResponse, currStart, currE
 	fmt.Printf("📈 Period Over Period Change\n")
 
 	// Score difference
-	scoreDiff := resp.CurrentPeriodScore - resp.PreviousPeriodScore
+	scoreDiff := resp.PointDifference
 	fmt.Printf("   Score Difference: ")
 	if scoreDiff > 0 {
 		fmt.Printf("+%.2f points\n", scoreDiff)
@@ -160,13 +177,17 @@ func displayResults(resp *proto.PeriodOverPeriodChangeResponse, currStart, currE
 	}
 
 	// Percentage change
+	unit := "%"
+	if resp.ChangeUnit == proto.ChangeUnit_CHANGE_UNIT_PERCENTAGE_POINTS {
+		unit = " pp"
+	}
 	fmt.Printf("   Percentage Change: ")
 	if resp.ChangePercentage > 0 {
-		fmt.Printf("↑ +%.2f%%\n", resp.ChangePercentage)
+		fmt.Printf("↑ +%.2f%s\n", resp.ChangePercentage, unit)
 	} else if resp.ChangePercentage < 0 {
-		fmt.Printf("↓ %.2f%%\n", resp.ChangePercentage)
+		fmt.Printf("↓ %.2f%s\n", resp.ChangePercentage, unit)
 	} else {
-		fmt.Printf("→ 0.00%% (No change)\n")
+		fmt.Printf("→ 0.00%s (No change)\n", unit)
 	}
 
 	// Rating count change
@@ -183,10 +204,38 @@ func displayResults(resp *proto.PeriodOverPeriodChangeResponse, currStart, currE
 	// Trend assessment
 	fmt.Printf("\n   Trend: %s\n", getTrendAssessment(resp.ChangePercentage))
 
+	// Significance
+	if sig := resp.Significance; sig != nil {
+		verdict := "not statistically significant"
+		if sig.Significant {
+			verdict = "statistically significant"
+		}
+		fmt.Printf("   Significance: %s (p = %.4f, effect size d = %.2f)\n", verdict, sig.PValue, sig.EffectSize)
+	} else {
+		fmt.Printf("   Significance: not enough ratings to test\n")
+	}
+
+	// Category breakdown
+	if len(resp.CategoryChanges) > 0 {
+		fmt.Printf("\n📋 By Category\n")
+		for _, c := range resp.CategoryChanges {
+			marker := ""
+			if c.Significance.GetSignificant() {
+				marker = " *"
+			}
+			fmt.Printf("   %-20s %6.2f%% → %6.2f%%  contribution %+.2f points%s\n",
+				c.CategoryName, c.PreviousScore, c.CurrentScore, c.Contribution, marker)
+		}
+		fmt.Printf("   (* significant at p < 0.05)\n")
+	}
+
 	// Special notes
-	if resp.PreviousPeriodScore == 0 && resp.CurrentPeriodScore > 0 {
-		fmt.Printf("\n   ⚠️  Note: Previous period had no data. Percentage change cannot be calculated.\n")
-	} else if resp.CurrentTotalRatings == 0 && resp.PreviousTotalRatings == 0 {
+	switch resp.Status {
+	case proto.ChangeStatus_CHANGE_STATUS_NO_PREVIOUS_DATA:
+		fmt.Printf("\n   ⚠️  Note: Previous period had no data. Change cannot be calculated.\n")
+	case proto.ChangeStatus_CHANGE_STATUS_NO_CURRENT_DATA:
+		fmt.Printf("\n   ⚠️  Note: Current period has no data yet. Change cannot be calculated.\n")
+	case proto.ChangeStatus_CHANGE_STATUS_NO_DATA:
 		fmt.Printf("\n   ⚠️  Note: No ratings found in either period.\n")
 	}
 
@@ -0,0 +1,157 @@
+// Package alerting evaluates the alert rules stored in the database on a schedule and
+// notifies their webhooks when a rule starts or stops firing
+package alerting
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"log"
+	"sync"
+	"time"
+
+	"go-grpc-backend/internal/models"
+	"go-grpc-backend/internal/repository"
+	"go-grpc-backend/internal/service"
+)
+
+// Notification announces that a rule started firing, or resolved when State.Status is OK
+type Notification struct {
+	Rule  models.AlertRule
+	State models.AlertState
+}
+
+// Resolved reports whether the rule stopped firing
+func (n Notification) Resolved() bool {
+	return n.State.Status == models.AlertStatusOK
+}
+
+// Notifier delivers notifications; WebhookNotifier is the production implementation
+type Notifier interface {
+	Notify(ctx context.Context, n Notification) error
+}
+
+// Evaluator checks every enabled rule against the analytics data and records its state.
+// Only transitions between OK and firing are notified, so a rule that stays broken alerts once
+type Evaluator struct {
+	rules     repository.AlertRepositoryInterface
+	analytics repository.AnalyticsRepositoryInterface
+	notifier  Notifier
+	interval  time.Duration
+	now       func() time.Time
+}
+
+func NewEvaluator(rules repository.AlertRepositoryInterface, analytics repository.AnalyticsRepositoryInterface, notifier Notifier, interval time.Duration) *Evaluator {
+	return &Evaluator{
+		rules:     rules,
+		analytics: analytics,
+		notifier:  notifier,
+		interval:  interval,
+		now:       time.Now,
+	}
+}
+
+// Run evaluates the rules immediately and then every interval until ctx is cancelled
+func (e *Evaluator) Run(ctx context.Context) {
+	ticker := time.NewTicker(e.interval)
+	defer ticker.Stop()
+
+	for {
+		if err := e.EvaluateAll(ctx); err != nil {
+			log.Printf("Alert evaluation: %v", err)
+		}
+
+		select {
+		case <-ctx.Done():
+			return
+		case <-ticker.C:
+		}
+	}
+}
+
+// EvaluateAll runs one pass over every enabled rule. A failing rule doesn't stop the others;
+// their errors are joined. Status changes are saved as pending notifications, which are then
+// delivered concurrently so one slow webhook doesn't hold up the others. A notification stays
+// pending until delivered, so a failed delivery is retried on the next pass
+func (e *Evaluator) EvaluateAll(ctx context.Context) error {
+	rules, err := e.rules.ListAlertRules(ctx)
+	if err != nil {
+		return err
+	}
+	states, err := e.rules.ListAlertStates(ctx)
+	if err != nil {
+		return err
+	}
+
+	var (
+		errs    []error
+		pending []Notification
+	)
+	for _, rule := range rules {
+		if !rule.Enabled {
+			continue
+		}
+		if ctx.Err() != nil {
+			return ctx.Err()
+		}
+
+		var previous *models.AlertState
+		if s, ok := states[rule.ID]; ok {
+			previous = &s
+		}
+		state, err := e.evaluate(ctx, rule, previous)
+		if err != nil {
+			errs = append(errs, fmt.Errorf("rule %d (%s): %w", rule.ID, rule.Name, err))
+			continue
+		}
+		if state.NotifyPending {
+			pending = append(pending, Notification{Rule: rule, State: state})
+		}
+	}
+	errs = append(errs, e.deliver(ctx, pending)...)
+	return errors.Join(errs...)
+}
+
+func (e *Evaluator) evaluate(ctx context.Context, rule models.AlertRule, previous *models.AlertState) (models.AlertState, error) {
+	now := e.now()
+	eval, err := service.EvaluateAlertRule(ctx, e.analytics, rule, now)
+	if err != nil {
+		return models.AlertState{}, err
+	}
+
+	state, changed := service.NextAlertState(rule.ID, previous, eval, now)
+	if err := e.rules.SaveAlertState(ctx, state); err != nil {
+		return models.AlertState{}, err
+	}
+	if changed {
+		log.Printf("Alert rule %d (%s) is now %s: value %.2f over %d ratings", rule.ID, rule.Name, state.Status, state.Value, state.Sample)
+	}
+	return state, nil
+}
+
+// deliver sends the notifications concurrently and clears NotifyPending on the delivered ones
+func (e *Evaluator) deliver(ctx context.Context, notifications []Notification) []error {
+	var (
+		wg   sync.WaitGroup
+		mu   sync.Mutex
+		errs []error
+	)
+	for _, n := range notifications {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			err := e.notifier.Notify(ctx, n)
+			if err == nil {
+				n.State.NotifyPending = false
+				err = e.rules.SaveAlertState(ctx, n.State)
+			}
+			if err != nil {
+				mu.Lock()
+				errs = append(errs, fmt.Errorf("rule %d (%s): %w", n.Rule.ID, n.Rule.Name, err))
+				mu.Unlock()
+			}
+		}()
+	}
+	wg.Wait()
+	return errs
+}
@@ -0,0 +1,334 @@
+package alerting
+
+import (
+	"context"
+	"errors"
+	"net/http"
+	"sync"
+	"testing"
+	"time"
+
+	"go-grpc-backend/internal/models"
+	"go-grpc-backend/internal/repository"
+)
+
+// fakeAlertRepository keeps rules and states in memory. Notifications are delivered
+// concurrently, so states are guarded
+type fakeAlertRepository struct {
+	rules  []models.AlertRule
+	mu     sync.Mutex
+	states map[int]models.AlertState
+}
+
+func (f *fakeAlertRepository) ListAlertRules(ctx context.Context) ([]models.AlertRule, error) {
+	return f.rules, nil
+}
+
+func (f *fakeAlertRepository) GetAlertRule(ctx context.Context, id int) (models.AlertRule, error) {
+	for _, rule := range f.rules {
+		if rule.ID == id {
+			return rule, nil
+		}
+	}
+	return models.AlertRule{}, repository.ErrNotFound
+}
+
+func (f *fakeAlertRepository) CreateAlertRule(ctx context.Context, rule models.AlertRule) (models.AlertRule, error) {
+	rule.ID = len(f.rules) + 1
+	f.rules = append(f.rules, rule)
+	return rule, nil
+}
+
+func (f *fakeAlertRepository) UpdateAlertRule(ctx context.Context, rule models.AlertRule) (models.AlertRule, error) {
+	return rule, nil
+}
+
+func (f *fakeAlertRepository) DeleteAlertRule(ctx context.Context, id int) error {
+	return nil
+}
+
+func (f *fakeAlertRepository) ListAlertStates(ctx context.Context) (map[int]models.AlertState, error) {
+	f.mu.Lock()
+	defer f.mu.Unlock()
+	states := make(map[int]models.AlertState, len(f.states))
+	for id, state := range f.states {
+		states[id] = state
+	}
+	return states, nil
+}
+
+func (f *fakeAlertRepository) SaveAlertState(ctx context.Context, state models.AlertState) error {
+	f.mu.Lock()
+	defer f.mu.Unlock()
+	if f.states == nil {
+		f.states = make(map[int]models.AlertState)
+	}
+	f.states[state.RuleID] = state
+	return nil
+}
+
+// fakeAnalyticsRepository returns scores for GetOverallQualityScore, the only query rules use
+type fakeAnalyticsRepository struct {
+	scores []models.CategoryScore
+	err    error
+}
+
+func (f *fakeAnalyticsRepository) GetOverallQualityScore(ctx context.Context, rng models.DateRange) ([]models.CategoryScore, error) {
+	return f.scores, f.err
+}
+
+func (f *fakeAnalyticsRepository) GetDailyAggregatedCategoryRatings(ctx context.Context, rng models.DateRange) ([]models.CategoryRatingOverTimePeriod, error) {
+	return nil, nil
+}
+
+func (f *fakeAnalyticsRepository) GetWeeklyAggregatedCategoryRatings(ctx context.Context, rng models.DateRange) ([]models.CategoryRatingOverTimePeriod, error) {
+	return nil, nil
+}
+
+func (f *fakeAnalyticsRepository) GetScoresByTicket(ctx context.Context, rng models.DateRange) ([]models.TicketCategoryScore, error) {
+	return nil, nil
+}
+
+func (f *fakeAnalyticsRepository) GetRatingDistribution(ctx context.Context, rng models.DateRange, granularity models.Granularity) ([]models.RatingDistribution, error) {
+	return nil, nil
+}
+
+func (f *fakeAnalyticsRepository) GetCategoryScoresByPeriod(ctx context.Context, periods []models.DateRange) ([]models.PeriodCategoryScore, error) {
+	return nil, nil
+}
+
+// recordingNotifier keeps every notification it is asked to deliver
+type recordingNotifier struct {
+	mu   sync.Mutex
+	sent []Notification
+	err  error
+}
+
+func (r *recordingNotifier) Notify(ctx context.Context, n Notification) error {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	r.sent = append(r.sent, n)
+	return r.err
+}
+
+// scoreOf builds a single-category result whose overall score is score
+func scoreOf(score float64, ratings int) []models.CategoryScore {
+	return []models.CategoryScore{{CategoryID: 1, CategoryName: "Empathy", CategoryWeight: 1, Score: score / 20, RatingCount: ratings}}
+}
+
+func TestEvaluator_EvaluateAll_Transitions(t *testing.T) {
+	rules := &fakeAlertRepository{rules: []models.AlertRule{{
+		ID:         1,
+		Name:       "Low quality",
+		Metric:     models.AlertMetricQualityScore,
+		Window:     24 * time.Hour,
+		Comparator: models.AlertComparatorLessThan,
+		Threshold:  70,
+		MinSample:  5,
+		Enabled:    true,
+	}}}
+	analytics := &fakeAnalyticsRepository{}
+	notifier := &recordingNotifier{}
+	evaluator := NewEvaluator(rules, analytics, notifier, time.Minute)
+
+	steps := []struct {
+		name       string
+		scores     []models.CategoryScore
+		wantStatus models.AlertStatus
+		wantSent   int
+	}{
+		{name: "healthy", scores: scoreOf(80, 10), wantStatus: models.AlertStatusOK, wantSent: 0},
+		{name: "breached", scores: scoreOf(60, 10), wantStatus: models.AlertStatusFiring, wantSent: 1},
+		{name: "still breached", scores: scoreOf(50, 10), wantStatus: models.AlertStatusFiring, wantSent: 1},
+		{name: "too few ratings", scores: scoreOf(90, 2), wantStatus: models.AlertStatusFiring, wantSent: 1},
+		{name: "recovered", scores: scoreOf(90, 10), wantStatus: models.AlertStatusOK, wantSent: 2},
+	}
+
+	for _, step := range steps {
+		analytics.scores = step.scores
+		if err := evaluator.EvaluateAll(context.Background()); err != nil {
+			t.Fatalf("%s: EvaluateAll() error = %v", step.name, err)
+		}
+		if got := rules.states[1].Status; got != step.wantStatus {
+			t.Errorf("%s: expected status %s, got %s", step.name, step.wantStatus, got)
+		}
+		if len(notifier.sent) != step.wantSent {
+			t.Errorf("%s: expected %d notifications, got %d", step.name, step.wantSent, len(notifier.sent))
+		}
+	}
+
+	if len(notifier.sent) == 2 {
+		if notifier.sent[0].Resolved() || !notifier.sent[1].Resolved() {
+			t.Errorf("Expected a firing then a resolved notification, got %+v", notifier.sent)
+		}
+	}
+}
+
+func TestEvaluator_EvaluateAll_SkipsDisabledRules(t *testing.T) {
+	rules := &fakeAlertRepository{rules: []models.AlertRule{{
+		ID:         1,
+		Metric:     models.AlertMetricRatingCount,
+		Window:     time.Hour,
+		Comparator: models.AlertComparatorLessThan,
+		Threshold:  100,
+	}}}
+	notifier := &recordingNotifier{}
+	evaluator := NewEvaluator(rules, &fakeAnalyticsRepository{scores: scoreOf(80, 1)}, notifier, time.Minute)
+
+	if err := evaluator.EvaluateAll(context.Background()); err != nil {
+		t.Fatalf("EvaluateAll() error = %v", err)
+	}
+	if len(rules.states) != 0 || len(notifier.sent) != 0 {
+		t.Errorf("Expected disabled rule to be skipped, got states %v and %d notifications", rules.states, len(notifier.sent))
+	}
+}
+
+func TestEvaluator_EvaluateAll_Errors(t *testing.T) {
+	rule := models.AlertRule{
+		ID:         1,
+		Metric:     models.AlertMetricRatingCount,
+		Window:     time.Hour,
+		Comparator: models.AlertComparatorLessThan,
+		Threshold:  100,
+		Enabled:    true,
+	}
+
+	t.Run("query failure", func(t *testing.T) {
+		rules := &fakeAlertRepository{rules: []models.AlertRule{rule}}
+		evaluator := NewEvaluator(rules, &fakeAnalyticsRepository{err: errors.New("database error")}, &recordingNotifier{}, time.Minute)
+
+		if err := evaluator.EvaluateAll(context.Background()); err == nil {
+			t.Error("Expected error, got nil")
+		}
+		if len(rules.states) != 0 {
+			t.Errorf("Expected no state to be saved, got %v", rules.states)
+		}
+	})
+
+	t.Run("delivery failure is retried", func(t *testing.T) {
+		rules := &fakeAlertRepository{rules: []models.AlertRule{rule}}
+		notifier := &recordingNotifier{err: errors.New("webhook down")}
+		evaluator := NewEvaluator(rules, &fakeAnalyticsRepository{scores: scoreOf(80, 1)}, notifier, time.Minute)
+
+		if err := evaluator.EvaluateAll(context.Background()); err == nil {
+			t.Error("Expected error, got nil")
+		}
+		if state := rules.states[1]; state.Status != models.AlertStatusFiring || !state.NotifyPending {
+			t.Errorf("Expected firing state with a pending notification, got %+v", state)
+		}
+
+		// The next pass sees no transition but still delivers the pending notification
+		notifier.err = nil
+		if err := evaluator.EvaluateAll(context.Background()); err != nil {
+			t.Errorf("Second EvaluateAll() error = %v", err)
+		}
+		if len(notifier.sent) != 2 || notifier.sent[1].State.Status != models.AlertStatusFiring {
+			t.Errorf("Expected the firing notification to be sent again, got %+v", notifier.sent)
+		}
+		if rules.states[1].NotifyPending {
+			t.Errorf("Expected the delivered notification to be cleared, got %+v", rules.states[1])
+		}
+
+		// Delivered: nothing more to send
+		if err := evaluator.EvaluateAll(context.Background()); err != nil {
+			t.Errorf("Third EvaluateAll() error = %v", err)
+		}
+		if len(notifier.sent) != 2 {
+			t.Errorf("Expected 2 delivery attempts, got %d", len(notifier.sent))
+		}
+	})
+}
+
+func TestEvaluator_Run_DeliversToWebhook(t *testing.T) {
+	stub, server := newWebhookStub(t, http.StatusOK)
+	rules := &fakeAlertRepository{rules: []models.AlertRule{{
+		ID:         1,
+		Name:       "No ratings",
+		Metric:     models.AlertMetricRatingCount,
+		Window:     time.Hour,
+		Comparator: models.AlertComparatorLessThan,
+		Threshold:  1,
+		WebhookURL: server.URL,
+		Enabled:    true,
+	}}}
+	evaluator := NewEvaluator(rules, &fakeAnalyticsRepository{}, NewWebhookNotifier(time.Second, 1, time.Millisecond), time.Hour)
+
+	ctx, cancel := context.WithCancel(context.Background())
+	done := make(chan struct{})
+	go func() {
+		defer close(done)
+		evaluator.Run(ctx)
+	}()
+
+	// Run evaluates once on start, without waiting for the first tick
+	select {
+	case msg := <-stub.bodies:
+		if msg.Text != "[FIRING] No ratings: rating count is 0.00 over the last 1h0m0s" {
+			t.Errorf("Unexpected text %q", msg.Text)
+		}
+	case <-time.After(5 * time.Second):
+		t.Fatal("Timed out waiting for the webhook")
+	}
+
+	cancel()
+	select {
+	case <-done:
+	case <-time.After(5 * time.Second):
+		t.Fatal("Run did not return after cancellation")
+	}
+}
+
+// blockingNotifier holds deliveries to one rule until release is closed
+type blockingNotifier struct {
+	recordingNotifier
+	blockRule int
+	release   chan struct{}
+}
+
+func (b *blockingNotifier) Notify(ctx context.Context, n Notification) error {
+	if n.Rule.ID == b.blockRule {
+		<-b.release
+	}
+	return b.recordingNotifier.Notify(ctx, n)
+}
+
+func TestEvaluator_EvaluateAll_DeliversConcurrently(t *testing.T) {
+	rule := models.AlertRule{
+		Metric:     models.AlertMetricRatingCount,
+		Window:     time.Hour,
+		Comparator: models.AlertComparatorLessThan,
+		Threshold:  100,
+		Enabled:    true,
+	}
+	slow, fast := rule, rule
+	slow.ID, fast.ID = 1, 2
+	rules := &fakeAlertRepository{rules: []models.AlertRule{slow, fast}}
+	notifier := &blockingNotifier{blockRule: 1, release: make(chan struct{})}
+	evaluator := NewEvaluator(rules, &fakeAnalyticsRepository{scores: scoreOf(80, 1)}, notifier, time.Minute)
+
+	done := make(chan error)
+	go func() { done <- evaluator.EvaluateAll(context.Background()) }()
+
+	// Rule 2 is delivered while rule 1's webhook hangs
+	deadline := time.Now().Add(5 * time.Second)
+	for {
+		notifier.mu.Lock()
+		sent := len(notifier.sent)
+		notifier.mu.Unlock()
+		if sent == 1 {
+			break
+		}
+		if time.Now().After(deadline) {
+			t.Fatal("Timed out waiting for rule 2 to be delivered")
+		}
+		time.Sleep(time.Millisecond)
+	}
+	close(notifier.release)
+
+	if err := <-done; err != nil {
+		t.Fatalf("EvaluateAll() error = %v", err)
+	}
+	if len(notifier.sent) != 2 || notifier.sent[0].Rule.ID != 2 {
+		t.Errorf("Expected rule 2 then rule 1 to be delivered, got %+v", notifier.sent)
+	}
+}
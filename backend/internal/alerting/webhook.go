@@ -0,0 +1,138 @@
+package alerting
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"fmt"
+	"io"
+	"net/http"
+	"time"
+
+	"go-grpc-backend/internal/models"
+)
+
+// WebhookNotifier POSTs a Slack-compatible JSON message to the rule's webhook URL.
+// Network errors, 429 and 5xx responses are retried with exponential backoff; other
+// responses are final
+type WebhookNotifier struct {
+	client      *http.Client
+	maxAttempts int
+	backoff     time.Duration
+}
+
+// NewWebhookNotifier bounds each attempt by timeout and makes up to maxAttempts of them,
+// waiting backoff before the first retry and twice as long before each later one
+func NewWebhookNotifier(timeout time.Duration, maxAttempts int, backoff time.Duration) *WebhookNotifier {
+	return &WebhookNotifier{
+		client:      &http.Client{Timeout: timeout},
+		maxAttempts: maxAttempts,
+		backoff:     backoff,
+	}
+}
+
+func (n *WebhookNotifier) Notify(ctx context.Context, notification Notification) error {
+	body, err := json.Marshal(slackPayload(notification))
+	if err != nil {
+		return fmt.Errorf("encode webhook payload: %w", err)
+	}
+
+	delay := n.backoff
+	for attempt := 1; ; attempt++ {
+		retry, err := n.post(ctx, notification.Rule.WebhookURL, body)
+		if err == nil {
+			return nil
+		}
+		if !retry || attempt >= n.maxAttempts {
+			return fmt.Errorf("webhook delivery failed after %d attempt(s): %w", attempt, err)
+		}
+
+		select {
+		case <-ctx.Done():
+			return fmt.Errorf("webhook delivery cancelled after %d attempt(s): %w", attempt, err)
+		case <-time.After(delay):
+		}
+		delay *= 2
+	}
+}
+
+// post makes one delivery attempt and reports whether a failure is worth retrying
+func (n *WebhookNotifier) post(ctx context.Context, url string, body []byte) (retry bool, err error) {
+	req, err := http.NewRequestWithContext(ctx, http.MethodPost, url, bytes.NewReader(body))
+	if err != nil {
+		return false, err
+	}
+	req.Header.Set("Content-Type", "application/json")
+
+	resp, err := n.client.Do(req)
+	if err != nil {
+		return ctx.Err() == nil, err
+	}
+	defer resp.Body.Close()
+	// Drain so the connection can be reused
+	_, _ = io.Copy(io.Discard, io.LimitReader(resp.Body, 64<<10))
+
+	if resp.StatusCode >= 200 && resp.StatusCode < 300 {
+		return false, nil
+	}
+	retry = resp.StatusCode == http.StatusTooManyRequests || resp.StatusCode >= 500
+	return retry, fmt.Errorf("webhook responded %s", resp.Status)
+}
+
+// slackMessage is the subset of Slack's incoming webhook format that is sent.
+// Receivers other than Slack can read the same fields
+type slackMessage struct {
+	Text        string            `json:"text"`
+	Attachments []slackAttachment `json:"attachments"`
+}
+
+type slackAttachment struct {
+	Color  string       `json:"color"`
+	Title  string       `json:"title"`
+	Fields []slackField `json:"fields"`
+	Ts     int64        `json:"ts"`
+}
+
+type slackField struct {
+	Title string `json:"title"`
+	Value string `json:"value"`
+	Short bool   `json:"short"`
+}
+
+var comparatorSymbols = map[models.AlertComparator]string{
+	models.AlertComparatorLessThan:       "<",
+	models.AlertComparatorLessOrEqual:    "<=",
+	models.AlertComparatorGreaterThan:    ">",
+	models.AlertComparatorGreaterOrEqual: ">=",
+}
+
+var metricNames = map[models.AlertMetric]string{
+	models.AlertMetricQualityScore: "quality score",
+	models.AlertMetricRatingCount:  "rating count",
+}
+
+func slackPayload(n Notification) slackMessage {
+	rule, state := n.Rule, n.State
+
+	status, color := "FIRING", "danger"
+	if n.Resolved() {
+		status, color = "RESOLVED", "good"
+	}
+	condition := fmt.Sprintf("%s %s %g", metricNames[rule.Metric], comparatorSymbols[rule.Comparator], rule.Threshold)
+
+	return slackMessage{
+		Text: fmt.Sprintf("[%s] %s: %s is %.2f over the last %s", status, rule.Name, metricNames[rule.Metric], state.Value, rule.Window),
+		Attachments: []slackAttachment{{
+			Color: color,
+			Title: rule.Name,
+			Fields: []slackField{
+				{Title: "Status", Value: status, Short: true},
+				{Title: "Condition", Value: condition, Short: true},
+				{Title: "Value", Value: fmt.Sprintf("%.2f", state.Value), Short: true},
+				{Title: "Ratings", Value: fmt.Sprintf("%d", state.Sample), Short: true},
+				{Title: "Rule ID", Value: fmt.Sprintf("%d", rule.ID), Short: true},
+			},
+			Ts: state.ChangedAt.Unix(),
+		}},
+	}
+}
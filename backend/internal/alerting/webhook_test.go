@@ -0,0 +1,154 @@
+package alerting
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"sync/atomic"
+	"testing"
+	"time"
+
+	"go-grpc-backend/internal/models"
+)
+
+// webhookStub answers each request with the next status in statuses, repeating the last one,
+// and records the decoded bodies
+type webhookStub struct {
+	statuses []int
+	calls    atomic.Int32
+	bodies   chan slackMessage
+}
+
+func newWebhookStub(t *testing.T, statuses ...int) (*webhookStub, *httptest.Server) {
+	t.Helper()
+	stub := &webhookStub{statuses: statuses, bodies: make(chan slackMessage, 16)}
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		n := int(stub.calls.Add(1))
+		if r.Method != http.MethodPost || r.Header.Get("Content-Type") != "application/json" {
+			t.Errorf("Unexpected request %s with content type %q", r.Method, r.Header.Get("Content-Type"))
+		}
+		var msg slackMessage
+		if err := json.NewDecoder(r.Body).Decode(&msg); err != nil {
+			t.Errorf("Failed to decode webhook body: %v", err)
+		}
+		stub.bodies <- msg
+		w.WriteHeader(stub.statuses[min(n, len(stub.statuses))-1])
+	}))
+	t.Cleanup(server.Close)
+	return stub, server
+}
+
+func testNotification(url string, status models.AlertStatus) Notification {
+	return Notification{
+		Rule: models.AlertRule{
+			ID:         3,
+			Name:       "Low quality",
+			Metric:     models.AlertMetricQualityScore,
+			Window:     24 * time.Hour,
+			Comparator: models.AlertComparatorLessThan,
+			Threshold:  70,
+			WebhookURL: url,
+		},
+		State: models.AlertState{
+			RuleID:    3,
+			Status:    status,
+			Value:     62.5,
+			Sample:    40,
+			ChangedAt: time.Date(2025, 3, 1, 12, 0, 0, 0, time.UTC),
+		},
+	}
+}
+
+func TestWebhookNotifier_Notify_Payload(t *testing.T) {
+	stub, server := newWebhookStub(t, http.StatusOK)
+	notifier := NewWebhookNotifier(time.Second, 3, time.Millisecond)
+
+	if err := notifier.Notify(context.Background(), testNotification(server.URL, models.AlertStatusFiring)); err != nil {
+		t.Fatalf("Notify() error = %v", err)
+	}
+	if got := stub.calls.Load(); got != 1 {
+		t.Errorf("Expected 1 call, got %d", got)
+	}
+
+	msg := <-stub.bodies
+	if msg.Text != "[FIRING] Low quality: quality score is 62.50 over the last 24h0m0s" {
+		t.Errorf("Unexpected text %q", msg.Text)
+	}
+	if len(msg.Attachments) != 1 {
+		t.Fatalf("Expected 1 attachment, got %d", len(msg.Attachments))
+	}
+	attachment := msg.Attachments[0]
+	if attachment.Color != "danger" || attachment.Ts != 1740830400 {
+		t.Errorf("Unexpected attachment %+v", attachment)
+	}
+	fields := make(map[string]string)
+	for _, f := range attachment.Fields {
+		fields[f.Title] = f.Value
+	}
+	if fields["Condition"] != "quality score < 70" || fields["Ratings"] != "40" || fields["Rule ID"] != "3" {
+		t.Errorf("Unexpected fields %v", fields)
+	}
+}
+
+func TestWebhookNotifier_Notify_Resolved(t *testing.T) {
+	stub, server := newWebhookStub(t, http.StatusNoContent)
+	notifier := NewWebhookNotifier(time.Second, 3, time.Millisecond)
+
+	if err := notifier.Notify(context.Background(), testNotification(server.URL, models.AlertStatusOK)); err != nil {
+		t.Fatalf("Notify() error = %v", err)
+	}
+
+	msg := <-stub.bodies
+	if !strings.HasPrefix(msg.Text, "[RESOLVED]") || msg.Attachments[0].Color != "good" {
+		t.Errorf("Expected a resolved message, got %+v", msg)
+	}
+}
+
+func TestWebhookNotifier_Notify_Retries(t *testing.T) {
+	tests := []struct {
+		name      string
+		statuses  []int
+		wantCalls int32
+		wantErr   bool
+	}{
+		{name: "recovers after server errors", statuses: []int{500, 503, 200}, wantCalls: 3},
+		{name: "retries rate limiting", statuses: []int{429, 200}, wantCalls: 2},
+		{name: "gives up after max attempts", statuses: []int{502}, wantCalls: 3, wantErr: true},
+		{name: "client errors are final", statuses: []int{400}, wantCalls: 1, wantErr: true},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			stub, server := newWebhookStub(t, tt.statuses...)
+			notifier := NewWebhookNotifier(time.Second, 3, time.Millisecond)
+
+			err := notifier.Notify(context.Background(), testNotification(server.URL, models.AlertStatusFiring))
+			if (err != nil) != tt.wantErr {
+				t.Errorf("Notify() error = %v, wantErr %v", err, tt.wantErr)
+			}
+			if got := stub.calls.Load(); got != tt.wantCalls {
+				t.Errorf("Expected %d calls, got %d", tt.wantCalls, got)
+			}
+		})
+	}
+}
+
+func TestWebhookNotifier_Notify_Cancelled(t *testing.T) {
+	stub, server := newWebhookStub(t, http.StatusServiceUnavailable)
+	notifier := NewWebhookNotifier(time.Second, 5, time.Hour)
+
+	ctx, cancel := context.WithCancel(context.Background())
+	go func() {
+		<-stub.bodies
+		cancel()
+	}()
+
+	if err := notifier.Notify(ctx, testNotification(server.URL, models.AlertStatusFiring)); err == nil {
+		t.Fatal("Expected error when cancelled during backoff, got nil")
+	}
+	if got := stub.calls.Load(); got != 1 {
+		t.Errorf("Expected 1 call before cancellation, got %d", got)
+	}
+}
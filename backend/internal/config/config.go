@@ -0,0 +1,253 @@
+package config
+
+import (
+	"errors"
+	"fmt"
+	"log/slog"
+	"net"
+	"net/url"
+	"time"
+)
+
+// redactedValue replaces secret values when the configuration is printed
+const redactedValue = "[REDACTED]"
+
+// Config is the effective server configuration.
+// It is assembled by Load from defaults, an optional YAML file, environment variables and flags
+type Config struct {
+	Server    ServerConfig    `yaml:"server"`
+	Database  DatabaseConfig  `yaml:"database"`
+	Analytics AnalyticsConfig `yaml:"analytics"`
+	Cache     CacheConfig     `yaml:"cache"`
+	Auth      AuthConfig      `yaml:"auth"`
+	Alerting  AlertingConfig  `yaml:"alerting"`
+	Logging   LoggingConfig   `yaml:"logging"`
+}
+
+type ServerConfig struct {
+	// ListenAddress is the host:port the gRPC listener binds to
+	ListenAddress string `yaml:"listen_address"`
+	// RequestTimeout bounds every unary RPC, including the database queries it runs; zero disables the limit
+	RequestTimeout time.Duration `yaml:"request_timeout"`
+	// ShutdownTimeout is how long in-flight RPCs may drain before they are cancelled
+	ShutdownTimeout time.Duration `yaml:"shutdown_timeout"`
+}
+
+type DatabaseConfig struct {
+	Driver string `yaml:"driver"`
+	DSN    string `yaml:"dsn"`
+	// MaxOpenConns, MaxIdleConns and ConnMaxLifetime size the read-only analytics pool.
+	// Writes always go through a single dedicated connection
+	MaxOpenConns    int           `yaml:"max_open_conns"`
+	MaxIdleConns    int           `yaml:"max_idle_conns"`
+	ConnMaxLifetime time.Duration `yaml:"conn_max_lifetime"`
+	// BusyTimeout is how long a connection waits on a locked database before failing
+	BusyTimeout time.Duration `yaml:"busy_timeout"`
+	// CacheSizeKiB is the per-connection page cache size
+	CacheSizeKiB int `yaml:"cache_size_kib"`
+	// MmapSize is the number of bytes SQLite may memory-map; zero disables mmap
+	MmapSize int64 `yaml:"mmap_size"`
+}
+
+type AnalyticsConfig struct {
+	// WeeklyGranularityThreshold is the longest range still served with daily buckets
+	WeeklyGranularityThreshold time.Duration `yaml:"weekly_granularity_threshold"`
+	// MinSampleSize is the rating count below which scores are flagged low_sample
+	MinSampleSize int `yaml:"min_sample_size"`
+}
+
+type CacheConfig struct {
+	Enabled    bool          `yaml:"enabled"`
+	TTL        time.Duration `yaml:"ttl"`
+	MaxEntries int           `yaml:"max_entries"`
+}
+
+type AuthConfig struct {
+	Enabled bool `yaml:"enabled"`
+	// Tokens are the accepted bearer tokens; they are secrets and never printed
+	Tokens []string `yaml:"tokens"`
+}
+
+type AlertingConfig struct {
+	// Enabled runs the alert rule evaluator inside the server
+	Enabled bool `yaml:"enabled"`
+	// EvaluationInterval is how often every enabled rule is evaluated
+	EvaluationInterval time.Duration `yaml:"evaluation_interval"`
+	// WebhookTimeout bounds each webhook delivery attempt
+	WebhookTimeout time.Duration `yaml:"webhook_timeout"`
+	// WebhookMaxAttempts is how many times a notification is tried before it is dropped
+	WebhookMaxAttempts int `yaml:"webhook_max_attempts"`
+	// WebhookRetryBackoff is the wait before the first retry; it doubles after each attempt
+	WebhookRetryBackoff time.Duration `yaml:"webhook_retry_backoff"`
+}
+
+type LoggingConfig struct {
+	Level  string `yaml:"level"`
+	Format string `yaml:"format"`
+}
+
+// Default returns the configuration used when nothing is overridden
+func Default() *Config {
+	return &Config{
+		Server: ServerConfig{
+			ListenAddress:   ":50051",
+			RequestTimeout:  30 * time.Second,
+			ShutdownTimeout: 15 * time.Second,
+		},
+		Database: DatabaseConfig{
+			Driver:       "sqlite3",
+			DSN:          "./database.db",
+			MaxOpenConns: 8,
+			MaxIdleConns: 8,
+			BusyTimeout:  5 * time.Second,
+			CacheSizeKiB: 8192,
+			MmapSize:     0,
+		},
+		Analytics: AnalyticsConfig{
+			WeeklyGranularityThreshold: 30 * 24 * time.Hour,
+			MinSampleSize:              10,
+		},
+		Cache: CacheConfig{
+			Enabled:    false,
+			TTL:        time.Minute,
+			MaxEntries: 1000,
+		},
+		Auth: AuthConfig{
+			Enabled: false,
+		},
+		Alerting: AlertingConfig{
+			Enabled:             true,
+			EvaluationInterval:  time.Minute,
+			WebhookTimeout:      10 * time.Second,
+			WebhookMaxAttempts:  3,
+			WebhookRetryBackoff: time.Second,
+		},
+		Logging: LoggingConfig{
+			Level:  "info",
+			Format: "text",
+		},
+	}
+}
+
+// Validate checks the configuration and reports every problem found at once
+func (c *Config) Validate() error {
+	var errs []error
+
+	if _, _, err := net.SplitHostPort(c.Server.ListenAddress); err != nil {
+		errs = append(errs, fmt.Errorf("server.listen_address %q: %w", c.Server.ListenAddress, err))
+	}
+	if c.Server.RequestTimeout < 0 {
+		errs = append(errs, errors.New("server.request_timeout must not be negative"))
+	}
+	if c.Server.ShutdownTimeout <= 0 {
+		errs = append(errs, errors.New("server.shutdown_timeout must be positive"))
+	}
+
+	if c.Database.Driver != "sqlite3" {
+		errs = append(errs, fmt.Errorf("database.driver %q is not supported (supported: sqlite3)", c.Database.Driver))
+	}
+	if c.Database.DSN == "" {
+		errs = append(errs, errors.New("database.dsn must not be empty"))
+	}
+	if c.Database.MaxOpenConns < 0 {
+		errs = append(errs, errors.New("database.max_open_conns must not be negative"))
+	}
+	if c.Database.MaxIdleConns < 0 {
+		errs = append(errs, errors.New("database.max_idle_conns must not be negative"))
+	}
+	if c.Database.MaxOpenConns > 0 && c.Database.MaxIdleConns > c.Database.MaxOpenConns {
+		errs = append(errs, errors.New("database.max_idle_conns must not exceed database.max_open_conns"))
+	}
+	if c.Database.ConnMaxLifetime < 0 {
+		errs = append(errs, errors.New("database.conn_max_lifetime must not be negative"))
+	}
+	if c.Database.BusyTimeout < 0 {
+		errs = append(errs, errors.New("database.busy_timeout must not be negative"))
+	}
+	if c.Database.CacheSizeKiB < 0 {
+		errs = append(errs, errors.New("database.cache_size_kib must not be negative"))
+	}
+	if c.Database.MmapSize < 0 {
+		errs = append(errs, errors.New("database.mmap_size must not be negative"))
+	}
+
+	if c.Analytics.WeeklyGranularityThreshold <= 0 {
+		errs = append(errs, errors.New("analytics.weekly_granularity_threshold must be positive"))
+	}
+	if c.Analytics.MinSampleSize <= 0 {
+		errs = append(errs, errors.New("analytics.min_sample_size must be positive"))
+	}
+
+	if c.Cache.Enabled {
+		if c.Cache.TTL <= 0 {
+			errs = append(errs, errors.New("cache.ttl must be positive when the cache is enabled"))
+		}
+		if c.Cache.MaxEntries <= 0 {
+			errs = append(errs, errors.New("cache.max_entries must be positive when the cache is enabled"))
+		}
+	}
+
+	if c.Auth.Enabled {
+		if len(c.Auth.Tokens) == 0 {
+			errs = append(errs, errors.New("auth.tokens must not be empty when auth is enabled"))
+		}
+		for i, token := range c.Auth.Tokens {
+			if token == "" {
+				errs = append(errs, fmt.Errorf("auth.tokens[%d] must not be empty", i))
+			}
+		}
+	}
+
+	if c.Alerting.Enabled {
+		if c.Alerting.EvaluationInterval <= 0 {
+			errs = append(errs, errors.New("alerting.evaluation_interval must be positive when alerting is enabled"))
+		}
+		if c.Alerting.WebhookTimeout <= 0 {
+			errs = append(errs, errors.New("alerting.webhook_timeout must be positive when alerting is enabled"))
+		}
+		if c.Alerting.WebhookMaxAttempts <= 0 {
+			errs = append(errs, errors.New("alerting.webhook_max_attempts must be positive when alerting is enabled"))
+		}
+		if c.Alerting.WebhookRetryBackoff < 0 {
+			errs = append(errs, errors.New("alerting.webhook_retry_backoff must not be negative"))
+		}
+	}
+
+	var level slog.Level
+	if err := level.UnmarshalText([]byte(c.Logging.Level)); err != nil {
+		errs = append(errs, fmt.Errorf("logging.level %q: %w", c.Logging.Level, err))
+	}
+	if c.Logging.Format != "text" && c.Logging.Format != "json" {
+		errs = append(errs, fmt.Errorf("logging.format %q must be text or json", c.Logging.Format))
+	}
+
+	if len(errs) > 0 {
+		return fmt.Errorf("invalid configuration: %w", errors.Join(errs...))
+	}
+	return nil
+}
+
+// Redacted returns a copy of the configuration that is safe to print
+func (c *Config) Redacted() *Config {
+	out := *c
+
+	if len(c.Auth.Tokens) > 0 {
+		out.Auth.Tokens = make([]string, len(c.Auth.Tokens))
+		for i := range out.Auth.Tokens {
+			out.Auth.Tokens[i] = redactedValue
+		}
+	}
+
+	out.Database.DSN = redactDSN(c.Database.DSN)
+
+	return &out
+}
+
+// redactDSN hides the password of URL-style DSNs; plain file paths are returned as is
+func redactDSN(dsn string) string {
+	u, err := url.Parse(dsn)
+	if err != nil || u.User == nil {
+		return dsn
+	}
+	return u.Redacted()
+}
@@ -0,0 +1,172 @@
+package config
+
+import (
+	"bytes"
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+	"time"
+)
+
+func envFrom(values map[string]string) func(string) string {
+	return func(key string) string {
+		return values[key]
+	}
+}
+
+func writeConfigFile(t *testing.T, content string) string {
+	t.Helper()
+	path := filepath.Join(t.TempDir(), "config.yaml")
+	if err := os.WriteFile(path, []byte(content), 0o600); err != nil {
+		t.Fatalf("write config file: %v", err)
+	}
+	return path
+}
+
+func TestConfig_Default_IsValid(t *testing.T) {
+	if err := Default().Validate(); err != nil {
+		t.Fatalf("Default().Validate() error = %v", err)
+	}
+}
+
+func TestConfig_Load_DefaultsWithoutOverrides(t *testing.T) {
+	cfg, err := Load(nil, envFrom(nil))
+	if err != nil {
+		t.Fatalf("Load() error = %v", err)
+	}
+
+	if cfg.Server.ListenAddress != ":50051" {
+		t.Errorf("Expected listen address :50051, got %s", cfg.Server.ListenAddress)
+	}
+	if cfg.Database.DSN != "./database.db" {
+		t.Errorf("Expected DSN ./database.db, got %s", cfg.Database.DSN)
+	}
+	if cfg.Analytics.WeeklyGranularityThreshold != 30*24*time.Hour {
+		t.Errorf("Expected 30 day threshold, got %v", cfg.Analytics.WeeklyGranularityThreshold)
+	}
+	if cfg.Analytics.MinSampleSize != 10 {
+		t.Errorf("Expected minimum sample size 10, got %d", cfg.Analytics.MinSampleSize)
+	}
+}
+
+func TestConfig_Load_LayerPrecedence(t *testing.T) {
+	path := writeConfigFile(t, `
+server:
+  listen_address: "127.0.0.1:6000"
+  request_timeout: 5s
+database:
+  dsn: file.db
+  max_open_conns: 8
+  max_idle_conns: 4
+logging:
+  level: debug
+`)
+
+	env := envFrom(map[string]string{
+		"CONFIG_FILE": path,
+		"DB_PATH":     "env.db",
+		"LOG_FORMAT":  "json",
+	})
+
+	cfg, err := Load([]string{"-log-level", "warn"}, env)
+	if err != nil {
+		t.Fatalf("Load() error = %v", err)
+	}
+
+	// File only
+	if cfg.Server.ListenAddress != "127.0.0.1:6000" {
+		t.Errorf("Expected listen address from file, got %s", cfg.Server.ListenAddress)
+	}
+	if cfg.Server.RequestTimeout != 5*time.Second {
+		t.Errorf("Expected request timeout 5s, got %v", cfg.Server.RequestTimeout)
+	}
+	if cfg.Database.MaxOpenConns != 8 || cfg.Database.MaxIdleConns != 4 {
+		t.Errorf("Expected pool 8/4, got %d/%d", cfg.Database.MaxOpenConns, cfg.Database.MaxIdleConns)
+	}
+	// Env overrides file
+	if cfg.Database.DSN != "env.db" {
+		t.Errorf("Expected DSN from env, got %s", cfg.Database.DSN)
+	}
+	if cfg.Logging.Format != "json" {
+		t.Errorf("Expected log format from env, got %s", cfg.Logging.Format)
+	}
+	// Flag overrides file
+	if cfg.Logging.Level != "warn" {
+		t.Errorf("Expected log level from flag, got %s", cfg.Logging.Level)
+	}
+}
+
+func TestConfig_Load_LegacyPortVariable(t *testing.T) {
+	cfg, err := Load(nil, envFrom(map[string]string{"GRPC_PORT": "6001"}))
+	if err != nil {
+		t.Fatalf("Load() error = %v", err)
+	}
+	if cfg.Server.ListenAddress != ":6001" {
+		t.Errorf("Expected listen address :6001, got %s", cfg.Server.ListenAddress)
+	}
+}
+
+func TestConfig_Load_UnknownFileKey(t *testing.T) {
+	path := writeConfigFile(t, "server:\n  lisen_address: \":1\"\n")
+
+	if _, err := Load([]string{"-config", path}, envFrom(nil)); err == nil {
+		t.Fatal("Expected error for unknown key, got nil")
+	}
+}
+
+func TestConfig_Load_InvalidEnvValue(t *testing.T) {
+	_, err := Load(nil, envFrom(map[string]string{"DB_MAX_OPEN_CONNS": "many"}))
+	if err == nil {
+		t.Fatal("Expected error, got nil")
+	}
+	if !strings.Contains(err.Error(), "DB_MAX_OPEN_CONNS") {
+		t.Errorf("Expected error to name the variable, got %v", err)
+	}
+}
+
+func TestConfig_Validate_ReportsAllErrors(t *testing.T) {
+	cfg := Default()
+	cfg.Server.ListenAddress = "no-port"
+	cfg.Database.Driver = "postgres"
+	cfg.Cache.Enabled = true
+	cfg.Cache.TTL = 0
+	cfg.Auth.Enabled = true
+	cfg.Alerting.WebhookMaxAttempts = 0
+	cfg.Logging.Level = "loud"
+
+	err := cfg.Validate()
+	if err == nil {
+		t.Fatal("Expected validation error, got nil")
+	}
+
+	for _, field := range []string{"server.listen_address", "database.driver", "cache.ttl", "auth.tokens", "alerting.webhook_max_attempts", "logging.level"} {
+		if !strings.Contains(err.Error(), field) {
+			t.Errorf("Expected error to mention %s, got %v", field, err)
+		}
+	}
+}
+
+func TestConfig_Redacted_HidesSecrets(t *testing.T) {
+	cfg := Default()
+	cfg.Auth.Enabled = true
+	cfg.Auth.Tokens = []string{"top-secret"}
+	cfg.Database.DSN = "postgres://app:hunter2@db:5432/analytics"
+
+	var buf bytes.Buffer
+	if err := cfg.Redacted().Write(&buf); err != nil {
+		t.Fatalf("Write() error = %v", err)
+	}
+
+	out := buf.String()
+	for _, secret := range []string{"top-secret", "hunter2"} {
+		if strings.Contains(out, secret) {
+			t.Errorf("Printed config leaks %q:\n%s", secret, out)
+		}
+	}
+
+	// The original configuration must be untouched
+	if cfg.Auth.Tokens[0] != "top-secret" {
+		t.Errorf("Redacted() modified the original tokens")
+	}
+}
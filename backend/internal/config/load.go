@@ -0,0 +1,199 @@
+package config
+
+import (
+	"bytes"
+	"errors"
+	"flag"
+	"fmt"
+	"io"
+	"os"
+	"strconv"
+	"strings"
+	"time"
+
+	"gopkg.in/yaml.v3"
+)
+
+// envBinding maps an environment variable onto a configuration field
+type envBinding struct {
+	name  string
+	apply func(c *Config, value string) error
+}
+
+// envBindings lists every supported environment override.
+// GRPC_PORT and DB_PATH are kept for compatibility with existing .env files
+var envBindings = []envBinding{
+	{"GRPC_PORT", func(c *Config, v string) error { c.Server.ListenAddress = ":" + v; return nil }},
+	{"GRPC_LISTEN_ADDRESS", setString(func(c *Config) *string { return &c.Server.ListenAddress })},
+	{"GRPC_REQUEST_TIMEOUT", setDuration(func(c *Config) *time.Duration { return &c.Server.RequestTimeout })},
+	{"GRPC_SHUTDOWN_TIMEOUT", setDuration(func(c *Config) *time.Duration { return &c.Server.ShutdownTimeout })},
+	{"DB_DRIVER", setString(func(c *Config) *string { return &c.Database.Driver })},
+	{"DB_PATH", setString(func(c *Config) *string { return &c.Database.DSN })},
+	{"DB_DSN", setString(func(c *Config) *string { return &c.Database.DSN })},
+	{"DB_MAX_OPEN_CONNS", setInt(func(c *Config) *int { return &c.Database.MaxOpenConns })},
+	{"DB_MAX_IDLE_CONNS", setInt(func(c *Config) *int { return &c.Database.MaxIdleConns })},
+	{"DB_CONN_MAX_LIFETIME", setDuration(func(c *Config) *time.Duration { return &c.Database.ConnMaxLifetime })},
+	{"DB_BUSY_TIMEOUT", setDuration(func(c *Config) *time.Duration { return &c.Database.BusyTimeout })},
+	{"DB_CACHE_SIZE_KIB", setInt(func(c *Config) *int { return &c.Database.CacheSizeKiB })},
+	{"DB_MMAP_SIZE", setInt64(func(c *Config) *int64 { return &c.Database.MmapSize })},
+	{"ANALYTICS_WEEKLY_GRANULARITY_THRESHOLD", setDuration(func(c *Config) *time.Duration { return &c.Analytics.WeeklyGranularityThreshold })},
+	{"ANALYTICS_MIN_SAMPLE_SIZE", setInt(func(c *Config) *int { return &c.Analytics.MinSampleSize })},
+	{"CACHE_ENABLED", setBool(func(c *Config) *bool { return &c.Cache.Enabled })},
+	{"CACHE_TTL", setDuration(func(c *Config) *time.Duration { return &c.Cache.TTL })},
+	{"CACHE_MAX_ENTRIES", setInt(func(c *Config) *int { return &c.Cache.MaxEntries })},
+	{"AUTH_ENABLED", setBool(func(c *Config) *bool { return &c.Auth.Enabled })},
+	{"AUTH_TOKENS", func(c *Config, v string) error { c.Auth.Tokens = splitList(v); return nil }},
+	{"ALERTING_ENABLED", setBool(func(c *Config) *bool { return &c.Alerting.Enabled })},
+	{"ALERTING_EVALUATION_INTERVAL", setDuration(func(c *Config) *time.Duration { return &c.Alerting.EvaluationInterval })},
+	{"ALERTING_WEBHOOK_TIMEOUT", setDuration(func(c *Config) *time.Duration { return &c.Alerting.WebhookTimeout })},
+	{"ALERTING_WEBHOOK_MAX_ATTEMPTS", setInt(func(c *Config) *int { return &c.Alerting.WebhookMaxAttempts })},
+	{"ALERTING_WEBHOOK_RETRY_BACKOFF", setDuration(func(c *Config) *time.Duration { return &c.Alerting.WebhookRetryBackoff })},
+	{"LOG_LEVEL", setString(func(c *Config) *string { return &c.Logging.Level })},
+	{"LOG_FORMAT", setString(func(c *Config) *string { return &c.Logging.Format })},
+}
+
+// Load builds the effective configuration for the given command line arguments.
+// Layers are applied in order: defaults, YAML file (-config or CONFIG_FILE), environment, flags.
+// The result is validated before it is returned
+func Load(args []string, getenv func(string) string) (*Config, error) {
+	fs := flag.NewFlagSet("server", flag.ContinueOnError)
+
+	var (
+		configFile    = fs.String("config", getenv("CONFIG_FILE"), "Path to YAML configuration file")
+		listenAddress = fs.String("listen", "", "gRPC listen address (host:port)")
+		dbDriver      = fs.String("db-driver", "", "Database driver")
+		dbDSN         = fs.String("db-dsn", "", "Database DSN")
+		logLevel      = fs.String("log-level", "", "Log level (debug, info, warn, error)")
+		logFormat     = fs.String("log-format", "", "Log format (text, json)")
+	)
+	if err := fs.Parse(args); err != nil {
+		return nil, fmt.Errorf("parse flags: %w", err)
+	}
+
+	cfg := Default()
+
+	if *configFile != "" {
+		if err := loadFile(cfg, *configFile); err != nil {
+			return nil, err
+		}
+	}
+
+	for _, b := range envBindings {
+		value := getenv(b.name)
+		if value == "" {
+			continue
+		}
+		if err := b.apply(cfg, value); err != nil {
+			return nil, fmt.Errorf("environment variable %s: %w", b.name, err)
+		}
+	}
+
+	fs.Visit(func(f *flag.Flag) {
+		switch f.Name {
+		case "listen":
+			cfg.Server.ListenAddress = *listenAddress
+		case "db-driver":
+			cfg.Database.Driver = *dbDriver
+		case "db-dsn":
+			cfg.Database.DSN = *dbDSN
+		case "log-level":
+			cfg.Logging.Level = *logLevel
+		case "log-format":
+			cfg.Logging.Format = *logFormat
+		}
+	})
+
+	if err := cfg.Validate(); err != nil {
+		return nil, err
+	}
+
+	return cfg, nil
+}
+
+// loadFile overlays the YAML file at path onto cfg; unknown keys are rejected
+func loadFile(cfg *Config, path string) error {
+	data, err := os.ReadFile(path)
+	if err != nil {
+		return fmt.Errorf("read config file: %w", err)
+	}
+
+	dec := yaml.NewDecoder(bytes.NewReader(data))
+	dec.KnownFields(true)
+	if err := dec.Decode(cfg); err != nil && !errors.Is(err, io.EOF) {
+		return fmt.Errorf("parse config file %s: %w", path, err)
+	}
+
+	return nil
+}
+
+// Write prints the configuration as YAML
+func (c *Config) Write(w io.Writer) error {
+	enc := yaml.NewEncoder(w)
+	enc.SetIndent(2)
+	if err := enc.Encode(c); err != nil {
+		return fmt.Errorf("encode config: %w", err)
+	}
+	return enc.Close()
+}
+
+func setString(field func(*Config) *string) func(*Config, string) error {
+	return func(c *Config, v string) error {
+		*field(c) = v
+		return nil
+	}
+}
+
+func setInt(field func(*Config) *int) func(*Config, string) error {
+	return func(c *Config, v string) error {
+		n, err := strconv.Atoi(v)
+		if err != nil {
+			return err
+		}
+		*field(c) = n
+		return nil
+	}
+}
+
+func setInt64(field func(*Config) *int64) func(*Config, string) error {
+	return func(c *Config, v string) error {
+		n, err := strconv.ParseInt(v, 10, 64)
+		if err != nil {
+			return err
+		}
+		*field(c) = n
+		return nil
+	}
+}
+
+func setBool(field func(*Config) *bool) func(*Config, string) error {
+	return func(c *Config, v string) error {
+		b, err := strconv.ParseBool(v)
+		if err != nil {
+			return err
+		}
+		*field(c) = b
+		return nil
+	}
+}
+
+func setDuration(field func(*Config) *time.Duration) func(*Config, string) error {
+	return func(c *Config, v string) error {
+		d, err := time.ParseDuration(v)
+		if err != nil {
+			return err
+		}
+		*field(c) = d
+		return nil
+	}
+}
+
+func splitList(v string) []string {
+	parts := strings.Split(v, ",")
+	out := make([]string, 0, len(parts))
+	for _, p := range parts {
+		if p = strings.TrimSpace(p); p != "" {
+			out = append(out, p)
+		}
+	}
+	return out
+}
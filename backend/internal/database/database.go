@@ -1,55 +1,136 @@
 package database
 
 import (
+	"context"
 	"database/sql"
+	"database/sql/driver"
 	"fmt"
 	"log"
-	"os"
+	"strings"
 
-	_ "github.com/mattn/go-sqlite3"
+	"go-grpc-backend/internal/config"
+
+	"github.com/mattn/go-sqlite3"
 )
 
+// Database holds two pools over the same SQLite file.
+// Analytics reads go through ReadDB so they never queue behind the single writer connection
 type Database struct {
+	// DB is the single writer connection; use it for inserts, updates and schema changes
 	DB *sql.DB
+	// ReadDB is the read-only pool (mode=ro) used for analytics queries.
+	// For in-memory databases it is the same pool as DB
+	ReadDB *sql.DB
 }
 
-func NewDatabase() (*Database, error) {
-	dbPath := getEnv("DB_PATH", "./database.db")
-
-	db, err := sql.Open("sqlite3", dbPath)
-	if err != nil {
-		return nil, fmt.Errorf("failed to open database: %v", err)
-	}
+func NewDatabase(cfg config.DatabaseConfig) (*Database, error) {
+	// The driver is validated by config; only sqlite3 is supported
+	writer := openPool(cfg.DSN, pragmas(cfg, false))
+	writer.SetMaxOpenConns(1)
 
-	if err := db.Ping(); err != nil {
+	if err := writer.Ping(); err != nil {
+		writer.Close()
 		return nil, fmt.Errorf("failed to ping database: %v", err)
 	}
 
-	if _, err := db.Exec("PRAGMA foreign_keys = ON"); err != nil {
-		return nil, fmt.Errorf("failed to enable foreign keys: %v", err)
+	if err := Migrate(writer); err != nil {
+		writer.Close()
+		return nil, fmt.Errorf("failed to migrate database: %v", err)
 	}
 
-	if _, err := db.Exec("PRAGMA journal_mode = WAL"); err != nil {
-		return nil, fmt.Errorf("failed to enable WAL mode: %v", err)
-	}
+	database := &Database{DB: writer, ReadDB: writer}
+
+	if !isMemoryDSN(cfg.DSN) {
+		reader := openPool(withQueryParam(cfg.DSN, "mode=ro"), pragmas(cfg, true))
+		reader.SetMaxOpenConns(cfg.MaxOpenConns)
+		reader.SetMaxIdleConns(cfg.MaxIdleConns)
+		reader.SetConnMaxLifetime(cfg.ConnMaxLifetime)
 
-	database := &Database{DB: db}
+		if err := reader.Ping(); err != nil {
+			reader.Close()
+			writer.Close()
+			return nil, fmt.Errorf("failed to ping read-only database: %v", err)
+		}
+		database.ReadDB = reader
+	}
 
-	log.Printf("Connected to database: %s", dbPath)
+	log.Printf("Connected to database: %s", cfg.DSN)
 
 	return database, nil
 }
 
 func (d *Database) Close() error {
+	var readErr error
+	if d.ReadDB != nil && d.ReadDB != d.DB {
+		readErr = d.ReadDB.Close()
+	}
 	if d.DB != nil {
-		return d.DB.Close()
+		if err := d.DB.Close(); err != nil {
+			return err
+		}
+	}
+	return readErr
+}
+
+// pragmas returns the statements run on every new connection.
+// PRAGMAs are per connection in SQLite, so running them once after sql.Open would only tune one pool member
+func pragmas(cfg config.DatabaseConfig, readOnly bool) []string {
+	stmts := []string{
+		"PRAGMA foreign_keys = ON",
+		fmt.Sprintf("PRAGMA busy_timeout = %d", cfg.BusyTimeout.Milliseconds()),
+		// Negative cache_size is interpreted by SQLite as KiB rather than pages
+		fmt.Sprintf("PRAGMA cache_size = -%d", cfg.CacheSizeKiB),
+		fmt.Sprintf("PRAGMA mmap_size = %d", cfg.MmapSize),
+	}
+	if readOnly {
+		stmts = append(stmts, "PRAGMA query_only = ON")
+	} else {
+		stmts = append(stmts, "PRAGMA journal_mode = WAL")
 	}
-	return nil
+	return stmts
 }
 
-func getEnv(key, defaultValue string) string {
-	if value := os.Getenv(key); value != "" {
-		return value
+// openPool opens a pool whose connections all run the given pragmas on connect
+func openPool(dsn string, pragmas []string) *sql.DB {
+	drv := &sqlite3.SQLiteDriver{
+		ConnectHook: func(conn *sqlite3.SQLiteConn) error {
+			for _, stmt := range pragmas {
+				if _, err := conn.Exec(stmt, nil); err != nil {
+					return fmt.Errorf("%s: %w", stmt, err)
+				}
+			}
+			return nil
+		},
 	}
-	return defaultValue
+	return sql.OpenDB(&connector{driver: drv, dsn: dsn})
+}
+
+// connector adapts SQLiteDriver to driver.Connector so each pool gets its own ConnectHook
+// without registering a global driver name
+type connector struct {
+	driver *sqlite3.SQLiteDriver
+	dsn    string
+}
+
+func (c *connector) Connect(ctx context.Context) (driver.Conn, error) {
+	return c.driver.Open(c.dsn)
+}
+
+func (c *connector) Driver() driver.Driver {
+	return c.driver
+}
+
+// withQueryParam turns a plain path into a file: URI and appends param to its query string
+func withQueryParam(dsn, param string) string {
+	if !strings.HasPrefix(dsn, "file:") {
+		dsn = "file:" + dsn
+	}
+	if strings.Contains(dsn, "?") {
+		return dsn + "&" + param
+	}
+	return dsn + "?" + param
+}
+
+func isMemoryDSN(dsn string) bool {
+	return strings.Contains(dsn, ":memory:") || strings.Contains(dsn, "mode=memory")
 }
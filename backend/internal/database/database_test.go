@@ -0,0 +1,133 @@
+package database
+
+import (
+	"path/filepath"
+	"testing"
+	"time"
+
+	"go-grpc-backend/internal/config"
+)
+
+func testConfig(t *testing.T) config.DatabaseConfig {
+	t.Helper()
+	cfg := config.Default().Database
+	cfg.DSN = filepath.Join(t.TempDir(), "test.db")
+	cfg.BusyTimeout = 1500 * time.Millisecond
+	cfg.CacheSizeKiB = 4096
+	cfg.MmapSize = 1 << 20
+	return cfg
+}
+
+func TestDatabase_NewDatabase_AppliesPragmasToBothPools(t *testing.T) {
+	db, err := NewDatabase(testConfig(t))
+	if err != nil {
+		t.Fatalf("NewDatabase() error = %v", err)
+	}
+	defer db.Close()
+
+	if db.ReadDB == db.DB {
+		t.Fatal("Expected separate read and write pools for a file database")
+	}
+
+	tests := []struct {
+		pragma   string
+		expected int64
+	}{
+		{"busy_timeout", 1500},
+		{"cache_size", -4096},
+		{"mmap_size", 1 << 20},
+		{"foreign_keys", 1},
+	}
+
+	for _, tt := range tests {
+		var writerValue, readerValue int64
+		if err := db.DB.QueryRow("PRAGMA " + tt.pragma).Scan(&writerValue); err != nil {
+			t.Fatalf("writer PRAGMA %s error = %v", tt.pragma, err)
+		}
+		if err := db.ReadDB.QueryRow("PRAGMA " + tt.pragma).Scan(&readerValue); err != nil {
+			t.Fatalf("reader PRAGMA %s error = %v", tt.pragma, err)
+		}
+		if writerValue != tt.expected {
+			t.Errorf("writer PRAGMA %s = %d, expected %d", tt.pragma, writerValue, tt.expected)
+		}
+		if readerValue != tt.expected {
+			t.Errorf("reader PRAGMA %s = %d, expected %d", tt.pragma, readerValue, tt.expected)
+		}
+	}
+
+	var journalMode string
+	if err := db.DB.QueryRow("PRAGMA journal_mode").Scan(&journalMode); err != nil {
+		t.Fatalf("PRAGMA journal_mode error = %v", err)
+	}
+	if journalMode != "wal" {
+		t.Errorf("Expected WAL journal mode, got %s", journalMode)
+	}
+}
+
+func TestDatabase_NewDatabase_ReadPoolRejectsWrites(t *testing.T) {
+	db, err := NewDatabase(testConfig(t))
+	if err != nil {
+		t.Fatalf("NewDatabase() error = %v", err)
+	}
+	defer db.Close()
+
+	if _, err := db.DB.Exec("CREATE TABLE t (id INTEGER)"); err != nil {
+		t.Fatalf("writer CREATE TABLE error = %v", err)
+	}
+	if _, err := db.ReadDB.Exec("INSERT INTO t (id) VALUES (1)"); err == nil {
+		t.Error("Expected read-only pool to reject INSERT")
+	}
+
+	if _, err := db.DB.Exec("INSERT INTO t (id) VALUES (1)"); err != nil {
+		t.Fatalf("writer INSERT error = %v", err)
+	}
+	var count int
+	if err := db.ReadDB.QueryRow("SELECT COUNT(*) FROM t").Scan(&count); err != nil {
+		t.Fatalf("reader SELECT error = %v", err)
+	}
+	if count != 1 {
+		t.Errorf("Expected reader to see 1 row, got %d", count)
+	}
+}
+
+func TestDatabase_NewDatabase_InMemorySharesPool(t *testing.T) {
+	cfg := config.Default().Database
+	cfg.DSN = ":memory:"
+
+	db, err := NewDatabase(cfg)
+	if err != nil {
+		t.Fatalf("NewDatabase() error = %v", err)
+	}
+	defer db.Close()
+
+	if db.ReadDB != db.DB {
+		t.Error("Expected in-memory database to use a single pool")
+	}
+}
+
+func TestDatabase_NewDatabase_Migrates(t *testing.T) {
+	cfg := testConfig(t)
+	db, err := NewDatabase(cfg)
+	if err != nil {
+		t.Fatalf("NewDatabase() error = %v", err)
+	}
+
+	var version int
+	if err := db.DB.QueryRow("PRAGMA user_version").Scan(&version); err != nil {
+		t.Fatalf("PRAGMA user_version error = %v", err)
+	}
+	if version != len(migrations) {
+		t.Errorf("Expected schema version %d, got %d", len(migrations), version)
+	}
+	if _, err := db.ReadDB.Exec("SELECT COUNT(*) FROM alert_rules"); err != nil {
+		t.Errorf("Expected alert_rules to exist, got %v", err)
+	}
+	db.Close()
+
+	// Reopening an up-to-date database applies nothing
+	db, err = NewDatabase(cfg)
+	if err != nil {
+		t.Fatalf("NewDatabase() on a migrated database error = %v", err)
+	}
+	db.Close()
+}
@@ -0,0 +1,117 @@
+package database
+
+import (
+	"database/sql"
+	"fmt"
+)
+
+// migrations create the tables the server owns, in order. The analytics tables (users, tickets,
+// rating_categories, ratings) ship with database.db and their schema is never altered here.
+// PRAGMA user_version records how many have been applied: append new entries, never edit old ones
+var migrations = []string{
+	// 1: alert rules and their evaluation state
+	`CREATE TABLE alert_rules (
+		id          INTEGER PRIMARY KEY AUTOINCREMENT,
+		name        TEXT NOT NULL,
+		metric      TEXT NOT NULL,
+		window_ns   INTEGER NOT NULL,
+		comparator  TEXT NOT NULL,
+		threshold   REAL NOT NULL,
+		min_sample  INTEGER NOT NULL DEFAULT 0,
+		webhook_url TEXT NOT NULL,
+		enabled     INTEGER NOT NULL DEFAULT 1,
+		created_at  DATETIME NOT NULL
+	);
+	CREATE TABLE alert_rule_categories (
+		rule_id     INTEGER NOT NULL REFERENCES alert_rules (id) ON DELETE CASCADE,
+		category_id INTEGER NOT NULL,
+		PRIMARY KEY (rule_id, category_id)
+	);
+	CREATE TABLE alert_states (
+		rule_id      INTEGER PRIMARY KEY REFERENCES alert_rules (id) ON DELETE CASCADE,
+		status       TEXT NOT NULL,
+		value        REAL NOT NULL,
+		sample       INTEGER NOT NULL,
+		sufficient   INTEGER NOT NULL,
+		changed_at   DATETIME NOT NULL,
+		evaluated_at DATETIME NOT NULL
+	);`,
+	// 2: quality targets per rating category
+	`CREATE TABLE quality_targets (
+		id           INTEGER PRIMARY KEY AUTOINCREMENT,
+		category_id  INTEGER NOT NULL REFERENCES rating_categories (id) ON DELETE CASCADE,
+		target_score REAL NOT NULL,
+		window_ns    INTEGER NOT NULL,
+		created_at   DATETIME NOT NULL
+	);
+	CREATE INDEX idx_quality_targets_category ON quality_targets (category_id);`,
+	// 3: key/value attributes of tickets, such as channel or priority
+	`CREATE TABLE ticket_attributes (
+		ticket_id  INTEGER NOT NULL REFERENCES tickets (id) ON DELETE CASCADE,
+		key        TEXT NOT NULL,
+		value      TEXT NOT NULL,
+		updated_at DATETIME NOT NULL,
+		PRIMARY KEY (ticket_id, key)
+	);
+	CREATE INDEX idx_ticket_attributes_key ON ticket_attributes (key, value);`,
+	// 4: org > department > team hierarchy and dated user memberships
+	`CREATE TABLE teams (
+		id         INTEGER PRIMARY KEY AUTOINCREMENT,
+		name       TEXT NOT NULL,
+		kind       TEXT NOT NULL,
+		parent_id  INTEGER REFERENCES teams (id),
+		created_at DATETIME NOT NULL
+	);
+	CREATE INDEX idx_teams_parent ON teams (parent_id);
+	CREATE TABLE team_memberships (
+		id         INTEGER PRIMARY KEY AUTOINCREMENT,
+		team_id    INTEGER NOT NULL REFERENCES teams (id) ON DELETE CASCADE,
+		user_id    INTEGER NOT NULL REFERENCES users (id) ON DELETE CASCADE,
+		valid_from DATETIME NOT NULL,
+		valid_to   DATETIME
+	);
+	CREATE INDEX idx_team_memberships_team ON team_memberships (team_id);
+	CREATE INDEX idx_team_memberships_user ON team_memberships (user_id, valid_from);`,
+	// 5: weight history and archiving of rating categories
+	`CREATE TABLE rating_category_weights (
+		id             INTEGER PRIMARY KEY AUTOINCREMENT,
+		category_id    INTEGER NOT NULL REFERENCES rating_categories (id) ON DELETE CASCADE,
+		weight         REAL NOT NULL,
+		effective_from DATETIME NOT NULL
+	);
+	CREATE INDEX idx_rating_category_weights_category ON rating_category_weights (category_id, effective_from);
+	CREATE TABLE rating_category_archives (
+		category_id INTEGER PRIMARY KEY REFERENCES rating_categories (id) ON DELETE CASCADE,
+		archived_at DATETIME NOT NULL
+	);`,
+	// 6: alert notifications still to be delivered
+	`ALTER TABLE alert_states ADD COLUMN notify_pending INTEGER NOT NULL DEFAULT 0;`,
+}
+
+// Migrate applies the migrations db hasn't seen yet, each in its own transaction
+func Migrate(db *sql.DB) error {
+	var version int
+	if err := db.QueryRow("PRAGMA user_version").Scan(&version); err != nil {
+		return fmt.Errorf("read schema version: %w", err)
+	}
+
+	for i := version; i < len(migrations); i++ {
+		tx, err := db.Begin()
+		if err != nil {
+			return fmt.Errorf("migration %d: %w", i+1, err)
+		}
+		if _, err := tx.Exec(migrations[i]); err != nil {
+			tx.Rollback()
+			return fmt.Errorf("migration %d: %w", i+1, err)
+		}
+		// PRAGMA doesn't take bind parameters
+		if _, err := tx.Exec(fmt.Sprintf("PRAGMA user_version = %d", i+1)); err != nil {
+			tx.Rollback()
+			return fmt.Errorf("migration %d: %w", i+1, err)
+		}
+		if err := tx.Commit(); err != nil {
+			return fmt.Errorf("migration %d: %w", i+1, err)
+		}
+	}
+	return nil
+}
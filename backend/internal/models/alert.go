@@ -0,0 +1,78 @@
+package models
+
+import "time"
+
+// AlertMetric is the value an alert rule watches over its window
+type AlertMetric string
+
+const (
+	// AlertMetricQualityScore is the overall quality score of the rule's categories
+	AlertMetricQualityScore AlertMetric = "quality_score"
+	// AlertMetricRatingCount is the number of ratings in the rule's categories
+	AlertMetricRatingCount AlertMetric = "rating_count"
+)
+
+// AlertComparator decides whether a metric value breaches the threshold
+type AlertComparator string
+
+const (
+	AlertComparatorLessThan       AlertComparator = "lt"
+	AlertComparatorLessOrEqual    AlertComparator = "lte"
+	AlertComparatorGreaterThan    AlertComparator = "gt"
+	AlertComparatorGreaterOrEqual AlertComparator = "gte"
+)
+
+// Breached reports whether value compared to threshold meets the condition
+func (c AlertComparator) Breached(value, threshold float64) bool {
+	switch c {
+	case AlertComparatorLessThan:
+		return value < threshold
+	case AlertComparatorLessOrEqual:
+		return value <= threshold
+	case AlertComparatorGreaterThan:
+		return value > threshold
+	case AlertComparatorGreaterOrEqual:
+		return value >= threshold
+	}
+	return false
+}
+
+// AlertRule fires when its metric over the trailing window breaches the threshold
+type AlertRule struct {
+	ID     int         `json:"id" db:"id"`
+	Name   string      `json:"name" db:"name"`
+	Metric AlertMetric `json:"metric" db:"metric"`
+	// CategoryIDs restricts the metric to these categories; empty means all
+	CategoryIDs []int           `json:"category_ids"`
+	Window      time.Duration   `json:"window" db:"window_ns"`
+	Comparator  AlertComparator `json:"comparator" db:"comparator"`
+	Threshold   float64         `json:"threshold" db:"threshold"`
+	// MinSample is the number of ratings the window needs before a quality score rule can change state
+	MinSample  int       `json:"min_sample" db:"min_sample"`
+	WebhookURL string    `json:"webhook_url" db:"webhook_url"`
+	Enabled    bool      `json:"enabled" db:"enabled"`
+	CreatedAt  time.Time `json:"created_at" db:"created_at"`
+}
+
+// AlertStatus is whether a rule is currently firing
+type AlertStatus string
+
+const (
+	AlertStatusOK     AlertStatus = "ok"
+	AlertStatusFiring AlertStatus = "firing"
+)
+
+// AlertState is the outcome of a rule's latest evaluation
+type AlertState struct {
+	RuleID int         `json:"rule_id" db:"rule_id"`
+	Status AlertStatus `json:"status" db:"status"`
+	Value  float64     `json:"value" db:"value"`
+	Sample int         `json:"sample" db:"sample"`
+	// Sufficient is false when the window held fewer than MinSample ratings; Status was kept as it was
+	Sufficient bool `json:"sufficient" db:"sufficient"`
+	// ChangedAt is when Status last changed
+	ChangedAt   time.Time `json:"changed_at" db:"changed_at"`
+	EvaluatedAt time.Time `json:"evaluated_at" db:"evaluated_at"`
+	// NotifyPending is set from a status change until the notification for it is delivered
+	NotifyPending bool `json:"notify_pending" db:"notify_pending"`
+}
@@ -13,6 +13,7 @@ type CategoryRatingOverTimePeriod struct {
 	RatingCount       int       `json:"rating_count" db:"rating_count"`
 	Date              time.Time `json:"date" db:"bucket_date"`
 	RatingsTotalCount int       `json:"ratings_total_count" db:"ratings_total_count"`
+	RatingVariance    float64   `json:"rating_variance" db:"rating_variance"` // Population variance of the raw ratings
 }
 
 // response
@@ -49,12 +50,15 @@ type AggregatedResponse struct {
 }
 
 type TicketCategoryScore struct {
-	TicketID       int     `json:"ticket_id" db:"ticket_id"`
-	CategoryID     int     `json:"category_id" db:"category_id"`
-	CategoryName   string  `json:"category_name" db:"category_name"`
-	CategoryWeight float64 `json:"category_weight" db:"category_weight"`
-	Score          float64 `json:"score" db:"score"`
-	RatingCount    int     `json:"rating_count" db:"rating_count"`
+	TicketID        int       `json:"ticket_id" db:"ticket_id"`
+	TicketSubject   string    `json:"ticket_subject" db:"ticket_subject"`
+	TicketCreatedAt time.Time `json:"ticket_created_at" db:"ticket_created_at"`
+	CategoryID      int       `json:"category_id" db:"category_id"`
+	CategoryName    string    `json:"category_name" db:"category_name"`
+	CategoryWeight  float64   `json:"category_weight" db:"category_weight"`
+	Score           float64   `json:"score" db:"score"`
+	RatingCount     int       `json:"rating_count" db:"rating_count"`
+	RatingVariance  float64   `json:"rating_variance" db:"rating_variance"` // Population variance of the raw ratings
 }
 
 type OverallQualityScore struct {
@@ -74,10 +78,18 @@ type PeriodOverPeriodChange struct {
 	PreviousEnd         time.Time `json:"previous_end" db:"previous_end"`
 }
 
+// PeriodCategoryScore is a category's score within one of several periods queried together
+type PeriodCategoryScore struct {
+	PeriodIndex int `json:"period_index" db:"period_index"` // Index into the queried periods
+	CategoryScore
+}
+
 type CategoryScore struct {
-	CategoryID     int     `json:"category_id" db:"category_id"`
-	CategoryName   string  `json:"category_name" db:"category_name"`
-	CategoryWeight float64 `json:"category_weight" db:"category_weight"`
-	Score          float64 `json:"score" db:"score"`
-	RatingCount    int     `json:"rating_count" db:"rating_count"`
+	CategoryID       int     `json:"category_id" db:"category_id"`
+	CategoryName     string  `json:"category_name" db:"category_name"`
+	CategoryWeight   float64 `json:"category_weight" db:"category_weight"`
+	Score            float64 `json:"score" db:"score"`
+	RatingCount      int     `json:"rating_count" db:"rating_count"`
+	RatingVariance   float64 `json:"rating_variance" db:"rating_variance"`     // Population variance of the raw ratings
+	WeightedVariance float64 `json:"weighted_variance" db:"weighted_variance"` // Population variance of rating * weight at each rating's time
 }
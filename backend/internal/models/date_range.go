@@ -0,0 +1,44 @@
+package models
+
+import (
+	"fmt"
+	"time"
+)
+
+// DateBasis is the timestamp a DateRange applies to
+type DateBasis string
+
+const (
+	// DateBasisRatingCreated filters and buckets ratings by when they were created. It is the zero value
+	DateBasisRatingCreated DateBasis = ""
+	// DateBasisTicketCreated filters and buckets ratings by when their ticket was created
+	DateBasisTicketCreated DateBasis = "ticket_created"
+)
+
+// DateRange is the time window every analytics query filters ratings by.
+// It is half-open, [Start, End), unless InclusiveEnd is set, in which case it is [Start, End].
+// Half-open ranges tile without overlap: summing consecutive ranges never counts a rating twice
+type DateRange struct {
+	Start        time.Time `json:"start"`
+	End          time.Time `json:"end"`
+	InclusiveEnd bool      `json:"inclusive_end"`
+	Basis        DateBasis `json:"basis,omitempty"`
+}
+
+// NewDateRange returns the half-open range [start, end)
+func NewDateRange(start, end time.Time) DateRange {
+	return DateRange{Start: start, End: end}
+}
+
+// Duration is the length of the range
+func (r DateRange) Duration() time.Duration {
+	return r.End.Sub(r.Start)
+}
+
+// Validate rejects ranges whose end precedes their start
+func (r DateRange) Validate() error {
+	if r.End.Before(r.Start) {
+		return fmt.Errorf("end %s is before start %s", r.End.Format(time.RFC3339), r.Start.Format(time.RFC3339))
+	}
+	return nil
+}
@@ -0,0 +1,21 @@
+package models
+
+import "time"
+
+// ScoreGrouping picks the dimensions ratings are grouped by, on top of their category.
+// The zero value groups every rating of a category together
+type ScoreGrouping struct {
+	AttributeKeys []string    `json:"attribute_keys"` // Ticket attributes, in order
+	Reviewee      bool        `json:"reviewee"`
+	Granularity   Granularity `json:"granularity"` // Time bucket; empty for none
+}
+
+// GroupedCategoryScore is a category's score within one group of a ScoreGrouping
+type GroupedCategoryScore struct {
+	// AttributeValues follows ScoreGrouping.AttributeKeys; tickets without an attribute have ""
+	AttributeValues []string  `json:"attribute_values"`
+	RevieweeID      int       `json:"reviewee_id" db:"reviewee_id"` // Zero when not grouped by reviewee
+	RevieweeName    string    `json:"reviewee_name" db:"reviewee_name"`
+	Date            time.Time `json:"date" db:"bucket_date"` // Bucket start; zero when not bucketed
+	CategoryScore
+}
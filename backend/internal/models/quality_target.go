@@ -0,0 +1,16 @@
+package models
+
+import "time"
+
+// QualityTarget is the score a rating category is expected to hold over a trailing window.
+// TargetScore uses the same scale as the category scores of the analytics RPCs
+type QualityTarget struct {
+	ID         int `json:"id" db:"id"`
+	CategoryID int `json:"category_id" db:"category_id"`
+	// CategoryName and CategoryWeight are read from rating_categories
+	CategoryName   string        `json:"category_name" db:"category_name"`
+	CategoryWeight float64       `json:"category_weight" db:"category_weight"`
+	TargetScore    float64       `json:"target_score" db:"target_score"`
+	Window         time.Duration `json:"window" db:"window_ns"`
+	CreatedAt      time.Time     `json:"created_at" db:"created_at"`
+}
@@ -1,7 +1,24 @@
 package models
 
+import "time"
+
 type RatingCategory struct {
-	ID     int    `json:"id" db:"id"`
-	Name   string `json:"name" db:"name"`
-	Weight int    `json:"weight" db:"weight"`
+	ID         int       `json:"id" db:"id"`
+	Name       string    `json:"name" db:"name"`
+	Weight     float64   `json:"weight" db:"weight"`
+	ArchivedAt time.Time `json:"archived_at" db:"archived_at"` // Zero while the category is active
+}
+
+// Archived reports whether the category has been archived
+func (c RatingCategory) Archived() bool {
+	return !c.ArchivedAt.IsZero()
+}
+
+// RatingCategoryWeight is a weight a category had from EffectiveFrom until its next entry.
+// The weight a category started with is recorded with a zero EffectiveFrom once it first changes
+type RatingCategoryWeight struct {
+	ID            int       `json:"id" db:"id"`
+	CategoryID    int       `json:"category_id" db:"category_id"`
+	Weight        float64   `json:"weight" db:"weight"`
+	EffectiveFrom time.Time `json:"effective_from" db:"effective_from"`
 }
@@ -0,0 +1,87 @@
+package models
+
+import (
+	"math"
+	"time"
+)
+
+// MaxRatingValue is the highest value a rating can take; ratings range from 0 to MaxRatingValue
+const MaxRatingValue = 5
+
+// RatingDistribution is the histogram of a category's ratings, over the whole range
+// or over one time bucket. Because ratings are whole numbers the histogram is exact,
+// so histograms can be merged and every statistic derived from Counts
+type RatingDistribution struct {
+	CategoryID   int                     `json:"category_id" db:"category_id"`
+	CategoryName string                  `json:"category_name" db:"category_name"`
+	Date         time.Time               `json:"date" db:"bucket_date"` // Bucket start; zero when not bucketed
+	Counts       [MaxRatingValue + 1]int `json:"counts"`                // Counts[v] is the number of ratings with value v
+	Total        int                     `json:"total"`
+	Mean         float64                 `json:"mean"`
+	Median       float64                 `json:"median"`
+	StdDev       float64                 `json:"std_dev"`
+}
+
+// Add merges other's counts into d and refreshes the statistics
+func (d *RatingDistribution) Add(other RatingDistribution) {
+	for v, n := range other.Counts {
+		d.Counts[v] += n
+	}
+	d.Summarize()
+}
+
+// Summarize recomputes Total, Mean, Median and StdDev from Counts.
+// StdDev is the population standard deviation
+func (d *RatingDistribution) Summarize() {
+	d.Total = 0
+	var sum float64
+	for v, n := range d.Counts {
+		d.Total += n
+		sum += float64(v * n)
+	}
+	if d.Total == 0 {
+		d.Mean, d.Median, d.StdDev = 0, 0, 0
+		return
+	}
+
+	d.Mean = sum / float64(d.Total)
+
+	var sq float64
+	for v, n := range d.Counts {
+		diff := float64(v) - d.Mean
+		sq += diff * diff * float64(n)
+	}
+	d.StdDev = math.Sqrt(sq / float64(d.Total))
+	d.Median = d.Percentile(50)
+}
+
+// Percentile returns the p-th percentile (0-100), interpolating linearly between
+// the closest ranks. Returns 0 for an empty distribution
+func (d *RatingDistribution) Percentile(p float64) float64 {
+	total := 0
+	for _, n := range d.Counts {
+		total += n
+	}
+	if total == 0 {
+		return 0
+	}
+
+	h := float64(total-1) * p / 100
+	lo := int(math.Floor(h))
+	lower := d.valueAt(lo)
+	if lo+1 >= total {
+		return lower
+	}
+	return lower + (h-float64(lo))*(d.valueAt(lo+1)-lower)
+}
+
+// valueAt returns the i-th smallest rating (0-based)
+func (d *RatingDistribution) valueAt(i int) float64 {
+	for v, n := range d.Counts {
+		if i < n {
+			return float64(v)
+		}
+		i -= n
+	}
+	return MaxRatingValue
+}
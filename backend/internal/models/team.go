@@ -0,0 +1,54 @@
+package models
+
+import "time"
+
+// TeamKind is a team's level in the org > department > team hierarchy
+type TeamKind string
+
+const (
+	TeamKindOrg        TeamKind = "org"
+	TeamKindDepartment TeamKind = "department"
+	TeamKindTeam       TeamKind = "team"
+)
+
+// ParentKind is the kind a team of kind k must sit under; orgs have no parent
+func (k TeamKind) ParentKind() (parent TeamKind, ok bool) {
+	switch k {
+	case TeamKindDepartment:
+		return TeamKindOrg, true
+	case TeamKindTeam:
+		return TeamKindDepartment, true
+	}
+	return "", false
+}
+
+type Team struct {
+	ID        int       `json:"id" db:"id"`
+	Name      string    `json:"name" db:"name"`
+	Kind      TeamKind  `json:"kind" db:"kind"`
+	ParentID  int       `json:"parent_id" db:"parent_id"` // Zero for orgs
+	CreatedAt time.Time `json:"created_at" db:"created_at"`
+}
+
+// TeamMembership places a user in a team over [ValidFrom, ValidTo). A zero ValidTo is open-ended.
+// Ratings are attributed to the teams their reviewee belonged to when the rating was created
+type TeamMembership struct {
+	ID        int       `json:"id" db:"id"`
+	TeamID    int       `json:"team_id" db:"team_id"`
+	UserID    int       `json:"user_id" db:"user_id"`
+	UserName  string    `json:"user_name" db:"user_name"` // Read from users
+	ValidFrom time.Time `json:"valid_from" db:"valid_from"`
+	ValidTo   time.Time `json:"valid_to" db:"valid_to"`
+}
+
+// Overlaps reports whether m and other cover a common instant
+func (m TeamMembership) Overlaps(other TeamMembership) bool {
+	return (m.ValidTo.IsZero() || other.ValidFrom.Before(m.ValidTo)) &&
+		(other.ValidTo.IsZero() || m.ValidFrom.Before(other.ValidTo))
+}
+
+// TeamCategoryScore is a category's score over the ratings attributed to a team or any team below it
+type TeamCategoryScore struct {
+	TeamID int `json:"team_id" db:"team_id"`
+	CategoryScore
+}
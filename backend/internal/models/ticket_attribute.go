@@ -0,0 +1,12 @@
+package models
+
+import "time"
+
+// TicketAttribute is a key/value label on a ticket, such as channel=email or priority=high.
+// A ticket has at most one value per key
+type TicketAttribute struct {
+	TicketID  int       `json:"ticket_id" db:"ticket_id"`
+	Key       string    `json:"key" db:"key"`
+	Value     string    `json:"value" db:"value"`
+	UpdatedAt time.Time `json:"updated_at" db:"updated_at"`
+}
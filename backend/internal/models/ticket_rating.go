@@ -0,0 +1,16 @@
+package models
+
+import "time"
+
+// TicketRating is a single rating on a ticket with the names it refers to
+type TicketRating struct {
+	ID           int       `json:"id" db:"id"`
+	Rating       int       `json:"rating" db:"rating"`
+	CategoryID   int       `json:"category_id" db:"category_id"`
+	CategoryName string    `json:"category_name" db:"category_name"`
+	ReviewerID   int       `json:"reviewer_id" db:"reviewer_id"`
+	ReviewerName string    `json:"reviewer_name" db:"reviewer_name"`
+	RevieweeID   int       `json:"reviewee_id" db:"reviewee_id"`
+	RevieweeName string    `json:"reviewee_name" db:"reviewee_name"`
+	CreatedAt    time.Time `json:"created_at" db:"created_at"`
+}
@@ -0,0 +1,260 @@
+package repository
+
+import (
+	"context"
+	"database/sql"
+	"errors"
+	"fmt"
+	"time"
+
+	"go-grpc-backend/internal/models"
+)
+
+// AlertRepositoryInterface stores alert rules and the state of their latest evaluation
+type AlertRepositoryInterface interface {
+	ListAlertRules(ctx context.Context) ([]models.AlertRule, error)
+	GetAlertRule(ctx context.Context, id int) (models.AlertRule, error)
+	CreateAlertRule(ctx context.Context, rule models.AlertRule) (models.AlertRule, error)
+	UpdateAlertRule(ctx context.Context, rule models.AlertRule) (models.AlertRule, error)
+	DeleteAlertRule(ctx context.Context, id int) error
+	// ListAlertStates returns the state of every rule evaluated at least once, keyed by rule ID
+	ListAlertStates(ctx context.Context) (map[int]models.AlertState, error)
+	SaveAlertState(ctx context.Context, state models.AlertState) error
+}
+
+// AlertRepository keeps alert rules in the tables created by database.Migrate.
+// It writes, so it must be given the writer connection
+type AlertRepository struct {
+	db  *sql.DB
+	now func() time.Time
+}
+
+func NewAlertRepository(db *sql.DB) *AlertRepository {
+	return &AlertRepository{db: db, now: time.Now}
+}
+
+const alertRuleColumns = `id, name, metric, window_ns, comparator, threshold, min_sample, webhook_url, enabled, created_at`
+
+func (r *AlertRepository) ListAlertRules(ctx context.Context) ([]models.AlertRule, error) {
+	rows, err := r.db.QueryContext(ctx, `SELECT `+alertRuleColumns+` FROM alert_rules ORDER BY id`)
+	if err != nil {
+		return nil, fmt.Errorf("failed to query alert rules: %v", err)
+	}
+	defer rows.Close()
+
+	var rules []models.AlertRule
+	for rows.Next() {
+		rule, err := scanAlertRule(rows)
+		if err != nil {
+			return nil, err
+		}
+		rules = append(rules, rule)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, fmt.Errorf("failed to read alert rules: %v", err)
+	}
+
+	categories, err := r.alertRuleCategories(ctx, 0)
+	if err != nil {
+		return nil, err
+	}
+	for i := range rules {
+		rules[i].CategoryIDs = categories[rules[i].ID]
+	}
+	return rules, nil
+}
+
+func (r *AlertRepository) GetAlertRule(ctx context.Context, id int) (models.AlertRule, error) {
+	rule, err := scanAlertRule(r.db.QueryRowContext(ctx, `SELECT `+alertRuleColumns+` FROM alert_rules WHERE id = ?`, id))
+	if errors.Is(err, sql.ErrNoRows) {
+		return models.AlertRule{}, fmt.Errorf("alert rule %d: %w", id, ErrNotFound)
+	}
+	if err != nil {
+		return models.AlertRule{}, err
+	}
+
+	categories, err := r.alertRuleCategories(ctx, rule.ID)
+	if err != nil {
+		return models.AlertRule{}, err
+	}
+	rule.CategoryIDs = categories[rule.ID]
+	return rule, nil
+}
+
+// CreateAlertRule inserts rule and returns it with its ID and creation time set
+func (r *AlertRepository) CreateAlertRule(ctx context.Context, rule models.AlertRule) (models.AlertRule, error) {
+	rule.CreatedAt = r.now().UTC()
+
+	tx, err := r.db.BeginTx(ctx, nil)
+	if err != nil {
+		return models.AlertRule{}, fmt.Errorf("failed to create alert rule: %v", err)
+	}
+	defer tx.Rollback()
+
+	res, err := tx.ExecContext(ctx, `
+		INSERT INTO alert_rules (name, metric, window_ns, comparator, threshold, min_sample, webhook_url, enabled, created_at)
+		VALUES (?, ?, ?, ?, ?, ?, ?, ?, ?)`,
+		rule.Name, rule.Metric, int64(rule.Window), rule.Comparator, rule.Threshold, rule.MinSample, rule.WebhookURL, rule.Enabled, rule.CreatedAt)
+	if err != nil {
+		return models.AlertRule{}, fmt.Errorf("failed to create alert rule: %v", err)
+	}
+	id, err := res.LastInsertId()
+	if err != nil {
+		return models.AlertRule{}, fmt.Errorf("failed to create alert rule: %v", err)
+	}
+	rule.ID = int(id)
+
+	if err := setAlertRuleCategories(ctx, tx, rule); err != nil {
+		return models.AlertRule{}, err
+	}
+	if err := tx.Commit(); err != nil {
+		return models.AlertRule{}, fmt.Errorf("failed to create alert rule: %v", err)
+	}
+	return rule, nil
+}
+
+// UpdateAlertRule replaces every field of the rule with rule.ID except its creation time.
+// The rule's state is kept, so a firing rule resolves on its next evaluation if it no longer breaches
+func (r *AlertRepository) UpdateAlertRule(ctx context.Context, rule models.AlertRule) (models.AlertRule, error) {
+	tx, err := r.db.BeginTx(ctx, nil)
+	if err != nil {
+		return models.AlertRule{}, fmt.Errorf("failed to update alert rule: %v", err)
+	}
+	defer tx.Rollback()
+
+	res, err := tx.ExecContext(ctx, `
+		UPDATE alert_rules
+		SET name = ?, metric = ?, window_ns = ?, comparator = ?, threshold = ?, min_sample = ?, webhook_url = ?, enabled = ?
+		WHERE id = ?`,
+		rule.Name, rule.Metric, int64(rule.Window), rule.Comparator, rule.Threshold, rule.MinSample, rule.WebhookURL, rule.Enabled, rule.ID)
+	if err != nil {
+		return models.AlertRule{}, fmt.Errorf("failed to update alert rule: %v", err)
+	}
+	if n, err := res.RowsAffected(); err != nil {
+		return models.AlertRule{}, fmt.Errorf("failed to update alert rule: %v", err)
+	} else if n == 0 {
+		return models.AlertRule{}, fmt.Errorf("alert rule %d: %w", rule.ID, ErrNotFound)
+	}
+
+	if err := setAlertRuleCategories(ctx, tx, rule); err != nil {
+		return models.AlertRule{}, err
+	}
+	if err := tx.QueryRowContext(ctx, `SELECT created_at FROM alert_rules WHERE id = ?`, rule.ID).Scan(&rule.CreatedAt); err != nil {
+		return models.AlertRule{}, fmt.Errorf("failed to update alert rule: %v", err)
+	}
+	if err := tx.Commit(); err != nil {
+		return models.AlertRule{}, fmt.Errorf("failed to update alert rule: %v", err)
+	}
+	return rule, nil
+}
+
+// DeleteAlertRule removes the rule, its categories and its state
+func (r *AlertRepository) DeleteAlertRule(ctx context.Context, id int) error {
+	res, err := r.db.ExecContext(ctx, `DELETE FROM alert_rules WHERE id = ?`, id)
+	if err != nil {
+		return fmt.Errorf("failed to delete alert rule: %v", err)
+	}
+	if n, err := res.RowsAffected(); err != nil {
+		return fmt.Errorf("failed to delete alert rule: %v", err)
+	} else if n == 0 {
+		return fmt.Errorf("alert rule %d: %w", id, ErrNotFound)
+	}
+	return nil
+}
+
+func (r *AlertRepository) ListAlertStates(ctx context.Context) (map[int]models.AlertState, error) {
+	rows, err := r.db.QueryContext(ctx, `SELECT rule_id, status, value, sample, sufficient, changed_at, evaluated_at, notify_pending FROM alert_states`)
+	if err != nil {
+		return nil, fmt.Errorf("failed to query alert states: %v", err)
+	}
+	defer rows.Close()
+
+	states := make(map[int]models.AlertState)
+	for rows.Next() {
+		var s models.AlertState
+		if err := rows.Scan(&s.RuleID, &s.Status, &s.Value, &s.Sample, &s.Sufficient, &s.ChangedAt, &s.EvaluatedAt, &s.NotifyPending); err != nil {
+			return nil, fmt.Errorf("failed to scan alert state: %v", err)
+		}
+		states[s.RuleID] = s
+	}
+	if err := rows.Err(); err != nil {
+		return nil, fmt.Errorf("failed to read alert states: %v", err)
+	}
+	return states, nil
+}
+
+// SaveAlertState records the latest evaluation of a rule, replacing the previous one
+func (r *AlertRepository) SaveAlertState(ctx context.Context, state models.AlertState) error {
+	_, err := r.db.ExecContext(ctx, `
+		INSERT INTO alert_states (rule_id, status, value, sample, sufficient, changed_at, evaluated_at, notify_pending)
+		VALUES (?, ?, ?, ?, ?, ?, ?, ?)
+		ON CONFLICT (rule_id) DO UPDATE SET
+			status = excluded.status,
+			value = excluded.value,
+			sample = excluded.sample,
+			sufficient = excluded.sufficient,
+			changed_at = excluded.changed_at,
+			evaluated_at = excluded.evaluated_at,
+			notify_pending = excluded.notify_pending`,
+		state.RuleID, state.Status, state.Value, state.Sample, state.Sufficient, state.ChangedAt.UTC(), state.EvaluatedAt.UTC(), state.NotifyPending)
+	if err != nil {
+		return fmt.Errorf("failed to save alert state: %v", err)
+	}
+	return nil
+}
+
+// rowScanner is satisfied by *sql.Row and *sql.Rows
+type rowScanner interface {
+	Scan(dest ...any) error
+}
+
+func scanAlertRule(row rowScanner) (models.AlertRule, error) {
+	var (
+		rule   models.AlertRule
+		window int64
+	)
+	err := row.Scan(&rule.ID, &rule.Name, &rule.Metric, &window, &rule.Comparator, &rule.Threshold,
+		&rule.MinSample, &rule.WebhookURL, &rule.Enabled, &rule.CreatedAt)
+	if errors.Is(err, sql.ErrNoRows) {
+		return models.AlertRule{}, err
+	}
+	if err != nil {
+		return models.AlertRule{}, fmt.Errorf("failed to scan alert rule: %v", err)
+	}
+	rule.Window = time.Duration(window)
+	return rule, nil
+}
+
+// alertRuleCategories returns the category filter of the rule with ruleID, or of every rule for 0
+func (r *AlertRepository) alertRuleCategories(ctx context.Context, ruleID int) (map[int][]int, error) {
+	rows, err := r.db.QueryContext(ctx, `
+		SELECT rule_id, category_id FROM alert_rule_categories
+		WHERE ?1 = 0 OR rule_id = ?1
+		ORDER BY rule_id, category_id`, ruleID)
+	if err != nil {
+		return nil, fmt.Errorf("failed to query alert rule categories: %v", err)
+	}
+	defer rows.Close()
+
+	categories := make(map[int][]int)
+	for rows.Next() {
+		var ruleID, categoryID int
+		if err := rows.Scan(&ruleID, &categoryID); err != nil {
+			return nil, fmt.Errorf("failed to scan alert rule category: %v", err)
+		}
+		categories[ruleID] = append(categories[ruleID], categoryID)
+	}
+	return categories, rows.Err()
+}
+
+func setAlertRuleCategories(ctx context.Context, tx *sql.Tx, rule models.AlertRule) error {
+	if _, err := tx.ExecContext(ctx, `DELETE FROM alert_rule_categories WHERE rule_id = ?`, rule.ID); err != nil {
+		return fmt.Errorf("failed to set alert rule categories: %v", err)
+	}
+	for _, categoryID := range rule.CategoryIDs {
+		if _, err := tx.ExecContext(ctx, `INSERT OR IGNORE INTO alert_rule_categories (rule_id, category_id) VALUES (?, ?)`, rule.ID, categoryID); err != nil {
+			return fmt.Errorf("failed to set alert rule categories: %v", err)
+		}
+	}
+	return nil
+}
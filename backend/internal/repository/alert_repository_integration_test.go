@@ -0,0 +1,138 @@
+package repository
+
+import (
+	"context"
+	"errors"
+	"reflect"
+	"testing"
+	"time"
+
+	"go-grpc-backend/internal/models"
+)
+
+func newTestAlertRepository(t *testing.T) *AlertRepository {
+	t.Helper()
+	repo := NewAlertRepository(newTestDB(t, "basic"))
+	repo.now = func() time.Time { return time.Date(2025, 1, 15, 9, 0, 0, 0, time.UTC) }
+	return repo
+}
+
+func testAlertRule() models.AlertRule {
+	return models.AlertRule{
+		Name:        "Spelling below 70",
+		Metric:      models.AlertMetricQualityScore,
+		CategoryIDs: []int{1, 2},
+		Window:      24 * time.Hour,
+		Comparator:  models.AlertComparatorLessThan,
+		Threshold:   70,
+		MinSample:   5,
+		WebhookURL:  "http://localhost:9000/hook",
+		Enabled:     true,
+	}
+}
+
+func TestAlertRepository_Integration_CRUD(t *testing.T) {
+	repo := newTestAlertRepository(t)
+
+	created, err := repo.CreateAlertRule(context.Background(), testAlertRule())
+	if err != nil {
+		t.Fatalf("CreateAlertRule() error = %v", err)
+	}
+	if created.ID == 0 || !created.CreatedAt.Equal(repo.now()) {
+		t.Errorf("Expected an ID and creation time, got %+v", created)
+	}
+
+	got, err := repo.GetAlertRule(context.Background(), created.ID)
+	if err != nil {
+		t.Fatalf("GetAlertRule() error = %v", err)
+	}
+	if !reflect.DeepEqual(got, created) {
+		t.Errorf("Stored rule mismatch\n got: %+v\nwant: %+v", got, created)
+	}
+
+	update := created
+	update.CategoryIDs = []int{2}
+	update.Threshold = 60
+	update.Enabled = false
+	update.CreatedAt = time.Time{}
+	updated, err := repo.UpdateAlertRule(context.Background(), update)
+	if err != nil {
+		t.Fatalf("UpdateAlertRule() error = %v", err)
+	}
+	if !updated.CreatedAt.Equal(created.CreatedAt) {
+		t.Errorf("Expected the creation time kept, got %v", updated.CreatedAt)
+	}
+
+	rules, err := repo.ListAlertRules(context.Background())
+	if err != nil {
+		t.Fatalf("ListAlertRules() error = %v", err)
+	}
+	if len(rules) != 1 || !reflect.DeepEqual(rules[0], updated) {
+		t.Errorf("Listed rules mismatch\n got: %+v\nwant: %+v", rules, updated)
+	}
+
+	if err := repo.DeleteAlertRule(context.Background(), created.ID); err != nil {
+		t.Fatalf("DeleteAlertRule() error = %v", err)
+	}
+	if _, err := repo.GetAlertRule(context.Background(), created.ID); !errors.Is(err, ErrNotFound) {
+		t.Errorf("Expected ErrNotFound after delete, got %v", err)
+	}
+}
+
+func TestAlertRepository_Integration_NotFound(t *testing.T) {
+	repo := newTestAlertRepository(t)
+
+	missing := testAlertRule()
+	missing.ID = 42
+	if _, err := repo.UpdateAlertRule(context.Background(), missing); !errors.Is(err, ErrNotFound) {
+		t.Errorf("UpdateAlertRule(): expected ErrNotFound, got %v", err)
+	}
+	if err := repo.DeleteAlertRule(context.Background(), 42); !errors.Is(err, ErrNotFound) {
+		t.Errorf("DeleteAlertRule(): expected ErrNotFound, got %v", err)
+	}
+}
+
+func TestAlertRepository_Integration_States(t *testing.T) {
+	repo := newTestAlertRepository(t)
+
+	rule, err := repo.CreateAlertRule(context.Background(), testAlertRule())
+	if err != nil {
+		t.Fatalf("CreateAlertRule() error = %v", err)
+	}
+
+	firing := models.AlertState{
+		RuleID:      rule.ID,
+		Status:      models.AlertStatusFiring,
+		Value:       62.5,
+		Sample:      8,
+		Sufficient:  true,
+		ChangedAt:   time.Date(2025, 1, 15, 9, 0, 0, 0, time.UTC),
+		EvaluatedAt: time.Date(2025, 1, 15, 9, 0, 0, 0, time.UTC),
+		// Delivery failed; the next pass retries it
+		NotifyPending: true,
+	}
+	if err := repo.SaveAlertState(context.Background(), firing); err != nil {
+		t.Fatalf("SaveAlertState() error = %v", err)
+	}
+	later := firing
+	later.EvaluatedAt = firing.EvaluatedAt.Add(time.Minute)
+	if err := repo.SaveAlertState(context.Background(), later); err != nil {
+		t.Fatalf("SaveAlertState() error = %v", err)
+	}
+
+	states, err := repo.ListAlertStates(context.Background())
+	if err != nil {
+		t.Fatalf("ListAlertStates() error = %v", err)
+	}
+	if !reflect.DeepEqual(states, map[int]models.AlertState{rule.ID: later}) {
+		t.Errorf("States mismatch\n got: %+v\nwant: %+v", states, later)
+	}
+
+	// Deleting the rule drops its state
+	if err := repo.DeleteAlertRule(context.Background(), rule.ID); err != nil {
+		t.Fatalf("DeleteAlertRule() error = %v", err)
+	}
+	if states, err := repo.ListAlertStates(context.Background()); err != nil || len(states) != 0 {
+		t.Errorf("Expected no states after delete, got %v, %v", states, err)
+	}
+}
@@ -1,19 +1,25 @@
 package repository
 
 import (
+	"context"
 	"database/sql"
 	"fmt"
+	"strings"
 	"time"
 
 	"go-grpc-backend/internal/models"
 )
 
-// AnalyticsRepositoryInterface defines the contract for analytics data access
+// AnalyticsRepositoryInterface defines the contract for analytics data access.
+// Every method filters ratings by the same models.DateRange semantics, on ratings.created_at
+// or, for models.DateBasisTicketCreated, on the created_at of their ticket
 type AnalyticsRepositoryInterface interface {
-	GetDailyAggregatedCategoryRatings(startDate, endDate time.Time) ([]models.CategoryRatingOverTimePeriod, error)
-	GetWeeklyAggregatedCategoryRatings(startDate, endDate time.Time) ([]models.CategoryRatingOverTimePeriod, error)
-	GetScoresByTicket(startDate, endDate time.Time) ([]models.TicketCategoryScore, error)
-	GetOverallQualityScore(startDate, endDate time.Time) ([]models.CategoryScore, error)
+	GetDailyAggregatedCategoryRatings(ctx context.Context, rng models.DateRange) ([]models.CategoryRatingOverTimePeriod, error)
+	GetWeeklyAggregatedCategoryRatings(ctx context.Context, rng models.DateRange) ([]models.CategoryRatingOverTimePeriod, error)
+	GetScoresByTicket(ctx context.Context, rng models.DateRange) ([]models.TicketCategoryScore, error)
+	GetOverallQualityScore(ctx context.Context, rng models.DateRange) ([]models.CategoryScore, error)
+	GetRatingDistribution(ctx context.Context, rng models.DateRange, granularity models.Granularity) ([]models.RatingDistribution, error)
+	GetCategoryScoresByPeriod(ctx context.Context, periods []models.DateRange) ([]models.PeriodCategoryScore, error)
 }
 
 type AnalyticsRepository struct {
@@ -24,27 +30,102 @@ func NewAnalyticsRepository(db *sql.DB) *AnalyticsRepository {
 	return &AnalyticsRepository{db: db}
 }
 
-func (r *AnalyticsRepository) GetWeeklyAggregatedCategoryRatings(
-	startDate, endDate time.Time,
+// dateColumn is the timestamp ranges with basis filter and bucket ratings by
+func dateColumn(basis models.DateBasis) string {
+	if basis == models.DateBasisTicketCreated {
+		return "t.created_at"
+	}
+	return "r.created_at"
+}
+
+// ticketsJoin joins each rating's ticket as t when basis needs its created_at.
+// Queries that always join tickets don't use it
+func ticketsJoin(basis models.DateBasis) string {
+	if basis == models.DateBasisTicketCreated {
+		return "JOIN tickets t ON r.ticket_id = t.id"
+	}
+	return ""
+}
+
+// ratingsInRange is the date filter shared by every query so a given range
+// selects the same ratings in every RPC. Bind it with rangeArgs:
+// ?1 start, ?2 end, ?3 whether a rating exactly at end is included
+func ratingsInRange(basis models.DateBasis) string {
+	col := dateColumn(basis)
+	return col + ` >= ?1 AND (` + col + ` < ?2 OR (?3 AND ` + col + ` = ?2))`
+}
+
+// rangeArgs binds the bounds in UTC: the driver formats times with their own offset,
+// and created_at is compared as text against UTC timestamps. Every DATETIME column these
+// queries compare (created_at, effective_from) must therefore be written as a UTC time.Time
+// by the driver, "YYYY-MM-DD HH:MM:SS[.fff]+00:00", so that text order is time order
+func rangeArgs(rng models.DateRange) []any {
+	return []any{rng.Start.UTC(), rng.End.UTC(), rng.InclusiveEnd}
+}
+
+// weightPeriods is a CTE turning the weight history into the interval [effective_from, effective_to)
+// each entry applied over. Of entries effective at the same time the latest one wins: the others
+// get empty intervals
+const weightPeriods = `weight_periods AS (
+			SELECT category_id, weight, effective_from,
+				LEAD(effective_from) OVER (PARTITION BY category_id ORDER BY effective_from, id) AS effective_to
+			FROM rating_category_weights
+		)`
+
+// weightJoin matches each rating r to the weight period it was created in. Queries using
+// categoryWeight include weightPeriods and this join. Like ratingsInRange it compares the
+// timestamps as text, relying on the format described at rangeArgs
+const weightJoin = `LEFT JOIN weight_periods wp ON wp.category_id = r.rating_category_id
+			AND wp.effective_from <= r.created_at AND (wp.effective_to IS NULL OR r.created_at < wp.effective_to)`
+
+// ratingWeight is the weight of r's category when r was created, or rc.weight for categories
+// whose weight never changed
+const ratingWeight = `COALESCE(wp.weight, rc.weight)`
+
+// categoryWeight aggregates ratingWeight over a group of ratings so that AVG(r.rating) times it
+// is the mean of rating * weight, keeping CalculateCategoryScore exact when the weight changed
+// within the group. Groups rated only 0 take the mean weight
+const categoryWeight = `COALESCE(
+			SUM(r.rating * ` + ratingWeight + `) * 1.0 / NULLIF(SUM(r.rating), 0),
+			AVG(` + ratingWeight + `))`
+
+// avgWeightedSquare is the mean of (rating * ratingWeight)², from which the variance of the weighted
+// ratings follows exactly even when the weight changed within the group
+const avgWeightedSquare = `AVG(r.rating * r.rating * ` + ratingWeight + ` * ` + ratingWeight + `)`
+
+// variance derives the population variance from AVG(x) and AVG(x * x).
+// Rounding can push it slightly below zero for identical ratings
+func variance(mean, meanSquare float64) float64 {
+	return max(meanSquare-mean*mean, 0)
+}
+
+func (r *AnalyticsRepository) GetWeeklyAggregatedCategoryRatings(ctx context.Context,
+	rng models.DateRange,
 ) ([]models.CategoryRatingOverTimePeriod, error) {
 
-	const query = `
+	query := `
+		WITH ` + weightPeriods + `
 		SELECT
 			rc.id AS category_id,
 			rc.name AS category_name,
-			rc.weight as category_weight,
+			` + categoryWeight + ` AS category_weight,
 			AVG(r.rating) AS avg_percent,
+			AVG(r.rating * r.rating) AS avg_square,
 			COUNT(r.id) AS rating_count,
-			date(r.created_at, 'weekday 1', '-7 days') AS bucket_week_start,
-			COUNT(*) OVER (PARTITION BY rc.id) AS ratings_total
+			-- Step back 6 days then forward to Monday so Mondays map to themselves
+			date(` + dateColumn(rng.Basis) + `, '-6 days', 'weekday 1') AS bucket_week_start,
+			-- Window runs after GROUP BY: sum the per-bucket counts, not the bucket rows
+			SUM(COUNT(r.id)) OVER (PARTITION BY rc.id) AS ratings_total
 		FROM ratings r
 		JOIN rating_categories rc ON rc.id = r.rating_category_id
-		WHERE r.created_at >= ? AND r.created_at < ?
+		` + weightJoin + `
+		` + ticketsJoin(rng.Basis) + `
+		WHERE ` + ratingsInRange(rng.Basis) + `
 		GROUP BY rc.id, rc.name, bucket_week_start
 		ORDER BY rc.name, bucket_week_start;
 	`
 
-	rows, err := r.db.Query(query, startDate, endDate)
+	rows, err := r.db.QueryContext(ctx, query, rangeArgs(rng)...)
 	if err != nil {
 		return nil, fmt.Errorf("query weekly aggregated category rating: %w", err)
 	}
@@ -55,6 +136,7 @@ func (r *AnalyticsRepository) GetWeeklyAggregatedCategoryRatings(
 	for rows.Next() {
 		var (
 			score     models.CategoryRatingOverTimePeriod
+			avgSquare float64
 			bucketStr string
 		)
 
@@ -63,6 +145,7 @@ func (r *AnalyticsRepository) GetWeeklyAggregatedCategoryRatings(
 			&score.CategoryName,
 			&score.CategoryWeight,
 			&score.AvgPercent,
+			&avgSquare,
 			&score.RatingCount,
 			&bucketStr,
 			&score.RatingsTotalCount,
@@ -75,6 +158,7 @@ func (r *AnalyticsRepository) GetWeeklyAggregatedCategoryRatings(
 			return nil, fmt.Errorf("parse week start %q: %w", bucketStr, err)
 		}
 		score.Date = t
+		score.RatingVariance = variance(score.AvgPercent, avgSquare)
 
 		ratings = append(ratings, score)
 	}
@@ -86,24 +170,28 @@ func (r *AnalyticsRepository) GetWeeklyAggregatedCategoryRatings(
 	return ratings, nil
 }
 
-func (r *AnalyticsRepository) GetDailyAggregatedCategoryRatings(startDate, endDate time.Time) ([]models.CategoryRatingOverTimePeriod, error) {
-	const query = `
+func (r *AnalyticsRepository) GetDailyAggregatedCategoryRatings(ctx context.Context, rng models.DateRange) ([]models.CategoryRatingOverTimePeriod, error) {
+	query := `
+		WITH ` + weightPeriods + `
 		SELECT 
 			rc.id    AS category_id,
 			rc.name  AS category_name,
-			rc.weight AS category_weight,
+			` + categoryWeight + ` AS category_weight,
 			AVG(r.rating) AS avg_percent,
+			AVG(r.rating * r.rating) AS avg_square,
 			COUNT(r.id) AS rating_count,
-			strftime('%Y-%m-%d', r.created_at) AS day,
-			COUNT(*) OVER (PARTITION BY rc.id) AS ratings_total
+			strftime('%Y-%m-%d', ` + dateColumn(rng.Basis) + `) AS day,
+			SUM(COUNT(r.id)) OVER (PARTITION BY rc.id) AS ratings_total
 		FROM ratings r
 		JOIN rating_categories rc ON r.rating_category_id = rc.id
-		WHERE r.created_at >= ? AND r.created_at < ?
-		GROUP BY rc.id, rc.name, rc.weight, day
+		` + weightJoin + `
+		` + ticketsJoin(rng.Basis) + `
+		WHERE ` + ratingsInRange(rng.Basis) + `
+		GROUP BY rc.id, rc.name, day
 		ORDER BY rc.name, day;
 	`
 
-	rows, err := r.db.Query(query, startDate, endDate)
+	rows, err := r.db.QueryContext(ctx, query, rangeArgs(rng)...)
 	if err != nil {
 		return nil, fmt.Errorf("failed to query daily aggregated category scores: %w", err)
 	}
@@ -112,8 +200,9 @@ func (r *AnalyticsRepository) GetDailyAggregatedCategoryRatings(startDate, endDa
 	var out []models.CategoryRatingOverTimePeriod
 	for rows.Next() {
 		var (
-			item models.CategoryRatingOverTimePeriod
-			day  string // <- строка "YYYY-MM-DD"
+			item      models.CategoryRatingOverTimePeriod
+			avgSquare float64
+			day       string // <- строка "YYYY-MM-DD"
 		)
 
 		if err := rows.Scan(
@@ -121,6 +210,7 @@ func (r *AnalyticsRepository) GetDailyAggregatedCategoryRatings(startDate, endDa
 			&item.CategoryName,
 			&item.CategoryWeight,
 			&item.AvgPercent,
+			&avgSquare,
 			&item.RatingCount,
 			&day,
 			&item.RatingsTotalCount,
@@ -134,6 +224,7 @@ func (r *AnalyticsRepository) GetDailyAggregatedCategoryRatings(startDate, endDa
 			return nil, fmt.Errorf("failed to parse day %q: %w", day, err)
 		}
 		item.Date = t
+		item.RatingVariance = variance(item.AvgPercent, avgSquare)
 
 		out = append(out, item)
 	}
@@ -143,24 +234,30 @@ func (r *AnalyticsRepository) GetDailyAggregatedCategoryRatings(startDate, endDa
 	return out, nil
 }
 
-func (r *AnalyticsRepository) GetScoresByTicket(startDate, endDate time.Time) ([]models.TicketCategoryScore, error) {
+func (r *AnalyticsRepository) GetScoresByTicket(ctx context.Context, rng models.DateRange) ([]models.TicketCategoryScore, error) {
+	// Tickets are always joined here, so the filter needs no ticketsJoin
 	query := `
+		WITH ` + weightPeriods + `
 		SELECT 
 			t.id as ticket_id,
+			t.subject as ticket_subject,
+			t.created_at as ticket_created_at,
 			rc.id as category_id,
 			rc.name as category_name,
-			rc.weight as category_weight,
+			` + categoryWeight + ` AS category_weight,
 			AVG(r.rating) as avg_score,
+			AVG(r.rating * r.rating) as avg_square,
 			COUNT(r.id) as rating_count
 		FROM ratings r
 		JOIN tickets t ON r.ticket_id = t.id
 		JOIN rating_categories rc ON r.rating_category_id = rc.id
-		WHERE r.created_at >= ? AND r.created_at <= ?
-		GROUP BY t.id, rc.id, rc.name, rc.weight
+		` + weightJoin + `
+		WHERE ` + ratingsInRange(rng.Basis) + `
+		GROUP BY t.id, t.subject, t.created_at, rc.id, rc.name
 		ORDER BY t.id, rc.name
 	`
 
-	rows, err := r.db.Query(query, startDate, endDate)
+	rows, err := r.db.QueryContext(ctx, query, rangeArgs(rng)...)
 	if err != nil {
 		return nil, fmt.Errorf("failed to query scores by ticket: %v", err)
 	}
@@ -168,42 +265,57 @@ func (r *AnalyticsRepository) GetScoresByTicket(startDate, endDate time.Time) ([
 
 	var scores []models.TicketCategoryScore
 	for rows.Next() {
-		var score models.TicketCategoryScore
+		var (
+			score     models.TicketCategoryScore
+			avgSquare float64
+		)
 
 		err := rows.Scan(
 			&score.TicketID,
+			&score.TicketSubject,
+			&score.TicketCreatedAt,
 			&score.CategoryID,
 			&score.CategoryName,
 			&score.CategoryWeight,
 			&score.Score,
+			&avgSquare,
 			&score.RatingCount,
 		)
 		if err != nil {
 			return nil, fmt.Errorf("failed to scan ticket category score: %v", err)
 		}
+		score.RatingVariance = variance(score.Score, avgSquare)
 
 		scores = append(scores, score)
 	}
+	if err := rows.Err(); err != nil {
+		return nil, fmt.Errorf("rows error: %w", err)
+	}
 
 	return scores, nil
 }
 
-func (r *AnalyticsRepository) GetOverallQualityScore(startDate, endDate time.Time) ([]models.CategoryScore, error) {
+func (r *AnalyticsRepository) GetOverallQualityScore(ctx context.Context, rng models.DateRange) ([]models.CategoryScore, error) {
 	query := `
+		WITH ` + weightPeriods + `
 		SELECT 
 			rc.id as category_id,
 			rc.name as category_name,
-			rc.weight as category_weight,
+			` + categoryWeight + ` AS category_weight,
 			AVG(r.rating) as avg_score,
+			AVG(r.rating * r.rating) as avg_square,
+			` + avgWeightedSquare + ` AS avg_weighted_square,
 			COUNT(r.id) as rating_count
 		FROM ratings r
 		JOIN rating_categories rc ON r.rating_category_id = rc.id
-		WHERE r.created_at >= ? AND r.created_at <= ?
-		GROUP BY rc.id, rc.name, rc.weight
+		` + weightJoin + `
+		` + ticketsJoin(rng.Basis) + `
+		WHERE ` + ratingsInRange(rng.Basis) + `
+		GROUP BY rc.id, rc.name
 		ORDER BY rc.name
 	`
 
-	rows, err := r.db.Query(query, startDate, endDate)
+	rows, err := r.db.QueryContext(ctx, query, rangeArgs(rng)...)
 	if err != nil {
 		return nil, fmt.Errorf("failed to query overall quality score: %v", err)
 	}
@@ -211,29 +323,204 @@ func (r *AnalyticsRepository) GetOverallQualityScore(startDate, endDate time.Tim
 
 	var categoryScores []models.CategoryScore
 	for rows.Next() {
-		var cs models.CategoryScore
+		var (
+			cs                           models.CategoryScore
+			avgSquare, avgWeightedSquare float64
+		)
 
 		err := rows.Scan(
 			&cs.CategoryID,
 			&cs.CategoryName,
 			&cs.CategoryWeight,
 			&cs.Score,
+			&avgSquare,
+			&avgWeightedSquare,
 			&cs.RatingCount,
 		)
 		if err != nil {
 			return nil, fmt.Errorf("failed to scan category score: %v", err)
 		}
+		cs.RatingVariance = variance(cs.Score, avgSquare)
+		cs.WeightedVariance = variance(cs.Score*cs.CategoryWeight, avgWeightedSquare)
 
 		categoryScores = append(categoryScores, cs)
 	}
+	if err := rows.Err(); err != nil {
+		return nil, fmt.Errorf("rows error: %w", err)
+	}
 
 	return categoryScores, nil
 }
 
-func (r *AnalyticsRepository) GetRatingCategories() ([]models.RatingCategory, error) {
+// GetCategoryScoresByPeriod returns GetOverallQualityScore's per-category rows for every period
+// in one query. Periods are joined as a VALUES table, so each keeps its own InclusiveEnd;
+// they all use the Basis of the first. Rows are ordered by period index then category name
+func (r *AnalyticsRepository) GetCategoryScoresByPeriod(ctx context.Context, periods []models.DateRange) ([]models.PeriodCategoryScore, error) {
+	if len(periods) == 0 {
+		return nil, nil
+	}
+
+	values := make([]string, len(periods))
+	args := make([]any, 0, 4*len(periods))
+	for i, p := range periods {
+		values[i] = "(?, ?, ?, ?)"
+		args = append(args, i, p.Start.UTC(), p.End.UTC(), p.InclusiveEnd)
+	}
+
+	basis := periods[0].Basis
+	col := dateColumn(basis)
+	query := `
+		WITH ` + weightPeriods + `,
+		periods (idx, start_at, end_at, inclusive_end) AS (VALUES ` + strings.Join(values, ", ") + `)
+		SELECT
+			p.idx AS period_index,
+			rc.id AS category_id,
+			rc.name AS category_name,
+			` + categoryWeight + ` AS category_weight,
+			AVG(r.rating) AS avg_score,
+			AVG(r.rating * r.rating) AS avg_square,
+			` + avgWeightedSquare + ` AS avg_weighted_square,
+			COUNT(r.id) AS rating_count
+		FROM ratings r
+		` + ticketsJoin(basis) + `
+		JOIN periods p ON ` + col + ` >= p.start_at
+			AND (` + col + ` < p.end_at OR (p.inclusive_end AND ` + col + ` = p.end_at))
+		JOIN rating_categories rc ON r.rating_category_id = rc.id
+		` + weightJoin + `
+		GROUP BY p.idx, rc.id, rc.name
+		ORDER BY p.idx, rc.name
+	`
+
+	rows, err := r.db.QueryContext(ctx, query, args...)
+	if err != nil {
+		return nil, fmt.Errorf("query category scores by period: %w", err)
+	}
+	defer rows.Close()
+
+	var scores []models.PeriodCategoryScore
+	for rows.Next() {
+		var (
+			ps                           models.PeriodCategoryScore
+			avgSquare, avgWeightedSquare float64
+		)
+		if err := rows.Scan(
+			&ps.PeriodIndex,
+			&ps.CategoryID,
+			&ps.CategoryName,
+			&ps.CategoryWeight,
+			&ps.Score,
+			&avgSquare,
+			&avgWeightedSquare,
+			&ps.RatingCount,
+		); err != nil {
+			return nil, fmt.Errorf("scan period category score: %w", err)
+		}
+		ps.RatingVariance = variance(ps.Score, avgSquare)
+		ps.WeightedVariance = variance(ps.Score*ps.CategoryWeight, avgWeightedSquare)
+
+		scores = append(scores, ps)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, fmt.Errorf("rows error: %w", err)
+	}
+
+	return scores, nil
+}
+
+// bucketExpr is the SQL expression that buckets the basis timestamp into a YYYY-MM-DD string,
+// matching the daily and weekly queries. An empty granularity puts every rating in one bucket
+func bucketExpr(granularity models.Granularity, basis models.DateBasis) (string, error) {
+	switch granularity {
+	case "":
+		return `''`, nil
+	case models.GranularityDay:
+		return `strftime('%Y-%m-%d', ` + dateColumn(basis) + `)`, nil
+	case models.GranularityWeek:
+		return `date(` + dateColumn(basis) + `, '-6 days', 'weekday 1')`, nil
+	}
+	return "", fmt.Errorf("unknown granularity %q", granularity)
+}
+
+// GetRatingDistribution counts ratings at each value per category, and per time bucket
+// when granularity is set. Rows are ordered by category name then bucket
+func (r *AnalyticsRepository) GetRatingDistribution(ctx context.Context, rng models.DateRange, granularity models.Granularity) ([]models.RatingDistribution, error) {
+	bucket, err := bucketExpr(granularity, rng.Basis)
+	if err != nil {
+		return nil, err
+	}
+
+	query := `
+		SELECT
+			rc.id AS category_id,
+			rc.name AS category_name,
+			` + bucket + ` AS bucket,
+			r.rating,
+			COUNT(r.id) AS rating_count
+		FROM ratings r
+		JOIN rating_categories rc ON r.rating_category_id = rc.id
+		` + ticketsJoin(rng.Basis) + `
+		WHERE ` + ratingsInRange(rng.Basis) + `
+		GROUP BY rc.id, rc.name, bucket, r.rating
+		ORDER BY rc.name, rc.id, bucket, r.rating
+	`
+
+	rows, err := r.db.QueryContext(ctx, query, rangeArgs(rng)...)
+	if err != nil {
+		return nil, fmt.Errorf("query rating distribution: %w", err)
+	}
+	defer rows.Close()
+
+	var (
+		out     []models.RatingDistribution
+		current *models.RatingDistribution
+	)
+	for rows.Next() {
+		var (
+			categoryID   int
+			categoryName string
+			bucketStr    string
+			rating       int
+			count        int
+		)
+		if err := rows.Scan(&categoryID, &categoryName, &bucketStr, &rating, &count); err != nil {
+			return nil, fmt.Errorf("scan rating distribution: %w", err)
+		}
+		if rating < 0 || rating > models.MaxRatingValue {
+			return nil, fmt.Errorf("rating value %d in category %d is outside 0-%d", rating, categoryID, models.MaxRatingValue)
+		}
+
+		var date time.Time
+		if bucketStr != "" {
+			date, err = time.ParseInLocation("2006-01-02", bucketStr, time.UTC)
+			if err != nil {
+				return nil, fmt.Errorf("parse bucket %q: %w", bucketStr, err)
+			}
+		}
+
+		if current == nil || current.CategoryID != categoryID || !current.Date.Equal(date) {
+			out = append(out, models.RatingDistribution{
+				CategoryID:   categoryID,
+				CategoryName: categoryName,
+				Date:         date,
+			})
+			current = &out[len(out)-1]
+		}
+		current.Counts[rating] += count
+	}
+	if err := rows.Err(); err != nil {
+		return nil, fmt.Errorf("rows error: %w", err)
+	}
+
+	for i := range out {
+		out[i].Summarize()
+	}
+	return out, nil
+}
+
+func (r *AnalyticsRepository) GetRatingCategories(ctx context.Context) ([]models.RatingCategory, error) {
 	query := `SELECT id, name, weight FROM rating_categories ORDER BY name`
 
-	rows, err := r.db.Query(query)
+	rows, err := r.db.QueryContext(ctx, query)
 	if err != nil {
 		return nil, fmt.Errorf("failed to query rating categories: %v", err)
 	}
@@ -250,6 +537,9 @@ func (r *AnalyticsRepository) GetRatingCategories() ([]models.RatingCategory, er
 
 		categories = append(categories, category)
 	}
+	if err := rows.Err(); err != nil {
+		return nil, fmt.Errorf("rows error: %w", err)
+	}
 
 	return categories, nil
 }
@@ -0,0 +1,443 @@
+package repository
+
+import (
+	"context"
+	"errors"
+	"reflect"
+	"testing"
+	"time"
+
+	"go-grpc-backend/internal/models"
+)
+
+// bucket is the comparable subset of CategoryRatingOverTimePeriod checked by the integration tests
+type bucket struct {
+	CategoryID   int
+	Date         time.Time
+	AvgRating    float64
+	RatingCount  int
+	RatingsTotal int
+}
+
+func toBuckets(rows []models.CategoryRatingOverTimePeriod) []bucket {
+	out := make([]bucket, 0, len(rows))
+	for _, r := range rows {
+		out = append(out, bucket{
+			CategoryID:   r.CategoryID,
+			Date:         r.Date.UTC(),
+			AvgRating:    r.AvgPercent,
+			RatingCount:  r.RatingCount,
+			RatingsTotal: r.RatingsTotalCount,
+		})
+	}
+	return out
+}
+
+func TestAnalyticsRepository_Integration_GetDailyAggregatedCategoryRatings(t *testing.T) {
+	repo := NewAnalyticsRepository(newTestDB(t, "basic"))
+
+	rows, err := repo.GetDailyAggregatedCategoryRatings(context.Background(), models.NewDateRange(date(2025, 1, 1), date(2025, 1, 13)))
+	if err != nil {
+		t.Fatalf("GetDailyAggregatedCategoryRatings() error = %v", err)
+	}
+
+	// Ordered by category name then day; the rating exactly at endDate is excluded
+	// and Tone has no ratings so it doesn't appear at all
+	expected := []bucket{
+		{CategoryID: 2, Date: date(2025, 1, 7), AvgRating: 3, RatingCount: 1, RatingsTotal: 2},
+		{CategoryID: 2, Date: date(2025, 1, 12), AvgRating: 1, RatingCount: 1, RatingsTotal: 2},
+		{CategoryID: 1, Date: date(2025, 1, 5), AvgRating: 4, RatingCount: 1, RatingsTotal: 3},
+		{CategoryID: 1, Date: date(2025, 1, 6), AvgRating: 3.5, RatingCount: 2, RatingsTotal: 3},
+	}
+
+	if got := toBuckets(rows); !reflect.DeepEqual(got, expected) {
+		t.Errorf("Daily buckets mismatch\n got: %+v\nwant: %+v", got, expected)
+	}
+
+	if rows[0].CategoryName != "Grammar" || rows[0].CategoryWeight != 0.5 {
+		t.Errorf("Expected Grammar with weight 0.5, got %s with %v", rows[0].CategoryName, rows[0].CategoryWeight)
+	}
+
+	// Spelling on 2025-01-06 has ratings 2 and 5
+	if got := rows[3].RatingVariance; got != 2.25 {
+		t.Errorf("Expected variance 2.25, got %v", got)
+	}
+}
+
+func TestAnalyticsRepository_Integration_GetWeeklyAggregatedCategoryRatings(t *testing.T) {
+	repo := NewAnalyticsRepository(newTestDB(t, "basic"))
+
+	rows, err := repo.GetWeeklyAggregatedCategoryRatings(context.Background(), models.NewDateRange(date(2024, 12, 30), date(2025, 1, 13)))
+	if err != nil {
+		t.Fatalf("GetWeeklyAggregatedCategoryRatings() error = %v", err)
+	}
+
+	// Weeks start on Monday: Sunday 2025-01-05 belongs to the week of 2024-12-30,
+	// Monday 2025-01-06 00:00 starts the next week
+	expected := []bucket{
+		{CategoryID: 2, Date: date(2025, 1, 6), AvgRating: 2, RatingCount: 2, RatingsTotal: 2},
+		{CategoryID: 1, Date: date(2024, 12, 30), AvgRating: 4, RatingCount: 1, RatingsTotal: 3},
+		{CategoryID: 1, Date: date(2025, 1, 6), AvgRating: 3.5, RatingCount: 2, RatingsTotal: 3},
+	}
+
+	if got := toBuckets(rows); !reflect.DeepEqual(got, expected) {
+		t.Errorf("Weekly buckets mismatch\n got: %+v\nwant: %+v", got, expected)
+	}
+}
+
+func TestAnalyticsRepository_Integration_GetScoresByTicket(t *testing.T) {
+	repo := NewAnalyticsRepository(newTestDB(t, "basic"))
+
+	scores, err := repo.GetScoresByTicket(context.Background(), models.NewDateRange(date(2025, 1, 1), date(2025, 1, 13)))
+	if err != nil {
+		t.Fatalf("GetScoresByTicket() error = %v", err)
+	}
+
+	// The range is half-open: the 0 rating at 2025-01-13T00:00 is excluded.
+	// Ratings 4 and 2 (and 3 and 1) have a variance of 1
+	refund, login := time.Date(2024, 12, 30, 9, 0, 0, 0, time.UTC), time.Date(2025, 1, 5, 12, 0, 0, 0, time.UTC)
+	expected := []models.TicketCategoryScore{
+		{TicketID: 1, TicketSubject: "Refund request", TicketCreatedAt: refund, CategoryID: 1, CategoryName: "Spelling", CategoryWeight: 1, Score: 3, RatingCount: 2, RatingVariance: 1},
+		{TicketID: 2, TicketSubject: "Login issue", TicketCreatedAt: login, CategoryID: 2, CategoryName: "Grammar", CategoryWeight: 0.5, Score: 2, RatingCount: 2, RatingVariance: 1},
+		{TicketID: 2, TicketSubject: "Login issue", TicketCreatedAt: login, CategoryID: 1, CategoryName: "Spelling", CategoryWeight: 1, Score: 5, RatingCount: 1},
+	}
+
+	if !reflect.DeepEqual(scores, expected) {
+		t.Errorf("Ticket scores mismatch\n got: %+v\nwant: %+v", scores, expected)
+	}
+}
+
+func TestAnalyticsRepository_Integration_GetOverallQualityScore(t *testing.T) {
+	repo := NewAnalyticsRepository(newTestDB(t, "basic"))
+
+	scores, err := repo.GetOverallQualityScore(context.Background(), models.NewDateRange(date(2025, 1, 1), date(2025, 1, 13)))
+	if err != nil {
+		t.Fatalf("GetOverallQualityScore() error = %v", err)
+	}
+
+	// The range is half-open, so Spelling averages 4, 2 and 5
+	expected := []models.CategoryScore{
+		{CategoryID: 2, CategoryName: "Grammar", CategoryWeight: 0.5, Score: 2, RatingCount: 2, RatingVariance: 1, WeightedVariance: 0.25},
+		{CategoryID: 1, CategoryName: "Spelling", CategoryWeight: 1, Score: 11.0 / 3, RatingCount: 3, RatingVariance: variance(11.0/3, 15), WeightedVariance: variance(11.0/3, 15)},
+	}
+
+	if !reflect.DeepEqual(scores, expected) {
+		t.Errorf("Category scores mismatch\n got: %+v\nwant: %+v", scores, expected)
+	}
+}
+
+func TestAnalyticsRepository_Integration_GetOverallQualityScore_InclusiveEnd(t *testing.T) {
+	repo := NewAnalyticsRepository(newTestDB(t, "basic"))
+
+	rng := models.DateRange{Start: date(2025, 1, 1), End: date(2025, 1, 13), InclusiveEnd: true}
+	scores, err := repo.GetOverallQualityScore(context.Background(), rng)
+	if err != nil {
+		t.Fatalf("GetOverallQualityScore() error = %v", err)
+	}
+
+	// With an inclusive end the 0 rating at 2025-01-13T00:00 is counted
+	expected := []models.CategoryScore{
+		{CategoryID: 2, CategoryName: "Grammar", CategoryWeight: 0.5, Score: 2, RatingCount: 2, RatingVariance: 1, WeightedVariance: 0.25},
+		{CategoryID: 1, CategoryName: "Spelling", CategoryWeight: 1, Score: 2.75, RatingCount: 4, RatingVariance: 3.6875, WeightedVariance: 3.6875},
+	}
+
+	if !reflect.DeepEqual(scores, expected) {
+		t.Errorf("Category scores mismatch\n got: %+v\nwant: %+v", scores, expected)
+	}
+}
+
+// Regression test: every query must select the same ratings for the same range,
+// so bucketed counts add up to the overall total
+func TestAnalyticsRepository_Integration_RangeConsistency(t *testing.T) {
+	repo := NewAnalyticsRepository(newTestDB(t, "basic"))
+
+	ranges := map[string]models.DateRange{
+		"half-open":     {Start: date(2025, 1, 1), End: date(2025, 1, 13)},
+		"inclusive end": {Start: date(2025, 1, 1), End: date(2025, 1, 13), InclusiveEnd: true},
+		"mid-day start": {Start: time.Date(2025, 1, 6, 12, 0, 0, 0, time.UTC), End: date(2025, 1, 13)},
+	}
+
+	for name, rng := range ranges {
+		t.Run(name, func(t *testing.T) {
+			daily, err := repo.GetDailyAggregatedCategoryRatings(context.Background(), rng)
+			if err != nil {
+				t.Fatalf("GetDailyAggregatedCategoryRatings() error = %v", err)
+			}
+			weekly, err := repo.GetWeeklyAggregatedCategoryRatings(context.Background(), rng)
+			if err != nil {
+				t.Fatalf("GetWeeklyAggregatedCategoryRatings() error = %v", err)
+			}
+			tickets, err := repo.GetScoresByTicket(context.Background(), rng)
+			if err != nil {
+				t.Fatalf("GetScoresByTicket() error = %v", err)
+			}
+			overall, err := repo.GetOverallQualityScore(context.Background(), rng)
+			if err != nil {
+				t.Fatalf("GetOverallQualityScore() error = %v", err)
+			}
+
+			var dailyTotal, weeklyTotal, ticketTotal, overallTotal int
+			for _, b := range daily {
+				dailyTotal += b.RatingCount
+			}
+			for _, b := range weekly {
+				weeklyTotal += b.RatingCount
+			}
+			for _, ts := range tickets {
+				ticketTotal += ts.RatingCount
+			}
+			for _, cs := range overall {
+				overallTotal += cs.RatingCount
+			}
+
+			if dailyTotal != overallTotal || weeklyTotal != overallTotal || ticketTotal != overallTotal {
+				t.Errorf("Counts disagree: daily=%d weekly=%d tickets=%d overall=%d",
+					dailyTotal, weeklyTotal, ticketTotal, overallTotal)
+			}
+		})
+	}
+}
+
+func TestAnalyticsRepository_Integration_GetRatingCategories(t *testing.T) {
+	repo := NewAnalyticsRepository(newTestDB(t, "basic"))
+
+	categories, err := repo.GetRatingCategories(context.Background())
+	if err != nil {
+		t.Fatalf("GetRatingCategories() error = %v", err)
+	}
+
+	// Categories are listed even without ratings, and fractional weights survive
+	expected := []models.RatingCategory{
+		{ID: 2, Name: "Grammar", Weight: 0.5},
+		{ID: 1, Name: "Spelling", Weight: 1},
+		{ID: 3, Name: "Tone", Weight: 2},
+	}
+
+	if !reflect.DeepEqual(categories, expected) {
+		t.Errorf("Categories mismatch\n got: %+v\nwant: %+v", categories, expected)
+	}
+}
+
+func TestAnalyticsRepository_Integration_EmptyRange(t *testing.T) {
+	repo := NewAnalyticsRepository(newTestDB(t, "basic"))
+
+	rng := models.NewDateRange(date(2024, 1, 1), date(2024, 2, 1))
+
+	daily, err := repo.GetDailyAggregatedCategoryRatings(context.Background(), rng)
+	if err != nil {
+		t.Fatalf("GetDailyAggregatedCategoryRatings() error = %v", err)
+	}
+	weekly, err := repo.GetWeeklyAggregatedCategoryRatings(context.Background(), rng)
+	if err != nil {
+		t.Fatalf("GetWeeklyAggregatedCategoryRatings() error = %v", err)
+	}
+	tickets, err := repo.GetScoresByTicket(context.Background(), rng)
+	if err != nil {
+		t.Fatalf("GetScoresByTicket() error = %v", err)
+	}
+	overall, err := repo.GetOverallQualityScore(context.Background(), rng)
+	if err != nil {
+		t.Fatalf("GetOverallQualityScore() error = %v", err)
+	}
+
+	if len(daily) != 0 || len(weekly) != 0 || len(tickets) != 0 || len(overall) != 0 {
+		t.Errorf("Expected no rows, got daily=%d weekly=%d tickets=%d overall=%d",
+			len(daily), len(weekly), len(tickets), len(overall))
+	}
+}
+
+func TestAnalyticsRepository_Integration_GetRatingDistribution(t *testing.T) {
+	repo := NewAnalyticsRepository(newTestDB(t, "basic"))
+
+	rows, err := repo.GetRatingDistribution(context.Background(), models.NewDateRange(date(2025, 1, 1), date(2025, 1, 13)), "")
+	if err != nil {
+		t.Fatalf("GetRatingDistribution() error = %v", err)
+	}
+
+	if len(rows) != 2 {
+		t.Fatalf("Expected Grammar and Spelling, got %+v", rows)
+	}
+
+	// Spelling: 4, 2 and 5; the 0 at the end of the range is excluded
+	spelling := rows[1]
+	if spelling.CategoryName != "Spelling" || spelling.Counts != [6]int{0, 0, 1, 0, 1, 1} {
+		t.Errorf("Expected Spelling counts [0 0 1 0 1 1], got %s %v", spelling.CategoryName, spelling.Counts)
+	}
+	if spelling.Total != 3 || spelling.Median != 4 || !spelling.Date.IsZero() {
+		t.Errorf("Expected 3 undated ratings with median 4, got %d, median %v, date %v", spelling.Total, spelling.Median, spelling.Date)
+	}
+}
+
+func TestAnalyticsRepository_Integration_GetRatingDistribution_Weekly(t *testing.T) {
+	repo := NewAnalyticsRepository(newTestDB(t, "basic"))
+
+	rows, err := repo.GetRatingDistribution(context.Background(), models.NewDateRange(date(2024, 12, 30), date(2025, 1, 13)), models.GranularityWeek)
+	if err != nil {
+		t.Fatalf("GetRatingDistribution() error = %v", err)
+	}
+
+	// Buckets match GetWeeklyAggregatedCategoryRatings
+	expected := []struct {
+		category int
+		week     time.Time
+		counts   [6]int
+	}{
+		{2, date(2025, 1, 6), [6]int{0, 1, 0, 1, 0, 0}},
+		{1, date(2024, 12, 30), [6]int{0, 0, 0, 0, 1, 0}},
+		{1, date(2025, 1, 6), [6]int{0, 0, 1, 0, 0, 1}},
+	}
+	if len(rows) != len(expected) {
+		t.Fatalf("Expected %d rows, got %+v", len(expected), rows)
+	}
+	for i, e := range expected {
+		if rows[i].CategoryID != e.category || !rows[i].Date.Equal(e.week) || rows[i].Counts != e.counts {
+			t.Errorf("Row %d: expected %d %v %v, got %d %v %v", i, e.category, e.week, e.counts, rows[i].CategoryID, rows[i].Date, rows[i].Counts)
+		}
+	}
+}
+
+func TestAnalyticsRepository_Integration_NonUTCRange(t *testing.T) {
+	repo := NewAnalyticsRepository(newTestDB(t, "basic"))
+
+	// The same instants as [2025-01-06, 2025-01-13) UTC, expressed in UTC+2
+	loc := time.FixedZone("UTC+2", 2*60*60)
+	local := models.NewDateRange(date(2025, 1, 6).In(loc), date(2025, 1, 13).In(loc))
+
+	got, err := repo.GetOverallQualityScore(context.Background(), local)
+	if err != nil {
+		t.Fatalf("GetOverallQualityScore() error = %v", err)
+	}
+	expected, err := repo.GetOverallQualityScore(context.Background(), models.NewDateRange(date(2025, 1, 6), date(2025, 1, 13)))
+	if err != nil {
+		t.Fatalf("GetOverallQualityScore() error = %v", err)
+	}
+
+	if !reflect.DeepEqual(got, expected) {
+		t.Errorf("Range in another time zone selected different ratings\n got: %+v\nwant: %+v", got, expected)
+	}
+}
+
+func TestAnalyticsRepository_Integration_GetCategoryScoresByPeriod(t *testing.T) {
+	repo := NewAnalyticsRepository(newTestDB(t, "basic"))
+
+	periods := []models.DateRange{
+		models.NewDateRange(date(2024, 12, 30), date(2025, 1, 6)),
+		models.NewDateRange(date(2025, 1, 6), date(2025, 1, 13)),
+		models.NewDateRange(date(2025, 1, 20), date(2025, 1, 27)),
+	}
+	rows, err := repo.GetCategoryScoresByPeriod(context.Background(), periods)
+	if err != nil {
+		t.Fatalf("GetCategoryScoresByPeriod() error = %v", err)
+	}
+
+	// Every period must match a separate GetOverallQualityScore call; the empty one yields no rows
+	var expected []models.PeriodCategoryScore
+	for i, rng := range periods {
+		scores, err := repo.GetOverallQualityScore(context.Background(), rng)
+		if err != nil {
+			t.Fatalf("GetOverallQualityScore() error = %v", err)
+		}
+		for _, s := range scores {
+			expected = append(expected, models.PeriodCategoryScore{PeriodIndex: i, CategoryScore: s})
+		}
+	}
+	if len(expected) != 3 {
+		t.Fatalf("Fixture changed: expected 3 category rows across periods, got %d", len(expected))
+	}
+
+	if !reflect.DeepEqual(rows, expected) {
+		t.Errorf("Period scores mismatch\n got: %+v\nwant: %+v", rows, expected)
+	}
+}
+
+func TestAnalyticsRepository_Integration_TicketCreatedBasis(t *testing.T) {
+	repo := NewAnalyticsRepository(newTestDB(t, "basic"))
+
+	// Ticket 1 was created on 2024-12-30, before any of its ratings
+	rng := models.DateRange{Start: date(2024, 12, 30), End: date(2025, 1, 1), Basis: models.DateBasisTicketCreated}
+	scores, err := repo.GetOverallQualityScore(context.Background(), rng)
+	if err != nil {
+		t.Fatalf("GetOverallQualityScore() error = %v", err)
+	}
+	expected := []models.CategoryScore{
+		{CategoryID: 1, CategoryName: "Spelling", CategoryWeight: 1, Score: 2, RatingCount: 3, RatingVariance: variance(2, 20.0/3), WeightedVariance: variance(2, 20.0/3)},
+	}
+	if !reflect.DeepEqual(scores, expected) {
+		t.Errorf("Category scores mismatch\n got: %+v\nwant: %+v", scores, expected)
+	}
+
+	rng.Basis = models.DateBasisRatingCreated
+	if scores, err := repo.GetOverallQualityScore(context.Background(), rng); err != nil || len(scores) != 0 {
+		t.Errorf("Expected no ratings created in the range, got %+v (error %v)", scores, err)
+	}
+}
+
+func TestAnalyticsRepository_Integration_TicketCreatedBasis_Buckets(t *testing.T) {
+	repo := NewAnalyticsRepository(newTestDB(t, "basic"))
+
+	rng := models.DateRange{Start: date(2024, 12, 30), End: date(2025, 1, 13), InclusiveEnd: true, Basis: models.DateBasisTicketCreated}
+	rows, err := repo.GetDailyAggregatedCategoryRatings(context.Background(), rng)
+	if err != nil {
+		t.Fatalf("GetDailyAggregatedCategoryRatings() error = %v", err)
+	}
+
+	// Every rating lands on its ticket's creation day, including the one rated at the range end
+	expected := []bucket{
+		{CategoryID: 2, Date: date(2025, 1, 5), AvgRating: 2, RatingCount: 2, RatingsTotal: 2},
+		{CategoryID: 1, Date: date(2024, 12, 30), AvgRating: 2, RatingCount: 3, RatingsTotal: 4},
+		{CategoryID: 1, Date: date(2025, 1, 5), AvgRating: 5, RatingCount: 1, RatingsTotal: 4},
+	}
+	if got := toBuckets(rows); !reflect.DeepEqual(got, expected) {
+		t.Errorf("Daily buckets mismatch\n got: %+v\nwant: %+v", got, expected)
+	}
+
+	periods := []models.DateRange{
+		{Start: date(2024, 12, 30), End: date(2025, 1, 5), Basis: models.DateBasisTicketCreated},
+		{Start: date(2025, 1, 5), End: date(2025, 1, 6), Basis: models.DateBasisTicketCreated},
+	}
+	byPeriod, err := repo.GetCategoryScoresByPeriod(context.Background(), periods)
+	if err != nil {
+		t.Fatalf("GetCategoryScoresByPeriod() error = %v", err)
+	}
+	var want []models.PeriodCategoryScore
+	for i, p := range periods {
+		scores, err := repo.GetOverallQualityScore(context.Background(), p)
+		if err != nil {
+			t.Fatalf("GetOverallQualityScore() error = %v", err)
+		}
+		for _, s := range scores {
+			want = append(want, models.PeriodCategoryScore{PeriodIndex: i, CategoryScore: s})
+		}
+	}
+	if len(want) != 3 || !reflect.DeepEqual(byPeriod, want) {
+		t.Errorf("Period scores mismatch\n got: %+v\nwant: %+v", byPeriod, want)
+	}
+}
+
+func TestAnalyticsRepository_Integration_CancelsSlowQuery(t *testing.T) {
+	db := newTestDB(t, "basic")
+	// Replace ratings with a view generating far more rows than the query can scan before its deadline
+	_, err := db.Exec(`
+		DROP TABLE ratings;
+		CREATE VIEW ratings AS
+		WITH RECURSIVE n(i) AS (SELECT 1 UNION ALL SELECT i + 1 FROM n LIMIT 1000000000)
+		SELECT i AS id, 3 AS rating, 1 AS ticket_id, 1 AS rating_category_id, 1 AS reviewer_id, 2 AS reviewee_id,
+			'2025-01-05 00:00:00' AS created_at
+		FROM n`)
+	if err != nil {
+		t.Fatalf("create slow ratings view: %v", err)
+	}
+	repo := NewAnalyticsRepository(db)
+
+	ctx, cancel := context.WithTimeout(context.Background(), 50*time.Millisecond)
+	defer cancel()
+
+	start := time.Now()
+	_, err = repo.GetOverallQualityScore(ctx, models.NewDateRange(date(2025, 1, 1), date(2025, 2, 1)))
+	if !errors.Is(err, context.DeadlineExceeded) {
+		t.Fatalf("Expected the query to be cancelled by the context deadline, got %v", err)
+	}
+	if elapsed := time.Since(start); elapsed > 5*time.Second {
+		t.Errorf("Expected the query to stop shortly after the deadline, took %v", elapsed)
+	}
+}
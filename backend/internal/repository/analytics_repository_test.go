@@ -1,9 +1,12 @@
 package repository
 
 import (
+	"context"
 	"database/sql"
 	"testing"
 	"time"
+
+	"go-grpc-backend/internal/models"
 )
 
 func TestAnalyticsRepository_NewAnalyticsRepository(t *testing.T) {
@@ -33,7 +36,7 @@ func TestAnalyticsRepository_GetRatingCategories_ErrorHandling(t *testing.T) {
 		}
 	}()
 
-	repo.GetRatingCategories()
+	repo.GetRatingCategories(context.Background())
 }
 
 func TestAnalyticsRepository_GetOverallQualityScore_ErrorHandling(t *testing.T) {
@@ -51,5 +54,5 @@ func TestAnalyticsRepository_GetOverallQualityScore_ErrorHandling(t *testing.T)
 		}
 	}()
 
-	repo.GetOverallQualityScore(startDate, endDate)
+	repo.GetOverallQualityScore(context.Background(), models.NewDateRange(startDate, endDate))
 }
@@ -0,0 +1,11 @@
+package repository
+
+import "errors"
+
+var (
+	// ErrNotFound is returned, wrapped, when a lookup, update or delete names a row that doesn't exist
+	ErrNotFound = errors.New("not found")
+	// ErrConflict is returned, wrapped, when a write is refused because of other rows,
+	// such as an overlapping membership or a team's sub-teams
+	ErrConflict = errors.New("conflict")
+)
@@ -0,0 +1,151 @@
+package repository
+
+import (
+	"database/sql"
+	"os"
+	"path/filepath"
+	"testing"
+	"time"
+
+	"go-grpc-backend/internal/database"
+
+	_ "github.com/mattn/go-sqlite3"
+	"gopkg.in/yaml.v3"
+)
+
+// fixture is the declarative content of a testdata/fixtures/*.yaml file. Rows are inserted in
+// dependency order: users, tickets, categories, ratings, ticket attributes, teams, memberships.
+// Fixtures hold the data a test reads; rows a test exists to write go through the repository
+type fixture struct {
+	Users []struct {
+		ID   int    `yaml:"id"`
+		Name string `yaml:"name"`
+	} `yaml:"users"`
+	Tickets []struct {
+		ID        int       `yaml:"id"`
+		Subject   string    `yaml:"subject"`
+		CreatedAt time.Time `yaml:"created_at"`
+	} `yaml:"tickets"`
+	RatingCategories []struct {
+		ID     int     `yaml:"id"`
+		Name   string  `yaml:"name"`
+		Weight float64 `yaml:"weight"`
+	} `yaml:"rating_categories"`
+	Ratings []struct {
+		ID               int       `yaml:"id"`
+		Rating           int       `yaml:"rating"`
+		TicketID         int       `yaml:"ticket_id"`
+		RatingCategoryID int       `yaml:"rating_category_id"`
+		ReviewerID       int       `yaml:"reviewer_id"`
+		RevieweeID       int       `yaml:"reviewee_id"`
+		CreatedAt        time.Time `yaml:"created_at"`
+	} `yaml:"ratings"`
+	TicketAttributes []struct {
+		TicketID  int       `yaml:"ticket_id"`
+		Key       string    `yaml:"key"`
+		Value     string    `yaml:"value"`
+		UpdatedAt time.Time `yaml:"updated_at"`
+	} `yaml:"ticket_attributes"`
+	Teams []struct {
+		ID        int       `yaml:"id"`
+		Name      string    `yaml:"name"`
+		Kind      string    `yaml:"kind"`
+		ParentID  *int      `yaml:"parent_id"`
+		CreatedAt time.Time `yaml:"created_at"`
+	} `yaml:"teams"`
+	TeamMemberships []struct {
+		ID        int        `yaml:"id"`
+		TeamID    int        `yaml:"team_id"`
+		UserID    int        `yaml:"user_id"`
+		ValidFrom time.Time  `yaml:"valid_from"`
+		ValidTo   *time.Time `yaml:"valid_to"`
+	} `yaml:"team_memberships"`
+}
+
+// newTestDB opens an in-memory SQLite database with the production schema and migrations, and loads the named fixtures
+func newTestDB(t *testing.T, fixtures ...string) *sql.DB {
+	t.Helper()
+
+	db, err := sql.Open("sqlite3", ":memory:")
+	if err != nil {
+		t.Fatalf("open in-memory database: %v", err)
+	}
+	// Every connection to :memory: is a separate database, so pin the pool to one
+	db.SetMaxOpenConns(1)
+	t.Cleanup(func() { db.Close() })
+
+	// Production connections enforce foreign keys; alert tables rely on ON DELETE CASCADE
+	if _, err := db.Exec("PRAGMA foreign_keys = ON"); err != nil {
+		t.Fatalf("enable foreign keys: %v", err)
+	}
+
+	schema, err := os.ReadFile(filepath.Join("testdata", "schema.sql"))
+	if err != nil {
+		t.Fatalf("read schema: %v", err)
+	}
+	if _, err := db.Exec(string(schema)); err != nil {
+		t.Fatalf("apply schema: %v", err)
+	}
+	// The tables the server creates on top of the shipped schema
+	if err := database.Migrate(db); err != nil {
+		t.Fatalf("migrate: %v", err)
+	}
+
+	for _, name := range fixtures {
+		loadFixture(t, db, name)
+	}
+
+	return db
+}
+
+func loadFixture(t *testing.T, db *sql.DB, name string) {
+	t.Helper()
+
+	data, err := os.ReadFile(filepath.Join("testdata", "fixtures", name+".yaml"))
+	if err != nil {
+		t.Fatalf("read fixture %s: %v", name, err)
+	}
+
+	var f fixture
+	if err := yaml.Unmarshal(data, &f); err != nil {
+		t.Fatalf("parse fixture %s: %v", name, err)
+	}
+
+	exec := func(query string, args ...any) {
+		t.Helper()
+		if _, err := db.Exec(query, args...); err != nil {
+			t.Fatalf("fixture %s: %v", name, err)
+		}
+	}
+
+	for _, u := range f.Users {
+		exec(`INSERT INTO users (id, name) VALUES (?, ?)`, u.ID, u.Name)
+	}
+	for _, tk := range f.Tickets {
+		exec(`INSERT INTO tickets (id, subject, created_at) VALUES (?, ?, ?)`, tk.ID, tk.Subject, tk.CreatedAt)
+	}
+	for _, c := range f.RatingCategories {
+		exec(`INSERT INTO rating_categories (id, name, weight) VALUES (?, ?, ?)`, c.ID, c.Name, c.Weight)
+	}
+	for _, r := range f.Ratings {
+		exec(`INSERT INTO ratings (id, rating, ticket_id, rating_category_id, reviewer_id, reviewee_id, created_at)
+			VALUES (?, ?, ?, ?, ?, ?, ?)`,
+			r.ID, r.Rating, r.TicketID, r.RatingCategoryID, r.ReviewerID, r.RevieweeID, r.CreatedAt)
+	}
+	for _, a := range f.TicketAttributes {
+		exec(`INSERT INTO ticket_attributes (ticket_id, key, value, updated_at) VALUES (?, ?, ?, ?)`,
+			a.TicketID, a.Key, a.Value, a.UpdatedAt)
+	}
+	for _, tm := range f.Teams {
+		exec(`INSERT INTO teams (id, name, kind, parent_id, created_at) VALUES (?, ?, ?, ?, ?)`,
+			tm.ID, tm.Name, tm.Kind, tm.ParentID, tm.CreatedAt)
+	}
+	for _, m := range f.TeamMemberships {
+		exec(`INSERT INTO team_memberships (id, team_id, user_id, valid_from, valid_to) VALUES (?, ?, ?, ?, ?)`,
+			m.ID, m.TeamID, m.UserID, m.ValidFrom, m.ValidTo)
+	}
+}
+
+func date(year int, month time.Month, day int) time.Time {
+	return time.Date(year, month, day, 0, 0, 0, 0, time.UTC)
+}
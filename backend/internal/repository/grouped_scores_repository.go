@@ -0,0 +1,208 @@
+package repository
+
+import (
+	"context"
+	"database/sql"
+	"fmt"
+	"strings"
+	"time"
+
+	"go-grpc-backend/internal/models"
+)
+
+// GroupedScoresRepositoryInterface aggregates category scores by ticket attributes, reviewee,
+// time bucket and team
+type GroupedScoresRepositoryInterface interface {
+	GetGroupedCategoryScores(ctx context.Context, rng models.DateRange, grouping models.ScoreGrouping) ([]models.GroupedCategoryScore, error)
+	GetTeamCategoryScores(ctx context.Context, rng models.DateRange) ([]models.TeamCategoryScore, error)
+}
+
+type GroupedScoresRepository struct {
+	db *sql.DB
+}
+
+func NewGroupedScoresRepository(db *sql.DB) *GroupedScoresRepository {
+	return &GroupedScoresRepository{db: db}
+}
+
+// GetGroupedCategoryScores returns one row per category within each group of the grouping.
+// Ratings are filtered like every AnalyticsRepository query, and ratings on tickets without
+// one of the attributes are grouped under an empty value. Rows are ordered by attribute values,
+// reviewee name, bucket and category name, so the rows of a group are adjacent
+func (r *GroupedScoresRepository) GetGroupedCategoryScores(ctx context.Context, rng models.DateRange, grouping models.ScoreGrouping) ([]models.GroupedCategoryScore, error) {
+	bucket, err := bucketExpr(grouping.Granularity, rng.Basis)
+	if err != nil {
+		return nil, err
+	}
+
+	args := rangeArgs(rng)
+	var (
+		columns []string
+		joins   []string
+		groupBy []string
+	)
+	for i, key := range grouping.AttributeKeys {
+		alias := fmt.Sprintf("a%d", i)
+		args = append(args, key)
+		columns = append(columns, fmt.Sprintf("COALESCE(%s.value, '') AS attr%d", alias, i))
+		joins = append(joins, fmt.Sprintf("LEFT JOIN ticket_attributes %s ON %s.ticket_id = r.ticket_id AND %s.key = ?%d", alias, alias, alias, len(args)))
+		groupBy = append(groupBy, fmt.Sprintf("attr%d", i))
+	}
+	if grouping.Reviewee {
+		columns = append(columns, "u.id AS reviewee_id", "u.name AS reviewee_name")
+		joins = append(joins, "JOIN users u ON u.id = r.reviewee_id")
+		groupBy = append(groupBy, "reviewee_name", "reviewee_id")
+	} else {
+		columns = append(columns, "0 AS reviewee_id", "'' AS reviewee_name")
+	}
+	columns = append(columns, bucket+" AS bucket")
+	groupBy = append(groupBy, "bucket")
+
+	query := `
+		WITH ` + weightPeriods + `
+		SELECT
+			` + strings.Join(columns, ",\n\t\t\t") + `,
+			rc.id AS category_id,
+			rc.name AS category_name,
+			` + categoryWeight + ` AS category_weight,
+			AVG(r.rating) AS avg_percent,
+			AVG(r.rating * r.rating) AS avg_square,
+			` + avgWeightedSquare + ` AS avg_weighted_square,
+			COUNT(r.id) AS rating_count
+		FROM ratings r
+		JOIN rating_categories rc ON r.rating_category_id = rc.id
+		` + weightJoin + `
+		` + ticketsJoin(rng.Basis) + `
+		` + strings.Join(joins, "\n\t\t") + `
+		WHERE ` + ratingsInRange(rng.Basis) + `
+		GROUP BY ` + strings.Join(groupBy, ", ") + `, rc.id
+		ORDER BY ` + strings.Join(groupBy, ", ") + `, rc.name, rc.id
+	`
+
+	rows, err := r.db.QueryContext(ctx, query, args...)
+	if err != nil {
+		return nil, fmt.Errorf("failed to query grouped scores: %v", err)
+	}
+	defer rows.Close()
+
+	var scores []models.GroupedCategoryScore
+	for rows.Next() {
+		var (
+			score                        models.GroupedCategoryScore
+			bucketStr                    string
+			avgSquare, avgWeightedSquare float64
+		)
+		score.AttributeValues = make([]string, len(grouping.AttributeKeys))
+		dest := make([]any, 0, len(grouping.AttributeKeys)+10)
+		for i := range score.AttributeValues {
+			dest = append(dest, &score.AttributeValues[i])
+		}
+		dest = append(dest,
+			&score.RevieweeID,
+			&score.RevieweeName,
+			&bucketStr,
+			&score.CategoryID,
+			&score.CategoryName,
+			&score.CategoryWeight,
+			&score.Score,
+			&avgSquare,
+			&avgWeightedSquare,
+			&score.RatingCount,
+		)
+		if err := rows.Scan(dest...); err != nil {
+			return nil, fmt.Errorf("failed to scan grouped score: %v", err)
+		}
+		if bucketStr != "" {
+			score.Date, err = time.ParseInLocation("2006-01-02", bucketStr, time.UTC)
+			if err != nil {
+				return nil, fmt.Errorf("failed to parse bucket %q: %v", bucketStr, err)
+			}
+		}
+		score.RatingVariance = variance(score.Score, avgSquare)
+		score.WeightedVariance = variance(score.Score*score.CategoryWeight, avgWeightedSquare)
+		scores = append(scores, score)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, fmt.Errorf("failed to read grouped scores: %v", err)
+	}
+
+	return scores, nil
+}
+
+// GetTeamCategoryScores rolls category scores up the team hierarchy. A rating counts towards every
+// team its reviewee was a member of when the rating was created, and towards all their ancestors,
+// but at most once per team even through several memberships. Memberships are matched on the
+// rating's created_at whatever the range's basis. Teams without attributed ratings have no rows;
+// rows are ordered by team ID then category name
+func (r *GroupedScoresRepository) GetTeamCategoryScores(ctx context.Context, rng models.DateRange) ([]models.TeamCategoryScore, error) {
+	query := `
+		WITH RECURSIVE ancestors (team_id, ancestor_id) AS (
+			SELECT id, id FROM teams
+			UNION
+			SELECT a.team_id, t.parent_id
+			FROM ancestors a
+			JOIN teams t ON t.id = a.ancestor_id
+			WHERE t.parent_id IS NOT NULL
+		),
+		attributed AS (
+			SELECT DISTINCT a.ancestor_id AS team_id, r.id AS rating_id
+			FROM ratings r
+			` + ticketsJoin(rng.Basis) + `
+			JOIN team_memberships m ON m.user_id = r.reviewee_id
+				AND m.valid_from <= r.created_at AND (m.valid_to IS NULL OR r.created_at < m.valid_to)
+			JOIN ancestors a ON a.team_id = m.team_id
+			WHERE ` + ratingsInRange(rng.Basis) + `
+		),
+		` + weightPeriods + `
+		SELECT
+			at.team_id,
+			rc.id AS category_id,
+			rc.name AS category_name,
+			` + categoryWeight + ` AS category_weight,
+			AVG(r.rating) AS avg_percent,
+			AVG(r.rating * r.rating) AS avg_square,
+			` + avgWeightedSquare + ` AS avg_weighted_square,
+			COUNT(r.id) AS rating_count
+		FROM attributed at
+		JOIN ratings r ON r.id = at.rating_id
+		JOIN rating_categories rc ON r.rating_category_id = rc.id
+		` + weightJoin + `
+		GROUP BY at.team_id, rc.id
+		ORDER BY at.team_id, rc.name, rc.id
+	`
+
+	rows, err := r.db.QueryContext(ctx, query, rangeArgs(rng)...)
+	if err != nil {
+		return nil, fmt.Errorf("failed to query team scores: %v", err)
+	}
+	defer rows.Close()
+
+	var scores []models.TeamCategoryScore
+	for rows.Next() {
+		var (
+			score                        models.TeamCategoryScore
+			avgSquare, avgWeightedSquare float64
+		)
+		err := rows.Scan(
+			&score.TeamID,
+			&score.CategoryID,
+			&score.CategoryName,
+			&score.CategoryWeight,
+			&score.Score,
+			&avgSquare,
+			&avgWeightedSquare,
+			&score.RatingCount,
+		)
+		if err != nil {
+			return nil, fmt.Errorf("failed to scan team score: %v", err)
+		}
+		score.RatingVariance = variance(score.Score, avgSquare)
+		score.WeightedVariance = variance(score.Score*score.CategoryWeight, avgWeightedSquare)
+		scores = append(scores, score)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, fmt.Errorf("failed to read team scores: %v", err)
+	}
+
+	return scores, nil
+}
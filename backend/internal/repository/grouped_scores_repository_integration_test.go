@@ -0,0 +1,144 @@
+package repository
+
+import (
+	"context"
+	"reflect"
+	"testing"
+	"time"
+
+	"go-grpc-backend/internal/models"
+)
+
+// groupedScore is the comparable subset of GroupedCategoryScore checked by the integration tests
+type groupedScore struct {
+	Values      []string
+	RevieweeID  int
+	Date        time.Time
+	CategoryID  int
+	Score       float64
+	RatingCount int
+}
+
+func toGroupedScores(rows []models.GroupedCategoryScore) []groupedScore {
+	out := make([]groupedScore, 0, len(rows))
+	for _, r := range rows {
+		out = append(out, groupedScore{
+			Values:      r.AttributeValues,
+			RevieweeID:  r.RevieweeID,
+			Date:        r.Date,
+			CategoryID:  r.CategoryID,
+			Score:       r.Score,
+			RatingCount: r.RatingCount,
+		})
+	}
+	return out
+}
+
+func newTestGroupedScoresRepository(t *testing.T) *GroupedScoresRepository {
+	t.Helper()
+	return NewGroupedScoresRepository(newTestDB(t, "basic", "ticket_attributes"))
+}
+
+func TestGroupedScoresRepository_Integration_AttributeAndReviewee(t *testing.T) {
+	repo := newTestGroupedScoresRepository(t)
+
+	rows, err := repo.GetGroupedCategoryScores(context.Background(), models.NewDateRange(date(2025, 1, 1), date(2025, 1, 13)), models.ScoreGrouping{
+		AttributeKeys: []string{"channel"},
+		Reviewee:      true,
+	})
+	if err != nil {
+		t.Fatalf("GetGroupedCategoryScores() error = %v", err)
+	}
+
+	// Ticket 1 (email) was rated for Bob only, ticket 2 (chat) for both
+	expected := []groupedScore{
+		{Values: []string{"chat"}, RevieweeID: 1, CategoryID: 2, Score: 3, RatingCount: 1},
+		{Values: []string{"chat"}, RevieweeID: 1, CategoryID: 1, Score: 5, RatingCount: 1},
+		{Values: []string{"chat"}, RevieweeID: 2, CategoryID: 2, Score: 1, RatingCount: 1},
+		{Values: []string{"email"}, RevieweeID: 2, CategoryID: 1, Score: 3, RatingCount: 2},
+	}
+	if got := toGroupedScores(rows); !reflect.DeepEqual(got, expected) {
+		t.Errorf("Grouped scores mismatch\n got: %+v\nwant: %+v", got, expected)
+	}
+	if rows[0].RevieweeName != "Alice" || rows[0].CategoryName != "Grammar" || rows[3].RatingVariance != 1 {
+		t.Errorf("Unexpected first or last row %+v, %+v", rows[0], rows[3])
+	}
+}
+
+func TestGroupedScoresRepository_Integration_MissingAttributeAndBucket(t *testing.T) {
+	repo := newTestGroupedScoresRepository(t)
+
+	rows, err := repo.GetGroupedCategoryScores(context.Background(), models.NewDateRange(date(2024, 12, 30), date(2025, 1, 13)), models.ScoreGrouping{
+		AttributeKeys: []string{"priority"},
+		Granularity:   models.GranularityWeek,
+	})
+	if err != nil {
+		t.Fatalf("GetGroupedCategoryScores() error = %v", err)
+	}
+
+	// Ticket 2 has no priority, so its ratings group under ""
+	expected := []groupedScore{
+		{Values: []string{""}, Date: date(2025, 1, 6), CategoryID: 2, Score: 2, RatingCount: 2},
+		{Values: []string{""}, Date: date(2025, 1, 6), CategoryID: 1, Score: 5, RatingCount: 1},
+		{Values: []string{"high"}, Date: date(2024, 12, 30), CategoryID: 1, Score: 4, RatingCount: 1},
+		{Values: []string{"high"}, Date: date(2025, 1, 6), CategoryID: 1, Score: 2, RatingCount: 1},
+	}
+	if got := toGroupedScores(rows); !reflect.DeepEqual(got, expected) {
+		t.Errorf("Grouped scores mismatch\n got: %+v\nwant: %+v", got, expected)
+	}
+}
+
+func TestGroupedScoresRepository_Integration_NoGrouping(t *testing.T) {
+	repo := newTestGroupedScoresRepository(t)
+	rng := models.NewDateRange(date(2025, 1, 1), date(2025, 1, 13))
+
+	rows, err := repo.GetGroupedCategoryScores(context.Background(), rng, models.ScoreGrouping{})
+	if err != nil {
+		t.Fatalf("GetGroupedCategoryScores() error = %v", err)
+	}
+	overall, err := NewAnalyticsRepository(repo.db).GetOverallQualityScore(context.Background(), rng)
+	if err != nil {
+		t.Fatalf("GetOverallQualityScore() error = %v", err)
+	}
+
+	// Without dimensions every category forms one group, exactly like GetOverallQualityScore
+	var got []models.CategoryScore
+	for _, r := range rows {
+		got = append(got, r.CategoryScore)
+	}
+	if !reflect.DeepEqual(got, overall) {
+		t.Errorf("Ungrouped scores mismatch\n got: %+v\nwant: %+v", got, overall)
+	}
+}
+
+func TestGroupedScoresRepository_Integration_GetTeamCategoryScores(t *testing.T) {
+	repo := NewGroupedScoresRepository(newTestDB(t, "basic", "teams", "team_memberships"))
+	rows, err := repo.GetTeamCategoryScores(context.Background(), models.NewDateRange(date(2025, 1, 1), date(2025, 1, 13)))
+	if err != nil {
+		t.Fatalf("GetTeamCategoryScores() error = %v", err)
+	}
+
+	type teamScore struct {
+		TeamID      int
+		CategoryID  int
+		Score       float64
+		RatingCount int
+	}
+	var got []teamScore
+	for _, r := range rows {
+		got = append(got, teamScore{r.TeamID, r.CategoryID, r.Score, r.RatingCount})
+	}
+	// Tier 1 gets Bob's Spelling ratings 4 and 2, Tier 2 the Grammar ratings 3 (Alice) and 1 (Bob);
+	// Alice's Spelling 5 predates her membership and counts nowhere
+	expected := []teamScore{
+		{TeamID: 1, CategoryID: 2, Score: 2, RatingCount: 2},
+		{TeamID: 1, CategoryID: 1, Score: 3, RatingCount: 2},
+		{TeamID: 2, CategoryID: 2, Score: 2, RatingCount: 2},
+		{TeamID: 2, CategoryID: 1, Score: 3, RatingCount: 2},
+		{TeamID: 3, CategoryID: 1, Score: 3, RatingCount: 2},
+		{TeamID: 4, CategoryID: 2, Score: 2, RatingCount: 2},
+	}
+	if !reflect.DeepEqual(got, expected) {
+		t.Errorf("Team scores mismatch\n got: %+v\nwant: %+v", got, expected)
+	}
+}
@@ -0,0 +1,142 @@
+package repository
+
+import (
+	"context"
+	"database/sql"
+	"errors"
+	"fmt"
+	"time"
+
+	"go-grpc-backend/internal/models"
+)
+
+// QualityTargetRepositoryInterface stores the quality targets of rating categories
+type QualityTargetRepositoryInterface interface {
+	ListQualityTargets(ctx context.Context) ([]models.QualityTarget, error)
+	GetQualityTarget(ctx context.Context, id int) (models.QualityTarget, error)
+	CreateQualityTarget(ctx context.Context, target models.QualityTarget) (models.QualityTarget, error)
+	UpdateQualityTarget(ctx context.Context, target models.QualityTarget) (models.QualityTarget, error)
+	DeleteQualityTarget(ctx context.Context, id int) error
+	GetRatingCategory(ctx context.Context, id int) (models.RatingCategory, error)
+}
+
+// QualityTargetRepository keeps quality targets in the table created by database.Migrate.
+// It writes, so it must be given the writer connection
+type QualityTargetRepository struct {
+	db  *sql.DB
+	now func() time.Time
+}
+
+func NewQualityTargetRepository(db *sql.DB) *QualityTargetRepository {
+	return &QualityTargetRepository{db: db, now: time.Now}
+}
+
+const qualityTargetQuery = `
+	SELECT qt.id, qt.category_id, rc.name, rc.weight, qt.target_score, qt.window_ns, qt.created_at
+	FROM quality_targets qt
+	JOIN rating_categories rc ON rc.id = qt.category_id`
+
+func (r *QualityTargetRepository) ListQualityTargets(ctx context.Context) ([]models.QualityTarget, error) {
+	rows, err := r.db.QueryContext(ctx, qualityTargetQuery+` ORDER BY qt.id`)
+	if err != nil {
+		return nil, fmt.Errorf("failed to query quality targets: %v", err)
+	}
+	defer rows.Close()
+
+	var targets []models.QualityTarget
+	for rows.Next() {
+		target, err := scanQualityTarget(rows)
+		if err != nil {
+			return nil, err
+		}
+		targets = append(targets, target)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, fmt.Errorf("failed to read quality targets: %v", err)
+	}
+	return targets, nil
+}
+
+func (r *QualityTargetRepository) GetQualityTarget(ctx context.Context, id int) (models.QualityTarget, error) {
+	target, err := scanQualityTarget(r.db.QueryRowContext(ctx, qualityTargetQuery+` WHERE qt.id = ?`, id))
+	if errors.Is(err, sql.ErrNoRows) {
+		return models.QualityTarget{}, fmt.Errorf("quality target %d: %w", id, ErrNotFound)
+	}
+	return target, err
+}
+
+// CreateQualityTarget inserts target and returns it as stored, with its category's name and weight
+func (r *QualityTargetRepository) CreateQualityTarget(ctx context.Context, target models.QualityTarget) (models.QualityTarget, error) {
+	res, err := r.db.ExecContext(ctx, `
+		INSERT INTO quality_targets (category_id, target_score, window_ns, created_at)
+		VALUES (?, ?, ?, ?)`,
+		target.CategoryID, target.TargetScore, int64(target.Window), r.now().UTC())
+	if err != nil {
+		return models.QualityTarget{}, fmt.Errorf("failed to create quality target: %v", err)
+	}
+	id, err := res.LastInsertId()
+	if err != nil {
+		return models.QualityTarget{}, fmt.Errorf("failed to create quality target: %v", err)
+	}
+	return r.GetQualityTarget(ctx, int(id))
+}
+
+// UpdateQualityTarget replaces the category, score and window of the target with target.ID
+func (r *QualityTargetRepository) UpdateQualityTarget(ctx context.Context, target models.QualityTarget) (models.QualityTarget, error) {
+	res, err := r.db.ExecContext(ctx, `
+		UPDATE quality_targets SET category_id = ?, target_score = ?, window_ns = ?
+		WHERE id = ?`,
+		target.CategoryID, target.TargetScore, int64(target.Window), target.ID)
+	if err != nil {
+		return models.QualityTarget{}, fmt.Errorf("failed to update quality target: %v", err)
+	}
+	if n, err := res.RowsAffected(); err != nil {
+		return models.QualityTarget{}, fmt.Errorf("failed to update quality target: %v", err)
+	} else if n == 0 {
+		return models.QualityTarget{}, fmt.Errorf("quality target %d: %w", target.ID, ErrNotFound)
+	}
+	return r.GetQualityTarget(ctx, target.ID)
+}
+
+func (r *QualityTargetRepository) DeleteQualityTarget(ctx context.Context, id int) error {
+	res, err := r.db.ExecContext(ctx, `DELETE FROM quality_targets WHERE id = ?`, id)
+	if err != nil {
+		return fmt.Errorf("failed to delete quality target: %v", err)
+	}
+	if n, err := res.RowsAffected(); err != nil {
+		return fmt.Errorf("failed to delete quality target: %v", err)
+	} else if n == 0 {
+		return fmt.Errorf("quality target %d: %w", id, ErrNotFound)
+	}
+	return nil
+}
+
+func (r *QualityTargetRepository) GetRatingCategory(ctx context.Context, id int) (models.RatingCategory, error) {
+	var category models.RatingCategory
+	err := r.db.QueryRowContext(ctx, `SELECT id, name, weight FROM rating_categories WHERE id = ?`, id).
+		Scan(&category.ID, &category.Name, &category.Weight)
+	if errors.Is(err, sql.ErrNoRows) {
+		return models.RatingCategory{}, fmt.Errorf("rating category %d: %w", id, ErrNotFound)
+	}
+	if err != nil {
+		return models.RatingCategory{}, fmt.Errorf("failed to query rating category: %v", err)
+	}
+	return category, nil
+}
+
+func scanQualityTarget(row rowScanner) (models.QualityTarget, error) {
+	var (
+		target models.QualityTarget
+		window int64
+	)
+	err := row.Scan(&target.ID, &target.CategoryID, &target.CategoryName, &target.CategoryWeight,
+		&target.TargetScore, &window, &target.CreatedAt)
+	if errors.Is(err, sql.ErrNoRows) {
+		return models.QualityTarget{}, err
+	}
+	if err != nil {
+		return models.QualityTarget{}, fmt.Errorf("failed to scan quality target: %v", err)
+	}
+	target.Window = time.Duration(window)
+	return target, nil
+}
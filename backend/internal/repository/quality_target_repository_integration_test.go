@@ -0,0 +1,85 @@
+package repository
+
+import (
+	"context"
+	"errors"
+	"reflect"
+	"testing"
+	"time"
+
+	"go-grpc-backend/internal/models"
+)
+
+func newTestQualityTargetRepository(t *testing.T) *QualityTargetRepository {
+	t.Helper()
+	repo := NewQualityTargetRepository(newTestDB(t, "basic"))
+	repo.now = func() time.Time { return time.Date(2025, 1, 15, 9, 0, 0, 0, time.UTC) }
+	return repo
+}
+
+func TestQualityTargetRepository_Integration_CRUD(t *testing.T) {
+	repo := newTestQualityTargetRepository(t)
+
+	created, err := repo.CreateQualityTarget(context.Background(), models.QualityTarget{CategoryID: 2, TargetScore: 40, Window: 7 * 24 * time.Hour})
+	if err != nil {
+		t.Fatalf("CreateQualityTarget() error = %v", err)
+	}
+	want := models.QualityTarget{
+		ID:             created.ID,
+		CategoryID:     2,
+		CategoryName:   "Grammar",
+		CategoryWeight: 0.5,
+		TargetScore:    40,
+		Window:         7 * 24 * time.Hour,
+		CreatedAt:      repo.now(),
+	}
+	if created.ID == 0 || !reflect.DeepEqual(created, want) {
+		t.Errorf("Created target mismatch\n got: %+v\nwant: %+v", created, want)
+	}
+
+	update := models.QualityTarget{ID: created.ID, CategoryID: 1, TargetScore: 85, Window: 30 * 24 * time.Hour}
+	updated, err := repo.UpdateQualityTarget(context.Background(), update)
+	if err != nil {
+		t.Fatalf("UpdateQualityTarget() error = %v", err)
+	}
+	if updated.CategoryName != "Spelling" || updated.TargetScore != 85 || updated.Window != update.Window || !updated.CreatedAt.Equal(created.CreatedAt) {
+		t.Errorf("Unexpected updated target %+v", updated)
+	}
+
+	targets, err := repo.ListQualityTargets(context.Background())
+	if err != nil {
+		t.Fatalf("ListQualityTargets() error = %v", err)
+	}
+	if len(targets) != 1 || !reflect.DeepEqual(targets[0], updated) {
+		t.Errorf("Listed targets mismatch\n got: %+v\nwant: %+v", targets, updated)
+	}
+
+	if err := repo.DeleteQualityTarget(context.Background(), created.ID); err != nil {
+		t.Fatalf("DeleteQualityTarget() error = %v", err)
+	}
+	if _, err := repo.GetQualityTarget(context.Background(), created.ID); !errors.Is(err, ErrNotFound) {
+		t.Errorf("Expected ErrNotFound after delete, got %v", err)
+	}
+}
+
+func TestQualityTargetRepository_Integration_NotFound(t *testing.T) {
+	repo := newTestQualityTargetRepository(t)
+
+	if _, err := repo.UpdateQualityTarget(context.Background(), models.QualityTarget{ID: 42, CategoryID: 1, TargetScore: 80, Window: time.Hour}); !errors.Is(err, ErrNotFound) {
+		t.Errorf("UpdateQualityTarget(): expected ErrNotFound, got %v", err)
+	}
+	if err := repo.DeleteQualityTarget(context.Background(), 42); !errors.Is(err, ErrNotFound) {
+		t.Errorf("DeleteQualityTarget(): expected ErrNotFound, got %v", err)
+	}
+	if _, err := repo.GetRatingCategory(context.Background(), 42); !errors.Is(err, ErrNotFound) {
+		t.Errorf("GetRatingCategory(): expected ErrNotFound, got %v", err)
+	}
+
+	category, err := repo.GetRatingCategory(context.Background(), 3)
+	if err != nil {
+		t.Fatalf("GetRatingCategory() error = %v", err)
+	}
+	if category != (models.RatingCategory{ID: 3, Name: "Tone", Weight: 2}) {
+		t.Errorf("Unexpected category %+v", category)
+	}
+}
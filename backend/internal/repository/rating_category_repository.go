@@ -0,0 +1,223 @@
+package repository
+
+import (
+	"context"
+	"database/sql"
+	"errors"
+	"fmt"
+	"time"
+
+	"go-grpc-backend/internal/models"
+)
+
+// RatingCategoryRepositoryInterface manages rating categories and the history of their weights
+type RatingCategoryRepositoryInterface interface {
+	ListRatingCategories(ctx context.Context, includeArchived bool) ([]models.RatingCategory, error)
+	GetRatingCategory(ctx context.Context, id int) (models.RatingCategory, error)
+	CreateRatingCategory(ctx context.Context, category models.RatingCategory) (models.RatingCategory, error)
+	// UpdateRatingCategory renames the category and records its weight from effectiveFrom
+	UpdateRatingCategory(ctx context.Context, category models.RatingCategory, effectiveFrom time.Time) (models.RatingCategory, error)
+	ArchiveRatingCategory(ctx context.Context, id int) (models.RatingCategory, error)
+	// ListRatingCategoryWeights returns the weight history of a category, or of every category for 0
+	ListRatingCategoryWeights(ctx context.Context, categoryID int) ([]models.RatingCategoryWeight, error)
+}
+
+// RatingCategoryRepository writes rating_categories and the weight and archive tables created by
+// database.Migrate. It writes, so it must be given the writer connection
+type RatingCategoryRepository struct {
+	db  *sql.DB
+	now func() time.Time
+}
+
+func NewRatingCategoryRepository(db *sql.DB) *RatingCategoryRepository {
+	return &RatingCategoryRepository{db: db, now: time.Now}
+}
+
+const ratingCategoryQuery = `
+	SELECT rc.id, rc.name, rc.weight, a.archived_at
+	FROM rating_categories rc
+	LEFT JOIN rating_category_archives a ON a.category_id = rc.id`
+
+// ListRatingCategories returns the categories ordered by name, leaving out archived ones
+// unless includeArchived is set
+func (r *RatingCategoryRepository) ListRatingCategories(ctx context.Context, includeArchived bool) ([]models.RatingCategory, error) {
+	query := ratingCategoryQuery
+	if !includeArchived {
+		query += ` WHERE a.category_id IS NULL`
+	}
+	rows, err := r.db.QueryContext(ctx, query+` ORDER BY rc.name, rc.id`)
+	if err != nil {
+		return nil, fmt.Errorf("failed to query rating categories: %v", err)
+	}
+	defer rows.Close()
+
+	var categories []models.RatingCategory
+	for rows.Next() {
+		category, err := scanRatingCategory(rows)
+		if err != nil {
+			return nil, err
+		}
+		categories = append(categories, category)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, fmt.Errorf("failed to read rating categories: %v", err)
+	}
+	return categories, nil
+}
+
+func (r *RatingCategoryRepository) GetRatingCategory(ctx context.Context, id int) (models.RatingCategory, error) {
+	category, err := scanRatingCategory(r.db.QueryRowContext(ctx, ratingCategoryQuery+` WHERE rc.id = ?`, id))
+	if errors.Is(err, sql.ErrNoRows) {
+		return models.RatingCategory{}, fmt.Errorf("rating category %d: %w", id, ErrNotFound)
+	}
+	return category, err
+}
+
+// CreateRatingCategory inserts an active category. Its weight has no history until it first changes
+func (r *RatingCategoryRepository) CreateRatingCategory(ctx context.Context, category models.RatingCategory) (models.RatingCategory, error) {
+	res, err := r.db.ExecContext(ctx, `INSERT INTO rating_categories (name, weight) VALUES (?, ?)`, category.Name, category.Weight)
+	if err != nil {
+		return models.RatingCategory{}, fmt.Errorf("failed to create rating category: %v", err)
+	}
+	id, err := res.LastInsertId()
+	if err != nil {
+		return models.RatingCategory{}, fmt.Errorf("failed to create rating category: %v", err)
+	}
+	return r.GetRatingCategory(ctx, int(id))
+}
+
+// UpdateRatingCategory renames the category with category.ID and, unless category.Weight already
+// applied at effectiveFrom, records it as the weight from then on. The first change also records
+// the weight the category started with, effective since the zero time, so earlier ratings keep it.
+// A back-dated entry is inserted into the history rather than replacing what follows: it applies
+// from effectiveFrom only until the next existing entry, which keeps its later ratings intact.
+// rating_categories.weight is kept at the latest effective weight
+func (r *RatingCategoryRepository) UpdateRatingCategory(ctx context.Context, category models.RatingCategory, effectiveFrom time.Time) (models.RatingCategory, error) {
+	tx, err := r.db.BeginTx(ctx, nil)
+	if err != nil {
+		return models.RatingCategory{}, fmt.Errorf("failed to update rating category: %v", err)
+	}
+	defer tx.Rollback()
+
+	var current float64
+	err = tx.QueryRowContext(ctx, `SELECT weight FROM rating_categories WHERE id = ?`, category.ID).Scan(&current)
+	if errors.Is(err, sql.ErrNoRows) {
+		return models.RatingCategory{}, fmt.Errorf("rating category %d: %w", category.ID, ErrNotFound)
+	}
+	if err != nil {
+		return models.RatingCategory{}, fmt.Errorf("failed to update rating category: %v", err)
+	}
+
+	if _, err := tx.ExecContext(ctx, `UPDATE rating_categories SET name = ? WHERE id = ?`, category.Name, category.ID); err != nil {
+		return models.RatingCategory{}, fmt.Errorf("failed to update rating category: %v", err)
+	}
+
+	// Once a category has history its first entry is effective since the zero time,
+	// so no entry applying at effectiveFrom means no history yet
+	applied, seeded := current, true
+	err = tx.QueryRowContext(ctx, `
+		SELECT weight FROM rating_category_weights
+		WHERE category_id = ? AND effective_from <= ?
+		ORDER BY effective_from DESC, id DESC
+		LIMIT 1`,
+		category.ID, effectiveFrom.UTC()).Scan(&applied)
+	if errors.Is(err, sql.ErrNoRows) {
+		seeded = false
+	} else if err != nil {
+		return models.RatingCategory{}, fmt.Errorf("failed to query rating category weights: %v", err)
+	}
+
+	if applied != category.Weight {
+		if !seeded {
+			if err := insertRatingCategoryWeight(ctx, tx, category.ID, current, time.Time{}); err != nil {
+				return models.RatingCategory{}, err
+			}
+		}
+		if err := insertRatingCategoryWeight(ctx, tx, category.ID, category.Weight, effectiveFrom); err != nil {
+			return models.RatingCategory{}, err
+		}
+		if _, err := tx.ExecContext(ctx, `
+			UPDATE rating_categories SET weight = (
+				SELECT weight FROM rating_category_weights
+				WHERE category_id = ?1
+				ORDER BY effective_from DESC, id DESC
+				LIMIT 1
+			)
+			WHERE id = ?1`, category.ID); err != nil {
+			return models.RatingCategory{}, fmt.Errorf("failed to update rating category weight: %v", err)
+		}
+	}
+
+	if err := tx.Commit(); err != nil {
+		return models.RatingCategory{}, fmt.Errorf("failed to update rating category: %v", err)
+	}
+	return r.GetRatingCategory(ctx, category.ID)
+}
+
+func insertRatingCategoryWeight(ctx context.Context, tx *sql.Tx, categoryID int, weight float64, effectiveFrom time.Time) error {
+	_, err := tx.ExecContext(ctx, `
+		INSERT INTO rating_category_weights (category_id, weight, effective_from)
+		VALUES (?, ?, ?)`,
+		categoryID, weight, effectiveFrom.UTC())
+	if err != nil {
+		return fmt.Errorf("failed to record rating category weight: %v", err)
+	}
+	return nil
+}
+
+// ArchiveRatingCategory marks the category as archived. Archiving it again keeps the first archived_at
+func (r *RatingCategoryRepository) ArchiveRatingCategory(ctx context.Context, id int) (models.RatingCategory, error) {
+	if _, err := r.GetRatingCategory(ctx, id); err != nil {
+		return models.RatingCategory{}, err
+	}
+	_, err := r.db.ExecContext(ctx, `
+		INSERT INTO rating_category_archives (category_id, archived_at) VALUES (?, ?)
+		ON CONFLICT (category_id) DO NOTHING`,
+		id, r.now().UTC())
+	if err != nil {
+		return models.RatingCategory{}, fmt.Errorf("failed to archive rating category: %v", err)
+	}
+	return r.GetRatingCategory(ctx, id)
+}
+
+// ListRatingCategoryWeights returns weight history entries ordered by category, then oldest first
+func (r *RatingCategoryRepository) ListRatingCategoryWeights(ctx context.Context, categoryID int) ([]models.RatingCategoryWeight, error) {
+	rows, err := r.db.QueryContext(ctx, `
+		SELECT id, category_id, weight, effective_from
+		FROM rating_category_weights
+		WHERE ?1 = 0 OR category_id = ?1
+		ORDER BY category_id, effective_from, id`, categoryID)
+	if err != nil {
+		return nil, fmt.Errorf("failed to query rating category weights: %v", err)
+	}
+	defer rows.Close()
+
+	var weights []models.RatingCategoryWeight
+	for rows.Next() {
+		var w models.RatingCategoryWeight
+		if err := rows.Scan(&w.ID, &w.CategoryID, &w.Weight, &w.EffectiveFrom); err != nil {
+			return nil, fmt.Errorf("failed to scan rating category weight: %v", err)
+		}
+		weights = append(weights, w)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, fmt.Errorf("failed to read rating category weights: %v", err)
+	}
+	return weights, nil
+}
+
+func scanRatingCategory(row rowScanner) (models.RatingCategory, error) {
+	var (
+		category   models.RatingCategory
+		archivedAt sql.NullTime
+	)
+	err := row.Scan(&category.ID, &category.Name, &category.Weight, &archivedAt)
+	if errors.Is(err, sql.ErrNoRows) {
+		return models.RatingCategory{}, err
+	}
+	if err != nil {
+		return models.RatingCategory{}, fmt.Errorf("failed to scan rating category: %v", err)
+	}
+	category.ArchivedAt = archivedAt.Time
+	return category, nil
+}
@@ -0,0 +1,216 @@
+package repository
+
+import (
+	"context"
+	"errors"
+	"math"
+	"reflect"
+	"testing"
+	"time"
+
+	"go-grpc-backend/internal/models"
+)
+
+func newTestRatingCategoryRepository(t *testing.T) *RatingCategoryRepository {
+	t.Helper()
+	repo := NewRatingCategoryRepository(newTestDB(t, "basic"))
+	repo.now = func() time.Time { return time.Date(2025, 1, 15, 9, 0, 0, 0, time.UTC) }
+	return repo
+}
+
+func TestRatingCategoryRepository_Integration_CreateAndArchive(t *testing.T) {
+	repo := newTestRatingCategoryRepository(t)
+
+	created, err := repo.CreateRatingCategory(context.Background(), models.RatingCategory{Name: "Empathy", Weight: 1.5})
+	if err != nil {
+		t.Fatalf("CreateRatingCategory() error = %v", err)
+	}
+	if created != (models.RatingCategory{ID: 4, Name: "Empathy", Weight: 1.5}) {
+		t.Errorf("Unexpected created category %+v", created)
+	}
+
+	archived, err := repo.ArchiveRatingCategory(context.Background(), 2)
+	if err != nil {
+		t.Fatalf("ArchiveRatingCategory() error = %v", err)
+	}
+	if !archived.ArchivedAt.Equal(repo.now()) {
+		t.Errorf("Expected archived_at %v, got %v", repo.now(), archived.ArchivedAt)
+	}
+	// Archiving again keeps the first archived_at
+	repo.now = func() time.Time { return time.Date(2025, 2, 1, 0, 0, 0, 0, time.UTC) }
+	if again, err := repo.ArchiveRatingCategory(context.Background(), 2); err != nil || !again.ArchivedAt.Equal(archived.ArchivedAt) {
+		t.Errorf("ArchiveRatingCategory() again = %+v, %v", again, err)
+	}
+
+	names := func(includeArchived bool) []string {
+		t.Helper()
+		categories, err := repo.ListRatingCategories(context.Background(), includeArchived)
+		if err != nil {
+			t.Fatalf("ListRatingCategories() error = %v", err)
+		}
+		var out []string
+		for _, c := range categories {
+			out = append(out, c.Name)
+		}
+		return out
+	}
+	if got, want := names(false), []string{"Empathy", "Spelling", "Tone"}; !reflect.DeepEqual(got, want) {
+		t.Errorf("Active categories = %v, want %v", got, want)
+	}
+	if got, want := names(true), []string{"Empathy", "Grammar", "Spelling", "Tone"}; !reflect.DeepEqual(got, want) {
+		t.Errorf("All categories = %v, want %v", got, want)
+	}
+
+	if _, err := repo.ArchiveRatingCategory(context.Background(), 42); !errors.Is(err, ErrNotFound) {
+		t.Errorf("ArchiveRatingCategory(): expected ErrNotFound, got %v", err)
+	}
+	if _, err := repo.GetRatingCategory(context.Background(), 42); !errors.Is(err, ErrNotFound) {
+		t.Errorf("GetRatingCategory(): expected ErrNotFound, got %v", err)
+	}
+}
+
+func TestRatingCategoryRepository_Integration_WeightHistory(t *testing.T) {
+	repo := newTestRatingCategoryRepository(t)
+	jan := func(day int) time.Time { return time.Date(2025, 1, day, 0, 0, 0, 0, time.UTC) }
+
+	// A rename alone records no history
+	renamed, err := repo.UpdateRatingCategory(context.Background(), models.RatingCategory{ID: 1, Name: "Orthography", Weight: 1}, jan(6))
+	if err != nil {
+		t.Fatalf("UpdateRatingCategory() error = %v", err)
+	}
+	if renamed.Name != "Orthography" || renamed.Weight != 1 {
+		t.Errorf("Unexpected renamed category %+v", renamed)
+	}
+
+	steps := []struct {
+		weight        float64
+		effectiveFrom time.Time
+	}{
+		{weight: 2, effectiveFrom: jan(6)},
+		// Back-dated before the previous change: the current weight stays 2
+		{weight: 3, effectiveFrom: jan(3)},
+		// Already the weight on January 7
+		{weight: 2, effectiveFrom: jan(7)},
+	}
+	for _, step := range steps {
+		if _, err := repo.UpdateRatingCategory(context.Background(), models.RatingCategory{ID: 1, Name: "Orthography", Weight: step.weight}, step.effectiveFrom); err != nil {
+			t.Fatalf("UpdateRatingCategory(%g from %v) error = %v", step.weight, step.effectiveFrom, err)
+		}
+	}
+
+	category, err := repo.GetRatingCategory(context.Background(), 1)
+	if err != nil {
+		t.Fatalf("GetRatingCategory() error = %v", err)
+	}
+	if category.Weight != 2 {
+		t.Errorf("Expected the latest effective weight 2, got %g", category.Weight)
+	}
+
+	history, err := repo.ListRatingCategoryWeights(context.Background(), 1)
+	if err != nil {
+		t.Fatalf("ListRatingCategoryWeights() error = %v", err)
+	}
+	want := []models.RatingCategoryWeight{
+		{ID: 1, CategoryID: 1, Weight: 1},
+		{ID: 3, CategoryID: 1, Weight: 3, EffectiveFrom: jan(3)},
+		{ID: 2, CategoryID: 1, Weight: 2, EffectiveFrom: jan(6)},
+	}
+	if len(history) != len(want) {
+		t.Fatalf("Expected %d history entries, got %+v", len(want), history)
+	}
+	for i := range want {
+		if history[i].ID != want[i].ID || history[i].Weight != want[i].Weight || !history[i].EffectiveFrom.Equal(want[i].EffectiveFrom) {
+			t.Errorf("History entry %d = %+v, want %+v", i, history[i], want[i])
+		}
+	}
+	if !history[0].EffectiveFrom.IsZero() {
+		t.Errorf("Expected the starting weight to be effective since the zero time, got %v", history[0].EffectiveFrom)
+	}
+
+	if all, err := repo.ListRatingCategoryWeights(context.Background(), 0); err != nil || len(all) != 3 {
+		t.Errorf("ListRatingCategoryWeights(0) = %+v, %v", all, err)
+	}
+	if _, err := repo.UpdateRatingCategory(context.Background(), models.RatingCategory{ID: 42, Name: "Ghost", Weight: 1}, jan(6)); !errors.Is(err, ErrNotFound) {
+		t.Errorf("UpdateRatingCategory(): expected ErrNotFound, got %v", err)
+	}
+}
+
+func TestAnalyticsRepository_Integration_HistoricalWeights(t *testing.T) {
+	db := newTestDB(t, "basic")
+	categories := NewRatingCategoryRepository(db)
+	analytics := NewAnalyticsRepository(db)
+	rng := models.NewDateRange(time.Date(2025, 1, 1, 0, 0, 0, 0, time.UTC), time.Date(2025, 1, 13, 0, 0, 0, 0, time.UTC))
+
+	// Spelling goes from 1 to 2 on January 6: rating 1 (4, January 5) keeps weight 1,
+	// ratings 2 (2) and 3 (5) get weight 2
+	if _, err := categories.UpdateRatingCategory(context.Background(), models.RatingCategory{ID: 1, Name: "Spelling", Weight: 2}, time.Date(2025, 1, 6, 0, 0, 0, 0, time.UTC)); err != nil {
+		t.Fatalf("UpdateRatingCategory() error = %v", err)
+	}
+
+	scores, err := analytics.GetOverallQualityScore(context.Background(), rng)
+	if err != nil {
+		t.Fatalf("GetOverallQualityScore() error = %v", err)
+	}
+	byName := make(map[string]models.CategoryScore)
+	for _, cs := range scores {
+		byName[cs.CategoryName] = cs
+	}
+
+	// (4*1 + 2*2 + 5*2) / 3 ratings
+	spelling := byName["Spelling"]
+	if got := spelling.Score * spelling.CategoryWeight; math.Abs(got-6) > 1e-9 {
+		t.Errorf("Expected a mean weighted Spelling rating of 6, got %g (avg %g, weight %g)", got, spelling.Score, spelling.CategoryWeight)
+	}
+	if math.Abs(spelling.Score-11.0/3) > 1e-9 {
+		t.Errorf("Expected the average rating to stay 11/3, got %g", spelling.Score)
+	}
+	if grammar := byName["Grammar"]; grammar.CategoryWeight != 0.5 {
+		t.Errorf("Expected Grammar's unchanged weight 0.5, got %g", grammar.CategoryWeight)
+	}
+
+	// Per day the weight is the one of that day's ratings
+	daily, err := analytics.GetDailyAggregatedCategoryRatings(context.Background(), rng)
+	if err != nil {
+		t.Fatalf("GetDailyAggregatedCategoryRatings() error = %v", err)
+	}
+	weights := make(map[string]float64)
+	for _, row := range daily {
+		if row.CategoryName == "Spelling" {
+			weights[row.Date.Format("2006-01-02")] = row.CategoryWeight
+		}
+	}
+	if weights["2025-01-05"] != 1 || weights["2025-01-06"] != 2 {
+		t.Errorf("Expected Spelling weights 1 then 2, got %v", weights)
+	}
+
+	// Rating 6 is a 0, so its group takes the mean weight
+	zero, err := analytics.GetOverallQualityScore(context.Background(), models.DateRange{Start: rng.End, End: rng.End, InclusiveEnd: true})
+	if err != nil {
+		t.Fatalf("GetOverallQualityScore() error = %v", err)
+	}
+	if len(zero) != 1 || zero[0].CategoryWeight != 2 || zero[0].Score != 0 {
+		t.Errorf("Unexpected scores for a single 0 rating: %+v", zero)
+	}
+
+	// A second change effective at the same time replaces the first, and each rating still counts once
+	if _, err := categories.UpdateRatingCategory(context.Background(), models.RatingCategory{ID: 1, Name: "Spelling", Weight: 3}, time.Date(2025, 1, 6, 0, 0, 0, 0, time.UTC)); err != nil {
+		t.Fatalf("UpdateRatingCategory() error = %v", err)
+	}
+	scores, err = analytics.GetOverallQualityScore(context.Background(), rng)
+	if err != nil {
+		t.Fatalf("GetOverallQualityScore() error = %v", err)
+	}
+	for _, cs := range scores {
+		if cs.CategoryName != "Spelling" {
+			continue
+		}
+		// (4*1 + 2*3 + 5*3) / 3 ratings
+		if got := cs.Score * cs.CategoryWeight; math.Abs(got-25.0/3) > 1e-9 || cs.RatingCount != 3 {
+			t.Errorf("Expected 3 Spelling ratings with a mean weighted rating of 25/3, got %d with %g", cs.RatingCount, got)
+		}
+		// Weighted ratings 4, 6 and 15
+		if math.Abs(cs.WeightedVariance-206.0/9) > 1e-9 {
+			t.Errorf("Expected a weighted variance of 206/9, got %g", cs.WeightedVariance)
+		}
+	}
+}
@@ -0,0 +1,305 @@
+package repository
+
+import (
+	"context"
+	"database/sql"
+	"errors"
+	"fmt"
+	"time"
+
+	"go-grpc-backend/internal/models"
+
+	"github.com/mattn/go-sqlite3"
+)
+
+// TeamRepositoryInterface stores the team hierarchy and the dated memberships of users
+type TeamRepositoryInterface interface {
+	ListTeams(ctx context.Context) ([]models.Team, error)
+	GetTeam(ctx context.Context, id int) (models.Team, error)
+	CreateTeam(ctx context.Context, team models.Team) (models.Team, error)
+	UpdateTeam(ctx context.Context, team models.Team) (models.Team, error)
+	// DeleteTeam returns ErrConflict for a team with sub-teams
+	DeleteTeam(ctx context.Context, id int) error
+	// ListTeamMemberships filters by team and user when their IDs are non-zero
+	ListTeamMemberships(ctx context.Context, teamID, userID int) ([]models.TeamMembership, error)
+	GetTeamMembership(ctx context.Context, id int) (models.TeamMembership, error)
+	// CreateTeamMembership and UpdateTeamMembership return ErrConflict when the membership would
+	// overlap another of the same user in the same team
+	CreateTeamMembership(ctx context.Context, membership models.TeamMembership) (models.TeamMembership, error)
+	UpdateTeamMembership(ctx context.Context, membership models.TeamMembership) (models.TeamMembership, error)
+	DeleteTeamMembership(ctx context.Context, id int) error
+	GetUser(ctx context.Context, id int) (models.User, error)
+}
+
+// TeamRepository keeps teams and memberships in the tables created by database.Migrate.
+// It writes, so it must be given the writer connection
+type TeamRepository struct {
+	db  *sql.DB
+	now func() time.Time
+}
+
+func NewTeamRepository(db *sql.DB) *TeamRepository {
+	return &TeamRepository{db: db, now: time.Now}
+}
+
+const teamQuery = `SELECT id, name, kind, COALESCE(parent_id, 0), created_at FROM teams`
+
+// ListTeams returns every team ordered by name
+func (r *TeamRepository) ListTeams(ctx context.Context) ([]models.Team, error) {
+	rows, err := r.db.QueryContext(ctx, teamQuery+` ORDER BY name, id`)
+	if err != nil {
+		return nil, fmt.Errorf("failed to query teams: %v", err)
+	}
+	defer rows.Close()
+
+	var teams []models.Team
+	for rows.Next() {
+		team, err := scanTeam(rows)
+		if err != nil {
+			return nil, err
+		}
+		teams = append(teams, team)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, fmt.Errorf("failed to read teams: %v", err)
+	}
+	return teams, nil
+}
+
+func (r *TeamRepository) GetTeam(ctx context.Context, id int) (models.Team, error) {
+	team, err := scanTeam(r.db.QueryRowContext(ctx, teamQuery+` WHERE id = ?`, id))
+	if errors.Is(err, sql.ErrNoRows) {
+		return models.Team{}, fmt.Errorf("team %d: %w", id, ErrNotFound)
+	}
+	return team, err
+}
+
+func (r *TeamRepository) CreateTeam(ctx context.Context, team models.Team) (models.Team, error) {
+	res, err := r.db.ExecContext(ctx, `INSERT INTO teams (name, kind, parent_id, created_at) VALUES (?, ?, ?, ?)`,
+		team.Name, string(team.Kind), nullableID(team.ParentID), r.now().UTC())
+	if err != nil {
+		return models.Team{}, fmt.Errorf("failed to create team: %v", err)
+	}
+	id, err := res.LastInsertId()
+	if err != nil {
+		return models.Team{}, fmt.Errorf("failed to create team: %v", err)
+	}
+	return r.GetTeam(ctx, int(id))
+}
+
+// UpdateTeam replaces the name and parent of the team with team.ID; its kind never changes
+func (r *TeamRepository) UpdateTeam(ctx context.Context, team models.Team) (models.Team, error) {
+	res, err := r.db.ExecContext(ctx, `UPDATE teams SET name = ?, parent_id = ? WHERE id = ?`,
+		team.Name, nullableID(team.ParentID), team.ID)
+	if err != nil {
+		return models.Team{}, fmt.Errorf("failed to update team: %v", err)
+	}
+	if n, err := res.RowsAffected(); err != nil {
+		return models.Team{}, fmt.Errorf("failed to update team: %v", err)
+	} else if n == 0 {
+		return models.Team{}, fmt.Errorf("team %d: %w", team.ID, ErrNotFound)
+	}
+	return r.GetTeam(ctx, team.ID)
+}
+
+// DeleteTeam removes a team and its memberships. Teams with sub-teams can't be deleted:
+// their parent_id references refuse it
+func (r *TeamRepository) DeleteTeam(ctx context.Context, id int) error {
+	res, err := r.db.ExecContext(ctx, `DELETE FROM teams WHERE id = ?`, id)
+	var sqliteErr sqlite3.Error
+	if errors.As(err, &sqliteErr) && sqliteErr.ExtendedCode == sqlite3.ErrConstraintForeignKey {
+		return fmt.Errorf("team %d still has sub-teams: %w", id, ErrConflict)
+	}
+	if err != nil {
+		return fmt.Errorf("failed to delete team: %v", err)
+	}
+	if n, err := res.RowsAffected(); err != nil {
+		return fmt.Errorf("failed to delete team: %v", err)
+	} else if n == 0 {
+		return fmt.Errorf("team %d: %w", id, ErrNotFound)
+	}
+	return nil
+}
+
+const teamMembershipQuery = `
+	SELECT m.id, m.team_id, m.user_id, u.name, m.valid_from, m.valid_to
+	FROM team_memberships m
+	JOIN users u ON u.id = m.user_id`
+
+// ListTeamMemberships returns memberships ordered by team, user and start
+func (r *TeamRepository) ListTeamMemberships(ctx context.Context, teamID, userID int) ([]models.TeamMembership, error) {
+	rows, err := r.db.QueryContext(ctx, teamMembershipQuery+`
+		WHERE (?1 = 0 OR m.team_id = ?1) AND (?2 = 0 OR m.user_id = ?2)
+		ORDER BY m.team_id, m.user_id, m.valid_from, m.id`, teamID, userID)
+	if err != nil {
+		return nil, fmt.Errorf("failed to query team memberships: %v", err)
+	}
+	defer rows.Close()
+
+	var memberships []models.TeamMembership
+	for rows.Next() {
+		membership, err := scanTeamMembership(rows)
+		if err != nil {
+			return nil, err
+		}
+		memberships = append(memberships, membership)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, fmt.Errorf("failed to read team memberships: %v", err)
+	}
+	return memberships, nil
+}
+
+func (r *TeamRepository) GetTeamMembership(ctx context.Context, id int) (models.TeamMembership, error) {
+	membership, err := scanTeamMembership(r.db.QueryRowContext(ctx, teamMembershipQuery+` WHERE m.id = ?`, id))
+	if errors.Is(err, sql.ErrNoRows) {
+		return models.TeamMembership{}, fmt.Errorf("team membership %d: %w", id, ErrNotFound)
+	}
+	return membership, err
+}
+
+// membershipOverlap matches the memberships other than ?1 of user ?3 in team ?2 that share an
+// instant with [?4, ?5). A NULL end is open. Bind it with membershipArgs
+const membershipOverlap = `EXISTS (
+		SELECT 1 FROM team_memberships o
+		WHERE o.team_id = ?2 AND o.user_id = ?3 AND o.id != ?1
+			AND (?5 IS NULL OR o.valid_from < ?5) AND (o.valid_to IS NULL OR ?4 < o.valid_to)
+	)`
+
+func membershipArgs(membership models.TeamMembership) []any {
+	return []any{membership.ID, membership.TeamID, membership.UserID, membership.ValidFrom.UTC(), nullableTime(membership.ValidTo)}
+}
+
+// CreateTeamMembership checks for overlaps and inserts in a single statement, so concurrent
+// requests can't both pass the check
+func (r *TeamRepository) CreateTeamMembership(ctx context.Context, membership models.TeamMembership) (models.TeamMembership, error) {
+	membership.ID = 0
+	res, err := r.db.ExecContext(ctx, `
+		INSERT INTO team_memberships (team_id, user_id, valid_from, valid_to)
+		SELECT ?2, ?3, ?4, ?5
+		WHERE NOT `+membershipOverlap,
+		membershipArgs(membership)...)
+	if err != nil {
+		return models.TeamMembership{}, fmt.Errorf("failed to create team membership: %v", err)
+	}
+	if n, err := res.RowsAffected(); err != nil {
+		return models.TeamMembership{}, fmt.Errorf("failed to create team membership: %v", err)
+	} else if n == 0 {
+		return models.TeamMembership{}, fmt.Errorf("user %d already belongs to team %d at that time: %w", membership.UserID, membership.TeamID, ErrConflict)
+	}
+	id, err := res.LastInsertId()
+	if err != nil {
+		return models.TeamMembership{}, fmt.Errorf("failed to create team membership: %v", err)
+	}
+	return r.GetTeamMembership(ctx, int(id))
+}
+
+// UpdateTeamMembership replaces the team, user and dates of the membership with membership.ID,
+// checking for overlaps in the same statement
+func (r *TeamRepository) UpdateTeamMembership(ctx context.Context, membership models.TeamMembership) (models.TeamMembership, error) {
+	tx, err := r.db.BeginTx(ctx, nil)
+	if err != nil {
+		return models.TeamMembership{}, fmt.Errorf("failed to update team membership: %v", err)
+	}
+	defer tx.Rollback()
+
+	res, err := tx.ExecContext(ctx, `
+		UPDATE team_memberships SET team_id = ?2, user_id = ?3, valid_from = ?4, valid_to = ?5
+		WHERE id = ?1 AND NOT `+membershipOverlap,
+		membershipArgs(membership)...)
+	if err != nil {
+		return models.TeamMembership{}, fmt.Errorf("failed to update team membership: %v", err)
+	}
+	if n, err := res.RowsAffected(); err != nil {
+		return models.TeamMembership{}, fmt.Errorf("failed to update team membership: %v", err)
+	} else if n == 0 {
+		// Either the membership is gone or the new dates overlap another
+		var exists bool
+		if err := tx.QueryRowContext(ctx, `SELECT EXISTS (SELECT 1 FROM team_memberships WHERE id = ?)`, membership.ID).Scan(&exists); err != nil {
+			return models.TeamMembership{}, fmt.Errorf("failed to update team membership: %v", err)
+		}
+		if !exists {
+			return models.TeamMembership{}, fmt.Errorf("team membership %d: %w", membership.ID, ErrNotFound)
+		}
+		return models.TeamMembership{}, fmt.Errorf("user %d already belongs to team %d at that time: %w", membership.UserID, membership.TeamID, ErrConflict)
+	}
+
+	if err := tx.Commit(); err != nil {
+		return models.TeamMembership{}, fmt.Errorf("failed to update team membership: %v", err)
+	}
+	return r.GetTeamMembership(ctx, membership.ID)
+}
+
+func (r *TeamRepository) DeleteTeamMembership(ctx context.Context, id int) error {
+	res, err := r.db.ExecContext(ctx, `DELETE FROM team_memberships WHERE id = ?`, id)
+	if err != nil {
+		return fmt.Errorf("failed to delete team membership: %v", err)
+	}
+	if n, err := res.RowsAffected(); err != nil {
+		return fmt.Errorf("failed to delete team membership: %v", err)
+	} else if n == 0 {
+		return fmt.Errorf("team membership %d: %w", id, ErrNotFound)
+	}
+	return nil
+}
+
+func (r *TeamRepository) GetUser(ctx context.Context, id int) (models.User, error) {
+	var user models.User
+	err := r.db.QueryRowContext(ctx, `SELECT id, name FROM users WHERE id = ?`, id).Scan(&user.ID, &user.Name)
+	if errors.Is(err, sql.ErrNoRows) {
+		return models.User{}, fmt.Errorf("user %d: %w", id, ErrNotFound)
+	}
+	if err != nil {
+		return models.User{}, fmt.Errorf("failed to query user: %v", err)
+	}
+	return user, nil
+}
+
+func scanTeam(row rowScanner) (models.Team, error) {
+	var (
+		team models.Team
+		kind string
+	)
+	err := row.Scan(&team.ID, &team.Name, &kind, &team.ParentID, &team.CreatedAt)
+	if errors.Is(err, sql.ErrNoRows) {
+		return models.Team{}, err
+	}
+	if err != nil {
+		return models.Team{}, fmt.Errorf("failed to scan team: %v", err)
+	}
+	team.Kind = models.TeamKind(kind)
+	return team, nil
+}
+
+func scanTeamMembership(row rowScanner) (models.TeamMembership, error) {
+	var (
+		membership models.TeamMembership
+		validTo    sql.NullTime
+	)
+	err := row.Scan(&membership.ID, &membership.TeamID, &membership.UserID, &membership.UserName,
+		&membership.ValidFrom, &validTo)
+	if errors.Is(err, sql.ErrNoRows) {
+		return models.TeamMembership{}, err
+	}
+	if err != nil {
+		return models.TeamMembership{}, fmt.Errorf("failed to scan team membership: %v", err)
+	}
+	membership.ValidTo = validTo.Time
+	return membership, nil
+}
+
+// nullableID stores a zero ID as NULL
+func nullableID(id int) any {
+	if id == 0 {
+		return nil
+	}
+	return id
+}
+
+// nullableTime stores a zero time as NULL
+func nullableTime(t time.Time) any {
+	if t.IsZero() {
+		return nil
+	}
+	return t.UTC()
+}
@@ -0,0 +1,162 @@
+package repository
+
+import (
+	"context"
+	"errors"
+	"reflect"
+	"testing"
+	"time"
+
+	"go-grpc-backend/internal/models"
+)
+
+// newTestTeamRepository loads the teams fixture: Acme (org) > Support (department) > Tier 1 and
+// Tier 2 (teams), with IDs 1 to 4
+func newTestTeamRepository(t *testing.T) *TeamRepository {
+	t.Helper()
+	repo := NewTeamRepository(newTestDB(t, "basic", "teams"))
+	repo.now = func() time.Time { return time.Date(2025, 1, 15, 9, 0, 0, 0, time.UTC) }
+	return repo
+}
+
+func TestTeamRepository_Integration_Teams(t *testing.T) {
+	repo := newTestTeamRepository(t)
+
+	team, err := repo.GetTeam(context.Background(), 3)
+	if err != nil {
+		t.Fatalf("GetTeam() error = %v", err)
+	}
+	want := models.Team{ID: 3, Name: "Tier 1", Kind: models.TeamKindTeam, ParentID: 2, CreatedAt: repo.now()}
+	if !reflect.DeepEqual(team, want) {
+		t.Errorf("Team mismatch\n got: %+v\nwant: %+v", team, want)
+	}
+
+	updated, err := repo.UpdateTeam(context.Background(), models.Team{ID: 3, Name: "Frontline", ParentID: 2})
+	if err != nil {
+		t.Fatalf("UpdateTeam() error = %v", err)
+	}
+	if updated.Name != "Frontline" || updated.Kind != models.TeamKindTeam {
+		t.Errorf("Expected the name to change and the kind to stay, got %+v", updated)
+	}
+
+	teams, err := repo.ListTeams(context.Background())
+	if err != nil {
+		t.Fatalf("ListTeams() error = %v", err)
+	}
+	var names []string
+	for _, team := range teams {
+		names = append(names, team.Name)
+	}
+	if !reflect.DeepEqual(names, []string{"Acme", "Frontline", "Support", "Tier 2"}) {
+		t.Errorf("Expected teams ordered by name, got %v", names)
+	}
+	if teams[0].ParentID != 0 {
+		t.Errorf("Expected the org to have no parent, got %d", teams[0].ParentID)
+	}
+
+	// The parent_id references refuse deleting a team with sub-teams
+	if err := repo.DeleteTeam(context.Background(), 2); !errors.Is(err, ErrConflict) {
+		t.Errorf("Expected ErrConflict deleting a department with teams, got %v", err)
+	}
+
+	if err := repo.DeleteTeam(context.Background(), 3); err != nil {
+		t.Fatalf("DeleteTeam() error = %v", err)
+	}
+	if _, err := repo.GetTeam(context.Background(), 3); !errors.Is(err, ErrNotFound) {
+		t.Errorf("Expected ErrNotFound after delete, got %v", err)
+	}
+	if err := repo.DeleteTeam(context.Background(), 3); !errors.Is(err, ErrNotFound) {
+		t.Errorf("Expected ErrNotFound deleting a deleted team, got %v", err)
+	}
+	if _, err := repo.UpdateTeam(context.Background(), models.Team{ID: 42, Name: "Ghost"}); !errors.Is(err, ErrNotFound) {
+		t.Errorf("Expected ErrNotFound updating an unknown team, got %v", err)
+	}
+}
+
+func TestTeamRepository_Integration_Memberships(t *testing.T) {
+	repo := newTestTeamRepository(t)
+
+	created, err := repo.CreateTeamMembership(context.Background(), models.TeamMembership{TeamID: 3, UserID: 2, ValidFrom: date(2025, 1, 1)})
+	if err != nil {
+		t.Fatalf("CreateTeamMembership() error = %v", err)
+	}
+	want := models.TeamMembership{ID: created.ID, TeamID: 3, UserID: 2, UserName: "Bob", ValidFrom: date(2025, 1, 1)}
+	if created.ID == 0 || !reflect.DeepEqual(created, want) {
+		t.Errorf("Membership mismatch\n got: %+v\nwant: %+v", created, want)
+	}
+
+	created.ValidTo = date(2025, 1, 6)
+	updated, err := repo.UpdateTeamMembership(context.Background(), created)
+	if err != nil {
+		t.Fatalf("UpdateTeamMembership() error = %v", err)
+	}
+	if !updated.ValidTo.Equal(date(2025, 1, 6)) {
+		t.Errorf("Expected the membership to end on 2025-01-06, got %+v", updated)
+	}
+
+	// Starting as the first one ends is fine, starting before is an overlap
+	if _, err := repo.CreateTeamMembership(context.Background(), models.TeamMembership{TeamID: 3, UserID: 2, ValidFrom: date(2025, 1, 5)}); !errors.Is(err, ErrConflict) {
+		t.Errorf("Expected ErrConflict for an overlapping membership, got %v", err)
+	}
+	next, err := repo.CreateTeamMembership(context.Background(), models.TeamMembership{TeamID: 3, UserID: 2, ValidFrom: date(2025, 1, 6), ValidTo: date(2025, 1, 8)})
+	if err != nil {
+		t.Fatalf("CreateTeamMembership() error = %v", err)
+	}
+	extended := updated
+	extended.ValidTo = date(2025, 1, 7)
+	if _, err := repo.UpdateTeamMembership(context.Background(), extended); !errors.Is(err, ErrConflict) {
+		t.Errorf("Expected ErrConflict extending into the next membership, got %v", err)
+	}
+	if err := repo.DeleteTeamMembership(context.Background(), next.ID); err != nil {
+		t.Fatalf("DeleteTeamMembership() error = %v", err)
+	}
+
+	if _, err := repo.CreateTeamMembership(context.Background(), models.TeamMembership{TeamID: 4, UserID: 2, ValidFrom: date(2025, 1, 6)}); err != nil {
+		t.Fatalf("CreateTeamMembership() error = %v", err)
+	}
+	if _, err := repo.CreateTeamMembership(context.Background(), models.TeamMembership{TeamID: 4, UserID: 1, ValidFrom: date(2025, 1, 7)}); err != nil {
+		t.Fatalf("CreateTeamMembership() error = %v", err)
+	}
+
+	byUser, err := repo.ListTeamMemberships(context.Background(), 0, 2)
+	if err != nil {
+		t.Fatalf("ListTeamMemberships() error = %v", err)
+	}
+	if len(byUser) != 2 || byUser[0].TeamID != 3 || byUser[1].TeamID != 4 {
+		t.Errorf("Expected Bob's memberships of teams 3 and 4, got %+v", byUser)
+	}
+	byTeam, err := repo.ListTeamMemberships(context.Background(), 4, 0)
+	if err != nil {
+		t.Fatalf("ListTeamMemberships() error = %v", err)
+	}
+	if len(byTeam) != 2 || byTeam[0].UserName != "Alice" || byTeam[1].UserName != "Bob" {
+		t.Errorf("Expected Alice and Bob in team 4, got %+v", byTeam)
+	}
+
+	// Deleting a team deletes its memberships
+	if err := repo.DeleteTeam(context.Background(), 3); err != nil {
+		t.Fatalf("DeleteTeam() error = %v", err)
+	}
+	if _, err := repo.GetTeamMembership(context.Background(), created.ID); !errors.Is(err, ErrNotFound) {
+		t.Errorf("Expected the membership to be deleted with its team, got %v", err)
+	}
+
+	if err := repo.DeleteTeamMembership(context.Background(), byTeam[0].ID); err != nil {
+		t.Fatalf("DeleteTeamMembership() error = %v", err)
+	}
+	if err := repo.DeleteTeamMembership(context.Background(), byTeam[0].ID); !errors.Is(err, ErrNotFound) {
+		t.Errorf("Expected ErrNotFound deleting a deleted membership, got %v", err)
+	}
+}
+
+func TestTeamRepository_Integration_GetUser(t *testing.T) {
+	repo := newTestTeamRepository(t)
+
+	user, err := repo.GetUser(context.Background(), 1)
+	if err != nil || user.Name != "Alice" {
+		t.Errorf("Expected Alice, got %+v (error %v)", user, err)
+	}
+	if _, err := repo.GetUser(context.Background(), 42); !errors.Is(err, ErrNotFound) {
+		t.Errorf("Expected ErrNotFound for an unknown user, got %v", err)
+	}
+}
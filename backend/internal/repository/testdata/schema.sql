@@ -0,0 +1,28 @@
+-- Schema of the analytics database as shipped in database.db.
+-- Loaded into in-memory SQLite by the repository integration tests.
+CREATE TABLE users (
+    id   INTEGER PRIMARY KEY AUTOINCREMENT,
+    name TEXT NOT NULL
+);
+
+CREATE TABLE tickets (
+    id         INTEGER PRIMARY KEY AUTOINCREMENT,
+    subject    TEXT NOT NULL,
+    created_at DATETIME NOT NULL
+);
+
+CREATE TABLE rating_categories (
+    id     INTEGER PRIMARY KEY AUTOINCREMENT,
+    name   TEXT NOT NULL,
+    weight REAL NOT NULL
+);
+
+CREATE TABLE ratings (
+    id                 INTEGER PRIMARY KEY AUTOINCREMENT,
+    rating             INTEGER NOT NULL,
+    ticket_id          INTEGER NOT NULL REFERENCES tickets (id),
+    rating_category_id INTEGER NOT NULL REFERENCES rating_categories (id),
+    reviewer_id        INTEGER NOT NULL REFERENCES users (id),
+    reviewee_id        INTEGER NOT NULL REFERENCES users (id),
+    created_at         DATETIME NOT NULL
+);
@@ -0,0 +1,117 @@
+package repository
+
+import (
+	"context"
+	"database/sql"
+	"fmt"
+	"time"
+
+	"go-grpc-backend/internal/models"
+)
+
+// TicketAttributeRepositoryInterface stores the key/value attributes of tickets
+type TicketAttributeRepositoryInterface interface {
+	GetTicketAttributes(ctx context.Context, ticketID int) ([]models.TicketAttribute, error)
+	SetTicketAttributes(ctx context.Context, ticketID int, attributes []models.TicketAttribute) ([]models.TicketAttribute, error)
+	DeleteTicketAttribute(ctx context.Context, ticketID int, key string) error
+}
+
+// TicketAttributeRepository keeps ticket attributes in the table created by database.Migrate.
+// It writes, so it must be given the writer connection
+type TicketAttributeRepository struct {
+	db  *sql.DB
+	now func() time.Time
+}
+
+func NewTicketAttributeRepository(db *sql.DB) *TicketAttributeRepository {
+	return &TicketAttributeRepository{db: db, now: time.Now}
+}
+
+// GetTicketAttributes returns the ticket's attributes ordered by key
+func (r *TicketAttributeRepository) GetTicketAttributes(ctx context.Context, ticketID int) ([]models.TicketAttribute, error) {
+	if err := checkTicketExists(ctx, r.db, ticketID); err != nil {
+		return nil, err
+	}
+
+	rows, err := r.db.QueryContext(ctx, `
+		SELECT ticket_id, key, value, updated_at FROM ticket_attributes
+		WHERE ticket_id = ?
+		ORDER BY key`, ticketID)
+	if err != nil {
+		return nil, fmt.Errorf("failed to query ticket attributes: %v", err)
+	}
+	defer rows.Close()
+
+	var attributes []models.TicketAttribute
+	for rows.Next() {
+		var a models.TicketAttribute
+		if err := rows.Scan(&a.TicketID, &a.Key, &a.Value, &a.UpdatedAt); err != nil {
+			return nil, fmt.Errorf("failed to scan ticket attribute: %v", err)
+		}
+		attributes = append(attributes, a)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, fmt.Errorf("failed to read ticket attributes: %v", err)
+	}
+	return attributes, nil
+}
+
+// SetTicketAttributes creates or overwrites the given keys in one transaction, leaving the
+// ticket's other attributes alone, and returns all of its attributes
+func (r *TicketAttributeRepository) SetTicketAttributes(ctx context.Context, ticketID int, attributes []models.TicketAttribute) ([]models.TicketAttribute, error) {
+	tx, err := r.db.BeginTx(ctx, nil)
+	if err != nil {
+		return nil, fmt.Errorf("failed to set ticket attributes: %v", err)
+	}
+	defer tx.Rollback()
+
+	if err := checkTicketExists(ctx, tx, ticketID); err != nil {
+		return nil, err
+	}
+
+	now := r.now().UTC()
+	for _, a := range attributes {
+		_, err := tx.ExecContext(ctx, `
+			INSERT INTO ticket_attributes (ticket_id, key, value, updated_at)
+			VALUES (?, ?, ?, ?)
+			ON CONFLICT (ticket_id, key) DO UPDATE SET value = excluded.value, updated_at = excluded.updated_at`,
+			ticketID, a.Key, a.Value, now)
+		if err != nil {
+			return nil, fmt.Errorf("failed to set ticket attribute %q: %v", a.Key, err)
+		}
+	}
+	if err := tx.Commit(); err != nil {
+		return nil, fmt.Errorf("failed to set ticket attributes: %v", err)
+	}
+
+	return r.GetTicketAttributes(ctx, ticketID)
+}
+
+func (r *TicketAttributeRepository) DeleteTicketAttribute(ctx context.Context, ticketID int, key string) error {
+	res, err := r.db.ExecContext(ctx, `DELETE FROM ticket_attributes WHERE ticket_id = ? AND key = ?`, ticketID, key)
+	if err != nil {
+		return fmt.Errorf("failed to delete ticket attribute: %v", err)
+	}
+	if n, err := res.RowsAffected(); err != nil {
+		return fmt.Errorf("failed to delete ticket attribute: %v", err)
+	} else if n == 0 {
+		return fmt.Errorf("ticket %d attribute %q: %w", ticketID, key, ErrNotFound)
+	}
+	return nil
+}
+
+// queryRower is satisfied by both *sql.DB and *sql.Tx
+type queryRower interface {
+	QueryRowContext(ctx context.Context, query string, args ...any) *sql.Row
+}
+
+func checkTicketExists(ctx context.Context, q queryRower, ticketID int) error {
+	var exists bool
+	if err := q.QueryRowContext(ctx, `SELECT EXISTS (SELECT 1 FROM tickets WHERE id = ?)`, ticketID).Scan(&exists); err != nil {
+		return fmt.Errorf("failed to query ticket: %v", err)
+	}
+	if !exists {
+		return fmt.Errorf("ticket %d: %w", ticketID, ErrNotFound)
+	}
+	return nil
+}
@@ -0,0 +1,76 @@
+package repository
+
+import (
+	"context"
+	"errors"
+	"reflect"
+	"testing"
+	"time"
+
+	"go-grpc-backend/internal/models"
+)
+
+func newTestTicketAttributeRepository(t *testing.T) *TicketAttributeRepository {
+	t.Helper()
+	repo := NewTicketAttributeRepository(newTestDB(t, "basic"))
+	repo.now = func() time.Time { return time.Date(2025, 1, 15, 9, 0, 0, 0, time.UTC) }
+	return repo
+}
+
+func TestTicketAttributeRepository_Integration_SetAndDelete(t *testing.T) {
+	repo := newTestTicketAttributeRepository(t)
+
+	attributes, err := repo.SetTicketAttributes(context.Background(), 1, []models.TicketAttribute{
+		{Key: "priority", Value: "high"},
+		{Key: "channel", Value: "email"},
+	})
+	if err != nil {
+		t.Fatalf("SetTicketAttributes() error = %v", err)
+	}
+	now := repo.now()
+	want := []models.TicketAttribute{
+		{TicketID: 1, Key: "channel", Value: "email", UpdatedAt: now},
+		{TicketID: 1, Key: "priority", Value: "high", UpdatedAt: now},
+	}
+	if !reflect.DeepEqual(attributes, want) {
+		t.Errorf("Attributes mismatch\n got: %+v\nwant: %+v", attributes, want)
+	}
+
+	// Setting a key again overwrites it and leaves the others alone
+	attributes, err = repo.SetTicketAttributes(context.Background(), 1, []models.TicketAttribute{{Key: "channel", Value: "chat"}})
+	if err != nil {
+		t.Fatalf("SetTicketAttributes() error = %v", err)
+	}
+	if len(attributes) != 2 || attributes[0].Value != "chat" || attributes[1].Value != "high" {
+		t.Errorf("Unexpected attributes after overwrite %+v", attributes)
+	}
+
+	if err := repo.DeleteTicketAttribute(context.Background(), 1, "priority"); err != nil {
+		t.Fatalf("DeleteTicketAttribute() error = %v", err)
+	}
+	if err := repo.DeleteTicketAttribute(context.Background(), 1, "priority"); !errors.Is(err, ErrNotFound) {
+		t.Errorf("Expected ErrNotFound deleting a deleted attribute, got %v", err)
+	}
+	attributes, err = repo.GetTicketAttributes(context.Background(), 1)
+	if err != nil {
+		t.Fatalf("GetTicketAttributes() error = %v", err)
+	}
+	if len(attributes) != 1 || attributes[0].Key != "channel" {
+		t.Errorf("Expected only channel to remain, got %+v", attributes)
+	}
+
+	if attributes, err := repo.GetTicketAttributes(context.Background(), 2); err != nil || len(attributes) != 0 {
+		t.Errorf("Expected no attributes on ticket 2, got %+v (error %v)", attributes, err)
+	}
+}
+
+func TestTicketAttributeRepository_Integration_UnknownTicket(t *testing.T) {
+	repo := newTestTicketAttributeRepository(t)
+
+	if _, err := repo.GetTicketAttributes(context.Background(), 42); !errors.Is(err, ErrNotFound) {
+		t.Errorf("GetTicketAttributes: expected ErrNotFound, got %v", err)
+	}
+	if _, err := repo.SetTicketAttributes(context.Background(), 42, []models.TicketAttribute{{Key: "channel", Value: "email"}}); !errors.Is(err, ErrNotFound) {
+		t.Errorf("SetTicketAttributes: expected ErrNotFound, got %v", err)
+	}
+}
@@ -0,0 +1,90 @@
+package repository
+
+import (
+	"context"
+	"database/sql"
+	"errors"
+	"fmt"
+
+	"go-grpc-backend/internal/models"
+)
+
+// TicketRepositoryInterface reads individual tickets and their ratings
+type TicketRepositoryInterface interface {
+	GetTicket(ctx context.Context, id int) (models.Ticket, error)
+	GetTicketRatings(ctx context.Context, ticketID int) ([]models.TicketRating, error)
+}
+
+type TicketRepository struct {
+	db *sql.DB
+}
+
+func NewTicketRepository(db *sql.DB) *TicketRepository {
+	return &TicketRepository{db: db}
+}
+
+func (r *TicketRepository) GetTicket(ctx context.Context, id int) (models.Ticket, error) {
+	var ticket models.Ticket
+	err := r.db.QueryRowContext(ctx, `SELECT id, subject, created_at FROM tickets WHERE id = ?`, id).
+		Scan(&ticket.ID, &ticket.Subject, &ticket.CreatedAt)
+	if errors.Is(err, sql.ErrNoRows) {
+		return models.Ticket{}, fmt.Errorf("ticket %d: %w", id, ErrNotFound)
+	}
+	if err != nil {
+		return models.Ticket{}, fmt.Errorf("failed to query ticket: %v", err)
+	}
+	return ticket, nil
+}
+
+// GetTicketRatings returns every rating on the ticket, oldest first
+func (r *TicketRepository) GetTicketRatings(ctx context.Context, ticketID int) ([]models.TicketRating, error) {
+	const query = `
+		SELECT
+			r.id,
+			r.rating,
+			rc.id AS category_id,
+			rc.name AS category_name,
+			reviewer.id AS reviewer_id,
+			reviewer.name AS reviewer_name,
+			reviewee.id AS reviewee_id,
+			reviewee.name AS reviewee_name,
+			r.created_at
+		FROM ratings r
+		JOIN rating_categories rc ON r.rating_category_id = rc.id
+		JOIN users reviewer ON r.reviewer_id = reviewer.id
+		JOIN users reviewee ON r.reviewee_id = reviewee.id
+		WHERE r.ticket_id = ?
+		ORDER BY r.created_at, r.id
+	`
+
+	rows, err := r.db.QueryContext(ctx, query, ticketID)
+	if err != nil {
+		return nil, fmt.Errorf("failed to query ticket ratings: %v", err)
+	}
+	defer rows.Close()
+
+	var ratings []models.TicketRating
+	for rows.Next() {
+		var rating models.TicketRating
+		err := rows.Scan(
+			&rating.ID,
+			&rating.Rating,
+			&rating.CategoryID,
+			&rating.CategoryName,
+			&rating.ReviewerID,
+			&rating.ReviewerName,
+			&rating.RevieweeID,
+			&rating.RevieweeName,
+			&rating.CreatedAt,
+		)
+		if err != nil {
+			return nil, fmt.Errorf("failed to scan ticket rating: %v", err)
+		}
+		ratings = append(ratings, rating)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, fmt.Errorf("failed to read ticket ratings: %v", err)
+	}
+
+	return ratings, nil
+}
@@ -0,0 +1,51 @@
+package repository
+
+import (
+	"context"
+	"errors"
+	"reflect"
+	"testing"
+	"time"
+
+	"go-grpc-backend/internal/models"
+)
+
+func TestTicketRepository_Integration_GetTicket(t *testing.T) {
+	repo := NewTicketRepository(newTestDB(t, "basic"))
+
+	ticket, err := repo.GetTicket(context.Background(), 2)
+	if err != nil {
+		t.Fatalf("GetTicket() error = %v", err)
+	}
+	expected := models.Ticket{ID: 2, Subject: "Login issue", CreatedAt: time.Date(2025, 1, 5, 12, 0, 0, 0, time.UTC)}
+	if ticket != expected {
+		t.Errorf("Ticket mismatch\n got: %+v\nwant: %+v", ticket, expected)
+	}
+
+	if _, err := repo.GetTicket(context.Background(), 42); !errors.Is(err, ErrNotFound) {
+		t.Errorf("Expected ErrNotFound for an unknown ticket, got %v", err)
+	}
+}
+
+func TestTicketRepository_Integration_GetTicketRatings(t *testing.T) {
+	repo := NewTicketRepository(newTestDB(t, "basic"))
+
+	ratings, err := repo.GetTicketRatings(context.Background(), 2)
+	if err != nil {
+		t.Fatalf("GetTicketRatings() error = %v", err)
+	}
+
+	// Every rating on the ticket regardless of date, oldest first
+	expected := []models.TicketRating{
+		{ID: 3, Rating: 5, CategoryID: 1, CategoryName: "Spelling", ReviewerID: 2, ReviewerName: "Bob", RevieweeID: 1, RevieweeName: "Alice", CreatedAt: time.Date(2025, 1, 6, 15, 30, 0, 0, time.UTC)},
+		{ID: 4, Rating: 3, CategoryID: 2, CategoryName: "Grammar", ReviewerID: 2, ReviewerName: "Bob", RevieweeID: 1, RevieweeName: "Alice", CreatedAt: time.Date(2025, 1, 7, 8, 0, 0, 0, time.UTC)},
+		{ID: 5, Rating: 1, CategoryID: 2, CategoryName: "Grammar", ReviewerID: 1, ReviewerName: "Alice", RevieweeID: 2, RevieweeName: "Bob", CreatedAt: time.Date(2025, 1, 12, 18, 0, 0, 0, time.UTC)},
+	}
+	if !reflect.DeepEqual(ratings, expected) {
+		t.Errorf("Ticket ratings mismatch\n got: %+v\nwant: %+v", ratings, expected)
+	}
+
+	if ratings, err := repo.GetTicketRatings(context.Background(), 42); err != nil || len(ratings) != 0 {
+		t.Errorf("Expected no ratings for an unknown ticket, got %v, %v", ratings, err)
+	}
+}
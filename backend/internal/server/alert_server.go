@@ -0,0 +1,131 @@
+package server
+
+import (
+	"context"
+	"errors"
+	"math"
+	"net/url"
+	"slices"
+
+	"go-grpc-backend/internal/models"
+	"go-grpc-backend/internal/repository"
+	"go-grpc-backend/internal/service"
+	"go-grpc-backend/proto"
+
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+func (s *AnalyticsServer) ListAlertRules(ctx context.Context, req *proto.ListAlertRulesRequest) (*proto.ListAlertRulesResponse, error) {
+	if s.alertRepo == nil {
+		return nil, errAlertingUnavailable
+	}
+	return service.ListAlertRules(ctx, s.alertRepo)
+}
+
+func (s *AnalyticsServer) CreateAlertRule(ctx context.Context, req *proto.CreateAlertRuleRequest) (*proto.AlertRule, error) {
+	if s.alertRepo == nil {
+		return nil, errAlertingUnavailable
+	}
+	rule, err := requestAlertRule(req.Rule)
+	if err != nil {
+		return nil, err
+	}
+	if rule.WebhookURL == "" {
+		return nil, status.Error(codes.InvalidArgument, "rule.webhook_url is required")
+	}
+	return service.CreateAlertRule(ctx, s.alertRepo, rule)
+}
+
+func (s *AnalyticsServer) UpdateAlertRule(ctx context.Context, req *proto.UpdateAlertRuleRequest) (*proto.AlertRule, error) {
+	if s.alertRepo == nil {
+		return nil, errAlertingUnavailable
+	}
+	rule, err := requestAlertRule(req.Rule)
+	if err != nil {
+		return nil, err
+	}
+	if rule.ID <= 0 {
+		return nil, status.Error(codes.InvalidArgument, "rule.id is required")
+	}
+
+	updated, err := service.UpdateAlertRule(ctx, s.alertRepo, rule)
+	return updated, repositoryError(err)
+}
+
+func (s *AnalyticsServer) DeleteAlertRule(ctx context.Context, req *proto.DeleteAlertRuleRequest) (*proto.DeleteAlertRuleResponse, error) {
+	if s.alertRepo == nil {
+		return nil, errAlertingUnavailable
+	}
+	if req.Id <= 0 {
+		return nil, status.Error(codes.InvalidArgument, "id is required")
+	}
+
+	resp, err := service.DeleteAlertRule(ctx, s.alertRepo, int(req.Id))
+	return resp, repositoryError(err)
+}
+
+var errAlertingUnavailable = status.Error(codes.Unimplemented, "alert rules are not configured on this server")
+
+// repositoryError maps a missing record to NotFound and passes other errors through
+func repositoryError(err error) error {
+	if errors.Is(err, repository.ErrNotFound) {
+		return status.Error(codes.NotFound, err.Error())
+	}
+	return err
+}
+
+// requestAlertRule validates the rule a create or update request carries.
+// Category IDs are sorted and deduplicated. An empty webhook URL is left to the caller
+func requestAlertRule(r *proto.AlertRule) (models.AlertRule, error) {
+	if r == nil {
+		return models.AlertRule{}, status.Error(codes.InvalidArgument, "rule is required")
+	}
+	if r.Name == "" {
+		return models.AlertRule{}, status.Error(codes.InvalidArgument, "rule.name is required")
+	}
+	metric, ok := service.AlertMetricFromProto(r.Metric)
+	if !ok {
+		return models.AlertRule{}, status.Errorf(codes.InvalidArgument, "unsupported metric %v", r.Metric)
+	}
+	comparator, ok := service.AlertComparatorFromProto(r.Comparator)
+	if !ok {
+		return models.AlertRule{}, status.Errorf(codes.InvalidArgument, "unsupported comparator %v", r.Comparator)
+	}
+	if r.Window == nil || r.Window.AsDuration() <= 0 {
+		return models.AlertRule{}, status.Error(codes.InvalidArgument, "rule.window must be positive")
+	}
+	if math.IsNaN(r.Threshold) || math.IsInf(r.Threshold, 0) {
+		return models.AlertRule{}, status.Error(codes.InvalidArgument, "rule.threshold must be finite")
+	}
+	if r.MinSample < 0 {
+		return models.AlertRule{}, status.Error(codes.InvalidArgument, "rule.min_sample must be non-negative")
+	}
+	if r.WebhookUrl != "" {
+		if u, err := url.Parse(r.WebhookUrl); err != nil || (u.Scheme != "http" && u.Scheme != "https") || u.Host == "" {
+			return models.AlertRule{}, status.Error(codes.InvalidArgument, "rule.webhook_url must be an absolute http(s) URL")
+		}
+	}
+
+	var categoryIDs []int
+	for _, id := range r.CategoryIds {
+		if id <= 0 {
+			return models.AlertRule{}, status.Errorf(codes.InvalidArgument, "invalid category id %d", id)
+		}
+		categoryIDs = append(categoryIDs, int(id))
+	}
+	slices.Sort(categoryIDs)
+
+	return models.AlertRule{
+		ID:          int(r.Id),
+		Name:        r.Name,
+		Metric:      metric,
+		CategoryIDs: slices.Compact(categoryIDs),
+		Window:      r.Window.AsDuration(),
+		Comparator:  comparator,
+		Threshold:   r.Threshold,
+		MinSample:   int(r.MinSample),
+		WebhookURL:  r.WebhookUrl,
+		Enabled:     r.Enabled,
+	}, nil
+}
@@ -0,0 +1,214 @@
+package server
+
+import (
+	"context"
+	"fmt"
+	"math"
+	"testing"
+	"time"
+
+	"go-grpc-backend/internal/models"
+	"go-grpc-backend/internal/repository"
+	"go-grpc-backend/proto"
+
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/types/known/durationpb"
+)
+
+// fakeAlertRepository keeps alert rules and states in memory, like AlertRepository does in SQLite
+type fakeAlertRepository struct {
+	rules  map[int]models.AlertRule
+	states map[int]models.AlertState
+	nextID int
+}
+
+func newFakeAlertRepository() *fakeAlertRepository {
+	return &fakeAlertRepository{rules: make(map[int]models.AlertRule), states: make(map[int]models.AlertState)}
+}
+
+func (f *fakeAlertRepository) ListAlertRules(ctx context.Context) ([]models.AlertRule, error) {
+	var rules []models.AlertRule
+	for id := 1; id <= f.nextID; id++ {
+		if rule, ok := f.rules[id]; ok {
+			rules = append(rules, rule)
+		}
+	}
+	return rules, nil
+}
+
+func (f *fakeAlertRepository) GetAlertRule(ctx context.Context, id int) (models.AlertRule, error) {
+	rule, ok := f.rules[id]
+	if !ok {
+		return rule, fmt.Errorf("alert rule %d: %w", id, repository.ErrNotFound)
+	}
+	return rule, nil
+}
+
+func (f *fakeAlertRepository) CreateAlertRule(ctx context.Context, rule models.AlertRule) (models.AlertRule, error) {
+	f.nextID++
+	rule.ID = f.nextID
+	rule.CreatedAt = time.Date(2025, 3, 1, 0, 0, 0, 0, time.UTC)
+	f.rules[rule.ID] = rule
+	return rule, nil
+}
+
+func (f *fakeAlertRepository) UpdateAlertRule(ctx context.Context, rule models.AlertRule) (models.AlertRule, error) {
+	existing, err := f.GetAlertRule(ctx, rule.ID)
+	if err != nil {
+		return rule, err
+	}
+	rule.CreatedAt = existing.CreatedAt
+	f.rules[rule.ID] = rule
+	return rule, nil
+}
+
+func (f *fakeAlertRepository) DeleteAlertRule(ctx context.Context, id int) error {
+	if _, err := f.GetAlertRule(ctx, id); err != nil {
+		return err
+	}
+	delete(f.rules, id)
+	delete(f.states, id)
+	return nil
+}
+
+func (f *fakeAlertRepository) ListAlertStates(ctx context.Context) (map[int]models.AlertState, error) {
+	return f.states, nil
+}
+
+func (f *fakeAlertRepository) SaveAlertState(ctx context.Context, state models.AlertState) error {
+	f.states[state.RuleID] = state
+	return nil
+}
+
+func validAlertRule() *proto.AlertRule {
+	return &proto.AlertRule{
+		Name:        "Low quality",
+		Metric:      proto.AlertMetric_ALERT_METRIC_QUALITY_SCORE,
+		CategoryIds: []int32{3, 1, 3},
+		Window:      durationpb.New(24 * time.Hour),
+		Comparator:  proto.AlertComparator_ALERT_COMPARATOR_LESS_THAN,
+		Threshold:   70,
+		MinSample:   10,
+		WebhookUrl:  "https://hooks.example.com/services/T000/B000",
+		Enabled:     true,
+	}
+}
+
+func TestAnalyticsServer_EndToEnd_AlertRules(t *testing.T) {
+	alerts := newFakeAlertRepository()
+	// The cache must not serve a stale rule list after a change
+	client := startTestServer(t, New(&fakeRepository{},
+		WithAlertRepository(alerts),
+		WithUnaryInterceptors(newResponseCache(time.Minute, 100).interceptor()),
+	))
+	ctx := testContext(t)
+
+	created, err := client.CreateAlertRule(ctx, &proto.CreateAlertRuleRequest{Rule: validAlertRule()})
+	if err != nil {
+		t.Fatalf("CreateAlertRule() error = %v", err)
+	}
+	if created.Id != 1 || created.CreatedAt == nil || created.State != nil {
+		t.Errorf("Unexpected created rule %+v", created)
+	}
+	if got := created.CategoryIds; len(got) != 2 || got[0] != 1 || got[1] != 3 {
+		t.Errorf("Expected sorted, deduplicated category ids [1 3], got %v", got)
+	}
+	if created.WebhookUrl != "" {
+		t.Errorf("Expected the webhook URL to be withheld, got %q", created.WebhookUrl)
+	}
+
+	list, err := client.ListAlertRules(ctx, &proto.ListAlertRulesRequest{})
+	if err != nil {
+		t.Fatalf("ListAlertRules() error = %v", err)
+	}
+	if len(list.Rules) != 1 || list.Rules[0].Name != "Low quality" {
+		t.Fatalf("Expected the created rule, got %+v", list.Rules)
+	}
+
+	alerts.states[1] = models.AlertState{RuleID: 1, Status: models.AlertStatusFiring, Value: 61, Sample: 4}
+	update := validAlertRule()
+	update.Id = created.Id
+	update.Threshold = 60
+	// Left empty, the stored webhook URL is kept
+	update.WebhookUrl = ""
+	updated, err := client.UpdateAlertRule(ctx, &proto.UpdateAlertRuleRequest{Rule: update})
+	if err != nil {
+		t.Fatalf("UpdateAlertRule() error = %v", err)
+	}
+	if updated.Threshold != 60 || !updated.CreatedAt.AsTime().Equal(created.CreatedAt.AsTime()) {
+		t.Errorf("Unexpected updated rule %+v", updated)
+	}
+	if updated.State.GetStatus() != proto.AlertStatus_ALERT_STATUS_FIRING || !updated.State.InsufficientSample {
+		t.Errorf("Expected the rule's state to be returned, got %+v", updated.State)
+	}
+
+	list, err = client.ListAlertRules(ctx, &proto.ListAlertRulesRequest{})
+	if err != nil {
+		t.Fatalf("ListAlertRules() error = %v", err)
+	}
+	if len(list.Rules) != 1 || list.Rules[0].Threshold != 60 {
+		t.Errorf("Expected the updated rule, got %+v", list.Rules)
+	}
+	if stored := alerts.rules[1].WebhookURL; stored != "https://hooks.example.com/services/T000/B000" {
+		t.Errorf("Expected the stored webhook URL to be kept, got %q", stored)
+	}
+	if list.Rules[0].WebhookUrl != "" {
+		t.Errorf("Expected the webhook URL to be withheld, got %q", list.Rules[0].WebhookUrl)
+	}
+
+	if _, err := client.DeleteAlertRule(ctx, &proto.DeleteAlertRuleRequest{Id: created.Id}); err != nil {
+		t.Fatalf("DeleteAlertRule() error = %v", err)
+	}
+	if _, err := client.DeleteAlertRule(ctx, &proto.DeleteAlertRuleRequest{Id: created.Id}); status.Code(err) != codes.NotFound {
+		t.Errorf("Expected NotFound deleting a deleted rule, got %v", err)
+	}
+	update.Id = 42
+	if _, err := client.UpdateAlertRule(ctx, &proto.UpdateAlertRuleRequest{Rule: update}); status.Code(err) != codes.NotFound {
+		t.Errorf("Expected NotFound updating an unknown rule, got %v", err)
+	}
+}
+
+func TestAnalyticsServer_EndToEnd_AlertRuleValidation(t *testing.T) {
+	client := startTestServer(t, New(&fakeRepository{}, WithAlertRepository(newFakeAlertRepository())))
+	ctx := testContext(t)
+
+	invalid := map[string]func(r *proto.AlertRule){
+		"missing name":        func(r *proto.AlertRule) { r.Name = "" },
+		"unspecified metric":  func(r *proto.AlertRule) { r.Metric = proto.AlertMetric_ALERT_METRIC_UNSPECIFIED },
+		"unknown comparator":  func(r *proto.AlertRule) { r.Comparator = proto.AlertComparator(99) },
+		"missing window":      func(r *proto.AlertRule) { r.Window = nil },
+		"negative window":     func(r *proto.AlertRule) { r.Window = durationpb.New(-time.Hour) },
+		"NaN threshold":       func(r *proto.AlertRule) { r.Threshold = math.NaN() },
+		"negative min sample": func(r *proto.AlertRule) { r.MinSample = -1 },
+		"relative webhook":    func(r *proto.AlertRule) { r.WebhookUrl = "/hooks/abc" },
+		"non-http webhook":    func(r *proto.AlertRule) { r.WebhookUrl = "ftp://hooks.example.com/abc" },
+		"missing webhook":     func(r *proto.AlertRule) { r.WebhookUrl = "" },
+		"invalid category id": func(r *proto.AlertRule) { r.CategoryIds = []int32{0} },
+	}
+	for name, mutate := range invalid {
+		rule := validAlertRule()
+		mutate(rule)
+		if _, err := client.CreateAlertRule(ctx, &proto.CreateAlertRuleRequest{Rule: rule}); status.Code(err) != codes.InvalidArgument {
+			t.Errorf("%s: expected InvalidArgument, got %v", name, err)
+		}
+	}
+
+	if _, err := client.CreateAlertRule(ctx, &proto.CreateAlertRuleRequest{}); status.Code(err) != codes.InvalidArgument {
+		t.Errorf("Missing rule: expected InvalidArgument, got %v", err)
+	}
+	if _, err := client.UpdateAlertRule(ctx, &proto.UpdateAlertRuleRequest{Rule: validAlertRule()}); status.Code(err) != codes.InvalidArgument {
+		t.Errorf("Update without id: expected InvalidArgument, got %v", err)
+	}
+	if _, err := client.DeleteAlertRule(ctx, &proto.DeleteAlertRuleRequest{}); status.Code(err) != codes.InvalidArgument {
+		t.Errorf("Delete without id: expected InvalidArgument, got %v", err)
+	}
+}
+
+func TestAnalyticsServer_EndToEnd_AlertRulesUnconfigured(t *testing.T) {
+	client := startTestServer(t, New(&fakeRepository{}))
+
+	if _, err := client.ListAlertRules(testContext(t), &proto.ListAlertRulesRequest{}); status.Code(err) != codes.Unimplemented {
+		t.Errorf("Expected Unimplemented without an alert repository, got %v", err)
+	}
+}
@@ -4,49 +4,265 @@ import (
 	"context"
 	"fmt"
 	"log"
+	"math"
 	"net"
+	"time"
 
+	"go-grpc-backend/internal/alerting"
+	"go-grpc-backend/internal/config"
 	"go-grpc-backend/internal/database"
+	"go-grpc-backend/internal/models"
 	"go-grpc-backend/internal/repository"
 	"go-grpc-backend/internal/service"
 	"go-grpc-backend/proto"
 
 	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/health"
+	healthpb "google.golang.org/grpc/health/grpc_health_v1"
+	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/types/known/timestamppb"
 )
 
 type AnalyticsServer struct {
 	proto.UnimplementedAnalyticsServiceServer
-	analyticsRepo *repository.AnalyticsRepository
+	analyticsRepo repository.AnalyticsRepositoryInterface
+	alertRepo     repository.AlertRepositoryInterface
+	targetRepo    repository.QualityTargetRepositoryInterface
+	ticketRepo    repository.TicketRepositoryInterface
+	attributeRepo repository.TicketAttributeRepositoryInterface
+	groupedRepo   repository.GroupedScoresRepositoryInterface
+	teamRepo      repository.TeamRepositoryInterface
+	categoryRepo  repository.RatingCategoryRepositoryInterface
 	grpcServer    *grpc.Server
+	health        *health.Server
+	db            *database.Database
+	aggregation   service.AggregationOptions
+	shutdownHooks []ShutdownHook
 }
 
-func NewAnalyticsServer() (*AnalyticsServer, error) {
-	db, err := database.NewDatabase()
-	if err != nil {
-		return nil, fmt.Errorf("failed to initialize database: %v", err)
+// Option customizes an AnalyticsServer built with New
+type Option func(*serverOptions)
+
+type serverOptions struct {
+	grpcOptions   []grpc.ServerOption
+	interceptors  []grpc.UnaryServerInterceptor
+	aggregation   service.AggregationOptions
+	db            *database.Database
+	alertRepo     repository.AlertRepositoryInterface
+	targetRepo    repository.QualityTargetRepositoryInterface
+	ticketRepo    repository.TicketRepositoryInterface
+	attributeRepo repository.TicketAttributeRepositoryInterface
+	groupedRepo   repository.GroupedScoresRepositoryInterface
+	teamRepo      repository.TeamRepositoryInterface
+	categoryRepo  repository.RatingCategoryRepositoryInterface
+}
+
+// WithServerOptions passes extra options to grpc.NewServer
+func WithServerOptions(opts ...grpc.ServerOption) Option {
+	return func(o *serverOptions) {
+		o.grpcOptions = append(o.grpcOptions, opts...)
+	}
+}
+
+// WithUnaryInterceptors appends interceptors to the unary chain, in order
+func WithUnaryInterceptors(interceptors ...grpc.UnaryServerInterceptor) Option {
+	return func(o *serverOptions) {
+		o.interceptors = append(o.interceptors, interceptors...)
+	}
+}
+
+// WithAggregationOptions sets the tunables used by GetAggregatedCategoryScores and GetScoresByTicket
+func WithAggregationOptions(aggregation service.AggregationOptions) Option {
+	return func(o *serverOptions) {
+		o.aggregation = aggregation
+	}
+}
+
+// WithDatabase hands ownership of db to the server; it is closed during Shutdown
+func WithDatabase(db *database.Database) Option {
+	return func(o *serverOptions) {
+		o.db = db
+	}
+}
+
+// WithAlertRepository enables the alert rule RPCs; without it they return Unimplemented
+func WithAlertRepository(repo repository.AlertRepositoryInterface) Option {
+	return func(o *serverOptions) {
+		o.alertRepo = repo
+	}
+}
+
+// WithQualityTargetRepository enables the quality target RPCs; without it they return Unimplemented
+func WithQualityTargetRepository(repo repository.QualityTargetRepositoryInterface) Option {
+	return func(o *serverOptions) {
+		o.targetRepo = repo
 	}
+}
+
+// WithTicketRepository enables GetTicketDetail; without it the RPC returns Unimplemented
+func WithTicketRepository(repo repository.TicketRepositoryInterface) Option {
+	return func(o *serverOptions) {
+		o.ticketRepo = repo
+	}
+}
+
+// WithTicketAttributeRepository enables the ticket attribute RPCs; without it they return Unimplemented
+func WithTicketAttributeRepository(repo repository.TicketAttributeRepositoryInterface) Option {
+	return func(o *serverOptions) {
+		o.attributeRepo = repo
+	}
+}
+
+// WithGroupedScoresRepository enables GetScoresGroupedBy; without it the RPC returns Unimplemented
+func WithGroupedScoresRepository(repo repository.GroupedScoresRepositoryInterface) Option {
+	return func(o *serverOptions) {
+		o.groupedRepo = repo
+	}
+}
 
-	analyticsRepo := repository.NewAnalyticsRepository(db.DB)
-	grpcServer := grpc.NewServer()
+// WithTeamRepository enables the team and membership RPCs; GetTeamScores also needs
+// WithGroupedScoresRepository. Without it they return Unimplemented
+func WithTeamRepository(repo repository.TeamRepositoryInterface) Option {
+	return func(o *serverOptions) {
+		o.teamRepo = repo
+	}
+}
+
+// WithRatingCategoryRepository enables the rating category RPCs. Without it they return Unimplemented
+func WithRatingCategoryRepository(repo repository.RatingCategoryRepositoryInterface) Option {
+	return func(o *serverOptions) {
+		o.categoryRepo = repo
+	}
+}
+
+// New builds a server around an existing repository.
+// It does not open any resources, which makes it suitable for tests with fake repositories
+func New(repo repository.AnalyticsRepositoryInterface, opts ...Option) *AnalyticsServer {
+	var o serverOptions
+	for _, opt := range opts {
+		opt(&o)
+	}
+
+	grpcOptions := append([]grpc.ServerOption{grpc.ChainUnaryInterceptor(o.interceptors...)}, o.grpcOptions...)
+	grpcServer := grpc.NewServer(grpcOptions...)
+
+	healthServer := health.NewServer()
 
 	server := &AnalyticsServer{
-		analyticsRepo: analyticsRepo,
+		analyticsRepo: repo,
+		alertRepo:     o.alertRepo,
+		targetRepo:    o.targetRepo,
+		ticketRepo:    o.ticketRepo,
+		attributeRepo: o.attributeRepo,
+		groupedRepo:   o.groupedRepo,
+		teamRepo:      o.teamRepo,
+		categoryRepo:  o.categoryRepo,
 		grpcServer:    grpcServer,
+		health:        healthServer,
+		db:            o.db,
+		aggregation:   o.aggregation,
 	}
 
 	proto.RegisterAnalyticsServiceServer(grpcServer, server)
+	healthpb.RegisterHealthServer(grpcServer, healthServer)
+
+	return server
+}
+
+// NewAnalyticsServer opens the configured database and builds a production server on top of it
+func NewAnalyticsServer(cfg *config.Config) (*AnalyticsServer, error) {
+	db, err := database.NewDatabase(cfg.Database)
+	if err != nil {
+		return nil, fmt.Errorf("failed to initialize database: %v", err)
+	}
+
+	analyticsRepo := repository.NewAnalyticsRepository(db.ReadDB)
+	ticketRepo := repository.NewTicketRepository(db.ReadDB)
+	groupedRepo := repository.NewGroupedScoresRepository(db.ReadDB)
+	// Alert rules, their states, quality targets, ticket attributes, teams and rating categories are written,
+	// so they go through the writer connection
+	alertRepo := repository.NewAlertRepository(db.DB)
+	targetRepo := repository.NewQualityTargetRepository(db.DB)
+	attributeRepo := repository.NewTicketAttributeRepository(db.DB)
+	teamRepo := repository.NewTeamRepository(db.DB)
+	categoryRepo := repository.NewRatingCategoryRepository(db.DB)
+
+	server := New(analyticsRepo,
+		WithDatabase(db),
+		WithAlertRepository(alertRepo),
+		WithQualityTargetRepository(targetRepo),
+		WithTicketRepository(ticketRepo),
+		WithTicketAttributeRepository(attributeRepo),
+		WithGroupedScoresRepository(groupedRepo),
+		WithTeamRepository(teamRepo),
+		WithRatingCategoryRepository(categoryRepo),
+		WithUnaryInterceptors(unaryInterceptors(cfg)...),
+		WithAggregationOptions(service.AggregationOptions{
+			WeeklyThreshold: cfg.Analytics.WeeklyGranularityThreshold,
+			Confidence:      service.ConfidenceOptions{MinSampleSize: cfg.Analytics.MinSampleSize},
+		}),
+	)
+
+	if cfg.Alerting.Enabled {
+		notifier := alerting.NewWebhookNotifier(cfg.Alerting.WebhookTimeout, cfg.Alerting.WebhookMaxAttempts, cfg.Alerting.WebhookRetryBackoff)
+		evaluator := alerting.NewEvaluator(alertRepo, analyticsRepo, notifier, cfg.Alerting.EvaluationInterval)
+		server.runUntilShutdown(evaluator.Run)
+	}
 
 	return server, nil
 }
 
-func (s *AnalyticsServer) Start(port string) error {
-	listener, err := net.Listen("tcp", ":"+port)
+// runUntilShutdown runs fn in the background; Shutdown cancels its context and waits for it
+// to return before the database is closed
+func (s *AnalyticsServer) runUntilShutdown(fn func(ctx context.Context)) {
+	ctx, cancel := context.WithCancel(context.Background())
+	done := make(chan struct{})
+	go func() {
+		defer close(done)
+		fn(ctx)
+	}()
+
+	s.OnShutdown(func(context.Context) error {
+		cancel()
+		<-done
+		return nil
+	})
+}
+
+// unaryInterceptors builds the interceptor chain enabled by the configuration.
+// Auth runs first so unauthenticated calls never reach the cache
+func unaryInterceptors(cfg *config.Config) []grpc.UnaryServerInterceptor {
+	var interceptors []grpc.UnaryServerInterceptor
+
+	if cfg.Auth.Enabled {
+		interceptors = append(interceptors, authInterceptor(cfg.Auth.Tokens))
+	}
+	if cfg.Server.RequestTimeout > 0 {
+		interceptors = append(interceptors, timeoutInterceptor(cfg.Server.RequestTimeout))
+	}
+	if cfg.Cache.Enabled {
+		interceptors = append(interceptors, newResponseCache(cfg.Cache.TTL, cfg.Cache.MaxEntries).interceptor())
+	}
+
+	return interceptors
+}
+
+// Start listens on the TCP address and serves until Shutdown
+func (s *AnalyticsServer) Start(address string) error {
+	listener, err := net.Listen("tcp", address)
 	if err != nil {
 		return fmt.Errorf("failed to create listener: %v", err)
 	}
 
-	log.Printf("Starting Analytics gRPC server on port %s", port)
+	log.Printf("Starting Analytics gRPC server on %s", address)
+
+	return s.Serve(listener)
+}
 
+// Serve accepts connections on an existing listener until Shutdown.
+// Tests pass a bufconn listener here
+func (s *AnalyticsServer) Serve(listener net.Listener) error {
 	if err := s.grpcServer.Serve(listener); err != nil {
 		return fmt.Errorf("failed to serve: %v", err)
 	}
@@ -54,37 +270,276 @@ func (s *AnalyticsServer) Start(port string) error {
 	return nil
 }
 
-func (s *AnalyticsServer) Stop() {
-	log.Println("Stopping Analytics gRPC server...")
-	s.grpcServer.GracefulStop()
+// requestRange builds the range a request asks for and rejects ranges that end before they start
+func requestRange(start, end *timestamppb.Timestamp, inclusiveEnd bool, basis proto.DateBasis) (models.DateRange, error) {
+	b, err := requestDateBasis(basis)
+	if err != nil {
+		return models.DateRange{}, err
+	}
+	rng := models.DateRange{Start: start.AsTime(), End: end.AsTime(), InclusiveEnd: inclusiveEnd, Basis: b}
+	if err := rng.Validate(); err != nil {
+		return rng, status.Errorf(codes.InvalidArgument, "invalid date range: %v", err)
+	}
+	return rng, nil
+}
+
+// requestDateBasis validates the timestamp a request's ranges apply to
+func requestDateBasis(basis proto.DateBasis) (models.DateBasis, error) {
+	b, ok := service.DateBasisFromProto(basis)
+	if !ok {
+		return b, status.Errorf(codes.InvalidArgument, "unsupported date_basis %v", basis)
+	}
+	return b, nil
 }
 
 func (s *AnalyticsServer) GetAggregatedCategoryScores(ctx context.Context, req *proto.AggregatedCategoryScoresRequest) (*proto.AggregatedCategoryScoresResponse, error) {
-	startDate := req.StartDate.AsTime()
-	endDate := req.EndDate.AsTime()
+	rng, err := requestRange(req.StartDate, req.EndDate, req.InclusiveEnd, req.DateBasis)
+	if err != nil {
+		return nil, err
+	}
+
+	smoothing, err := requestSmoothing(req.Smoothing)
+	if err != nil {
+		return nil, err
+	}
+	if _, ok := proto.FillMode_name[int32(req.FillMode)]; !ok {
+		return nil, status.Errorf(codes.InvalidArgument, "unsupported fill_mode %v", req.FillMode)
+	}
+
+	opts := s.aggregation
+	opts.Fill = req.FillMode
+	opts.Confidence.Enabled = req.IncludeConfidence
+	opts.Smoothing = smoothing
+
+	return service.GetAggregatedCategoryScores(ctx, s.analyticsRepo, rng, opts)
+}
 
-	return service.GetAggregatedCategoryScores(s.analyticsRepo, startDate, endDate)
+// requestSmoothing validates the smoothing a request asks for; nil means none
+func requestSmoothing(req *proto.Smoothing) (service.SmoothingOptions, error) {
+	if req.GetRollingWindow() < 0 {
+		return service.SmoothingOptions{}, status.Error(codes.InvalidArgument, "rolling_window must not be negative")
+	}
+	// Also rejects NaN
+	if alpha := req.GetEmaAlpha(); !(alpha >= 0 && alpha <= 1) {
+		return service.SmoothingOptions{}, status.Error(codes.InvalidArgument, "ema_alpha must be between 0 and 1")
+	}
+
+	return service.SmoothingOptions{
+		RollingWindow: int(req.GetRollingWindow()),
+		EMAAlpha:      req.GetEmaAlpha(),
+		Cumulative:    req.GetCumulative(),
+	}, nil
 }
 
 func (s *AnalyticsServer) GetScoresByTicket(ctx context.Context, req *proto.ScoresByTicketRequest) (*proto.ScoresByTicketResponse, error) {
-	startDate := req.StartDate.AsTime()
-	endDate := req.EndDate.AsTime()
+	rng, err := requestRange(req.StartDate, req.EndDate, req.InclusiveEnd, req.DateBasis)
+	if err != nil {
+		return nil, err
+	}
+
+	conf := s.aggregation.Confidence
+	conf.Enabled = req.IncludeConfidence
+
+	return service.GetScoresByTicket(ctx, s.analyticsRepo, rng, conf)
+}
+
+func (s *AnalyticsServer) GetLowestScoringTickets(ctx context.Context, req *proto.LowestScoringTicketsRequest) (*proto.LowestScoringTicketsResponse, error) {
+	rng, err := requestRange(req.StartDate, req.EndDate, req.InclusiveEnd, req.DateBasis)
+	if err != nil {
+		return nil, err
+	}
+	if req.Limit < 0 || req.Limit > service.MaxLowestScoringLimit {
+		return nil, status.Errorf(codes.InvalidArgument, "limit must be between 1 and %d, or 0 for the default", service.MaxLowestScoringLimit)
+	}
+	if req.MinRatings < 0 {
+		return nil, status.Error(codes.InvalidArgument, "min_ratings must be non-negative")
+	}
+	var categoryIDs []int
+	for _, id := range req.CategoryIds {
+		if id <= 0 {
+			return nil, status.Errorf(codes.InvalidArgument, "invalid category id %d", id)
+		}
+		categoryIDs = append(categoryIDs, int(id))
+	}
 
-	return service.GetScoresByTicket(s.analyticsRepo, startDate, endDate)
+	return service.GetLowestScoringTickets(ctx, s.analyticsRepo, rng, service.LowestScoringOptions{
+		Limit:       int(req.Limit),
+		MinRatings:  int(req.MinRatings),
+		CategoryIDs: categoryIDs,
+	})
+}
+
+func (s *AnalyticsServer) GetTicketDetail(ctx context.Context, req *proto.TicketDetailRequest) (*proto.TicketDetailResponse, error) {
+	if s.ticketRepo == nil {
+		return nil, status.Error(codes.Unimplemented, "ticket details are not configured on this server")
+	}
+	if req.TicketId <= 0 {
+		return nil, status.Error(codes.InvalidArgument, "ticket_id is required")
+	}
+
+	resp, err := service.GetTicketDetail(ctx, s.ticketRepo, int(req.TicketId))
+	return resp, repositoryError(err)
 }
 
 func (s *AnalyticsServer) GetOverallQualityScore(ctx context.Context, req *proto.OverallQualityScoreRequest) (*proto.OverallQualityScoreResponse, error) {
-	startDate := req.StartDate.AsTime()
-	endDate := req.EndDate.AsTime()
+	rng, err := requestRange(req.StartDate, req.EndDate, req.InclusiveEnd, req.DateBasis)
+	if err != nil {
+		return nil, err
+	}
 
-	return service.GetOverallQualityScore(s.analyticsRepo, startDate, endDate)
+	return service.GetOverallQualityScore(ctx, s.analyticsRepo, rng)
 }
 
 func (s *AnalyticsServer) GetPeriodOverPeriodChange(ctx context.Context, req *proto.PeriodOverPeriodChangeRequest) (*proto.PeriodOverPeriodChangeResponse, error) {
-	currentStart := req.CurrentStart.AsTime()
-	currentEnd := req.CurrentEnd.AsTime()
-	previousStart := req.PreviousStart.AsTime()
-	previousEnd := req.PreviousEnd.AsTime()
+	if req.Preset != proto.PeriodPreset_PERIOD_PRESET_UNSPECIFIED {
+		current, previous, err := presetRanges(req)
+		if err != nil {
+			return nil, err
+		}
+		return service.GetPeriodOverPeriodChange(ctx, s.analyticsRepo, current, previous, req.ChangeUnit)
+	}
+
+	current, err := requestRange(req.CurrentStart, req.CurrentEnd, req.InclusiveEnd, req.DateBasis)
+	if err != nil {
+		return nil, err
+	}
+	previous, err := requestRange(req.PreviousStart, req.PreviousEnd, req.InclusiveEnd, req.DateBasis)
+	if err != nil {
+		return nil, err
+	}
+
+	return service.GetPeriodOverPeriodChange(ctx, s.analyticsRepo, current, previous, req.ChangeUnit)
+}
+
+func (s *AnalyticsServer) GetPeriodSeries(ctx context.Context, req *proto.PeriodSeriesRequest) (*proto.PeriodSeriesResponse, error) {
+	if req.Count <= 0 || req.Count > service.MaxPeriodSeriesCount {
+		return nil, status.Errorf(codes.InvalidArgument, "count must be between 1 and %d", service.MaxPeriodSeriesCount)
+	}
+
+	loc, err := requestLocation(req.TimeZone)
+	if err != nil {
+		return nil, err
+	}
+
+	basis, err := requestDateBasis(req.DateBasis)
+	if err != nil {
+		return nil, err
+	}
+
+	// One extra period in front is the baseline for the first period's change
+	periods, err := service.ResolvePeriodSeries(req.Unit, int(req.Count)+1, requestAnchor(req.Anchor), loc)
+	if err != nil {
+		return nil, status.Error(codes.InvalidArgument, err.Error())
+	}
+	for i := range periods {
+		periods[i].Basis = basis
+	}
+
+	return service.GetPeriodSeries(ctx, s.analyticsRepo, periods, req.Unit, req.ChangeUnit)
+}
+
+func (s *AnalyticsServer) GetAnomalies(ctx context.Context, req *proto.AnomaliesRequest) (*proto.AnomaliesResponse, error) {
+	rng, err := requestRange(req.StartDate, req.EndDate, req.InclusiveEnd, req.DateBasis)
+	if err != nil {
+		return nil, err
+	}
+	// Also rejects NaN
+	if !(req.Threshold >= 0) || math.IsInf(req.Threshold, 1) {
+		return nil, status.Error(codes.InvalidArgument, "threshold must be a finite number, 0 or more")
+	}
+	if req.MinRatings < 0 {
+		return nil, status.Error(codes.InvalidArgument, "min_ratings must not be negative")
+	}
+
+	return service.GetAnomalies(ctx, s.analyticsRepo, rng, service.AnomalyOptions{
+		Threshold:  req.Threshold,
+		MinRatings: int(req.MinRatings),
+	})
+}
+
+func (s *AnalyticsServer) GetScoreForecast(ctx context.Context, req *proto.ScoreForecastRequest) (*proto.ScoreForecastResponse, error) {
+	rng, err := requestRange(req.StartDate, req.EndDate, req.InclusiveEnd, req.DateBasis)
+	if err != nil {
+		return nil, err
+	}
+	if !rng.End.After(rng.Start) && !rng.InclusiveEnd {
+		return nil, status.Error(codes.InvalidArgument, "forecast needs a non-empty date range")
+	}
+	if req.HorizonDays <= 0 || req.HorizonDays > service.MaxForecastHorizonDays {
+		return nil, status.Errorf(codes.InvalidArgument, "horizon_days must be between 1 and %d", service.MaxForecastHorizonDays)
+	}
+
+	return service.GetScoreForecast(ctx, s.analyticsRepo, rng, int(req.HorizonDays))
+}
+
+// requestLocation loads the IANA time zone a request names; empty means UTC
+func requestLocation(name string) (*time.Location, error) {
+	if name == "" {
+		return time.UTC, nil
+	}
+	loc, err := time.LoadLocation(name)
+	if err != nil {
+		return nil, status.Errorf(codes.InvalidArgument, "invalid time zone: %v", err)
+	}
+	return loc, nil
+}
+
+// requestAnchor is the date relative periods are resolved against; unset means now
+func requestAnchor(anchor *timestamppb.Timestamp) time.Time {
+	if anchor == nil {
+		return time.Now()
+	}
+	return anchor.AsTime()
+}
+
+// presetRanges resolves a period-over-period preset against the request's anchor and time zone
+func presetRanges(req *proto.PeriodOverPeriodChangeRequest) (current, previous models.DateRange, err error) {
+	if req.CurrentStart != nil || req.CurrentEnd != nil || req.PreviousStart != nil || req.PreviousEnd != nil {
+		return current, previous, status.Error(codes.InvalidArgument, "preset and explicit period timestamps are mutually exclusive")
+	}
+	// Preset periods always end at the end of the anchor's day
+	if req.InclusiveEnd {
+		return current, previous, status.Error(codes.InvalidArgument, "preset and inclusive_end are mutually exclusive")
+	}
+
+	loc, err := requestLocation(req.TimeZone)
+	if err != nil {
+		return current, previous, err
+	}
+	basis, err := requestDateBasis(req.DateBasis)
+	if err != nil {
+		return current, previous, err
+	}
+
+	current, previous, err = service.ResolvePeriodPreset(req.Preset, requestAnchor(req.Anchor), loc)
+	if err != nil {
+		return current, previous, status.Error(codes.InvalidArgument, err.Error())
+	}
+	current.Basis, previous.Basis = basis, basis
+	return current, previous, nil
+}
+
+func (s *AnalyticsServer) GetRatingDistribution(ctx context.Context, req *proto.RatingDistributionRequest) (*proto.RatingDistributionResponse, error) {
+	rng, err := requestRange(req.StartDate, req.EndDate, req.InclusiveEnd, req.DateBasis)
+	if err != nil {
+		return nil, err
+	}
+	for _, p := range req.Percentiles {
+		if !(p >= 0 && p <= 100) {
+			return nil, status.Errorf(codes.InvalidArgument, "percentile %v is outside 0-100", p)
+		}
+	}
+
+	var granularity models.Granularity
+	switch req.Granularity {
+	case proto.Granularity_GRANULARITY_UNSPECIFIED:
+	case proto.Granularity_GRANULARITY_DAY:
+		granularity = models.GranularityDay
+	case proto.Granularity_GRANULARITY_WEEK:
+		granularity = models.GranularityWeek
+	default:
+		return nil, status.Errorf(codes.InvalidArgument, "unknown granularity %v", req.Granularity)
+	}
 
-	return service.GetPeriodOverPeriodChange(s.analyticsRepo, currentStart, currentEnd, previousStart, previousEnd)
+	return service.GetRatingDistribution(ctx, s.analyticsRepo, rng, granularity, req.Percentiles)
 }
@@ -0,0 +1,549 @@
+package server
+
+import (
+	"context"
+	"fmt"
+	"math"
+	"net"
+	"testing"
+	"time"
+
+	"go-grpc-backend/internal/models"
+	"go-grpc-backend/internal/repository"
+	"go-grpc-backend/proto"
+
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/credentials/insecure"
+	"google.golang.org/grpc/metadata"
+	"google.golang.org/grpc/status"
+	"google.golang.org/grpc/test/bufconn"
+	"google.golang.org/protobuf/types/known/timestamppb"
+)
+
+// fakeRepository serves canned rows for every AnalyticsRepositoryInterface method
+type fakeRepository struct {
+	daily         []models.CategoryRatingOverTimePeriod
+	weekly        []models.CategoryRatingOverTimePeriod
+	ticketScores  []models.TicketCategoryScore
+	overallScores []models.CategoryScore
+	distribution  []models.RatingDistribution
+	periodScores  []models.PeriodCategoryScore
+}
+
+func (f *fakeRepository) GetDailyAggregatedCategoryRatings(ctx context.Context, rng models.DateRange) ([]models.CategoryRatingOverTimePeriod, error) {
+	return f.daily, nil
+}
+
+func (f *fakeRepository) GetWeeklyAggregatedCategoryRatings(ctx context.Context, rng models.DateRange) ([]models.CategoryRatingOverTimePeriod, error) {
+	return f.weekly, nil
+}
+
+func (f *fakeRepository) GetScoresByTicket(ctx context.Context, rng models.DateRange) ([]models.TicketCategoryScore, error) {
+	return f.ticketScores, nil
+}
+
+func (f *fakeRepository) GetOverallQualityScore(ctx context.Context, rng models.DateRange) ([]models.CategoryScore, error) {
+	return f.overallScores, nil
+}
+
+func (f *fakeRepository) GetRatingDistribution(ctx context.Context, rng models.DateRange, granularity models.Granularity) ([]models.RatingDistribution, error) {
+	return f.distribution, nil
+}
+
+func (f *fakeRepository) GetCategoryScoresByPeriod(ctx context.Context, periods []models.DateRange) ([]models.PeriodCategoryScore, error) {
+	return f.periodScores, nil
+}
+
+// fakeTicketRepository serves a single ticket and its ratings
+type fakeTicketRepository struct {
+	ticket  models.Ticket
+	ratings []models.TicketRating
+}
+
+func (f *fakeTicketRepository) GetTicket(ctx context.Context, id int) (models.Ticket, error) {
+	if id != f.ticket.ID {
+		return models.Ticket{}, fmt.Errorf("ticket %d: %w", id, repository.ErrNotFound)
+	}
+	return f.ticket, nil
+}
+
+func (f *fakeTicketRepository) GetTicketRatings(ctx context.Context, ticketID int) ([]models.TicketRating, error) {
+	if ticketID != f.ticket.ID {
+		return nil, nil
+	}
+	return f.ratings, nil
+}
+
+// startTestServer runs s on an in-memory bufconn listener and returns a client connected to it.
+// Requests and responses go through real gRPC serialization
+func startTestServer(t *testing.T, s *AnalyticsServer, dialOpts ...grpc.DialOption) proto.AnalyticsServiceClient {
+	t.Helper()
+
+	listener := bufconn.Listen(1 << 20)
+	go func() {
+		_ = s.Serve(listener)
+	}()
+
+	dialOpts = append([]grpc.DialOption{
+		grpc.WithContextDialer(func(ctx context.Context, _ string) (net.Conn, error) {
+			return listener.DialContext(ctx)
+		}),
+		grpc.WithTransportCredentials(insecure.NewCredentials()),
+	}, dialOpts...)
+
+	conn, err := grpc.NewClient("passthrough:///bufnet", dialOpts...)
+	if err != nil {
+		t.Fatalf("grpc.NewClient() error = %v", err)
+	}
+
+	t.Cleanup(func() {
+		conn.Close()
+		ctx, cancel := context.WithTimeout(context.Background(), time.Second)
+		defer cancel()
+		if _, err := s.Shutdown(ctx); err != nil {
+			t.Errorf("Shutdown() error = %v", err)
+		}
+	})
+
+	return proto.NewAnalyticsServiceClient(conn)
+}
+
+func testContext(t *testing.T) context.Context {
+	t.Helper()
+	ctx, cancel := context.WithTimeout(context.Background(), 5*time.Second)
+	t.Cleanup(cancel)
+	return ctx
+}
+
+func TestAnalyticsServer_EndToEnd_AllRPCs(t *testing.T) {
+	day := time.Date(2025, 1, 2, 0, 0, 0, 0, time.UTC)
+	repo := &fakeRepository{
+		daily: []models.CategoryRatingOverTimePeriod{
+			{CategoryID: 1, CategoryName: "Spelling", AvgPercent: 4, CategoryWeight: 1, RatingCount: 3, Date: day},
+		},
+		ticketScores: []models.TicketCategoryScore{
+			{TicketID: 7, CategoryID: 1, CategoryName: "Spelling", CategoryWeight: 1, Score: 5, RatingCount: 1},
+		},
+		overallScores: []models.CategoryScore{
+			{CategoryID: 1, CategoryName: "Spelling", CategoryWeight: 1, Score: 4, RatingCount: 3},
+		},
+		distribution: []models.RatingDistribution{
+			{CategoryID: 1, CategoryName: "Spelling", Counts: [6]int{0, 0, 0, 1, 1, 1}},
+		},
+		periodScores: []models.PeriodCategoryScore{
+			{PeriodIndex: 0, CategoryScore: models.CategoryScore{CategoryID: 1, CategoryName: "Spelling", CategoryWeight: 1, Score: 4, RatingCount: 2}},
+			{PeriodIndex: 1, CategoryScore: models.CategoryScore{CategoryID: 1, CategoryName: "Spelling", CategoryWeight: 1, Score: 5, RatingCount: 1}},
+		},
+	}
+	tickets := &fakeTicketRepository{
+		ticket: models.Ticket{ID: 7, Subject: "Refund request", CreatedAt: day},
+		ratings: []models.TicketRating{
+			{ID: 1, Rating: 5, CategoryID: 1, CategoryName: "Spelling", ReviewerID: 1, ReviewerName: "Alice", RevieweeID: 2, RevieweeName: "Bob", CreatedAt: day},
+		},
+	}
+	client := startTestServer(t, New(repo, WithTicketRepository(tickets)))
+	ctx := testContext(t)
+
+	start := timestamppb.New(time.Date(2025, 1, 1, 0, 0, 0, 0, time.UTC))
+	end := timestamppb.New(time.Date(2025, 1, 8, 0, 0, 0, 0, time.UTC))
+
+	t.Run("GetAggregatedCategoryScores", func(t *testing.T) {
+		resp, err := client.GetAggregatedCategoryScores(ctx, &proto.AggregatedCategoryScoresRequest{StartDate: start, EndDate: end})
+		if err != nil {
+			t.Fatalf("GetAggregatedCategoryScores() error = %v", err)
+		}
+		if resp.Granularity != proto.Granularity_GRANULARITY_DAY {
+			t.Errorf("Expected GRANULARITY_DAY, got %v", resp.Granularity)
+		}
+		if len(resp.Categories) != 1 || len(resp.Categories[0].Scores) != 1 {
+			t.Fatalf("Expected 1 category with 1 point, got %v", resp.Categories)
+		}
+		if got := resp.Categories[0].Scores[0].Score; got != 80 {
+			t.Errorf("Expected score 80, got %v", got)
+		}
+
+		resp, err = client.GetAggregatedCategoryScores(ctx, &proto.AggregatedCategoryScoresRequest{
+			StartDate: start,
+			EndDate:   end,
+			Smoothing: &proto.Smoothing{RollingWindow: 7, EmaAlpha: 0.3, Cumulative: true},
+		})
+		if err != nil {
+			t.Fatalf("GetAggregatedCategoryScores() with smoothing error = %v", err)
+		}
+		if smoothed := resp.OverallSmoothed; len(smoothed.GetRollingMean()) != 1 || len(smoothed.GetEma()) != 1 || len(smoothed.GetCumulative()) != 1 {
+			t.Errorf("Expected every smoothed overall series, got %v", smoothed)
+		}
+
+		for _, smoothing := range []*proto.Smoothing{{RollingWindow: -1}, {EmaAlpha: 1.5}, {EmaAlpha: math.NaN()}} {
+			_, err := client.GetAggregatedCategoryScores(ctx, &proto.AggregatedCategoryScoresRequest{StartDate: start, EndDate: end, Smoothing: smoothing})
+			if status.Code(err) != codes.InvalidArgument {
+				t.Errorf("Expected InvalidArgument for %v, got %v", smoothing, err)
+			}
+		}
+
+		_, err = client.GetAggregatedCategoryScores(ctx, &proto.AggregatedCategoryScoresRequest{StartDate: start, EndDate: end, FillMode: proto.FillMode(99)})
+		if status.Code(err) != codes.InvalidArgument {
+			t.Errorf("Expected InvalidArgument for an unknown fill mode, got %v", err)
+		}
+	})
+
+	t.Run("GetScoresByTicket", func(t *testing.T) {
+		resp, err := client.GetScoresByTicket(ctx, &proto.ScoresByTicketRequest{StartDate: start, EndDate: end})
+		if err != nil {
+			t.Fatalf("GetScoresByTicket() error = %v", err)
+		}
+		if len(resp.Tickets) != 1 || resp.Tickets[0].TicketId != 7 {
+			t.Fatalf("Expected ticket 7, got %v", resp.Tickets)
+		}
+		if got := resp.Tickets[0].CategoryScores[0].Score; got != 100 {
+			t.Errorf("Expected score 100, got %v", got)
+		}
+		if got := resp.Tickets[0]; got.OverallScore != 100 || got.TotalRatingCount != 1 {
+			t.Errorf("Expected overall score 100 over 1 rating, got %v over %d", got.OverallScore, got.TotalRatingCount)
+		}
+	})
+
+	t.Run("GetLowestScoringTickets", func(t *testing.T) {
+		resp, err := client.GetLowestScoringTickets(ctx, &proto.LowestScoringTicketsRequest{StartDate: start, EndDate: end})
+		if err != nil {
+			t.Fatalf("GetLowestScoringTickets() error = %v", err)
+		}
+		if len(resp.Tickets) != 1 || resp.Tickets[0].TicketId != 7 || resp.Tickets[0].Score != 100 || resp.Tickets[0].RatingCount != 1 {
+			t.Fatalf("Expected ticket 7 scoring 100, got %v", resp.Tickets)
+		}
+
+		// The category filter leaves nothing to rank
+		resp, err = client.GetLowestScoringTickets(ctx, &proto.LowestScoringTicketsRequest{StartDate: start, EndDate: end, CategoryIds: []int32{2}})
+		if err != nil {
+			t.Fatalf("GetLowestScoringTickets() with category filter error = %v", err)
+		}
+		if len(resp.Tickets) != 0 {
+			t.Errorf("Expected no tickets, got %v", resp.Tickets)
+		}
+
+		invalid := map[string]*proto.LowestScoringTicketsRequest{
+			"negative limit":       {StartDate: start, EndDate: end, Limit: -1},
+			"limit over maximum":   {StartDate: start, EndDate: end, Limit: 1001},
+			"negative min_ratings": {StartDate: start, EndDate: end, MinRatings: -1},
+			"invalid category id":  {StartDate: start, EndDate: end, CategoryIds: []int32{0}},
+		}
+		for name, req := range invalid {
+			if _, err := client.GetLowestScoringTickets(ctx, req); status.Code(err) != codes.InvalidArgument {
+				t.Errorf("%s: expected InvalidArgument, got %v", name, err)
+			}
+		}
+	})
+
+	t.Run("GetTicketDetail", func(t *testing.T) {
+		resp, err := client.GetTicketDetail(ctx, &proto.TicketDetailRequest{TicketId: 7})
+		if err != nil {
+			t.Fatalf("GetTicketDetail() error = %v", err)
+		}
+		if resp.Subject != "Refund request" || len(resp.Ratings) != 1 {
+			t.Fatalf("Unexpected response %v", resp)
+		}
+		if r := resp.Ratings[0]; r.ReviewerName != "Alice" || r.RevieweeName != "Bob" || r.Rating != 5 {
+			t.Errorf("Unexpected rating %v", r)
+		}
+
+		if _, err := client.GetTicketDetail(ctx, &proto.TicketDetailRequest{TicketId: 8}); status.Code(err) != codes.NotFound {
+			t.Errorf("Expected NotFound for an unknown ticket, got %v", err)
+		}
+		if _, err := client.GetTicketDetail(ctx, &proto.TicketDetailRequest{}); status.Code(err) != codes.InvalidArgument {
+			t.Errorf("Expected InvalidArgument without ticket_id, got %v", err)
+		}
+	})
+
+	t.Run("GetOverallQualityScore", func(t *testing.T) {
+		resp, err := client.GetOverallQualityScore(ctx, &proto.OverallQualityScoreRequest{StartDate: start, EndDate: end})
+		if err != nil {
+			t.Fatalf("GetOverallQualityScore() error = %v", err)
+		}
+		if resp.OverallScore != 80 || resp.TotalRatings != 3 {
+			t.Errorf("Expected 80%% over 3 ratings, got %v%% over %d", resp.OverallScore, resp.TotalRatings)
+		}
+		if !resp.StartDate.AsTime().Equal(start.AsTime()) {
+			t.Errorf("Expected start date %v, got %v", start.AsTime(), resp.StartDate.AsTime())
+		}
+	})
+
+	t.Run("GetPeriodOverPeriodChange", func(t *testing.T) {
+		resp, err := client.GetPeriodOverPeriodChange(ctx, &proto.PeriodOverPeriodChangeRequest{
+			CurrentStart:  start,
+			CurrentEnd:    end,
+			PreviousStart: start,
+			PreviousEnd:   end,
+		})
+		if err != nil {
+			t.Fatalf("GetPeriodOverPeriodChange() error = %v", err)
+		}
+		if resp.CurrentPeriodScore != 80 || resp.PreviousPeriodScore != 80 || resp.ChangePercentage != 0 {
+			t.Errorf("Unexpected response %v", resp)
+		}
+	})
+
+	t.Run("GetRatingDistribution", func(t *testing.T) {
+		resp, err := client.GetRatingDistribution(ctx, &proto.RatingDistributionRequest{StartDate: start, EndDate: end, Percentiles: []float64{50}})
+		if err != nil {
+			t.Fatalf("GetRatingDistribution() error = %v", err)
+		}
+		if len(resp.Categories) != 1 || resp.Categories[0].Total.Percentiles[0].Value != 4 {
+			t.Errorf("Unexpected response %v", resp)
+		}
+
+		for _, p := range []float64{-1, 101, math.NaN()} {
+			_, err = client.GetRatingDistribution(ctx, &proto.RatingDistributionRequest{StartDate: start, EndDate: end, Percentiles: []float64{p}})
+			if status.Code(err) != codes.InvalidArgument {
+				t.Errorf("Expected InvalidArgument for percentile %v, got %v", p, err)
+			}
+		}
+
+		_, err = client.GetRatingDistribution(ctx, &proto.RatingDistributionRequest{StartDate: start, EndDate: end, Granularity: proto.Granularity(99)})
+		if status.Code(err) != codes.InvalidArgument {
+			t.Errorf("Expected InvalidArgument for unknown granularity, got %v", err)
+		}
+	})
+
+	t.Run("GetAnomalies", func(t *testing.T) {
+		resp, err := client.GetAnomalies(ctx, &proto.AnomaliesRequest{StartDate: start, EndDate: end, Threshold: 5})
+		if err != nil {
+			t.Fatalf("GetAnomalies() error = %v", err)
+		}
+		// A single day is too short a history to flag anything
+		if len(resp.Anomalies) != 0 || resp.Threshold != 5 {
+			t.Errorf("Unexpected response %v", resp)
+		}
+
+		invalid := map[string]*proto.AnomaliesRequest{
+			"negative threshold":   {StartDate: start, EndDate: end, Threshold: -1},
+			"NaN threshold":        {StartDate: start, EndDate: end, Threshold: math.NaN()},
+			"negative min_ratings": {StartDate: start, EndDate: end, MinRatings: -1},
+			"inverted range":       {StartDate: end, EndDate: start},
+		}
+		for name, req := range invalid {
+			if _, err := client.GetAnomalies(ctx, req); status.Code(err) != codes.InvalidArgument {
+				t.Errorf("%s: expected InvalidArgument, got %v", name, err)
+			}
+		}
+	})
+
+	t.Run("GetScoreForecast", func(t *testing.T) {
+		resp, err := client.GetScoreForecast(ctx, &proto.ScoreForecastRequest{StartDate: start, EndDate: end, HorizonDays: 7})
+		if err != nil {
+			t.Fatalf("GetScoreForecast() error = %v", err)
+		}
+		// A single day is too short a history to forecast from
+		if resp.Overall.GetHistoryDays() != 1 || len(resp.Overall.GetPoints()) != 0 || len(resp.Categories) != 1 {
+			t.Errorf("Unexpected response %v", resp)
+		}
+
+		for _, horizon := range []int32{0, 91} {
+			_, err := client.GetScoreForecast(ctx, &proto.ScoreForecastRequest{StartDate: start, EndDate: end, HorizonDays: horizon})
+			if status.Code(err) != codes.InvalidArgument {
+				t.Errorf("Expected InvalidArgument for horizon %d, got %v", horizon, err)
+			}
+		}
+
+		for name, req := range map[string]*proto.ScoreForecastRequest{
+			"start equals end": {StartDate: start, EndDate: start, HorizonDays: 7},
+			"unset range":      {HorizonDays: 7},
+		} {
+			if _, err := client.GetScoreForecast(ctx, req); status.Code(err) != codes.InvalidArgument {
+				t.Errorf("%s: expected InvalidArgument, got %v", name, err)
+			}
+		}
+	})
+
+	t.Run("GetPeriodSeries", func(t *testing.T) {
+		resp, err := client.GetPeriodSeries(ctx, &proto.PeriodSeriesRequest{Unit: proto.PeriodUnit_PERIOD_UNIT_WEEK, Count: 1, Anchor: end})
+		if err != nil {
+			t.Fatalf("GetPeriodSeries() error = %v", err)
+		}
+		if len(resp.Periods) != 1 || resp.Periods[0].OverallScore != 100 || resp.Periods[0].Change != 25 {
+			t.Errorf("Unexpected response %v", resp)
+		}
+
+		invalid := map[string]*proto.PeriodSeriesRequest{
+			"zero count":        {Unit: proto.PeriodUnit_PERIOD_UNIT_WEEK},
+			"count over limit":  {Unit: proto.PeriodUnit_PERIOD_UNIT_WEEK, Count: 367},
+			"unspecified unit":  {Count: 4},
+			"unknown time zone": {Unit: proto.PeriodUnit_PERIOD_UNIT_WEEK, Count: 4, TimeZone: "Mars/Olympus"},
+		}
+		for name, req := range invalid {
+			if _, err := client.GetPeriodSeries(ctx, req); status.Code(err) != codes.InvalidArgument {
+				t.Errorf("%s: expected InvalidArgument, got %v", name, err)
+			}
+		}
+	})
+}
+
+func TestAnalyticsServer_EndToEnd_DateRange(t *testing.T) {
+	client := startTestServer(t, New(&fakeRepository{}))
+	ctx := testContext(t)
+
+	start := timestamppb.New(time.Date(2025, 1, 1, 0, 0, 0, 0, time.UTC))
+	end := timestamppb.New(time.Date(2025, 1, 8, 0, 0, 0, 0, time.UTC))
+
+	resp, err := client.GetOverallQualityScore(ctx, &proto.OverallQualityScoreRequest{StartDate: start, EndDate: end, InclusiveEnd: true})
+	if err != nil {
+		t.Fatalf("GetOverallQualityScore() error = %v", err)
+	}
+	if !resp.Range.GetInclusiveEnd() || !resp.Range.GetEnd().AsTime().Equal(end.AsTime()) {
+		t.Errorf("Expected inclusive range echoed back, got %v", resp.Range)
+	}
+
+	if resp.Range.GetBasis() != proto.DateBasis_DATE_BASIS_RATING_CREATED {
+		t.Errorf("Expected the rating basis by default, got %v", resp.Range.GetBasis())
+	}
+
+	resp, err = client.GetOverallQualityScore(ctx, &proto.OverallQualityScoreRequest{StartDate: start, EndDate: end, DateBasis: proto.DateBasis_DATE_BASIS_TICKET_CREATED})
+	if err != nil {
+		t.Fatalf("GetOverallQualityScore() error = %v", err)
+	}
+	if resp.Range.GetBasis() != proto.DateBasis_DATE_BASIS_TICKET_CREATED {
+		t.Errorf("Expected the ticket basis echoed back, got %v", resp.Range.GetBasis())
+	}
+
+	_, err = client.GetScoresByTicket(ctx, &proto.ScoresByTicketRequest{StartDate: end, EndDate: start})
+	if status.Code(err) != codes.InvalidArgument {
+		t.Errorf("Expected InvalidArgument for inverted range, got %v", err)
+	}
+	_, err = client.GetScoresByTicket(ctx, &proto.ScoresByTicketRequest{StartDate: start, EndDate: end, DateBasis: proto.DateBasis(99)})
+	if status.Code(err) != codes.InvalidArgument {
+		t.Errorf("Expected InvalidArgument for unknown date basis, got %v", err)
+	}
+}
+
+func TestAnalyticsServer_EndToEnd_PeriodPreset(t *testing.T) {
+	client := startTestServer(t, New(&fakeRepository{}))
+	ctx := testContext(t)
+
+	anchor := timestamppb.New(time.Date(2025, 3, 12, 12, 0, 0, 0, time.UTC))
+
+	resp, err := client.GetPeriodOverPeriodChange(ctx, &proto.PeriodOverPeriodChangeRequest{
+		Preset:   proto.PeriodPreset_PERIOD_PRESET_WEEK_OVER_WEEK,
+		Anchor:   anchor,
+		TimeZone: "Europe/Tallinn",
+	})
+	if err != nil {
+		t.Fatalf("GetPeriodOverPeriodChange() error = %v", err)
+	}
+	// Monday 2025-03-10 00:00 in Tallinn (UTC+2)
+	if expected := time.Date(2025, 3, 9, 22, 0, 0, 0, time.UTC); !resp.CurrentRange.Start.AsTime().Equal(expected) {
+		t.Errorf("Expected current start %v, got %v", expected, resp.CurrentRange.Start.AsTime())
+	}
+
+	resp, err = client.GetPeriodOverPeriodChange(ctx, &proto.PeriodOverPeriodChangeRequest{
+		Preset:    proto.PeriodPreset_PERIOD_PRESET_WEEK_OVER_WEEK,
+		Anchor:    anchor,
+		DateBasis: proto.DateBasis_DATE_BASIS_TICKET_CREATED,
+	})
+	if err != nil {
+		t.Fatalf("GetPeriodOverPeriodChange() error = %v", err)
+	}
+	if resp.CurrentRange.Basis != proto.DateBasis_DATE_BASIS_TICKET_CREATED || resp.PreviousRange.Basis != proto.DateBasis_DATE_BASIS_TICKET_CREATED {
+		t.Errorf("Expected both preset periods on the ticket basis, got %v and %v", resp.CurrentRange.Basis, resp.PreviousRange.Basis)
+	}
+
+	invalid := map[string]*proto.PeriodOverPeriodChangeRequest{
+		"unknown time zone": {Preset: proto.PeriodPreset_PERIOD_PRESET_WEEK_OVER_WEEK, TimeZone: "Mars/Olympus"},
+		"preset and dates":  {Preset: proto.PeriodPreset_PERIOD_PRESET_WEEK_OVER_WEEK, CurrentStart: anchor},
+		"inclusive end":     {Preset: proto.PeriodPreset_PERIOD_PRESET_WEEK_OVER_WEEK, Anchor: anchor, InclusiveEnd: true},
+		"unknown preset":    {Preset: proto.PeriodPreset(99)},
+		"unknown basis":     {Preset: proto.PeriodPreset_PERIOD_PRESET_WEEK_OVER_WEEK, DateBasis: proto.DateBasis(99)},
+	}
+	for name, req := range invalid {
+		if _, err := client.GetPeriodOverPeriodChange(ctx, req); status.Code(err) != codes.InvalidArgument {
+			t.Errorf("%s: expected InvalidArgument, got %v", name, err)
+		}
+	}
+}
+
+func TestAnalyticsServer_EndToEnd_Interceptors(t *testing.T) {
+	client := startTestServer(t, New(&fakeRepository{},
+		WithUnaryInterceptors(authInterceptor([]string{"secret"})),
+	))
+
+	req := &proto.OverallQualityScoreRequest{
+		StartDate: timestamppb.New(time.Date(2025, 1, 1, 0, 0, 0, 0, time.UTC)),
+		EndDate:   timestamppb.New(time.Date(2025, 1, 8, 0, 0, 0, 0, time.UTC)),
+	}
+
+	_, err := client.GetOverallQualityScore(testContext(t), req)
+	if status.Code(err) != codes.Unauthenticated {
+		t.Errorf("Expected Unauthenticated without token, got %v", err)
+	}
+
+	ctx := metadata.AppendToOutgoingContext(testContext(t), "authorization", "Bearer secret")
+	if _, err := client.GetOverallQualityScore(ctx, req); err != nil {
+		t.Errorf("Expected success with token, got %v", err)
+	}
+}
+
+// blockingRepository holds GetOverallQualityScore until the request context is done, like a slow query
+type blockingRepository struct {
+	fakeRepository
+	started   chan struct{}
+	cancelled chan struct{}
+}
+
+func newBlockingRepository() *blockingRepository {
+	return &blockingRepository{started: make(chan struct{}), cancelled: make(chan struct{})}
+}
+
+func (b *blockingRepository) GetOverallQualityScore(ctx context.Context, rng models.DateRange) ([]models.CategoryScore, error) {
+	close(b.started)
+	<-ctx.Done()
+	close(b.cancelled)
+	return nil, fmt.Errorf("failed to query overall quality score: %v", ctx.Err())
+}
+
+func TestAnalyticsServer_EndToEnd_RequestTimeout(t *testing.T) {
+	repo := newBlockingRepository()
+	client := startTestServer(t, New(repo,
+		WithUnaryInterceptors(timeoutInterceptor(50*time.Millisecond)),
+	))
+
+	req := &proto.OverallQualityScoreRequest{
+		StartDate: timestamppb.New(time.Date(2025, 1, 1, 0, 0, 0, 0, time.UTC)),
+		EndDate:   timestamppb.New(time.Date(2025, 1, 8, 0, 0, 0, 0, time.UTC)),
+	}
+
+	_, err := client.GetOverallQualityScore(testContext(t), req)
+	if status.Code(err) != codes.DeadlineExceeded {
+		t.Errorf("Expected DeadlineExceeded, got %v", err)
+	}
+	select {
+	case <-repo.cancelled:
+	default:
+		t.Error("Expected the repository call to see the request deadline")
+	}
+}
+
+func TestResponseCache_AnchorlessPresetNotCached(t *testing.T) {
+	interceptor := newResponseCache(time.Minute, 100).interceptor()
+	info := &grpc.UnaryServerInfo{FullMethod: proto.AnalyticsService_GetPeriodOverPeriodChange_FullMethodName}
+
+	calls := 0
+	handler := func(ctx context.Context, req any) (any, error) {
+		calls++
+		return &proto.PeriodOverPeriodChangeResponse{}, nil
+	}
+
+	anchored := &proto.PeriodOverPeriodChangeRequest{
+		Preset: proto.PeriodPreset_PERIOD_PRESET_WEEK_OVER_WEEK,
+		Anchor: timestamppb.New(time.Date(2025, 3, 12, 0, 0, 0, 0, time.UTC)),
+	}
+	anchorless := &proto.PeriodOverPeriodChangeRequest{Preset: proto.PeriodPreset_PERIOD_PRESET_WEEK_OVER_WEEK}
+
+	for _, req := range []*proto.PeriodOverPeriodChangeRequest{anchored, anchored, anchorless, anchorless} {
+		if _, err := interceptor(context.Background(), req, info, handler); err != nil {
+			t.Fatalf("interceptor() error = %v", err)
+		}
+	}
+	// The anchored request is served from the cache the second time, the anchor-less one never
+	if calls != 3 {
+		t.Errorf("Expected 3 handler calls, got %d", calls)
+	}
+}
@@ -0,0 +1,61 @@
+package server
+
+import (
+	"context"
+	"crypto/subtle"
+	"strings"
+	"time"
+
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/metadata"
+	"google.golang.org/grpc/status"
+)
+
+// timeoutInterceptor applies a default deadline to unary RPCs whose context has none shorter.
+// Handlers pass the context down to their queries, so the deadline cancels them; the plain
+// errors that come back are reported as DeadlineExceeded or Canceled
+func timeoutInterceptor(timeout time.Duration) grpc.UnaryServerInterceptor {
+	return func(ctx context.Context, req any, info *grpc.UnaryServerInfo, handler grpc.UnaryHandler) (any, error) {
+		if deadline, ok := ctx.Deadline(); !ok || time.Until(deadline) > timeout {
+			var cancel context.CancelFunc
+			ctx, cancel = context.WithTimeout(ctx, timeout)
+			defer cancel()
+		}
+		resp, err := handler(ctx, req)
+		if err != nil && ctx.Err() != nil {
+			if _, ok := status.FromError(err); !ok {
+				return nil, status.FromContextError(ctx.Err()).Err()
+			}
+		}
+		return resp, err
+	}
+}
+
+// authInterceptor rejects unary RPCs that don't carry one of the accepted bearer tokens.
+// Health checks stay open so orchestrators can probe the server without credentials
+func authInterceptor(tokens []string) grpc.UnaryServerInterceptor {
+	return func(ctx context.Context, req any, info *grpc.UnaryServerInfo, handler grpc.UnaryHandler) (any, error) {
+		if isHealthMethod(info.FullMethod) {
+			return handler(ctx, req)
+		}
+
+		md, _ := metadata.FromIncomingContext(ctx)
+		for _, header := range md.Get("authorization") {
+			token, ok := strings.CutPrefix(header, "Bearer ")
+			if !ok {
+				continue
+			}
+			for _, accepted := range tokens {
+				if subtle.ConstantTimeCompare([]byte(token), []byte(accepted)) == 1 {
+					return handler(ctx, req)
+				}
+			}
+		}
+		return nil, status.Error(codes.Unauthenticated, "missing or invalid bearer token")
+	}
+}
+
+func isHealthMethod(fullMethod string) bool {
+	return strings.HasPrefix(fullMethod, "/grpc.health.v1.Health/")
+}
@@ -0,0 +1,108 @@
+package server
+
+import (
+	"context"
+	"errors"
+	"math"
+
+	"go-grpc-backend/internal/models"
+	"go-grpc-backend/internal/service"
+	"go-grpc-backend/proto"
+
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+func (s *AnalyticsServer) ListQualityTargets(ctx context.Context, req *proto.ListQualityTargetsRequest) (*proto.ListQualityTargetsResponse, error) {
+	if s.targetRepo == nil {
+		return nil, errQualityTargetsUnavailable
+	}
+	return service.ListQualityTargets(ctx, s.targetRepo)
+}
+
+func (s *AnalyticsServer) CreateQualityTarget(ctx context.Context, req *proto.CreateQualityTargetRequest) (*proto.QualityTarget, error) {
+	if s.targetRepo == nil {
+		return nil, errQualityTargetsUnavailable
+	}
+	target, err := requestQualityTarget(req.Target)
+	if err != nil {
+		return nil, err
+	}
+
+	created, err := service.CreateQualityTarget(ctx, s.targetRepo, target)
+	return created, qualityTargetError(err)
+}
+
+func (s *AnalyticsServer) UpdateQualityTarget(ctx context.Context, req *proto.UpdateQualityTargetRequest) (*proto.QualityTarget, error) {
+	if s.targetRepo == nil {
+		return nil, errQualityTargetsUnavailable
+	}
+	target, err := requestQualityTarget(req.Target)
+	if err != nil {
+		return nil, err
+	}
+	if target.ID <= 0 {
+		return nil, status.Error(codes.InvalidArgument, "target.id is required")
+	}
+
+	updated, err := service.UpdateQualityTarget(ctx, s.targetRepo, target)
+	return updated, qualityTargetError(err)
+}
+
+func (s *AnalyticsServer) DeleteQualityTarget(ctx context.Context, req *proto.DeleteQualityTargetRequest) (*proto.DeleteQualityTargetResponse, error) {
+	if s.targetRepo == nil {
+		return nil, errQualityTargetsUnavailable
+	}
+	if req.Id <= 0 {
+		return nil, status.Error(codes.InvalidArgument, "id is required")
+	}
+
+	resp, err := service.DeleteQualityTarget(ctx, s.targetRepo, int(req.Id))
+	return resp, qualityTargetError(err)
+}
+
+func (s *AnalyticsServer) GetTargetStatus(ctx context.Context, req *proto.TargetStatusRequest) (*proto.TargetStatusResponse, error) {
+	if s.targetRepo == nil {
+		return nil, errQualityTargetsUnavailable
+	}
+	burnWindow := req.BurnWindow.AsDuration()
+	if req.BurnWindow != nil && burnWindow <= 0 {
+		return nil, status.Error(codes.InvalidArgument, "burn_window must be positive")
+	}
+
+	return service.GetTargetStatus(ctx, s.targetRepo, s.analyticsRepo, requestAnchor(req.AsOf), burnWindow)
+}
+
+var errQualityTargetsUnavailable = status.Error(codes.Unimplemented, "quality targets are not configured on this server")
+
+// qualityTargetError maps targets a category can't hold to InvalidArgument and missing targets to NotFound
+func qualityTargetError(err error) error {
+	if errors.Is(err, service.ErrInvalidQualityTarget) {
+		return status.Error(codes.InvalidArgument, err.Error())
+	}
+	return repositoryError(err)
+}
+
+// requestQualityTarget validates the target a create or update request carries.
+// Whether the score is reachable depends on the category and is checked by the service
+func requestQualityTarget(t *proto.QualityTarget) (models.QualityTarget, error) {
+	if t == nil {
+		return models.QualityTarget{}, status.Error(codes.InvalidArgument, "target is required")
+	}
+	if t.CategoryId <= 0 {
+		return models.QualityTarget{}, status.Error(codes.InvalidArgument, "target.category_id is required")
+	}
+	if math.IsNaN(t.TargetScore) || t.TargetScore <= 0 {
+		return models.QualityTarget{}, status.Error(codes.InvalidArgument, "target.target_score must be positive")
+	}
+	if t.Window == nil || t.Window.AsDuration() <= 0 {
+		return models.QualityTarget{}, status.Error(codes.InvalidArgument, "target.window must be positive")
+	}
+
+	return models.QualityTarget{
+		ID:          int(t.Id),
+		CategoryID:  int(t.CategoryId),
+		TargetScore: t.TargetScore,
+		Window:      t.Window.AsDuration(),
+	}, nil
+}
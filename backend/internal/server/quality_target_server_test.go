@@ -0,0 +1,190 @@
+package server
+
+import (
+	"context"
+	"fmt"
+	"testing"
+	"time"
+
+	"go-grpc-backend/internal/models"
+	"go-grpc-backend/internal/repository"
+	"go-grpc-backend/proto"
+
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/types/known/durationpb"
+	"google.golang.org/protobuf/types/known/timestamppb"
+)
+
+// fakeQualityTargetRepository keeps targets in memory over a fixed set of categories
+type fakeQualityTargetRepository struct {
+	categories map[int]models.RatingCategory
+	targets    map[int]models.QualityTarget
+	nextID     int
+}
+
+func newFakeQualityTargetRepository() *fakeQualityTargetRepository {
+	return &fakeQualityTargetRepository{
+		categories: map[int]models.RatingCategory{
+			1: {ID: 1, Name: "Spelling", Weight: 1},
+			2: {ID: 2, Name: "Grammar", Weight: 0.5},
+		},
+		targets: make(map[int]models.QualityTarget),
+	}
+}
+
+func (f *fakeQualityTargetRepository) ListQualityTargets(ctx context.Context) ([]models.QualityTarget, error) {
+	var targets []models.QualityTarget
+	for id := 1; id <= f.nextID; id++ {
+		if t, ok := f.targets[id]; ok {
+			targets = append(targets, t)
+		}
+	}
+	return targets, nil
+}
+
+func (f *fakeQualityTargetRepository) GetQualityTarget(ctx context.Context, id int) (models.QualityTarget, error) {
+	t, ok := f.targets[id]
+	if !ok {
+		return t, fmt.Errorf("quality target %d: %w", id, repository.ErrNotFound)
+	}
+	return t, nil
+}
+
+func (f *fakeQualityTargetRepository) CreateQualityTarget(ctx context.Context, target models.QualityTarget) (models.QualityTarget, error) {
+	f.nextID++
+	target.ID = f.nextID
+	target.CreatedAt = time.Date(2025, 3, 1, 0, 0, 0, 0, time.UTC)
+	return f.store(target), nil
+}
+
+func (f *fakeQualityTargetRepository) UpdateQualityTarget(ctx context.Context, target models.QualityTarget) (models.QualityTarget, error) {
+	existing, err := f.GetQualityTarget(ctx, target.ID)
+	if err != nil {
+		return target, err
+	}
+	target.CreatedAt = existing.CreatedAt
+	return f.store(target), nil
+}
+
+func (f *fakeQualityTargetRepository) store(target models.QualityTarget) models.QualityTarget {
+	category := f.categories[target.CategoryID]
+	target.CategoryName, target.CategoryWeight = category.Name, category.Weight
+	f.targets[target.ID] = target
+	return target
+}
+
+func (f *fakeQualityTargetRepository) DeleteQualityTarget(ctx context.Context, id int) error {
+	if _, err := f.GetQualityTarget(ctx, id); err != nil {
+		return err
+	}
+	delete(f.targets, id)
+	return nil
+}
+
+func (f *fakeQualityTargetRepository) GetRatingCategory(ctx context.Context, id int) (models.RatingCategory, error) {
+	category, ok := f.categories[id]
+	if !ok {
+		return category, fmt.Errorf("rating category %d: %w", id, repository.ErrNotFound)
+	}
+	return category, nil
+}
+
+func TestAnalyticsServer_EndToEnd_QualityTargets(t *testing.T) {
+	repo := &fakeRepository{overallScores: []models.CategoryScore{
+		// 4.5 * 1 * 20 = 90
+		{CategoryID: 1, CategoryName: "Spelling", CategoryWeight: 1, Score: 4.5, RatingCount: 12},
+	}}
+	client := startTestServer(t, New(repo,
+		WithQualityTargetRepository(newFakeQualityTargetRepository()),
+		WithUnaryInterceptors(newResponseCache(time.Minute, 100).interceptor()),
+	))
+	ctx := testContext(t)
+
+	created, err := client.CreateQualityTarget(ctx, &proto.CreateQualityTargetRequest{Target: &proto.QualityTarget{
+		CategoryId:  1,
+		TargetScore: 80,
+		Window:      durationpb.New(7 * 24 * time.Hour),
+	}})
+	if err != nil {
+		t.Fatalf("CreateQualityTarget() error = %v", err)
+	}
+	if created.Id != 1 || created.CategoryName != "Spelling" || created.CreatedAt == nil {
+		t.Errorf("Unexpected created target %+v", created)
+	}
+
+	asOf := timestamppb.New(time.Date(2025, 3, 1, 12, 0, 0, 0, time.UTC))
+	resp, err := client.GetTargetStatus(ctx, &proto.TargetStatusRequest{AsOf: asOf})
+	if err != nil {
+		t.Fatalf("GetTargetStatus() error = %v", err)
+	}
+	if len(resp.Statuses) != 1 {
+		t.Fatalf("Expected 1 status, got %d", len(resp.Statuses))
+	}
+	if s := resp.Statuses[0]; !s.Met || s.Score != 90 || s.RemainingErrorBudget != 0.5 || s.BurnRate != 0.5 {
+		t.Errorf("Unexpected status %+v", s)
+	}
+
+	// A stricter target shows up in the next status, not a cached one
+	if _, err := client.UpdateQualityTarget(ctx, &proto.UpdateQualityTargetRequest{Target: &proto.QualityTarget{
+		Id:          created.Id,
+		CategoryId:  1,
+		TargetScore: 95,
+		Window:      durationpb.New(7 * 24 * time.Hour),
+	}}); err != nil {
+		t.Fatalf("UpdateQualityTarget() error = %v", err)
+	}
+	resp, err = client.GetTargetStatus(ctx, &proto.TargetStatusRequest{AsOf: asOf})
+	if err != nil {
+		t.Fatalf("GetTargetStatus() error = %v", err)
+	}
+	if s := resp.Statuses[0]; s.Met || s.RemainingErrorBudget != -1 {
+		t.Errorf("Expected the updated target to be missed, got %+v", s)
+	}
+
+	list, err := client.ListQualityTargets(ctx, &proto.ListQualityTargetsRequest{})
+	if err != nil {
+		t.Fatalf("ListQualityTargets() error = %v", err)
+	}
+	if len(list.Targets) != 1 || list.Targets[0].TargetScore != 95 {
+		t.Errorf("Expected the updated target, got %+v", list.Targets)
+	}
+
+	if _, err := client.DeleteQualityTarget(ctx, &proto.DeleteQualityTargetRequest{Id: created.Id}); err != nil {
+		t.Fatalf("DeleteQualityTarget() error = %v", err)
+	}
+	if _, err := client.DeleteQualityTarget(ctx, &proto.DeleteQualityTargetRequest{Id: created.Id}); status.Code(err) != codes.NotFound {
+		t.Errorf("Expected NotFound deleting a deleted target, got %v", err)
+	}
+}
+
+func TestAnalyticsServer_EndToEnd_QualityTargetValidation(t *testing.T) {
+	client := startTestServer(t, New(&fakeRepository{}, WithQualityTargetRepository(newFakeQualityTargetRepository())))
+	ctx := testContext(t)
+
+	week := durationpb.New(7 * 24 * time.Hour)
+	invalid := map[string]*proto.QualityTarget{
+		"missing category":     {TargetScore: 80, Window: week},
+		"unknown category":     {CategoryId: 9, TargetScore: 80, Window: week},
+		"zero score":           {CategoryId: 1, Window: week},
+		"unreachable score":    {CategoryId: 2, TargetScore: 50, Window: week},
+		"missing window":       {CategoryId: 1, TargetScore: 80},
+		"non-positive window":  {CategoryId: 1, TargetScore: 80, Window: durationpb.New(0)},
+		"missing target entry": nil,
+	}
+	for name, target := range invalid {
+		if _, err := client.CreateQualityTarget(ctx, &proto.CreateQualityTargetRequest{Target: target}); status.Code(err) != codes.InvalidArgument {
+			t.Errorf("%s: expected InvalidArgument, got %v", name, err)
+		}
+	}
+
+	if _, err := client.UpdateQualityTarget(ctx, &proto.UpdateQualityTargetRequest{Target: &proto.QualityTarget{CategoryId: 1, TargetScore: 80, Window: week}}); status.Code(err) != codes.InvalidArgument {
+		t.Errorf("Update without id: expected InvalidArgument, got %v", err)
+	}
+	if _, err := client.UpdateQualityTarget(ctx, &proto.UpdateQualityTargetRequest{Target: &proto.QualityTarget{Id: 42, CategoryId: 1, TargetScore: 80, Window: week}}); status.Code(err) != codes.NotFound {
+		t.Errorf("Update of unknown target: expected NotFound, got %v", err)
+	}
+	if _, err := client.GetTargetStatus(ctx, &proto.TargetStatusRequest{BurnWindow: durationpb.New(-time.Hour)}); status.Code(err) != codes.InvalidArgument {
+		t.Errorf("Negative burn window: expected InvalidArgument, got %v", err)
+	}
+}
@@ -0,0 +1,100 @@
+package server
+
+import (
+	"context"
+	"errors"
+	"math"
+	"time"
+
+	"go-grpc-backend/internal/models"
+	"go-grpc-backend/internal/service"
+	"go-grpc-backend/proto"
+
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+func (s *AnalyticsServer) ListRatingCategories(ctx context.Context, req *proto.ListRatingCategoriesRequest) (*proto.ListRatingCategoriesResponse, error) {
+	if s.categoryRepo == nil {
+		return nil, errRatingCategoriesUnavailable
+	}
+	return service.ListRatingCategories(ctx, s.categoryRepo, req.IncludeArchived)
+}
+
+func (s *AnalyticsServer) CreateRatingCategory(ctx context.Context, req *proto.CreateRatingCategoryRequest) (*proto.RatingCategory, error) {
+	if s.categoryRepo == nil {
+		return nil, errRatingCategoriesUnavailable
+	}
+	category, err := requestRatingCategory(req.Category)
+	if err != nil {
+		return nil, err
+	}
+
+	created, err := service.CreateRatingCategory(ctx, s.categoryRepo, category)
+	return created, ratingCategoryError(err)
+}
+
+func (s *AnalyticsServer) UpdateRatingCategory(ctx context.Context, req *proto.UpdateRatingCategoryRequest) (*proto.RatingCategory, error) {
+	if s.categoryRepo == nil {
+		return nil, errRatingCategoriesUnavailable
+	}
+	category, err := requestRatingCategory(req.Category)
+	if err != nil {
+		return nil, err
+	}
+	if category.ID <= 0 {
+		return nil, status.Error(codes.InvalidArgument, "category.id is required")
+	}
+	now := time.Now()
+	effectiveFrom := now
+	if req.WeightEffectiveFrom != nil {
+		effectiveFrom = req.WeightEffectiveFrom.AsTime()
+		if effectiveFrom.After(now) {
+			return nil, status.Error(codes.InvalidArgument, "weight_effective_from can't be in the future")
+		}
+	}
+
+	updated, err := service.UpdateRatingCategory(ctx, s.categoryRepo, category, effectiveFrom)
+	return updated, ratingCategoryError(err)
+}
+
+func (s *AnalyticsServer) ArchiveRatingCategory(ctx context.Context, req *proto.ArchiveRatingCategoryRequest) (*proto.RatingCategory, error) {
+	if s.categoryRepo == nil {
+		return nil, errRatingCategoriesUnavailable
+	}
+	if req.Id <= 0 {
+		return nil, status.Error(codes.InvalidArgument, "id is required")
+	}
+
+	archived, err := service.ArchiveRatingCategory(ctx, s.categoryRepo, int(req.Id))
+	return archived, repositoryError(err)
+}
+
+var errRatingCategoriesUnavailable = status.Error(codes.Unimplemented, "rating category management is not configured on this server")
+
+// ratingCategoryError maps changes the service rejects to InvalidArgument and missing categories to NotFound
+func ratingCategoryError(err error) error {
+	if errors.Is(err, service.ErrInvalidRatingCategory) {
+		return status.Error(codes.InvalidArgument, err.Error())
+	}
+	return repositoryError(err)
+}
+
+// requestRatingCategory validates the category a create or update request carries
+func requestRatingCategory(c *proto.RatingCategory) (models.RatingCategory, error) {
+	if c == nil {
+		return models.RatingCategory{}, status.Error(codes.InvalidArgument, "category is required")
+	}
+	if c.Name == "" {
+		return models.RatingCategory{}, status.Error(codes.InvalidArgument, "category.name is required")
+	}
+	if math.IsNaN(c.Weight) || math.IsInf(c.Weight, 0) || c.Weight <= 0 {
+		return models.RatingCategory{}, status.Error(codes.InvalidArgument, "category.weight must be positive and finite")
+	}
+
+	return models.RatingCategory{
+		ID:     int(c.Id),
+		Name:   c.Name,
+		Weight: c.Weight,
+	}, nil
+}
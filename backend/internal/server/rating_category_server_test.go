@@ -0,0 +1,213 @@
+package server
+
+import (
+	"context"
+	"fmt"
+	"math"
+	"sort"
+	"testing"
+	"time"
+
+	"go-grpc-backend/internal/models"
+	"go-grpc-backend/internal/repository"
+	"go-grpc-backend/proto"
+
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/types/known/timestamppb"
+)
+
+// fakeRatingCategoryRepository keeps categories in memory and records every weight change,
+// without RatingCategoryRepository's starting weight entry
+type fakeRatingCategoryRepository struct {
+	categories map[int]models.RatingCategory
+	weights    []models.RatingCategoryWeight
+}
+
+func newFakeRatingCategoryRepository() *fakeRatingCategoryRepository {
+	return &fakeRatingCategoryRepository{categories: make(map[int]models.RatingCategory)}
+}
+
+func (f *fakeRatingCategoryRepository) ListRatingCategories(ctx context.Context, includeArchived bool) ([]models.RatingCategory, error) {
+	var categories []models.RatingCategory
+	for _, c := range f.categories {
+		if includeArchived || !c.Archived() {
+			categories = append(categories, c)
+		}
+	}
+	sort.Slice(categories, func(i, j int) bool { return categories[i].Name < categories[j].Name })
+	return categories, nil
+}
+
+func (f *fakeRatingCategoryRepository) GetRatingCategory(ctx context.Context, id int) (models.RatingCategory, error) {
+	category, ok := f.categories[id]
+	if !ok {
+		return category, fmt.Errorf("rating category %d: %w", id, repository.ErrNotFound)
+	}
+	return category, nil
+}
+
+func (f *fakeRatingCategoryRepository) CreateRatingCategory(ctx context.Context, category models.RatingCategory) (models.RatingCategory, error) {
+	category.ID = len(f.categories) + 1
+	f.categories[category.ID] = category
+	return category, nil
+}
+
+func (f *fakeRatingCategoryRepository) UpdateRatingCategory(ctx context.Context, category models.RatingCategory, effectiveFrom time.Time) (models.RatingCategory, error) {
+	existing, err := f.GetRatingCategory(ctx, category.ID)
+	if err != nil {
+		return category, err
+	}
+	if category.Weight != existing.Weight {
+		f.weights = append(f.weights, models.RatingCategoryWeight{ID: len(f.weights) + 1, CategoryID: category.ID, Weight: category.Weight, EffectiveFrom: effectiveFrom})
+	}
+	f.categories[category.ID] = category
+	return category, nil
+}
+
+func (f *fakeRatingCategoryRepository) ArchiveRatingCategory(ctx context.Context, id int) (models.RatingCategory, error) {
+	category, err := f.GetRatingCategory(ctx, id)
+	if err != nil {
+		return category, err
+	}
+	category.ArchivedAt = time.Date(2025, 3, 1, 0, 0, 0, 0, time.UTC)
+	f.categories[id] = category
+	return category, nil
+}
+
+func (f *fakeRatingCategoryRepository) ListRatingCategoryWeights(ctx context.Context, categoryID int) ([]models.RatingCategoryWeight, error) {
+	var weights []models.RatingCategoryWeight
+	for _, w := range f.weights {
+		if categoryID == 0 || w.CategoryID == categoryID {
+			weights = append(weights, w)
+		}
+	}
+	return weights, nil
+}
+
+func TestAnalyticsServer_EndToEnd_RatingCategories(t *testing.T) {
+	categories := newFakeRatingCategoryRepository()
+	// The cache must not serve a stale category list after a change
+	client := startTestServer(t, New(&fakeRepository{},
+		WithRatingCategoryRepository(categories),
+		WithUnaryInterceptors(newResponseCache(time.Minute, 100).interceptor()),
+	))
+	ctx := testContext(t)
+
+	created, err := client.CreateRatingCategory(ctx, &proto.CreateRatingCategoryRequest{Category: &proto.RatingCategory{Name: "Empathy", Weight: 1.5}})
+	if err != nil {
+		t.Fatalf("CreateRatingCategory() error = %v", err)
+	}
+	if created.Id != 1 || created.Weight != 1.5 || created.ArchivedAt != nil {
+		t.Errorf("Unexpected created category %+v", created)
+	}
+	if _, err := client.CreateRatingCategory(ctx, &proto.CreateRatingCategoryRequest{Category: &proto.RatingCategory{Name: "Empathy", Weight: 1}}); status.Code(err) != codes.InvalidArgument {
+		t.Errorf("Expected InvalidArgument for a duplicate name, got %v", err)
+	}
+
+	list, err := client.ListRatingCategories(ctx, &proto.ListRatingCategoriesRequest{})
+	if err != nil {
+		t.Fatalf("ListRatingCategories() error = %v", err)
+	}
+	if len(list.Categories) != 1 {
+		t.Fatalf("Expected the created category, got %+v", list.Categories)
+	}
+
+	effectiveFrom := timestamppb.New(time.Date(2025, 1, 6, 0, 0, 0, 0, time.UTC))
+	updated, err := client.UpdateRatingCategory(ctx, &proto.UpdateRatingCategoryRequest{
+		Category:            &proto.RatingCategory{Id: created.Id, Name: "Empathy", Weight: 2},
+		WeightEffectiveFrom: effectiveFrom,
+	})
+	if err != nil {
+		t.Fatalf("UpdateRatingCategory() error = %v", err)
+	}
+	if updated.Weight != 2 || len(updated.WeightHistory) != 1 || !updated.WeightHistory[0].EffectiveFrom.AsTime().Equal(effectiveFrom.AsTime()) {
+		t.Errorf("Expected the weight change in the history, got %+v", updated)
+	}
+
+	// Without weight_effective_from the change applies from now
+	before := time.Now()
+	updated, err = client.UpdateRatingCategory(ctx, &proto.UpdateRatingCategoryRequest{Category: &proto.RatingCategory{Id: created.Id, Name: "Empathy", Weight: 3}})
+	if err != nil {
+		t.Fatalf("UpdateRatingCategory() without effective date error = %v", err)
+	}
+	if len(updated.WeightHistory) != 2 || updated.WeightHistory[1].EffectiveFrom.AsTime().Before(before) {
+		t.Errorf("Expected a weight change effective now, got %+v", updated.WeightHistory)
+	}
+
+	archived, err := client.ArchiveRatingCategory(ctx, &proto.ArchiveRatingCategoryRequest{Id: created.Id})
+	if err != nil {
+		t.Fatalf("ArchiveRatingCategory() error = %v", err)
+	}
+	if archived.ArchivedAt == nil || len(archived.WeightHistory) != 2 {
+		t.Errorf("Unexpected archived category %+v", archived)
+	}
+	if _, err := client.UpdateRatingCategory(ctx, &proto.UpdateRatingCategoryRequest{Category: &proto.RatingCategory{Id: created.Id, Name: "Empathy", Weight: 1}}); status.Code(err) != codes.InvalidArgument {
+		t.Errorf("Expected InvalidArgument updating an archived category, got %v", err)
+	}
+
+	list, err = client.ListRatingCategories(ctx, &proto.ListRatingCategoriesRequest{})
+	if err != nil {
+		t.Fatalf("ListRatingCategories() error = %v", err)
+	}
+	if len(list.Categories) != 0 {
+		t.Errorf("Expected archived categories to be left out, got %+v", list.Categories)
+	}
+	list, err = client.ListRatingCategories(ctx, &proto.ListRatingCategoriesRequest{IncludeArchived: true})
+	if err != nil {
+		t.Fatalf("ListRatingCategories() with archived error = %v", err)
+	}
+	if len(list.Categories) != 1 || list.Categories[0].ArchivedAt == nil {
+		t.Errorf("Expected the archived category, got %+v", list.Categories)
+	}
+
+	if _, err := client.ArchiveRatingCategory(ctx, &proto.ArchiveRatingCategoryRequest{Id: 42}); status.Code(err) != codes.NotFound {
+		t.Errorf("Expected NotFound archiving an unknown category, got %v", err)
+	}
+	if _, err := client.UpdateRatingCategory(ctx, &proto.UpdateRatingCategoryRequest{Category: &proto.RatingCategory{Id: 42, Name: "Ghost", Weight: 1}}); status.Code(err) != codes.NotFound {
+		t.Errorf("Expected NotFound updating an unknown category, got %v", err)
+	}
+}
+
+func TestAnalyticsServer_EndToEnd_RatingCategoryValidation(t *testing.T) {
+	categories := newFakeRatingCategoryRepository()
+	categories.categories[1] = models.RatingCategory{ID: 1, Name: "Spelling", Weight: 1}
+	client := startTestServer(t, New(&fakeRepository{}, WithRatingCategoryRepository(categories)))
+	ctx := testContext(t)
+
+	invalid := map[string]*proto.RatingCategory{
+		"missing category": nil,
+		"missing name":     {Weight: 1},
+		"zero weight":      {Name: "Tone"},
+		"negative weight":  {Name: "Tone", Weight: -1},
+		"NaN weight":       {Name: "Tone", Weight: math.NaN()},
+		"infinite weight":  {Name: "Tone", Weight: math.Inf(1)},
+	}
+	for name, category := range invalid {
+		if _, err := client.CreateRatingCategory(ctx, &proto.CreateRatingCategoryRequest{Category: category}); status.Code(err) != codes.InvalidArgument {
+			t.Errorf("%s: expected InvalidArgument, got %v", name, err)
+		}
+	}
+
+	if _, err := client.UpdateRatingCategory(ctx, &proto.UpdateRatingCategoryRequest{Category: &proto.RatingCategory{Name: "Spelling", Weight: 1}}); status.Code(err) != codes.InvalidArgument {
+		t.Errorf("Update without id: expected InvalidArgument, got %v", err)
+	}
+	future := timestamppb.New(time.Now().Add(time.Hour))
+	if _, err := client.UpdateRatingCategory(ctx, &proto.UpdateRatingCategoryRequest{
+		Category:            &proto.RatingCategory{Id: 1, Name: "Spelling", Weight: 2},
+		WeightEffectiveFrom: future,
+	}); status.Code(err) != codes.InvalidArgument {
+		t.Errorf("Future weight_effective_from: expected InvalidArgument, got %v", err)
+	}
+	if _, err := client.ArchiveRatingCategory(ctx, &proto.ArchiveRatingCategoryRequest{}); status.Code(err) != codes.InvalidArgument {
+		t.Errorf("Archive without id: expected InvalidArgument, got %v", err)
+	}
+}
+
+func TestAnalyticsServer_EndToEnd_RatingCategoriesUnconfigured(t *testing.T) {
+	client := startTestServer(t, New(&fakeRepository{}))
+
+	if _, err := client.ListRatingCategories(testContext(t), &proto.ListRatingCategoriesRequest{}); status.Code(err) != codes.Unimplemented {
+		t.Errorf("Expected Unimplemented without a rating category repository, got %v", err)
+	}
+}
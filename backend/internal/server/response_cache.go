@@ -0,0 +1,144 @@
+package server
+
+import (
+	"context"
+	"sync"
+	"time"
+
+	"go-grpc-backend/proto"
+
+	"google.golang.org/grpc"
+	protobuf "google.golang.org/protobuf/proto"
+)
+
+type cacheEntry struct {
+	resp      protobuf.Message
+	expiresAt time.Time
+}
+
+// uncachedMethods read or change state that must be visible immediately, unlike the
+// analytics queries whose results may lag by one TTL
+var uncachedMethods = map[string]bool{
+	proto.AnalyticsService_ListAlertRules_FullMethodName:  true,
+	proto.AnalyticsService_CreateAlertRule_FullMethodName: true,
+	proto.AnalyticsService_UpdateAlertRule_FullMethodName: true,
+	proto.AnalyticsService_DeleteAlertRule_FullMethodName: true,
+	// Target status reflects target changes immediately, like the target list
+	proto.AnalyticsService_GetTargetStatus_FullMethodName:       true,
+	proto.AnalyticsService_ListQualityTargets_FullMethodName:    true,
+	proto.AnalyticsService_CreateQualityTarget_FullMethodName:   true,
+	proto.AnalyticsService_UpdateQualityTarget_FullMethodName:   true,
+	proto.AnalyticsService_DeleteQualityTarget_FullMethodName:   true,
+	proto.AnalyticsService_ListTicketAttributes_FullMethodName:  true,
+	proto.AnalyticsService_SetTicketAttributes_FullMethodName:   true,
+	proto.AnalyticsService_DeleteTicketAttribute_FullMethodName: true,
+	proto.AnalyticsService_ListTeams_FullMethodName:             true,
+	proto.AnalyticsService_CreateTeam_FullMethodName:            true,
+	proto.AnalyticsService_UpdateTeam_FullMethodName:            true,
+	proto.AnalyticsService_DeleteTeam_FullMethodName:            true,
+	proto.AnalyticsService_ListTeamMemberships_FullMethodName:   true,
+	proto.AnalyticsService_CreateTeamMembership_FullMethodName:  true,
+	proto.AnalyticsService_UpdateTeamMembership_FullMethodName:  true,
+	proto.AnalyticsService_DeleteTeamMembership_FullMethodName:  true,
+	proto.AnalyticsService_ListRatingCategories_FullMethodName:  true,
+	proto.AnalyticsService_CreateRatingCategory_FullMethodName:  true,
+	proto.AnalyticsService_UpdateRatingCategory_FullMethodName:  true,
+	proto.AnalyticsService_ArchiveRatingCategory_FullMethodName: true,
+}
+
+// resolvesAgainstNow reports whether req picks its periods relative to the current time,
+// so that an identical request covers a different range once the day changes
+func resolvesAgainstNow(req any) bool {
+	switch r := req.(type) {
+	case *proto.PeriodOverPeriodChangeRequest:
+		return r.Preset != proto.PeriodPreset_PERIOD_PRESET_UNSPECIFIED && r.Anchor == nil
+	case *proto.PeriodSeriesRequest:
+		return r.Anchor == nil
+	}
+	return false
+}
+
+// responseCache memoizes unary responses by method and serialized request for a fixed TTL
+type responseCache struct {
+	mu         sync.Mutex
+	ttl        time.Duration
+	maxEntries int
+	entries    map[string]cacheEntry
+	now        func() time.Time
+}
+
+func newResponseCache(ttl time.Duration, maxEntries int) *responseCache {
+	return &responseCache{
+		ttl:        ttl,
+		maxEntries: maxEntries,
+		entries:    make(map[string]cacheEntry),
+		now:        time.Now,
+	}
+}
+
+func (c *responseCache) get(key string) (protobuf.Message, bool) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	entry, ok := c.entries[key]
+	if !ok {
+		return nil, false
+	}
+	if c.now().After(entry.expiresAt) {
+		delete(c.entries, key)
+		return nil, false
+	}
+	return protobuf.Clone(entry.resp), true
+}
+
+func (c *responseCache) put(key string, resp protobuf.Message) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	now := c.now()
+	if len(c.entries) >= c.maxEntries {
+		for k, entry := range c.entries {
+			if now.After(entry.expiresAt) {
+				delete(c.entries, k)
+			}
+		}
+	}
+	// Still full: drop an arbitrary entry rather than growing past the limit
+	for k := range c.entries {
+		if len(c.entries) < c.maxEntries {
+			break
+		}
+		delete(c.entries, k)
+	}
+
+	c.entries[key] = cacheEntry{resp: protobuf.Clone(resp), expiresAt: now.Add(c.ttl)}
+}
+
+// interceptor serves repeated identical requests from the cache.
+// Errors, health checks, uncachedMethods and requests without an anchor are never cached
+func (c *responseCache) interceptor() grpc.UnaryServerInterceptor {
+	return func(ctx context.Context, req any, info *grpc.UnaryServerInfo, handler grpc.UnaryHandler) (any, error) {
+		msg, ok := req.(protobuf.Message)
+		if !ok || isHealthMethod(info.FullMethod) || uncachedMethods[info.FullMethod] || resolvesAgainstNow(req) {
+			return handler(ctx, req)
+		}
+		body, err := protobuf.MarshalOptions{Deterministic: true}.Marshal(msg)
+		if err != nil {
+			return handler(ctx, req)
+		}
+		key := info.FullMethod + "\x00" + string(body)
+
+		if cached, ok := c.get(key); ok {
+			return cached, nil
+		}
+
+		resp, err := handler(ctx, req)
+		if err != nil {
+			return nil, err
+		}
+		if out, ok := resp.(protobuf.Message); ok {
+			c.put(key, out)
+		}
+		return resp, nil
+	}
+}
@@ -0,0 +1,63 @@
+package server
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"log"
+)
+
+// ShutdownHook releases a resource during Shutdown, e.g. flushing metric or trace exporters.
+// Hooks run after RPCs have drained and before the database is closed
+type ShutdownHook func(ctx context.Context) error
+
+// OnShutdown registers a hook to run during Shutdown; hooks run in registration order
+func (s *AnalyticsServer) OnShutdown(hook ShutdownHook) {
+	s.shutdownHooks = append(s.shutdownHooks, hook)
+}
+
+// Shutdown stops the server in order:
+//  1. health flips to NOT_SERVING so load balancers stop routing new calls
+//  2. the listener closes and in-flight RPCs drain until ctx is done
+//  3. remaining RPCs are cancelled if the drain deadline passes
+//  4. shutdown hooks run and the database is closed
+//
+// Cancelling an RPC cancels its context, which handlers pass down to their database queries,
+// so the cancelled RPCs return promptly. They are still waited for so the database is never
+// closed under a running query. forced reports whether the drain deadline was hit
+func (s *AnalyticsServer) Shutdown(ctx context.Context) (forced bool, err error) {
+	log.Println("Stopping Analytics gRPC server...")
+	s.health.Shutdown()
+
+	drained := make(chan struct{})
+	go func() {
+		s.grpcServer.GracefulStop()
+		close(drained)
+	}()
+
+	select {
+	case <-drained:
+	case <-ctx.Done():
+		log.Println("Drain deadline exceeded, cancelling in-flight RPCs")
+		forced = true
+		s.grpcServer.Stop()
+		// Stop cancels the RPCs' contexts; wait for their handlers to unwind
+		<-drained
+	}
+
+	// Hooks get a fresh deadline-free context: the drain context may already be expired
+	var errs []error
+	for _, hook := range s.shutdownHooks {
+		if err := hook(context.WithoutCancel(ctx)); err != nil {
+			errs = append(errs, err)
+		}
+	}
+
+	if s.db != nil {
+		if err := s.db.Close(); err != nil {
+			errs = append(errs, fmt.Errorf("close database: %w", err))
+		}
+	}
+
+	return forced, errors.Join(errs...)
+}
@@ -0,0 +1,106 @@
+package server
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+
+	"go-grpc-backend/internal/config"
+	"go-grpc-backend/proto"
+
+	healthpb "google.golang.org/grpc/health/grpc_health_v1"
+	"google.golang.org/protobuf/types/known/timestamppb"
+)
+
+func newTestServer(t *testing.T) *AnalyticsServer {
+	t.Helper()
+	cfg := config.Default()
+	cfg.Database.DSN = ":memory:"
+
+	s, err := NewAnalyticsServer(cfg)
+	if err != nil {
+		t.Fatalf("NewAnalyticsServer() error = %v", err)
+	}
+	return s
+}
+
+func TestAnalyticsServer_Shutdown_Clean(t *testing.T) {
+	s := newTestServer(t)
+
+	var hookCalled bool
+	s.OnShutdown(func(ctx context.Context) error {
+		hookCalled = true
+		return nil
+	})
+
+	forced, err := s.Shutdown(context.Background())
+	if err != nil {
+		t.Fatalf("Shutdown() error = %v", err)
+	}
+	if forced {
+		t.Error("Expected clean shutdown, got forced")
+	}
+	if !hookCalled {
+		t.Error("Expected shutdown hook to run")
+	}
+
+	resp, err := s.health.Check(context.Background(), &healthpb.HealthCheckRequest{})
+	if err != nil {
+		t.Fatalf("health Check() error = %v", err)
+	}
+	if resp.Status != healthpb.HealthCheckResponse_NOT_SERVING {
+		t.Errorf("Expected NOT_SERVING after shutdown, got %v", resp.Status)
+	}
+
+	if err := s.db.DB.Ping(); err == nil {
+		t.Error("Expected database to be closed after shutdown")
+	}
+}
+
+func TestAnalyticsServer_Shutdown_HookErrors(t *testing.T) {
+	s := newTestServer(t)
+
+	hookErr := errors.New("flush failed")
+	s.OnShutdown(func(ctx context.Context) error { return hookErr })
+
+	ctx, cancel := context.WithTimeout(context.Background(), time.Second)
+	defer cancel()
+
+	_, err := s.Shutdown(ctx)
+	if !errors.Is(err, hookErr) {
+		t.Errorf("Expected hook error, got %v", err)
+	}
+}
+
+func TestAnalyticsServer_Shutdown_ForcedCancelsInFlightRPCs(t *testing.T) {
+	repo := newBlockingRepository()
+	s := New(repo)
+	client := startTestServer(t, s)
+
+	go client.GetOverallQualityScore(testContext(t), &proto.OverallQualityScoreRequest{
+		StartDate: timestamppb.New(time.Date(2025, 1, 1, 0, 0, 0, 0, time.UTC)),
+		EndDate:   timestamppb.New(time.Date(2025, 1, 8, 0, 0, 0, 0, time.UTC)),
+	})
+	<-repo.started
+
+	ctx, cancel := context.WithTimeout(context.Background(), 50*time.Millisecond)
+	defer cancel()
+
+	start := time.Now()
+	forced, err := s.Shutdown(ctx)
+	if err != nil {
+		t.Fatalf("Shutdown() error = %v", err)
+	}
+	if !forced {
+		t.Error("Expected forced shutdown with an RPC still in flight")
+	}
+	if elapsed := time.Since(start); elapsed > 5*time.Second {
+		t.Errorf("Expected Shutdown to return shortly after the drain deadline, took %v", elapsed)
+	}
+	select {
+	case <-repo.cancelled:
+	default:
+		t.Error("Expected the in-flight RPC's context to be cancelled")
+	}
+}
@@ -0,0 +1,194 @@
+package server
+
+import (
+	"context"
+	"errors"
+
+	"go-grpc-backend/internal/models"
+	"go-grpc-backend/internal/service"
+	"go-grpc-backend/proto"
+
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+func (s *AnalyticsServer) ListTeams(ctx context.Context, req *proto.ListTeamsRequest) (*proto.ListTeamsResponse, error) {
+	if s.teamRepo == nil {
+		return nil, errTeamsUnavailable
+	}
+	return service.ListTeams(ctx, s.teamRepo)
+}
+
+func (s *AnalyticsServer) CreateTeam(ctx context.Context, req *proto.CreateTeamRequest) (*proto.Team, error) {
+	if s.teamRepo == nil {
+		return nil, errTeamsUnavailable
+	}
+	team, err := requestTeam(req.Team)
+	if err != nil {
+		return nil, err
+	}
+
+	created, err := service.CreateTeam(ctx, s.teamRepo, team)
+	return created, teamError(err)
+}
+
+func (s *AnalyticsServer) UpdateTeam(ctx context.Context, req *proto.UpdateTeamRequest) (*proto.Team, error) {
+	if s.teamRepo == nil {
+		return nil, errTeamsUnavailable
+	}
+	team, err := requestTeam(req.Team)
+	if err != nil {
+		return nil, err
+	}
+	if team.ID <= 0 {
+		return nil, status.Error(codes.InvalidArgument, "team.id is required")
+	}
+
+	updated, err := service.UpdateTeam(ctx, s.teamRepo, team)
+	return updated, teamError(err)
+}
+
+func (s *AnalyticsServer) DeleteTeam(ctx context.Context, req *proto.DeleteTeamRequest) (*proto.DeleteTeamResponse, error) {
+	if s.teamRepo == nil {
+		return nil, errTeamsUnavailable
+	}
+	if req.Id <= 0 {
+		return nil, status.Error(codes.InvalidArgument, "id is required")
+	}
+
+	resp, err := service.DeleteTeam(ctx, s.teamRepo, int(req.Id))
+	return resp, teamError(err)
+}
+
+func (s *AnalyticsServer) ListTeamMemberships(ctx context.Context, req *proto.ListTeamMembershipsRequest) (*proto.ListTeamMembershipsResponse, error) {
+	if s.teamRepo == nil {
+		return nil, errTeamsUnavailable
+	}
+	if req.TeamId < 0 || req.UserId < 0 {
+		return nil, status.Error(codes.InvalidArgument, "team_id and user_id must not be negative")
+	}
+	return service.ListTeamMemberships(ctx, s.teamRepo, int(req.TeamId), int(req.UserId))
+}
+
+func (s *AnalyticsServer) CreateTeamMembership(ctx context.Context, req *proto.CreateTeamMembershipRequest) (*proto.TeamMembership, error) {
+	if s.teamRepo == nil {
+		return nil, errTeamsUnavailable
+	}
+	membership, err := requestTeamMembership(req.Membership)
+	if err != nil {
+		return nil, err
+	}
+
+	created, err := service.CreateTeamMembership(ctx, s.teamRepo, membership)
+	return created, teamError(err)
+}
+
+func (s *AnalyticsServer) UpdateTeamMembership(ctx context.Context, req *proto.UpdateTeamMembershipRequest) (*proto.TeamMembership, error) {
+	if s.teamRepo == nil {
+		return nil, errTeamsUnavailable
+	}
+	membership, err := requestTeamMembership(req.Membership)
+	if err != nil {
+		return nil, err
+	}
+	if membership.ID <= 0 {
+		return nil, status.Error(codes.InvalidArgument, "membership.id is required")
+	}
+
+	updated, err := service.UpdateTeamMembership(ctx, s.teamRepo, membership)
+	return updated, teamError(err)
+}
+
+func (s *AnalyticsServer) DeleteTeamMembership(ctx context.Context, req *proto.DeleteTeamMembershipRequest) (*proto.DeleteTeamMembershipResponse, error) {
+	if s.teamRepo == nil {
+		return nil, errTeamsUnavailable
+	}
+	if req.Id <= 0 {
+		return nil, status.Error(codes.InvalidArgument, "id is required")
+	}
+
+	resp, err := service.DeleteTeamMembership(ctx, s.teamRepo, int(req.Id))
+	return resp, teamError(err)
+}
+
+func (s *AnalyticsServer) GetTeamScores(ctx context.Context, req *proto.TeamScoresRequest) (*proto.TeamScoresResponse, error) {
+	if s.teamRepo == nil || s.groupedRepo == nil {
+		return nil, errTeamsUnavailable
+	}
+	rng, err := requestRange(req.StartDate, req.EndDate, req.InclusiveEnd, req.DateBasis)
+	if err != nil {
+		return nil, err
+	}
+	if req.TeamId < 0 {
+		return nil, status.Error(codes.InvalidArgument, "team_id must not be negative")
+	}
+
+	resp, err := service.GetTeamScores(ctx, s.teamRepo, s.groupedRepo, rng, int(req.TeamId))
+	return resp, repositoryError(err)
+}
+
+var errTeamsUnavailable = status.Error(codes.Unimplemented, "teams are not configured on this server")
+
+// teamError maps teams and memberships that break the hierarchy's rules to InvalidArgument
+// and missing records to NotFound
+func teamError(err error) error {
+	if errors.Is(err, service.ErrInvalidTeam) || errors.Is(err, service.ErrInvalidTeamMembership) {
+		return status.Error(codes.InvalidArgument, err.Error())
+	}
+	return repositoryError(err)
+}
+
+// requestTeam validates the team a create or update request carries.
+// Whether its parent fits the hierarchy is checked by the service
+func requestTeam(t *proto.Team) (models.Team, error) {
+	if t == nil {
+		return models.Team{}, status.Error(codes.InvalidArgument, "team is required")
+	}
+	if t.Name == "" {
+		return models.Team{}, status.Error(codes.InvalidArgument, "team.name is required")
+	}
+	kind, ok := service.TeamKindFromProto(t.Kind)
+	if !ok {
+		return models.Team{}, status.Errorf(codes.InvalidArgument, "unsupported team kind %v", t.Kind)
+	}
+	if t.ParentId < 0 {
+		return models.Team{}, status.Error(codes.InvalidArgument, "team.parent_id must not be negative")
+	}
+
+	return models.Team{
+		ID:       int(t.Id),
+		Name:     t.Name,
+		Kind:     kind,
+		ParentID: int(t.ParentId),
+	}, nil
+}
+
+// requestTeamMembership validates the membership a create or update request carries
+func requestTeamMembership(m *proto.TeamMembership) (models.TeamMembership, error) {
+	if m == nil {
+		return models.TeamMembership{}, status.Error(codes.InvalidArgument, "membership is required")
+	}
+	if m.TeamId <= 0 {
+		return models.TeamMembership{}, status.Error(codes.InvalidArgument, "membership.team_id is required")
+	}
+	if m.UserId <= 0 {
+		return models.TeamMembership{}, status.Error(codes.InvalidArgument, "membership.user_id is required")
+	}
+	if m.ValidFrom == nil {
+		return models.TeamMembership{}, status.Error(codes.InvalidArgument, "membership.valid_from is required")
+	}
+
+	membership := models.TeamMembership{
+		ID:        int(m.Id),
+		TeamID:    int(m.TeamId),
+		UserID:    int(m.UserId),
+		ValidFrom: m.ValidFrom.AsTime(),
+	}
+	if m.ValidTo != nil {
+		membership.ValidTo = m.ValidTo.AsTime()
+		if !membership.ValidTo.After(membership.ValidFrom) {
+			return models.TeamMembership{}, status.Error(codes.InvalidArgument, "membership.valid_to must be after valid_from")
+		}
+	}
+	return membership, nil
+}
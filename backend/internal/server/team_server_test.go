@@ -0,0 +1,329 @@
+package server
+
+import (
+	"context"
+	"fmt"
+	"sort"
+	"testing"
+	"time"
+
+	"go-grpc-backend/internal/models"
+	"go-grpc-backend/internal/repository"
+	"go-grpc-backend/proto"
+
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/types/known/timestamppb"
+)
+
+// fakeTeamRepository keeps teams and memberships in memory, like TeamRepository does in SQLite.
+// Users 1 (Alice) and 2 (Bob) exist
+type fakeTeamRepository struct {
+	teams       map[int]models.Team
+	memberships map[int]models.TeamMembership
+	nextID      int
+}
+
+func newFakeTeamRepository() *fakeTeamRepository {
+	return &fakeTeamRepository{teams: make(map[int]models.Team), memberships: make(map[int]models.TeamMembership)}
+}
+
+func (f *fakeTeamRepository) ListTeams(ctx context.Context) ([]models.Team, error) {
+	var teams []models.Team
+	for _, team := range f.teams {
+		teams = append(teams, team)
+	}
+	sort.Slice(teams, func(i, j int) bool { return teams[i].Name < teams[j].Name })
+	return teams, nil
+}
+
+func (f *fakeTeamRepository) GetTeam(ctx context.Context, id int) (models.Team, error) {
+	team, ok := f.teams[id]
+	if !ok {
+		return team, fmt.Errorf("team %d: %w", id, repository.ErrNotFound)
+	}
+	return team, nil
+}
+
+func (f *fakeTeamRepository) CreateTeam(ctx context.Context, team models.Team) (models.Team, error) {
+	f.nextID++
+	team.ID = f.nextID
+	team.CreatedAt = time.Date(2025, 3, 1, 0, 0, 0, 0, time.UTC)
+	f.teams[team.ID] = team
+	return team, nil
+}
+
+func (f *fakeTeamRepository) UpdateTeam(ctx context.Context, team models.Team) (models.Team, error) {
+	existing, err := f.GetTeam(ctx, team.ID)
+	if err != nil {
+		return team, err
+	}
+	existing.Name, existing.ParentID = team.Name, team.ParentID
+	f.teams[team.ID] = existing
+	return existing, nil
+}
+
+func (f *fakeTeamRepository) DeleteTeam(ctx context.Context, id int) error {
+	if _, err := f.GetTeam(ctx, id); err != nil {
+		return err
+	}
+	for _, team := range f.teams {
+		if team.ParentID == id {
+			return fmt.Errorf("team %d still has sub-teams: %w", id, repository.ErrConflict)
+		}
+	}
+	delete(f.teams, id)
+	for mid, m := range f.memberships {
+		if m.TeamID == id {
+			delete(f.memberships, mid)
+		}
+	}
+	return nil
+}
+
+func (f *fakeTeamRepository) ListTeamMemberships(ctx context.Context, teamID, userID int) ([]models.TeamMembership, error) {
+	var memberships []models.TeamMembership
+	for _, m := range f.memberships {
+		if (teamID == 0 || m.TeamID == teamID) && (userID == 0 || m.UserID == userID) {
+			memberships = append(memberships, m)
+		}
+	}
+	sort.Slice(memberships, func(i, j int) bool { return memberships[i].ID < memberships[j].ID })
+	return memberships, nil
+}
+
+func (f *fakeTeamRepository) GetTeamMembership(ctx context.Context, id int) (models.TeamMembership, error) {
+	m, ok := f.memberships[id]
+	if !ok {
+		return m, fmt.Errorf("team membership %d: %w", id, repository.ErrNotFound)
+	}
+	return m, nil
+}
+
+// overlaps reports whether membership overlaps another of the same user in the same team
+func (f *fakeTeamRepository) overlaps(membership models.TeamMembership) bool {
+	for _, m := range f.memberships {
+		if m.ID != membership.ID && m.TeamID == membership.TeamID && m.UserID == membership.UserID && membership.Overlaps(m) {
+			return true
+		}
+	}
+	return false
+}
+
+func (f *fakeTeamRepository) CreateTeamMembership(ctx context.Context, membership models.TeamMembership) (models.TeamMembership, error) {
+	if f.overlaps(membership) {
+		return membership, fmt.Errorf("overlapping membership: %w", repository.ErrConflict)
+	}
+	f.nextID++
+	membership.ID = f.nextID
+	user, _ := f.GetUser(ctx, membership.UserID)
+	membership.UserName = user.Name
+	f.memberships[membership.ID] = membership
+	return membership, nil
+}
+
+func (f *fakeTeamRepository) UpdateTeamMembership(ctx context.Context, membership models.TeamMembership) (models.TeamMembership, error) {
+	if _, err := f.GetTeamMembership(ctx, membership.ID); err != nil {
+		return membership, err
+	}
+	if f.overlaps(membership) {
+		return membership, fmt.Errorf("overlapping membership: %w", repository.ErrConflict)
+	}
+	user, _ := f.GetUser(ctx, membership.UserID)
+	membership.UserName = user.Name
+	f.memberships[membership.ID] = membership
+	return membership, nil
+}
+
+func (f *fakeTeamRepository) DeleteTeamMembership(ctx context.Context, id int) error {
+	if _, err := f.GetTeamMembership(ctx, id); err != nil {
+		return err
+	}
+	delete(f.memberships, id)
+	return nil
+}
+
+func (f *fakeTeamRepository) GetUser(ctx context.Context, id int) (models.User, error) {
+	switch id {
+	case 1:
+		return models.User{ID: 1, Name: "Alice"}, nil
+	case 2:
+		return models.User{ID: 2, Name: "Bob"}, nil
+	}
+	return models.User{}, fmt.Errorf("user %d: %w", id, repository.ErrNotFound)
+}
+
+func TestAnalyticsServer_EndToEnd_Teams(t *testing.T) {
+	teams := newFakeTeamRepository()
+	// The cache must not serve a stale team list after a change
+	client := startTestServer(t, New(&fakeRepository{},
+		WithTeamRepository(teams),
+		WithUnaryInterceptors(newResponseCache(time.Minute, 100).interceptor()),
+	))
+	ctx := testContext(t)
+
+	create := func(name string, kind proto.TeamKind, parentID int32) *proto.Team {
+		t.Helper()
+		team, err := client.CreateTeam(ctx, &proto.CreateTeamRequest{Team: &proto.Team{Name: name, Kind: kind, ParentId: parentID}})
+		if err != nil {
+			t.Fatalf("CreateTeam(%s) error = %v", name, err)
+		}
+		return team
+	}
+	acme := create("Acme", proto.TeamKind_TEAM_KIND_ORG, 0)
+	support := create("Support", proto.TeamKind_TEAM_KIND_DEPARTMENT, acme.Id)
+	tier1 := create("Tier 1", proto.TeamKind_TEAM_KIND_TEAM, support.Id)
+	if tier1.Kind != proto.TeamKind_TEAM_KIND_TEAM || tier1.ParentId != support.Id || tier1.CreatedAt == nil {
+		t.Errorf("Unexpected created team %+v", tier1)
+	}
+
+	if _, err := client.CreateTeam(ctx, &proto.CreateTeamRequest{Team: &proto.Team{Name: "Tier 2", Kind: proto.TeamKind_TEAM_KIND_TEAM, ParentId: acme.Id}}); status.Code(err) != codes.InvalidArgument {
+		t.Errorf("Expected InvalidArgument for a team directly under an org, got %v", err)
+	}
+
+	list, err := client.ListTeams(ctx, &proto.ListTeamsRequest{})
+	if err != nil {
+		t.Fatalf("ListTeams() error = %v", err)
+	}
+	if len(list.Teams) != 3 || list.Teams[0].Name != "Acme" {
+		t.Fatalf("Expected the created teams, got %+v", list.Teams)
+	}
+
+	updated, err := client.UpdateTeam(ctx, &proto.UpdateTeamRequest{Team: &proto.Team{Id: tier1.Id, Name: "Frontline", Kind: proto.TeamKind_TEAM_KIND_TEAM, ParentId: support.Id}})
+	if err != nil {
+		t.Fatalf("UpdateTeam() error = %v", err)
+	}
+	if updated.Name != "Frontline" {
+		t.Errorf("Expected the team to be renamed, got %+v", updated)
+	}
+
+	jan := func(day int) *timestamppb.Timestamp {
+		return timestamppb.New(time.Date(2025, 1, day, 0, 0, 0, 0, time.UTC))
+	}
+	membership, err := client.CreateTeamMembership(ctx, &proto.CreateTeamMembershipRequest{Membership: &proto.TeamMembership{TeamId: tier1.Id, UserId: 2, ValidFrom: jan(1)}})
+	if err != nil {
+		t.Fatalf("CreateTeamMembership() error = %v", err)
+	}
+	if membership.UserName != "Bob" || membership.ValidTo != nil {
+		t.Errorf("Unexpected created membership %+v", membership)
+	}
+	if _, err := client.CreateTeamMembership(ctx, &proto.CreateTeamMembershipRequest{Membership: &proto.TeamMembership{TeamId: tier1.Id, UserId: 2, ValidFrom: jan(5)}}); status.Code(err) != codes.InvalidArgument {
+		t.Errorf("Expected InvalidArgument for an overlapping membership, got %v", err)
+	}
+
+	membership.ValidTo = jan(5)
+	if _, err := client.UpdateTeamMembership(ctx, &proto.UpdateTeamMembershipRequest{Membership: membership}); err != nil {
+		t.Fatalf("UpdateTeamMembership() error = %v", err)
+	}
+	if _, err := client.CreateTeamMembership(ctx, &proto.CreateTeamMembershipRequest{Membership: &proto.TeamMembership{TeamId: tier1.Id, UserId: 2, ValidFrom: jan(5)}}); err != nil {
+		t.Errorf("Expected a membership starting when the previous one ended, got %v", err)
+	}
+
+	memberships, err := client.ListTeamMemberships(ctx, &proto.ListTeamMembershipsRequest{UserId: 2})
+	if err != nil {
+		t.Fatalf("ListTeamMemberships() error = %v", err)
+	}
+	if len(memberships.Memberships) != 2 || !memberships.Memberships[0].ValidTo.AsTime().Equal(jan(5).AsTime()) {
+		t.Errorf("Expected both memberships, got %+v", memberships.Memberships)
+	}
+
+	if _, err := client.DeleteTeam(ctx, &proto.DeleteTeamRequest{Id: support.Id}); status.Code(err) != codes.InvalidArgument {
+		t.Errorf("Expected InvalidArgument deleting a department with teams, got %v", err)
+	}
+	if _, err := client.DeleteTeam(ctx, &proto.DeleteTeamRequest{Id: tier1.Id}); err != nil {
+		t.Fatalf("DeleteTeam() error = %v", err)
+	}
+	if _, err := client.DeleteTeam(ctx, &proto.DeleteTeamRequest{Id: tier1.Id}); status.Code(err) != codes.NotFound {
+		t.Errorf("Expected NotFound deleting a deleted team, got %v", err)
+	}
+	if _, err := client.DeleteTeamMembership(ctx, &proto.DeleteTeamMembershipRequest{Id: membership.Id}); status.Code(err) != codes.NotFound {
+		t.Errorf("Expected the team's memberships to be deleted with it, got %v", err)
+	}
+}
+
+func TestAnalyticsServer_EndToEnd_TeamValidation(t *testing.T) {
+	client := startTestServer(t, New(&fakeRepository{}, WithTeamRepository(newFakeTeamRepository())))
+	ctx := testContext(t)
+	from := timestamppb.New(time.Date(2025, 1, 5, 0, 0, 0, 0, time.UTC))
+	before := timestamppb.New(time.Date(2025, 1, 1, 0, 0, 0, 0, time.UTC))
+
+	teams := map[string]*proto.Team{
+		"missing team":     nil,
+		"missing name":     {Kind: proto.TeamKind_TEAM_KIND_ORG},
+		"unspecified kind": {Name: "Acme"},
+		"negative parent":  {Name: "Acme", Kind: proto.TeamKind_TEAM_KIND_ORG, ParentId: -1},
+	}
+	for name, team := range teams {
+		if _, err := client.CreateTeam(ctx, &proto.CreateTeamRequest{Team: team}); status.Code(err) != codes.InvalidArgument {
+			t.Errorf("%s: expected InvalidArgument, got %v", name, err)
+		}
+	}
+
+	memberships := map[string]*proto.TeamMembership{
+		"missing membership":   nil,
+		"missing team id":      {UserId: 1, ValidFrom: from},
+		"missing user id":      {TeamId: 1, ValidFrom: from},
+		"missing valid_from":   {TeamId: 1, UserId: 1},
+		"valid_to before from": {TeamId: 1, UserId: 1, ValidFrom: from, ValidTo: before},
+		"unknown team":         {TeamId: 42, UserId: 1, ValidFrom: from},
+	}
+	for name, m := range memberships {
+		if _, err := client.CreateTeamMembership(ctx, &proto.CreateTeamMembershipRequest{Membership: m}); status.Code(err) != codes.InvalidArgument {
+			t.Errorf("%s: expected InvalidArgument, got %v", name, err)
+		}
+	}
+
+	if _, err := client.UpdateTeam(ctx, &proto.UpdateTeamRequest{Team: &proto.Team{Name: "Acme", Kind: proto.TeamKind_TEAM_KIND_ORG}}); status.Code(err) != codes.InvalidArgument {
+		t.Errorf("Update without id: expected InvalidArgument, got %v", err)
+	}
+	if _, err := client.ListTeamMemberships(ctx, &proto.ListTeamMembershipsRequest{TeamId: -1}); status.Code(err) != codes.InvalidArgument {
+		t.Errorf("Negative team_id: expected InvalidArgument, got %v", err)
+	}
+}
+
+func TestAnalyticsServer_EndToEnd_GetTeamScores(t *testing.T) {
+	teams := newFakeTeamRepository()
+	acme, _ := teams.CreateTeam(context.Background(), models.Team{Name: "Acme", Kind: models.TeamKindOrg})
+	support, _ := teams.CreateTeam(context.Background(), models.Team{Name: "Support", Kind: models.TeamKindDepartment, ParentID: acme.ID})
+	grouped := &fakeGroupedScoresRepository{teamScores: []models.TeamCategoryScore{
+		{TeamID: acme.ID, CategoryScore: models.CategoryScore{CategoryID: 1, CategoryName: "Spelling", CategoryWeight: 1, Score: 4, RatingCount: 3}},
+		{TeamID: support.ID, CategoryScore: models.CategoryScore{CategoryID: 1, CategoryName: "Spelling", CategoryWeight: 1, Score: 4, RatingCount: 3}},
+	}}
+	client := startTestServer(t, New(&fakeRepository{}, WithTeamRepository(teams), WithGroupedScoresRepository(grouped)))
+	ctx := testContext(t)
+
+	start := timestamppb.New(time.Date(2025, 1, 1, 0, 0, 0, 0, time.UTC))
+	end := timestamppb.New(time.Date(2025, 1, 8, 0, 0, 0, 0, time.UTC))
+
+	resp, err := client.GetTeamScores(ctx, &proto.TeamScoresRequest{StartDate: start, EndDate: end, DateBasis: proto.DateBasis_DATE_BASIS_TICKET_CREATED})
+	if err != nil {
+		t.Fatalf("GetTeamScores() error = %v", err)
+	}
+	if len(resp.Teams) != 2 || resp.Teams[1].Team.Name != "Support" || resp.Teams[1].Depth != 1 || resp.Teams[1].GetScore() != 80 {
+		t.Errorf("Unexpected team scores %+v", resp.Teams)
+	}
+	if resp.Range.GetBasis() != proto.DateBasis_DATE_BASIS_TICKET_CREATED {
+		t.Errorf("Expected the date basis to be echoed, got %v", resp.Range.GetBasis())
+	}
+
+	if _, err := client.GetTeamScores(ctx, &proto.TeamScoresRequest{StartDate: start, EndDate: end, TeamId: 42}); status.Code(err) != codes.NotFound {
+		t.Errorf("Expected NotFound for an unknown team, got %v", err)
+	}
+	if _, err := client.GetTeamScores(ctx, &proto.TeamScoresRequest{StartDate: end, EndDate: start}); status.Code(err) != codes.InvalidArgument {
+		t.Errorf("Expected InvalidArgument for an inverted range, got %v", err)
+	}
+}
+
+func TestAnalyticsServer_EndToEnd_TeamsUnconfigured(t *testing.T) {
+	client := startTestServer(t, New(&fakeRepository{}, WithTeamRepository(newFakeTeamRepository())))
+	ctx := testContext(t)
+
+	if _, err := client.GetTeamScores(ctx, &proto.TeamScoresRequest{}); status.Code(err) != codes.Unimplemented {
+		t.Errorf("Expected Unimplemented without a grouped scores repository, got %v", err)
+	}
+
+	client = startTestServer(t, New(&fakeRepository{}))
+	if _, err := client.ListTeams(testContext(t), &proto.ListTeamsRequest{}); status.Code(err) != codes.Unimplemented {
+		t.Errorf("Expected Unimplemented without a team repository, got %v", err)
+	}
+}
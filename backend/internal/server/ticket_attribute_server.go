@@ -0,0 +1,135 @@
+package server
+
+import (
+	"context"
+	"regexp"
+	"unicode/utf8"
+
+	"go-grpc-backend/internal/models"
+	"go-grpc-backend/internal/service"
+	"go-grpc-backend/proto"
+
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+// maxAttributeValueLength is the longest attribute value accepted, in characters
+const maxAttributeValueLength = 256
+
+// attributeKeyPattern keeps keys short and safe to show in dashboards and URLs
+var attributeKeyPattern = regexp.MustCompile(`^[A-Za-z0-9_.-]{1,64}$`)
+
+func (s *AnalyticsServer) ListTicketAttributes(ctx context.Context, req *proto.ListTicketAttributesRequest) (*proto.ListTicketAttributesResponse, error) {
+	if s.attributeRepo == nil {
+		return nil, errTicketAttributesUnavailable
+	}
+	if req.TicketId <= 0 {
+		return nil, status.Error(codes.InvalidArgument, "ticket_id is required")
+	}
+
+	resp, err := service.ListTicketAttributes(ctx, s.attributeRepo, int(req.TicketId))
+	return resp, repositoryError(err)
+}
+
+func (s *AnalyticsServer) SetTicketAttributes(ctx context.Context, req *proto.SetTicketAttributesRequest) (*proto.ListTicketAttributesResponse, error) {
+	if s.attributeRepo == nil {
+		return nil, errTicketAttributesUnavailable
+	}
+	if req.TicketId <= 0 {
+		return nil, status.Error(codes.InvalidArgument, "ticket_id is required")
+	}
+	attributes, err := requestTicketAttributes(req.Attributes)
+	if err != nil {
+		return nil, err
+	}
+
+	resp, err := service.SetTicketAttributes(ctx, s.attributeRepo, int(req.TicketId), attributes)
+	return resp, repositoryError(err)
+}
+
+func (s *AnalyticsServer) DeleteTicketAttribute(ctx context.Context, req *proto.DeleteTicketAttributeRequest) (*proto.DeleteTicketAttributeResponse, error) {
+	if s.attributeRepo == nil {
+		return nil, errTicketAttributesUnavailable
+	}
+	if req.TicketId <= 0 {
+		return nil, status.Error(codes.InvalidArgument, "ticket_id is required")
+	}
+	if req.Key == "" {
+		return nil, status.Error(codes.InvalidArgument, "key is required")
+	}
+
+	resp, err := service.DeleteTicketAttribute(ctx, s.attributeRepo, int(req.TicketId), req.Key)
+	return resp, repositoryError(err)
+}
+
+func (s *AnalyticsServer) GetScoresGroupedBy(ctx context.Context, req *proto.ScoresGroupedByRequest) (*proto.ScoresGroupedByResponse, error) {
+	if s.groupedRepo == nil {
+		return nil, status.Error(codes.Unimplemented, "grouped scores are not configured on this server")
+	}
+	rng, err := requestRange(req.StartDate, req.EndDate, req.InclusiveEnd, req.DateBasis)
+	if err != nil {
+		return nil, err
+	}
+	grouping, err := requestScoreGrouping(req.GroupBy)
+	if err != nil {
+		return nil, err
+	}
+
+	return service.GetScoresGroupedBy(ctx, s.groupedRepo, rng, grouping, req.GroupBy.GetCategory())
+}
+
+var errTicketAttributesUnavailable = status.Error(codes.Unimplemented, "ticket attributes are not configured on this server")
+
+// requestTicketAttributes validates the attributes a set request carries
+func requestTicketAttributes(attrs []*proto.TicketAttribute) ([]models.TicketAttribute, error) {
+	if len(attrs) == 0 {
+		return nil, status.Error(codes.InvalidArgument, "attributes are required")
+	}
+
+	seen := make(map[string]bool, len(attrs))
+	attributes := make([]models.TicketAttribute, 0, len(attrs))
+	for _, a := range attrs {
+		if !attributeKeyPattern.MatchString(a.Key) {
+			return nil, status.Errorf(codes.InvalidArgument, "invalid attribute key %q", a.Key)
+		}
+		if seen[a.Key] {
+			return nil, status.Errorf(codes.InvalidArgument, "duplicate attribute key %q", a.Key)
+		}
+		seen[a.Key] = true
+		if a.Value == "" || utf8.RuneCountInString(a.Value) > maxAttributeValueLength {
+			return nil, status.Errorf(codes.InvalidArgument, "attribute %q must have a value of 1-%d characters", a.Key, maxAttributeValueLength)
+		}
+		attributes = append(attributes, models.TicketAttribute{Key: a.Key, Value: a.Value})
+	}
+	return attributes, nil
+}
+
+// requestScoreGrouping validates the dimensions a GetScoresGroupedBy request asks for; nil means none
+func requestScoreGrouping(g *proto.GroupBy) (models.ScoreGrouping, error) {
+	keys := g.GetAttributeKeys()
+	if len(keys) > service.MaxGroupByAttributes {
+		return models.ScoreGrouping{}, status.Errorf(codes.InvalidArgument, "at most %d attribute keys can be grouped by", service.MaxGroupByAttributes)
+	}
+	seen := make(map[string]bool, len(keys))
+	for _, key := range keys {
+		if !attributeKeyPattern.MatchString(key) {
+			return models.ScoreGrouping{}, status.Errorf(codes.InvalidArgument, "invalid attribute key %q", key)
+		}
+		if seen[key] {
+			return models.ScoreGrouping{}, status.Errorf(codes.InvalidArgument, "duplicate attribute key %q", key)
+		}
+		seen[key] = true
+	}
+
+	grouping := models.ScoreGrouping{AttributeKeys: keys, Reviewee: g.GetReviewee()}
+	switch g.GetGranularity() {
+	case proto.Granularity_GRANULARITY_UNSPECIFIED:
+	case proto.Granularity_GRANULARITY_DAY:
+		grouping.Granularity = models.GranularityDay
+	case proto.Granularity_GRANULARITY_WEEK:
+		grouping.Granularity = models.GranularityWeek
+	default:
+		return models.ScoreGrouping{}, status.Errorf(codes.InvalidArgument, "unsupported granularity %v", g.GetGranularity())
+	}
+	return grouping, nil
+}
@@ -0,0 +1,177 @@
+package server
+
+import (
+	"context"
+	"fmt"
+	"slices"
+	"strings"
+	"testing"
+	"time"
+
+	"go-grpc-backend/internal/models"
+	"go-grpc-backend/internal/repository"
+	"go-grpc-backend/proto"
+
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/types/known/timestamppb"
+)
+
+// fakeTicketAttributeRepository keeps the attributes of tickets 1-9 in memory
+type fakeTicketAttributeRepository struct {
+	attributes map[int]map[string]string
+}
+
+func (f *fakeTicketAttributeRepository) GetTicketAttributes(ctx context.Context, ticketID int) ([]models.TicketAttribute, error) {
+	if ticketID > 9 {
+		return nil, fmt.Errorf("ticket %d: %w", ticketID, repository.ErrNotFound)
+	}
+	var attributes []models.TicketAttribute
+	for key, value := range f.attributes[ticketID] {
+		attributes = append(attributes, models.TicketAttribute{TicketID: ticketID, Key: key, Value: value})
+	}
+	slices.SortFunc(attributes, func(a, b models.TicketAttribute) int { return strings.Compare(a.Key, b.Key) })
+	return attributes, nil
+}
+
+func (f *fakeTicketAttributeRepository) SetTicketAttributes(ctx context.Context, ticketID int, attributes []models.TicketAttribute) ([]models.TicketAttribute, error) {
+	if ticketID > 9 {
+		return nil, fmt.Errorf("ticket %d: %w", ticketID, repository.ErrNotFound)
+	}
+	if f.attributes[ticketID] == nil {
+		f.attributes[ticketID] = make(map[string]string)
+	}
+	for _, a := range attributes {
+		f.attributes[ticketID][a.Key] = a.Value
+	}
+	return f.GetTicketAttributes(ctx, ticketID)
+}
+
+func (f *fakeTicketAttributeRepository) DeleteTicketAttribute(ctx context.Context, ticketID int, key string) error {
+	if _, ok := f.attributes[ticketID][key]; !ok {
+		return fmt.Errorf("ticket %d attribute %q: %w", ticketID, key, repository.ErrNotFound)
+	}
+	delete(f.attributes[ticketID], key)
+	return nil
+}
+
+// fakeGroupedScoresRepository serves canned rows and records the grouping it was asked for
+type fakeGroupedScoresRepository struct {
+	rows       []models.GroupedCategoryScore
+	teamScores []models.TeamCategoryScore
+	grouping   models.ScoreGrouping
+}
+
+func (f *fakeGroupedScoresRepository) GetGroupedCategoryScores(ctx context.Context, rng models.DateRange, grouping models.ScoreGrouping) ([]models.GroupedCategoryScore, error) {
+	f.grouping = grouping
+	return f.rows, nil
+}
+
+func (f *fakeGroupedScoresRepository) GetTeamCategoryScores(ctx context.Context, rng models.DateRange) ([]models.TeamCategoryScore, error) {
+	return f.teamScores, nil
+}
+
+func TestAnalyticsServer_EndToEnd_TicketAttributes(t *testing.T) {
+	attributes := &fakeTicketAttributeRepository{attributes: make(map[int]map[string]string)}
+	client := startTestServer(t, New(&fakeRepository{},
+		WithTicketAttributeRepository(attributes),
+		WithUnaryInterceptors(newResponseCache(time.Minute, 100).interceptor()),
+	))
+	ctx := testContext(t)
+
+	set, err := client.SetTicketAttributes(ctx, &proto.SetTicketAttributesRequest{TicketId: 1, Attributes: []*proto.TicketAttribute{
+		{Key: "priority", Value: "high"},
+		{Key: "channel", Value: "email"},
+	}})
+	if err != nil {
+		t.Fatalf("SetTicketAttributes() error = %v", err)
+	}
+	if len(set.Attributes) != 2 || set.Attributes[0].Key != "channel" || set.Attributes[1].Value != "high" {
+		t.Errorf("Unexpected attributes %v", set.Attributes)
+	}
+
+	if _, err := client.DeleteTicketAttribute(ctx, &proto.DeleteTicketAttributeRequest{TicketId: 1, Key: "priority"}); err != nil {
+		t.Fatalf("DeleteTicketAttribute() error = %v", err)
+	}
+	// The cache must not serve the list from before the delete
+	list, err := client.ListTicketAttributes(ctx, &proto.ListTicketAttributesRequest{TicketId: 1})
+	if err != nil {
+		t.Fatalf("ListTicketAttributes() error = %v", err)
+	}
+	if list.TicketId != 1 || len(list.Attributes) != 1 || list.Attributes[0].Key != "channel" {
+		t.Errorf("Expected only channel to remain, got %v", list)
+	}
+
+	if _, err := client.DeleteTicketAttribute(ctx, &proto.DeleteTicketAttributeRequest{TicketId: 1, Key: "priority"}); status.Code(err) != codes.NotFound {
+		t.Errorf("Expected NotFound deleting a deleted attribute, got %v", err)
+	}
+	if _, err := client.ListTicketAttributes(ctx, &proto.ListTicketAttributesRequest{TicketId: 42}); status.Code(err) != codes.NotFound {
+		t.Errorf("Expected NotFound for an unknown ticket, got %v", err)
+	}
+
+	invalid := map[string]*proto.SetTicketAttributesRequest{
+		"missing ticket id": {Attributes: []*proto.TicketAttribute{{Key: "channel", Value: "email"}}},
+		"no attributes":     {TicketId: 1},
+		"empty key":         {TicketId: 1, Attributes: []*proto.TicketAttribute{{Value: "email"}}},
+		"key with spaces":   {TicketId: 1, Attributes: []*proto.TicketAttribute{{Key: "support channel", Value: "email"}}},
+		"empty value":       {TicketId: 1, Attributes: []*proto.TicketAttribute{{Key: "channel"}}},
+		"long value":        {TicketId: 1, Attributes: []*proto.TicketAttribute{{Key: "channel", Value: strings.Repeat("x", 257)}}},
+		"duplicate key":     {TicketId: 1, Attributes: []*proto.TicketAttribute{{Key: "channel", Value: "email"}, {Key: "channel", Value: "chat"}}},
+	}
+	for name, req := range invalid {
+		if _, err := client.SetTicketAttributes(ctx, req); status.Code(err) != codes.InvalidArgument {
+			t.Errorf("%s: expected InvalidArgument, got %v", name, err)
+		}
+	}
+}
+
+func TestAnalyticsServer_EndToEnd_GetScoresGroupedBy(t *testing.T) {
+	grouped := &fakeGroupedScoresRepository{rows: []models.GroupedCategoryScore{
+		{AttributeValues: []string{"chat"}, CategoryScore: models.CategoryScore{CategoryID: 1, CategoryName: "Spelling", CategoryWeight: 1, Score: 4, RatingCount: 2}},
+		{AttributeValues: []string{"email"}, CategoryScore: models.CategoryScore{CategoryID: 1, CategoryName: "Spelling", CategoryWeight: 1, Score: 3, RatingCount: 1}},
+	}}
+	client := startTestServer(t, New(&fakeRepository{}, WithGroupedScoresRepository(grouped)))
+	ctx := testContext(t)
+
+	start := timestamppb.New(time.Date(2025, 1, 1, 0, 0, 0, 0, time.UTC))
+	end := timestamppb.New(time.Date(2025, 1, 8, 0, 0, 0, 0, time.UTC))
+
+	resp, err := client.GetScoresGroupedBy(ctx, &proto.ScoresGroupedByRequest{StartDate: start, EndDate: end, GroupBy: &proto.GroupBy{
+		AttributeKeys: []string{"channel"},
+		Granularity:   proto.Granularity_GRANULARITY_WEEK,
+	}})
+	if err != nil {
+		t.Fatalf("GetScoresGroupedBy() error = %v", err)
+	}
+	if len(resp.Groups) != 2 || resp.Groups[0].Score != 80 || resp.Groups[1].Attributes[0].Value != "email" {
+		t.Errorf("Unexpected groups %v", resp.Groups)
+	}
+	if grouped.grouping.Granularity != models.GranularityWeek || !slices.Equal(grouped.grouping.AttributeKeys, []string{"channel"}) {
+		t.Errorf("Unexpected grouping passed to the repository %+v", grouped.grouping)
+	}
+
+	invalid := map[string]*proto.GroupBy{
+		"invalid key":         {AttributeKeys: []string{"a b"}},
+		"duplicate key":       {AttributeKeys: []string{"channel", "channel"}},
+		"too many keys":       {AttributeKeys: []string{"a", "b", "c", "d", "e", "f"}},
+		"unknown granularity": {Granularity: proto.Granularity(99)},
+	}
+	for name, groupBy := range invalid {
+		req := &proto.ScoresGroupedByRequest{StartDate: start, EndDate: end, GroupBy: groupBy}
+		if _, err := client.GetScoresGroupedBy(ctx, req); status.Code(err) != codes.InvalidArgument {
+			t.Errorf("%s: expected InvalidArgument, got %v", name, err)
+		}
+	}
+}
+
+func TestAnalyticsServer_EndToEnd_TicketAttributesUnconfigured(t *testing.T) {
+	client := startTestServer(t, New(&fakeRepository{}))
+	ctx := testContext(t)
+
+	if _, err := client.ListTicketAttributes(ctx, &proto.ListTicketAttributesRequest{TicketId: 1}); status.Code(err) != codes.Unimplemented {
+		t.Errorf("Expected Unimplemented without an attribute repository, got %v", err)
+	}
+	if _, err := client.GetScoresGroupedBy(ctx, &proto.ScoresGroupedByRequest{}); status.Code(err) != codes.Unimplemented {
+		t.Errorf("Expected Unimplemented without a grouped scores repository, got %v", err)
+	}
+}
@@ -0,0 +1,223 @@
+package service
+
+import (
+	"context"
+	"time"
+
+	"go-grpc-backend/internal/models"
+	"go-grpc-backend/internal/repository"
+	"go-grpc-backend/proto"
+
+	"google.golang.org/protobuf/types/known/durationpb"
+	"google.golang.org/protobuf/types/known/timestamppb"
+)
+
+// AlertEvaluation is a rule's metric over its window at one point in time
+type AlertEvaluation struct {
+	Value  float64
+	Sample int
+	// Sufficient is false when the window held fewer than the rule's MinSample ratings
+	Sufficient bool
+	// Breached is whether Value meets the rule's condition; always false when not Sufficient
+	Breached bool
+}
+
+// EvaluateAlertRule computes rule's metric over the window ending at now, from the same
+// per-category rows as GetOverallQualityScore
+func EvaluateAlertRule(ctx context.Context, repo repository.AnalyticsRepositoryInterface, rule models.AlertRule, now time.Time) (AlertEvaluation, error) {
+	categoryScores, err := repo.GetOverallQualityScore(ctx, models.NewDateRange(now.Add(-rule.Window), now))
+	if err != nil {
+		return AlertEvaluation{}, err
+	}
+
+	if len(rule.CategoryIDs) > 0 {
+		wanted := make(map[int]bool, len(rule.CategoryIDs))
+		for _, id := range rule.CategoryIDs {
+			wanted[id] = true
+		}
+		filtered := categoryScores[:0:0]
+		for _, cs := range categoryScores {
+			if wanted[cs.CategoryID] {
+				filtered = append(filtered, cs)
+			}
+		}
+		categoryScores = filtered
+	}
+
+	score, sample := CalculateOverallScore(categoryScores)
+	eval := AlertEvaluation{Value: score, Sample: sample}
+	if rule.Metric == models.AlertMetricRatingCount {
+		eval.Value = float64(sample)
+	}
+
+	// A rating count is meaningful however low; a score from too few ratings, or none, is not
+	eval.Sufficient = rule.Metric == models.AlertMetricRatingCount || (sample > 0 && sample >= rule.MinSample)
+	eval.Breached = eval.Sufficient && rule.Comparator.Breached(eval.Value, rule.Threshold)
+	return eval, nil
+}
+
+// NextAlertState applies an evaluation to the rule's previous state; previous is nil for a rule
+// never evaluated, which starts out OK. changed reports a transition between OK and firing.
+// An insufficient sample keeps the previous status. A transition sets NotifyPending, which
+// carries over until the evaluator clears it on delivery
+func NextAlertState(ruleID int, previous *models.AlertState, eval AlertEvaluation, now time.Time) (state models.AlertState, changed bool) {
+	state = models.AlertState{
+		RuleID:      ruleID,
+		Status:      models.AlertStatusOK,
+		Value:       eval.Value,
+		Sample:      eval.Sample,
+		Sufficient:  eval.Sufficient,
+		ChangedAt:   now,
+		EvaluatedAt: now,
+	}
+	if previous != nil {
+		state.Status = previous.Status
+		state.ChangedAt = previous.ChangedAt
+		state.NotifyPending = previous.NotifyPending
+	}
+	if !eval.Sufficient {
+		return state, false
+	}
+
+	status := models.AlertStatusOK
+	if eval.Breached {
+		status = models.AlertStatusFiring
+	}
+	if status != state.Status {
+		state.Status = status
+		state.ChangedAt = now
+		state.NotifyPending = true
+		changed = true
+	}
+	return state, changed
+}
+
+// ListAlertRules returns every alert rule with the state of its latest evaluation
+func ListAlertRules(ctx context.Context, repo repository.AlertRepositoryInterface) (*proto.ListAlertRulesResponse, error) {
+	rules, err := repo.ListAlertRules(ctx)
+	if err != nil {
+		return nil, err
+	}
+	states, err := repo.ListAlertStates(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	resp := &proto.ListAlertRulesResponse{}
+	for _, rule := range rules {
+		var state *models.AlertState
+		if s, ok := states[rule.ID]; ok {
+			state = &s
+		}
+		resp.Rules = append(resp.Rules, alertRuleToProto(rule, state))
+	}
+	return resp, nil
+}
+
+// CreateAlertRule stores a new rule; it is evaluated from the next scheduled run
+func CreateAlertRule(ctx context.Context, repo repository.AlertRepositoryInterface, rule models.AlertRule) (*proto.AlertRule, error) {
+	created, err := repo.CreateAlertRule(ctx, rule)
+	if err != nil {
+		return nil, err
+	}
+	return alertRuleToProto(created, nil), nil
+}
+
+// UpdateAlertRule replaces a rule, keeping its creation time and state. An empty WebhookURL keeps
+// the stored one, since responses never include it
+func UpdateAlertRule(ctx context.Context, repo repository.AlertRepositoryInterface, rule models.AlertRule) (*proto.AlertRule, error) {
+	if rule.WebhookURL == "" {
+		existing, err := repo.GetAlertRule(ctx, rule.ID)
+		if err != nil {
+			return nil, err
+		}
+		rule.WebhookURL = existing.WebhookURL
+	}
+	updated, err := repo.UpdateAlertRule(ctx, rule)
+	if err != nil {
+		return nil, err
+	}
+	states, err := repo.ListAlertStates(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	var state *models.AlertState
+	if s, ok := states[updated.ID]; ok {
+		state = &s
+	}
+	return alertRuleToProto(updated, state), nil
+}
+
+func DeleteAlertRule(ctx context.Context, repo repository.AlertRepositoryInterface, id int) (*proto.DeleteAlertRuleResponse, error) {
+	if err := repo.DeleteAlertRule(ctx, id); err != nil {
+		return nil, err
+	}
+	return &proto.DeleteAlertRuleResponse{}, nil
+}
+
+var (
+	alertMetricsToProto = map[models.AlertMetric]proto.AlertMetric{
+		models.AlertMetricQualityScore: proto.AlertMetric_ALERT_METRIC_QUALITY_SCORE,
+		models.AlertMetricRatingCount:  proto.AlertMetric_ALERT_METRIC_RATING_COUNT,
+	}
+	alertComparatorsToProto = map[models.AlertComparator]proto.AlertComparator{
+		models.AlertComparatorLessThan:       proto.AlertComparator_ALERT_COMPARATOR_LESS_THAN,
+		models.AlertComparatorLessOrEqual:    proto.AlertComparator_ALERT_COMPARATOR_LESS_OR_EQUAL,
+		models.AlertComparatorGreaterThan:    proto.AlertComparator_ALERT_COMPARATOR_GREATER_THAN,
+		models.AlertComparatorGreaterOrEqual: proto.AlertComparator_ALERT_COMPARATOR_GREATER_OR_EQUAL,
+	}
+)
+
+// AlertMetricFromProto maps a request's metric; ok is false for UNSPECIFIED and unknown values
+func AlertMetricFromProto(metric proto.AlertMetric) (m models.AlertMetric, ok bool) {
+	for model, p := range alertMetricsToProto {
+		if p == metric {
+			return model, true
+		}
+	}
+	return "", false
+}
+
+// AlertComparatorFromProto maps a request's comparator; ok is false for UNSPECIFIED and unknown values
+func AlertComparatorFromProto(comparator proto.AlertComparator) (c models.AlertComparator, ok bool) {
+	for model, p := range alertComparatorsToProto {
+		if p == comparator {
+			return model, true
+		}
+	}
+	return "", false
+}
+
+func alertRuleToProto(rule models.AlertRule, state *models.AlertState) *proto.AlertRule {
+	out := &proto.AlertRule{
+		Id:         int32(rule.ID),
+		Name:       rule.Name,
+		Metric:     alertMetricsToProto[rule.Metric],
+		Window:     durationpb.New(rule.Window),
+		Comparator: alertComparatorsToProto[rule.Comparator],
+		Threshold:  rule.Threshold,
+		MinSample:  int32(rule.MinSample),
+		Enabled:    rule.Enabled,
+		CreatedAt:  timestamppb.New(rule.CreatedAt),
+	}
+	for _, id := range rule.CategoryIDs {
+		out.CategoryIds = append(out.CategoryIds, int32(id))
+	}
+
+	if state != nil {
+		status := proto.AlertStatus_ALERT_STATUS_OK
+		if state.Status == models.AlertStatusFiring {
+			status = proto.AlertStatus_ALERT_STATUS_FIRING
+		}
+		out.State = &proto.AlertState{
+			Status:             status,
+			Value:              state.Value,
+			Sample:             int32(state.Sample),
+			InsufficientSample: !state.Sufficient,
+			ChangedAt:          timestamppb.New(state.ChangedAt),
+			EvaluatedAt:        timestamppb.New(state.EvaluatedAt),
+		}
+	}
+	return out
+}
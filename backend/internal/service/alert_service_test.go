@@ -0,0 +1,122 @@
+package service
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+
+	"go-grpc-backend/internal/models"
+)
+
+func TestAlertService_EvaluateAlertRule(t *testing.T) {
+	now := time.Date(2025, 3, 1, 12, 0, 0, 0, time.UTC)
+	// Category 1: 4.0 * 0.5 * 20 = 40; category 2: 2.0 * 0.5 * 20 = 20
+	repo := &mockOverallQualityScoreRepository{
+		categoryScores: []models.CategoryScore{
+			{CategoryID: 1, CategoryName: "Empathy", CategoryWeight: 0.5, Score: 4.0, RatingCount: 8},
+			{CategoryID: 2, CategoryName: "Grammar", CategoryWeight: 0.5, Score: 2.0, RatingCount: 4},
+		},
+	}
+
+	tests := []struct {
+		name string
+		rule models.AlertRule
+		want AlertEvaluation
+	}{
+		{
+			name: "quality score over all categories",
+			rule: models.AlertRule{Metric: models.AlertMetricQualityScore, Comparator: models.AlertComparatorLessThan, Threshold: 35, MinSample: 10},
+			want: AlertEvaluation{Value: 30, Sample: 12, Sufficient: true, Breached: true},
+		},
+		{
+			name: "quality score filtered to one category",
+			rule: models.AlertRule{Metric: models.AlertMetricQualityScore, CategoryIDs: []int{1}, Comparator: models.AlertComparatorLessThan, Threshold: 35},
+			want: AlertEvaluation{Value: 40, Sample: 8, Sufficient: true, Breached: false},
+		},
+		{
+			name: "quality score below min sample never breaches",
+			rule: models.AlertRule{Metric: models.AlertMetricQualityScore, CategoryIDs: []int{2}, Comparator: models.AlertComparatorLessThan, Threshold: 35, MinSample: 5},
+			want: AlertEvaluation{Value: 20, Sample: 4, Sufficient: false, Breached: false},
+		},
+		{
+			name: "quality score with no ratings is insufficient",
+			rule: models.AlertRule{Metric: models.AlertMetricQualityScore, CategoryIDs: []int{3}, Comparator: models.AlertComparatorLessThan, Threshold: 35},
+			want: AlertEvaluation{Value: 0, Sample: 0, Sufficient: false, Breached: false},
+		},
+		{
+			name: "rating count is sufficient with no ratings",
+			rule: models.AlertRule{Metric: models.AlertMetricRatingCount, CategoryIDs: []int{3}, Comparator: models.AlertComparatorLessOrEqual, Threshold: 0, MinSample: 5},
+			want: AlertEvaluation{Value: 0, Sample: 0, Sufficient: true, Breached: true},
+		},
+		{
+			name: "rating count",
+			rule: models.AlertRule{Metric: models.AlertMetricRatingCount, Comparator: models.AlertComparatorGreaterThan, Threshold: 12},
+			want: AlertEvaluation{Value: 12, Sample: 12, Sufficient: true, Breached: false},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			tt.rule.Window = 24 * time.Hour
+			got, err := EvaluateAlertRule(context.Background(), repo, tt.rule, now)
+			if err != nil {
+				t.Fatalf("EvaluateAlertRule() error = %v", err)
+			}
+			if got != tt.want {
+				t.Errorf("EvaluateAlertRule() = %+v, want %+v", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestAlertService_EvaluateAlertRule_RepositoryError(t *testing.T) {
+	repo := &mockOverallQualityScoreRepository{overallScoreError: errors.New("database error")}
+	rule := models.AlertRule{Metric: models.AlertMetricQualityScore, Window: time.Hour, Comparator: models.AlertComparatorLessThan}
+
+	if _, err := EvaluateAlertRule(context.Background(), repo, rule, time.Now()); err == nil {
+		t.Error("Expected error, got nil")
+	}
+}
+
+func TestAlertService_NextAlertState(t *testing.T) {
+	start := time.Date(2025, 3, 1, 12, 0, 0, 0, time.UTC)
+	later := start.Add(time.Minute)
+
+	// A rule never evaluated starts OK, so only a breach is a transition
+	state, changed := NextAlertState(7, nil, AlertEvaluation{Value: 40, Sample: 10, Sufficient: true}, start)
+	if changed || state.Status != models.AlertStatusOK || !state.ChangedAt.Equal(start) {
+		t.Errorf("First healthy evaluation: got %+v, changed %v", state, changed)
+	}
+
+	state, changed = NextAlertState(7, nil, AlertEvaluation{Value: 20, Sample: 10, Sufficient: true, Breached: true}, start)
+	if !changed || state.Status != models.AlertStatusFiring || state.RuleID != 7 || !state.NotifyPending {
+		t.Fatalf("First breached evaluation: got %+v, changed %v", state, changed)
+	}
+
+	// Still breached: no transition, ChangedAt stays put
+	firing := state
+	state, changed = NextAlertState(7, &firing, AlertEvaluation{Value: 18, Sample: 12, Sufficient: true, Breached: true}, later)
+	if changed || state.Status != models.AlertStatusFiring || !state.ChangedAt.Equal(start) || !state.EvaluatedAt.Equal(later) {
+		t.Errorf("Repeated breach: got %+v, changed %v", state, changed)
+	}
+	if state.Value != 18 || state.Sample != 12 {
+		t.Errorf("Expected latest value and sample to be recorded, got %+v", state)
+	}
+	// An undelivered notification stays pending
+	if !state.NotifyPending {
+		t.Errorf("Expected the pending notification to carry over, got %+v", state)
+	}
+	firing.NotifyPending = false
+
+	// Too few ratings: the rule keeps firing rather than resolving on thin data
+	state, changed = NextAlertState(7, &firing, AlertEvaluation{Value: 50, Sample: 1}, later)
+	if changed || state.Status != models.AlertStatusFiring || state.Sufficient {
+		t.Errorf("Insufficient sample: got %+v, changed %v", state, changed)
+	}
+
+	state, changed = NextAlertState(7, &firing, AlertEvaluation{Value: 50, Sample: 10, Sufficient: true}, later)
+	if !changed || state.Status != models.AlertStatusOK || !state.ChangedAt.Equal(later) || !state.NotifyPending {
+		t.Errorf("Recovery: got %+v, changed %v", state, changed)
+	}
+}
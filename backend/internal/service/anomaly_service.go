@@ -0,0 +1,174 @@
+package service
+
+import (
+	"context"
+	"math"
+	"sort"
+	"time"
+
+	"go-grpc-backend/internal/models"
+	"go-grpc-backend/internal/repository"
+	"go-grpc-backend/proto"
+
+	"google.golang.org/protobuf/types/known/timestamppb"
+)
+
+const (
+	// DefaultAnomalyThreshold is the robust z-score a day must reach to be flagged,
+	// the usual cut-off for the modified z-score
+	DefaultAnomalyThreshold = 3.5
+	// MinAnomalyHistory is the number of days with ratings a series needs before it is checked
+	MinAnomalyHistory = 7
+
+	// anomalyLevelWindow is how far either side of a day the local level looks
+	anomalyLevelWindow = 14 * 24 * time.Hour
+	// minSeasonalSamples is how many days a weekday needs for its own seasonal offset
+	minSeasonalSamples = 2
+	// madScale makes the median absolute deviation comparable to a standard deviation
+	madScale = 0.6745
+	// meanADScale does the same for the mean absolute deviation, used when the MAD is 0
+	meanADScale = 1.253314
+)
+
+// AnomalyOptions tunes GetAnomalies; the zero value uses the defaults
+type AnomalyOptions struct {
+	// Threshold is the robust z-score a day must reach to be flagged
+	Threshold float64
+	// MinRatings keeps days with fewer ratings from being flagged
+	MinRatings int
+}
+
+// GetAnomalies flags days whose overall or category score departs from the expected one.
+// Each series is decomposed into a local level (the median of the scores within two weeks either
+// side), a day-of-week offset (the median deviation from the level on that weekday) and a residual.
+// Residuals are scaled by their median absolute deviation, so a few bad days don't hide each other
+func GetAnomalies(ctx context.Context, repo repository.AnalyticsRepositoryInterface, rng models.DateRange, opts AnomalyOptions) (*proto.AnomaliesResponse, error) {
+	if opts.Threshold <= 0 {
+		opts.Threshold = DefaultAnomalyThreshold
+	}
+
+	rows, err := repo.GetDailyAggregatedCategoryRatings(ctx, rng)
+	if err != nil {
+		return nil, err
+	}
+
+	var anomalies []*proto.Anomaly
+	for _, series := range dailyScoreSeries(rows) {
+		anomalies = append(anomalies, detectAnomalies(series, opts)...)
+	}
+
+	// Series come overall first, then by category name; a stable sort by date keeps that order per day
+	sort.SliceStable(anomalies, func(i, j int) bool {
+		return anomalies[i].Date.AsTime().Before(anomalies[j].Date.AsTime())
+	})
+
+	return &proto.AnomaliesResponse{
+		Anomalies: anomalies,
+		Range:     dateRangeToProto(rng),
+		Threshold: opts.Threshold,
+	}, nil
+}
+
+// detectAnomalies checks every day of one series against its seasonal baseline
+func detectAnomalies(series scoreSeries, opts AnomalyOptions) []*proto.Anomaly {
+	points := series.points
+	if len(points) < MinAnomalyHistory {
+		return nil
+	}
+
+	levels := make([]float64, len(points))
+	for i, p := range points {
+		var window []float64
+		for _, q := range points {
+			if d := q.date.Sub(p.date); d >= -anomalyLevelWindow && d <= anomalyLevelWindow {
+				window = append(window, q.score)
+			}
+		}
+		levels[i] = median(window)
+	}
+
+	var byWeekday [7][]float64
+	for i, p := range points {
+		wd := p.date.Weekday()
+		byWeekday[wd] = append(byWeekday[wd], p.score-levels[i])
+	}
+	var seasonal [7]float64
+	for wd, deviations := range byWeekday {
+		if len(deviations) >= minSeasonalSamples {
+			seasonal[wd] = median(deviations)
+		}
+	}
+
+	expected := make([]float64, len(points))
+	residuals := make([]float64, len(points))
+	for i, p := range points {
+		expected[i] = levels[i] + seasonal[p.date.Weekday()]
+		residuals[i] = p.score - expected[i]
+	}
+	center := median(residuals)
+
+	deviations := make([]float64, len(residuals))
+	for i, r := range residuals {
+		deviations[i] = math.Abs(r - center)
+	}
+	// Robust z = (residual - center) / spread
+	spread := median(deviations) / madScale
+	if spread == 0 {
+		// More than half the days sit exactly on the baseline
+		var sum float64
+		for _, d := range deviations {
+			sum += d
+		}
+		spread = meanADScale * sum / float64(len(deviations))
+	}
+	if spread == 0 {
+		return nil
+	}
+
+	var anomalies []*proto.Anomaly
+	for i, p := range points {
+		if p.count < opts.MinRatings {
+			continue
+		}
+		z := (residuals[i] - center) / spread
+		severity := anomalySeverity(z, opts.Threshold)
+		if severity == proto.AnomalySeverity_ANOMALY_SEVERITY_UNSPECIFIED {
+			continue
+		}
+
+		direction := proto.AnomalyDirection_ANOMALY_DIRECTION_SPIKE
+		if z < 0 {
+			direction = proto.AnomalyDirection_ANOMALY_DIRECTION_DROP
+		}
+		anomaly := &proto.Anomaly{
+			Date:        timestamppb.New(p.date),
+			Overall:     series.overall,
+			Observed:    float32(p.score),
+			Expected:    float32(expected[i] + center),
+			RobustZ:     z,
+			Severity:    severity,
+			Direction:   direction,
+			RatingCount: int32(p.count),
+		}
+		if !series.overall {
+			anomaly.CategoryId = int32(series.categoryID)
+			anomaly.CategoryName = series.categoryName
+		}
+		anomalies = append(anomalies, anomaly)
+	}
+	return anomalies
+}
+
+// anomalySeverity grades a robust z-score; UNSPECIFIED means it is below the threshold
+func anomalySeverity(z, threshold float64) proto.AnomalySeverity {
+	switch abs := math.Abs(z); {
+	case abs >= 2*threshold:
+		return proto.AnomalySeverity_ANOMALY_SEVERITY_HIGH
+	case abs >= 1.5*threshold:
+		return proto.AnomalySeverity_ANOMALY_SEVERITY_MEDIUM
+	case abs >= threshold:
+		return proto.AnomalySeverity_ANOMALY_SEVERITY_LOW
+	default:
+		return proto.AnomalySeverity_ANOMALY_SEVERITY_UNSPECIFIED
+	}
+}
@@ -0,0 +1,127 @@
+package service
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+
+	"go-grpc-backend/internal/models"
+	"go-grpc-backend/proto"
+)
+
+// anomalyRatings is four weeks of Spelling from Monday 2025-01-06: weekdays score 80 or 82,
+// weekends 60, and Wednesday 2025-01-22 drops to 30 on 2 ratings.
+// Grammar is only rated in the first week, too short a history to be checked
+func anomalyRatings() []models.CategoryRatingOverTimePeriod {
+	drop := time.Date(2025, 1, 22, 0, 0, 0, 0, time.UTC)
+
+	var rows []models.CategoryRatingOverTimePeriod
+	for day := time.Date(2025, 1, 6, 0, 0, 0, 0, time.UTC); day.Before(time.Date(2025, 2, 3, 0, 0, 0, 0, time.UTC)); day = day.AddDate(0, 0, 1) {
+		avg, count := 4.0+0.1*float64(day.Day()%2), 10
+		switch {
+		case day.Equal(drop):
+			avg, count = 1.5, 2
+		case day.Weekday() == time.Saturday || day.Weekday() == time.Sunday:
+			avg = 3
+		}
+		rows = append(rows, models.CategoryRatingOverTimePeriod{CategoryID: 1, CategoryName: "Spelling", AvgPercent: avg, CategoryWeight: 1, RatingCount: count, Date: day})
+
+		if day.Before(time.Date(2025, 1, 11, 0, 0, 0, 0, time.UTC)) {
+			rows = append(rows, models.CategoryRatingOverTimePeriod{CategoryID: 2, CategoryName: "Grammar", AvgPercent: 4, CategoryWeight: 1, RatingCount: 5, Date: day})
+		}
+	}
+	return rows
+}
+
+func TestScoreService_GetAnomalies(t *testing.T) {
+	mockRepo := &mockCategoryScoresRepository{dailyRatings: anomalyRatings()}
+	rng := models.NewDateRange(time.Date(2025, 1, 6, 0, 0, 0, 0, time.UTC), time.Date(2025, 2, 3, 0, 0, 0, 0, time.UTC))
+
+	result, err := GetAnomalies(context.Background(), mockRepo, rng, AnomalyOptions{})
+	if err != nil {
+		t.Fatalf("GetAnomalies() error = %v", err)
+	}
+
+	if result.Threshold != DefaultAnomalyThreshold {
+		t.Errorf("Expected default threshold %v, got %v", DefaultAnomalyThreshold, result.Threshold)
+	}
+	// Weekends are low every week, so only the drop is flagged: in the overall series and in Spelling
+	if len(result.Anomalies) != 2 {
+		t.Fatalf("Expected 2 anomalies, got %v", result.Anomalies)
+	}
+	if !result.Anomalies[0].Overall || result.Anomalies[1].CategoryName != "Spelling" {
+		t.Errorf("Expected the overall anomaly before Spelling, got %v", result.Anomalies)
+	}
+
+	a := result.Anomalies[1]
+	if !a.Date.AsTime().Equal(time.Date(2025, 1, 22, 0, 0, 0, 0, time.UTC)) {
+		t.Errorf("Expected the anomaly on 2025-01-22, got %v", a.Date.AsTime())
+	}
+	if a.Observed != 30 || a.Expected < 79 || a.Expected > 83 {
+		t.Errorf("Expected observed 30 against about 81, got %v against %v", a.Observed, a.Expected)
+	}
+	if a.Direction != proto.AnomalyDirection_ANOMALY_DIRECTION_DROP || a.Severity != proto.AnomalySeverity_ANOMALY_SEVERITY_HIGH {
+		t.Errorf("Expected a HIGH drop, got %v %v", a.Severity, a.Direction)
+	}
+	if a.RobustZ >= -2*DefaultAnomalyThreshold || a.RatingCount != 2 {
+		t.Errorf("Expected robust z below %v over 2 ratings, got %v over %d", -2*DefaultAnomalyThreshold, a.RobustZ, a.RatingCount)
+	}
+}
+
+func TestScoreService_GetAnomalies_MinRatings(t *testing.T) {
+	mockRepo := &mockCategoryScoresRepository{dailyRatings: anomalyRatings()}
+	rng := models.NewDateRange(time.Date(2025, 1, 6, 0, 0, 0, 0, time.UTC), time.Date(2025, 2, 3, 0, 0, 0, 0, time.UTC))
+
+	result, err := GetAnomalies(context.Background(), mockRepo, rng, AnomalyOptions{MinRatings: 5})
+	if err != nil {
+		t.Fatalf("GetAnomalies() error = %v", err)
+	}
+	if len(result.Anomalies) != 0 {
+		t.Errorf("Expected the 2 rating day to be skipped, got %v", result.Anomalies)
+	}
+}
+
+func TestScoreService_GetAnomalies_ShortHistory(t *testing.T) {
+	// Six days: one short of MinAnomalyHistory, although the weekend would stand out
+	var rows []models.CategoryRatingOverTimePeriod
+	for _, r := range anomalyRatings() {
+		if r.Date.Before(time.Date(2025, 1, 12, 0, 0, 0, 0, time.UTC)) {
+			rows = append(rows, r)
+		}
+	}
+	mockRepo := &mockCategoryScoresRepository{dailyRatings: rows}
+
+	result, err := GetAnomalies(context.Background(), mockRepo, models.NewDateRange(time.Date(2025, 1, 6, 0, 0, 0, 0, time.UTC), time.Date(2025, 1, 13, 0, 0, 0, 0, time.UTC)), AnomalyOptions{})
+	if err != nil {
+		t.Fatalf("GetAnomalies() error = %v", err)
+	}
+	if len(result.Anomalies) != 0 {
+		t.Errorf("Expected no anomalies with fewer than %d days, got %v", MinAnomalyHistory, result.Anomalies)
+	}
+}
+
+func TestScoreService_GetAnomalies_Error(t *testing.T) {
+	mockRepo := &mockCategoryScoresRepository{dailyRatingsError: errors.New("database error")}
+
+	if _, err := GetAnomalies(context.Background(), mockRepo, models.NewDateRange(time.Now().Add(-time.Hour), time.Now()), AnomalyOptions{}); err == nil {
+		t.Error("Expected error, got nil")
+	}
+}
+
+func TestScoreService_AnomalySeverity(t *testing.T) {
+	tests := []struct {
+		z        float64
+		expected proto.AnomalySeverity
+	}{
+		{3, proto.AnomalySeverity_ANOMALY_SEVERITY_UNSPECIFIED},
+		{-4, proto.AnomalySeverity_ANOMALY_SEVERITY_LOW},
+		{5.25, proto.AnomalySeverity_ANOMALY_SEVERITY_MEDIUM},
+		{-7, proto.AnomalySeverity_ANOMALY_SEVERITY_HIGH},
+	}
+	for _, tt := range tests {
+		if got := anomalySeverity(tt.z, 3.5); got != tt.expected {
+			t.Errorf("anomalySeverity(%v) = %v, expected %v", tt.z, got, tt.expected)
+		}
+	}
+}
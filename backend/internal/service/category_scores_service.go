@@ -1,6 +1,7 @@
 package service
 
 import (
+	"context"
 	"sort"
 	"time"
 
@@ -12,20 +13,42 @@ import (
 	"google.golang.org/protobuf/types/known/wrapperspb"
 )
 
+// DefaultWeeklyThreshold is the longest range still aggregated by day
+const DefaultWeeklyThreshold = 30 * 24 * time.Hour
+
+// AggregationOptions tunes GetAggregatedCategoryScores; the zero value uses the defaults
+type AggregationOptions struct {
+	// WeeklyThreshold switches to weekly buckets for ranges longer than this
+	WeeklyThreshold time.Duration
+	// Fill selects how buckets without ratings are reported
+	Fill proto.FillMode
+	// Confidence attaches a ScoreConfidence to observed category points
+	Confidence ConfidenceOptions
+	// Smoothing adds smoothed copies of every series
+	Smoothing SmoothingOptions
+}
+
 // GetAggregatedCategoryScores retrieves and aggregates category scores over time
 // It automatically selects daily or weekly granularity based on the date range
-func GetAggregatedCategoryScores(repo repository.AnalyticsRepositoryInterface, startDate, endDate time.Time) (*proto.AggregatedCategoryScoresResponse, error) {
-	duration := endDate.Sub(startDate)
-	useWeekly := duration > 30*24*time.Hour
+// Besides the per-category series it returns an overall series whose points use the
+// GetOverallQualityScore formula over the categories rated in each bucket
+// Unless opts.Fill omits empty buckets, every series covers the same bucket grid
+func GetAggregatedCategoryScores(ctx context.Context, repo repository.AnalyticsRepositoryInterface, rng models.DateRange, opts AggregationOptions) (*proto.AggregatedCategoryScoresResponse, error) {
+	threshold := opts.WeeklyThreshold
+	if threshold <= 0 {
+		threshold = DefaultWeeklyThreshold
+	}
+
+	useWeekly := rng.Duration() > threshold
 
 	var (
 		rows []models.CategoryRatingOverTimePeriod
 		err  error
 	)
 	if useWeekly {
-		rows, err = repo.GetWeeklyAggregatedCategoryRatings(startDate, endDate)
+		rows, err = repo.GetWeeklyAggregatedCategoryRatings(ctx, rng)
 	} else {
-		rows, err = repo.GetDailyAggregatedCategoryRatings(startDate, endDate)
+		rows, err = repo.GetDailyAggregatedCategoryRatings(ctx, rng)
 	}
 	if err != nil {
 		return nil, err
@@ -33,6 +56,11 @@ func GetAggregatedCategoryScores(repo repository.AnalyticsRepositoryInterface, s
 
 	// Group by category → collect series slice
 	byCat := make(map[int32]*proto.CategorySeries)
+	// Per category: sum of weighted ratings (avg * weight * count), to derive the period score.
+	// The weight is applied per bucket since it can change within the period
+	weightedSums := make(map[int32]float64)
+	// Per bucket: the category averages that feed the overall series
+	byBucket := make(map[time.Time][]models.CategoryScore)
 	for _, r := range rows {
 		cid := int32(r.CategoryID)
 
@@ -49,21 +77,54 @@ func GetAggregatedCategoryScores(repo repository.AnalyticsRepositoryInterface, s
 
 		score := CalculateCategoryScore(r.AvgPercent, r.CategoryWeight)
 		series.Scores = append(series.Scores, &proto.ScorePoint{
-			Date:  timestamppb.New(r.Date),
-			Score: float32(score),
-			Count: wrapperspb.Int32(int32(r.RatingCount)),
+			Date:       timestamppb.New(r.Date),
+			Score:      float32(score),
+			Count:      wrapperspb.Int32(int32(r.RatingCount)),
+			Confidence: scoreConfidence(r.AvgPercent, r.RatingVariance, r.RatingCount, r.CategoryWeight, opts.Confidence),
 		})
 		series.CategoryTotalCount += int32(r.RatingCount)
+
+		weightedSums[cid] += r.AvgPercent * r.CategoryWeight * float64(r.RatingCount)
+		byBucket[r.Date] = append(byBucket[r.Date], models.CategoryScore{
+			CategoryID:     r.CategoryID,
+			CategoryName:   r.CategoryName,
+			CategoryWeight: r.CategoryWeight,
+			Score:          r.AvgPercent,
+			RatingCount:    r.RatingCount,
+		})
 	}
 
+	grid := bucketGrid(rng, useWeekly)
+
 	categories := make([]*proto.CategorySeries, 0, len(byCat))
-	for _, s := range byCat {
+	for cid, s := range byCat {
 		sort.Slice(s.Scores, func(i, j int) bool {
 			return s.Scores[i].Date.AsTime().Before(s.Scores[j].Date.AsTime())
 		})
+		s.Scores = fillSeries(s.Scores, grid, opts.Fill)
+		s.Smoothed = smoothSeries(s.Scores, bucketDays(useWeekly), opts.Smoothing)
+		// Average over every rating in the period, same as GetOverallQualityScore's per-category average
+		if s.CategoryTotalCount > 0 {
+			weightedAvg := weightedSums[cid] / float64(s.CategoryTotalCount)
+			s.PeriodScore = float32(CalculateCategoryScore(weightedAvg, 1))
+		}
 		categories = append(categories, s)
 	}
 
+	overall := make([]*proto.ScorePoint, 0, len(byBucket))
+	for bucket, categoryScores := range byBucket {
+		score, count := CalculateOverallScore(categoryScores)
+		overall = append(overall, &proto.ScorePoint{
+			Date:  timestamppb.New(bucket),
+			Score: float32(score),
+			Count: wrapperspb.Int32(int32(count)),
+		})
+	}
+	sort.Slice(overall, func(i, j int) bool {
+		return overall[i].Date.AsTime().Before(overall[j].Date.AsTime())
+	})
+	overall = fillSeries(overall, grid, opts.Fill)
+
 	gran := proto.Granularity_GRANULARITY_DAY
 	if useWeekly {
 		gran = proto.Granularity_GRANULARITY_WEEK
@@ -72,10 +133,13 @@ func GetAggregatedCategoryScores(repo repository.AnalyticsRepositoryInterface, s
 	resp := &proto.AggregatedCategoryScoresResponse{
 		Granularity: gran,
 		BucketRange: &proto.BucketRange{
-			Start: timestamppb.New(startDate),
-			End:   timestamppb.New(endDate),
+			Start: timestamppb.New(rng.Start),
+			End:   timestamppb.New(rng.End),
 		},
-		Categories: categories,
+		Categories:      categories,
+		Range:           dateRangeToProto(rng),
+		OverallScores:   overall,
+		OverallSmoothed: smoothSeries(overall, bucketDays(useWeekly), opts.Smoothing),
 	}
 	return resp, nil
 }
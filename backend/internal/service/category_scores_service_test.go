@@ -1,6 +1,7 @@
 package service
 
 import (
+	"context"
 	"errors"
 	"testing"
 	"time"
@@ -17,25 +18,33 @@ type mockCategoryScoresRepository struct {
 	weeklyRatingsError error
 }
 
-func (m *mockCategoryScoresRepository) GetDailyAggregatedCategoryRatings(startDate, endDate time.Time) ([]models.CategoryRatingOverTimePeriod, error) {
+func (m *mockCategoryScoresRepository) GetDailyAggregatedCategoryRatings(ctx context.Context, rng models.DateRange) ([]models.CategoryRatingOverTimePeriod, error) {
 	if m.dailyRatingsError != nil {
 		return nil, m.dailyRatingsError
 	}
 	return m.dailyRatings, nil
 }
 
-func (m *mockCategoryScoresRepository) GetWeeklyAggregatedCategoryRatings(startDate, endDate time.Time) ([]models.CategoryRatingOverTimePeriod, error) {
+func (m *mockCategoryScoresRepository) GetWeeklyAggregatedCategoryRatings(ctx context.Context, rng models.DateRange) ([]models.CategoryRatingOverTimePeriod, error) {
 	if m.weeklyRatingsError != nil {
 		return nil, m.weeklyRatingsError
 	}
 	return m.weeklyRatings, nil
 }
 
-func (m *mockCategoryScoresRepository) GetScoresByTicket(startDate, endDate time.Time) ([]models.TicketCategoryScore, error) {
+func (m *mockCategoryScoresRepository) GetScoresByTicket(ctx context.Context, rng models.DateRange) ([]models.TicketCategoryScore, error) {
 	return nil, nil
 }
 
-func (m *mockCategoryScoresRepository) GetOverallQualityScore(startDate, endDate time.Time) ([]models.CategoryScore, error) {
+func (m *mockCategoryScoresRepository) GetOverallQualityScore(ctx context.Context, rng models.DateRange) ([]models.CategoryScore, error) {
+	return nil, nil
+}
+
+func (m *mockCategoryScoresRepository) GetRatingDistribution(ctx context.Context, rng models.DateRange, granularity models.Granularity) ([]models.RatingDistribution, error) {
+	return nil, nil
+}
+
+func (m *mockCategoryScoresRepository) GetCategoryScoresByPeriod(ctx context.Context, periods []models.DateRange) ([]models.PeriodCategoryScore, error) {
 	return nil, nil
 }
 
@@ -73,7 +82,7 @@ func TestScoreService_GetAggregatedCategoryScores_DailyGranularity(t *testing.T)
 		},
 	}
 
-	result, err := GetAggregatedCategoryScores(mockRepo, startDate, endDate)
+	result, err := GetAggregatedCategoryScores(context.Background(), mockRepo, models.NewDateRange(startDate, endDate), AggregationOptions{})
 
 	if err != nil {
 		t.Fatalf("GetAggregatedCategoryScores() error = %v", err)
@@ -156,7 +165,7 @@ func TestScoreService_GetAggregatedCategoryScores_WeeklyGranularity(t *testing.T
 		},
 	}
 
-	result, err := GetAggregatedCategoryScores(mockRepo, startDate, endDate)
+	result, err := GetAggregatedCategoryScores(context.Background(), mockRepo, models.NewDateRange(startDate, endDate), AggregationOptions{})
 
 	if err != nil {
 		t.Fatalf("GetAggregatedCategoryScores() error = %v", err)
@@ -228,7 +237,7 @@ func TestScoreService_GetAggregatedCategoryScores_MultipleCategories(t *testing.
 		},
 	}
 
-	result, err := GetAggregatedCategoryScores(mockRepo, startDate, endDate)
+	result, err := GetAggregatedCategoryScores(context.Background(), mockRepo, models.NewDateRange(startDate, endDate), AggregationOptions{})
 
 	if err != nil {
 		t.Fatalf("GetAggregatedCategoryScores() error = %v", err)
@@ -275,7 +284,7 @@ func TestScoreService_GetAggregatedCategoryScores_ScoreCalculation(t *testing.T)
 		},
 	}
 
-	result, err := GetAggregatedCategoryScores(mockRepo, startDate, endDate)
+	result, err := GetAggregatedCategoryScores(context.Background(), mockRepo, models.NewDateRange(startDate, endDate), AggregationOptions{})
 
 	if err != nil {
 		t.Fatalf("GetAggregatedCategoryScores() error = %v", err)
@@ -313,7 +322,7 @@ func TestScoreService_GetAggregatedCategoryScores_EmptyResults(t *testing.T) {
 		dailyRatings: []models.CategoryRatingOverTimePeriod{},
 	}
 
-	result, err := GetAggregatedCategoryScores(mockRepo, startDate, endDate)
+	result, err := GetAggregatedCategoryScores(context.Background(), mockRepo, models.NewDateRange(startDate, endDate), AggregationOptions{})
 
 	if err != nil {
 		t.Fatalf("GetAggregatedCategoryScores() error = %v", err)
@@ -340,7 +349,7 @@ func TestScoreService_GetAggregatedCategoryScores_DailyError(t *testing.T) {
 		dailyRatingsError: expectedError,
 	}
 
-	result, err := GetAggregatedCategoryScores(mockRepo, startDate, endDate)
+	result, err := GetAggregatedCategoryScores(context.Background(), mockRepo, models.NewDateRange(startDate, endDate), AggregationOptions{})
 
 	if err == nil {
 		t.Fatal("Expected error, got nil")
@@ -365,7 +374,7 @@ func TestScoreService_GetAggregatedCategoryScores_WeeklyError(t *testing.T) {
 		weeklyRatingsError: expectedError,
 	}
 
-	result, err := GetAggregatedCategoryScores(mockRepo, startDate, endDate)
+	result, err := GetAggregatedCategoryScores(context.Background(), mockRepo, models.NewDateRange(startDate, endDate), AggregationOptions{})
 
 	if err == nil {
 		t.Fatal("Expected error, got nil")
@@ -415,7 +424,7 @@ func TestScoreService_GetAggregatedCategoryScores_SortingByDate(t *testing.T) {
 		},
 	}
 
-	result, err := GetAggregatedCategoryScores(mockRepo, startDate, endDate)
+	result, err := GetAggregatedCategoryScores(context.Background(), mockRepo, models.NewDateRange(startDate, endDate), AggregationOptions{})
 
 	if err != nil {
 		t.Fatalf("GetAggregatedCategoryScores() error = %v", err)
@@ -496,7 +505,7 @@ func TestScoreService_GetAggregatedCategoryScores_ThresholdBoundary(t *testing.T
 				weeklyRatings: []models.CategoryRatingOverTimePeriod{},
 			}
 
-			result, err := GetAggregatedCategoryScores(mockRepo, startDate, endDate)
+			result, err := GetAggregatedCategoryScores(context.Background(), mockRepo, models.NewDateRange(startDate, endDate), AggregationOptions{})
 
 			if err != nil {
 				t.Fatalf("GetAggregatedCategoryScores() error = %v", err)
@@ -518,7 +527,7 @@ func TestScoreService_GetAggregatedCategoryScores_NoData(t *testing.T) {
 		dailyRatings: []models.CategoryRatingOverTimePeriod{},
 	}
 
-	result, err := GetAggregatedCategoryScores(mockRepo, startDate, endDate)
+	result, err := GetAggregatedCategoryScores(context.Background(), mockRepo, models.NewDateRange(startDate, endDate), AggregationOptions{})
 
 	if err != nil {
 		t.Fatalf("GetAggregatedCategoryScores() error = %v, expected nil", err)
@@ -574,7 +583,7 @@ func TestScoreService_GetAggregatedCategoryScores_SingleScore(t *testing.T) {
 		},
 	}
 
-	result, err := GetAggregatedCategoryScores(mockRepo, startDate, endDate)
+	result, err := GetAggregatedCategoryScores(context.Background(), mockRepo, models.NewDateRange(startDate, endDate), AggregationOptions{})
 
 	if err != nil {
 		t.Fatalf("GetAggregatedCategoryScores() error = %v", err)
@@ -677,7 +686,7 @@ func TestScoreService_GetAggregatedCategoryScores_MultipleScores(t *testing.T) {
 		},
 	}
 
-	result, err := GetAggregatedCategoryScores(mockRepo, startDate, endDate)
+	result, err := GetAggregatedCategoryScores(context.Background(), mockRepo, models.NewDateRange(startDate, endDate), AggregationOptions{})
 
 	if err != nil {
 		t.Fatalf("GetAggregatedCategoryScores() error = %v", err)
@@ -812,3 +821,269 @@ func TestScoreService_GetAggregatedCategoryScores_MultipleScores(t *testing.T) {
 		t.Errorf("Expected GRANULARITY_DAY, got %v", result.Granularity)
 	}
 }
+
+func TestScoreService_GetAggregatedCategoryScores_CustomThreshold(t *testing.T) {
+	// 10 days is daily by default but weekly with a 7 day threshold
+	startDate := time.Date(2025, 1, 1, 0, 0, 0, 0, time.UTC)
+	endDate := time.Date(2025, 1, 11, 0, 0, 0, 0, time.UTC)
+
+	mockRepo := &mockCategoryScoresRepository{}
+
+	result, err := GetAggregatedCategoryScores(context.Background(), mockRepo, models.NewDateRange(startDate, endDate), AggregationOptions{WeeklyThreshold: 7 * 24 * time.Hour})
+	if err != nil {
+		t.Fatalf("GetAggregatedCategoryScores() error = %v", err)
+	}
+
+	if result.Granularity != proto.Granularity_GRANULARITY_WEEK {
+		t.Errorf("Expected GRANULARITY_WEEK, got %v", result.Granularity)
+	}
+}
+
+func TestScoreService_GetAggregatedCategoryScores_OverallSeries(t *testing.T) {
+	startDate := time.Date(2025, 1, 1, 0, 0, 0, 0, time.UTC)
+	endDate := time.Date(2025, 1, 8, 0, 0, 0, 0, time.UTC)
+	day1 := time.Date(2025, 1, 1, 0, 0, 0, 0, time.UTC)
+	day2 := time.Date(2025, 1, 2, 0, 0, 0, 0, time.UTC)
+
+	mockRepo := &mockCategoryScoresRepository{
+		dailyRatings: []models.CategoryRatingOverTimePeriod{
+			{CategoryID: 1, CategoryName: "Spelling", AvgPercent: 4, CategoryWeight: 1, RatingCount: 1, Date: day2},
+			{CategoryID: 1, CategoryName: "Spelling", AvgPercent: 2, CategoryWeight: 1, RatingCount: 3, Date: day1},
+			{CategoryID: 2, CategoryName: "Grammar", AvgPercent: 5, CategoryWeight: 0.5, RatingCount: 2, Date: day1},
+		},
+	}
+
+	result, err := GetAggregatedCategoryScores(context.Background(), mockRepo, models.NewDateRange(startDate, endDate), AggregationOptions{})
+	if err != nil {
+		t.Fatalf("GetAggregatedCategoryScores() error = %v", err)
+	}
+
+	// Day 1 averages both categories, day 2 only has Spelling
+	expected := []struct {
+		date  time.Time
+		score float32
+		count int32
+	}{
+		{day1, float32((2*1*RATING_TO_PERCENT_MODIFICATOR + 5*0.5*RATING_TO_PERCENT_MODIFICATOR) / 2), 5},
+		{day2, float32(4 * 1 * RATING_TO_PERCENT_MODIFICATOR), 1},
+	}
+
+	if len(result.OverallScores) != len(expected) {
+		t.Fatalf("Expected %d overall points, got %d", len(expected), len(result.OverallScores))
+	}
+	for i, e := range expected {
+		p := result.OverallScores[i]
+		if !p.Date.AsTime().Equal(e.date) || p.Score != e.score || p.Count.GetValue() != e.count {
+			t.Errorf("Overall point %d: expected %v %v (%d), got %v %v (%d)",
+				i, e.date, e.score, e.count, p.Date.AsTime(), p.Score, p.Count.GetValue())
+		}
+	}
+}
+
+func TestScoreService_GetAggregatedCategoryScores_PeriodScore(t *testing.T) {
+	startDate := time.Date(2025, 1, 1, 0, 0, 0, 0, time.UTC)
+	endDate := time.Date(2025, 1, 8, 0, 0, 0, 0, time.UTC)
+
+	mockRepo := &mockCategoryScoresRepository{
+		dailyRatings: []models.CategoryRatingOverTimePeriod{
+			{CategoryID: 1, CategoryName: "Spelling", AvgPercent: 2, CategoryWeight: 1, RatingCount: 3, Date: time.Date(2025, 1, 1, 0, 0, 0, 0, time.UTC)},
+			{CategoryID: 1, CategoryName: "Spelling", AvgPercent: 4, CategoryWeight: 1, RatingCount: 1, Date: time.Date(2025, 1, 2, 0, 0, 0, 0, time.UTC)},
+		},
+	}
+
+	result, err := GetAggregatedCategoryScores(context.Background(), mockRepo, models.NewDateRange(startDate, endDate), AggregationOptions{})
+	if err != nil {
+		t.Fatalf("GetAggregatedCategoryScores() error = %v", err)
+	}
+
+	// Weighted by rating count: (2*3 + 4*1) / 4 = 2.5, not the mean of bucket averages (3)
+	expected := float32(CalculateCategoryScore(2.5, 1))
+	if got := result.Categories[0].PeriodScore; got != expected {
+		t.Errorf("Expected period score %v, got %v", expected, got)
+	}
+}
+
+func TestScoreService_GetAggregatedCategoryScores_PeriodScoreWeightChange(t *testing.T) {
+	startDate := time.Date(2025, 1, 1, 0, 0, 0, 0, time.UTC)
+	endDate := time.Date(2025, 1, 8, 0, 0, 0, 0, time.UTC)
+
+	// The category's weight went from 1 to 2 between the two days
+	mockRepo := &mockCategoryScoresRepository{
+		dailyRatings: []models.CategoryRatingOverTimePeriod{
+			{CategoryID: 1, CategoryName: "Spelling", AvgPercent: 2, CategoryWeight: 1, RatingCount: 3, Date: time.Date(2025, 1, 1, 0, 0, 0, 0, time.UTC)},
+			{CategoryID: 1, CategoryName: "Spelling", AvgPercent: 4, CategoryWeight: 2, RatingCount: 1, Date: time.Date(2025, 1, 2, 0, 0, 0, 0, time.UTC)},
+		},
+	}
+
+	result, err := GetAggregatedCategoryScores(context.Background(), mockRepo, models.NewDateRange(startDate, endDate), AggregationOptions{})
+	if err != nil {
+		t.Fatalf("GetAggregatedCategoryScores() error = %v", err)
+	}
+
+	// Each day keeps its own weight: (2*1*3 + 4*2*1) / 4 = 3.5
+	expected := float32(CalculateCategoryScore(3.5, 1))
+	if got := result.Categories[0].PeriodScore; got != expected {
+		t.Errorf("Expected period score %v, got %v", expected, got)
+	}
+}
+
+func TestScoreService_GetAggregatedCategoryScores_FillModes(t *testing.T) {
+	startDate := time.Date(2025, 1, 1, 0, 0, 0, 0, time.UTC)
+	endDate := time.Date(2025, 1, 6, 0, 0, 0, 0, time.UTC)
+
+	// Spelling is rated on Jan 2 and Jan 4 only, so Jan 1, 3 and 5 are gaps
+	mockRepo := &mockCategoryScoresRepository{
+		dailyRatings: []models.CategoryRatingOverTimePeriod{
+			{CategoryID: 1, CategoryName: "Spelling", AvgPercent: 2, CategoryWeight: 1, RatingCount: 1, Date: time.Date(2025, 1, 2, 0, 0, 0, 0, time.UTC)},
+			{CategoryID: 1, CategoryName: "Spelling", AvgPercent: 4, CategoryWeight: 1, RatingCount: 2, Date: time.Date(2025, 1, 4, 0, 0, 0, 0, time.UTC)},
+		},
+	}
+
+	const (
+		observed     = proto.ScorePointSource_SCORE_POINT_SOURCE_OBSERVED
+		null         = proto.ScorePointSource_SCORE_POINT_SOURCE_NULL
+		carried      = proto.ScorePointSource_SCORE_POINT_SOURCE_CARRIED
+		interpolated = proto.ScorePointSource_SCORE_POINT_SOURCE_INTERPOLATED
+	)
+
+	tests := []struct {
+		mode    proto.FillMode
+		scores  []float32
+		sources []proto.ScorePointSource
+	}{
+		{proto.FillMode_FILL_MODE_OMIT, []float32{40, 80}, []proto.ScorePointSource{observed, observed}},
+		{proto.FillMode_FILL_MODE_NULL, []float32{0, 40, 0, 80, 0}, []proto.ScorePointSource{null, observed, null, observed, null}},
+		{proto.FillMode_FILL_MODE_CARRY_FORWARD, []float32{0, 40, 40, 80, 80}, []proto.ScorePointSource{null, observed, carried, observed, carried}},
+		{proto.FillMode_FILL_MODE_LINEAR, []float32{0, 40, 60, 80, 0}, []proto.ScorePointSource{null, observed, interpolated, observed, null}},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.mode.String(), func(t *testing.T) {
+			result, err := GetAggregatedCategoryScores(context.Background(), mockRepo, models.NewDateRange(startDate, endDate), AggregationOptions{Fill: tt.mode})
+			if err != nil {
+				t.Fatalf("GetAggregatedCategoryScores() error = %v", err)
+			}
+
+			for _, series := range [][]*proto.ScorePoint{result.Categories[0].Scores, result.OverallScores} {
+				if len(series) != len(tt.scores) {
+					t.Fatalf("Expected %d points, got %d", len(tt.scores), len(series))
+				}
+				for i, p := range series {
+					if p.Score != tt.scores[i] || p.Source != tt.sources[i] {
+						t.Errorf("Point %d: expected %v (%v), got %v (%v)", i, tt.scores[i], tt.sources[i], p.Score, p.Source)
+					}
+					if p.Source != observed && p.Count.GetValue() != 0 {
+						t.Errorf("Point %d: expected count 0 for filled bucket, got %d", i, p.Count.GetValue())
+					}
+				}
+				if tt.mode != proto.FillMode_FILL_MODE_OMIT && !series[0].Date.AsTime().Equal(startDate) {
+					t.Errorf("Expected grid to start at %v, got %v", startDate, series[0].Date.AsTime())
+				}
+			}
+		})
+	}
+}
+
+func TestScoreService_GetAggregatedCategoryScores_FillAlignsCategories(t *testing.T) {
+	// 62 days is weekly; weeks start on Monday, so the grid starts on 2024-12-30
+	startDate := time.Date(2025, 1, 1, 0, 0, 0, 0, time.UTC)
+	endDate := time.Date(2025, 3, 4, 0, 0, 0, 0, time.UTC)
+
+	mockRepo := &mockCategoryScoresRepository{
+		weeklyRatings: []models.CategoryRatingOverTimePeriod{
+			{CategoryID: 1, CategoryName: "Spelling", AvgPercent: 4, CategoryWeight: 1, RatingCount: 1, Date: time.Date(2025, 1, 6, 0, 0, 0, 0, time.UTC)},
+			{CategoryID: 2, CategoryName: "Grammar", AvgPercent: 3, CategoryWeight: 1, RatingCount: 1, Date: time.Date(2025, 2, 24, 0, 0, 0, 0, time.UTC)},
+		},
+	}
+
+	result, err := GetAggregatedCategoryScores(context.Background(), mockRepo, models.NewDateRange(startDate, endDate), AggregationOptions{Fill: proto.FillMode_FILL_MODE_NULL})
+	if err != nil {
+		t.Fatalf("GetAggregatedCategoryScores() error = %v", err)
+	}
+
+	firstWeek := time.Date(2024, 12, 30, 0, 0, 0, 0, time.UTC)
+	for _, cat := range result.Categories {
+		if len(cat.Scores) != 10 {
+			t.Fatalf("Expected 10 weekly points for %s, got %d", cat.CategoryName, len(cat.Scores))
+		}
+		for i, p := range cat.Scores {
+			if expected := firstWeek.AddDate(0, 0, 7*i); !p.Date.AsTime().Equal(expected) {
+				t.Errorf("%s point %d: expected %v, got %v", cat.CategoryName, i, expected, p.Date.AsTime())
+			}
+		}
+	}
+}
+
+func TestScoreService_GetAggregatedCategoryScores_Confidence(t *testing.T) {
+	startDate := time.Date(2025, 1, 1, 0, 0, 0, 0, time.UTC)
+	endDate := time.Date(2025, 1, 4, 0, 0, 0, 0, time.UTC)
+
+	mockRepo := &mockCategoryScoresRepository{
+		dailyRatings: []models.CategoryRatingOverTimePeriod{
+			{CategoryID: 1, CategoryName: "Spelling", AvgPercent: 4, CategoryWeight: 1, RatingCount: 2, RatingVariance: 1, Date: time.Date(2025, 1, 1, 0, 0, 0, 0, time.UTC)},
+			{CategoryID: 1, CategoryName: "Spelling", AvgPercent: 4, CategoryWeight: 1, RatingCount: 40, RatingVariance: 1, Date: time.Date(2025, 1, 3, 0, 0, 0, 0, time.UTC)},
+		},
+	}
+
+	opts := AggregationOptions{
+		Fill:       proto.FillMode_FILL_MODE_NULL,
+		Confidence: ConfidenceOptions{Enabled: true, MinSampleSize: 5},
+	}
+	result, err := GetAggregatedCategoryScores(context.Background(), mockRepo, models.NewDateRange(startDate, endDate), opts)
+	if err != nil {
+		t.Fatalf("GetAggregatedCategoryScores() error = %v", err)
+	}
+
+	points := result.Categories[0].Scores
+	if len(points) != 3 {
+		t.Fatalf("Expected 3 points, got %d", len(points))
+	}
+	if !points[0].Confidence.GetLowSample() || points[2].Confidence.GetLowSample() {
+		t.Errorf("Expected only the 2 rating bucket to be low_sample, got %v and %v", points[0].Confidence, points[2].Confidence)
+	}
+	if points[1].Confidence != nil {
+		t.Errorf("Expected no confidence on a filled bucket, got %v", points[1].Confidence)
+	}
+	if points[0].Confidence.Upper-points[0].Confidence.Lower <= points[2].Confidence.Upper-points[2].Confidence.Lower {
+		t.Errorf("Expected a wider interval for 2 ratings than for 40")
+	}
+}
+
+func TestScoreService_GetAggregatedCategoryScores_Smoothing(t *testing.T) {
+	startDate := time.Date(2025, 1, 1, 0, 0, 0, 0, time.UTC)
+	endDate := time.Date(2025, 1, 4, 0, 0, 0, 0, time.UTC)
+
+	mockRepo := &mockCategoryScoresRepository{
+		dailyRatings: []models.CategoryRatingOverTimePeriod{
+			{CategoryID: 1, CategoryName: "Spelling", AvgPercent: 2, CategoryWeight: 1, RatingCount: 1, Date: time.Date(2025, 1, 1, 0, 0, 0, 0, time.UTC)},
+			{CategoryID: 1, CategoryName: "Spelling", AvgPercent: 4, CategoryWeight: 1, RatingCount: 3, Date: time.Date(2025, 1, 3, 0, 0, 0, 0, time.UTC)},
+		},
+	}
+
+	opts := AggregationOptions{Smoothing: SmoothingOptions{Cumulative: true}}
+	result, err := GetAggregatedCategoryScores(context.Background(), mockRepo, models.NewDateRange(startDate, endDate), opts)
+	if err != nil {
+		t.Fatalf("GetAggregatedCategoryScores() error = %v", err)
+	}
+
+	// The last cumulative point is the period score
+	for name, smoothed := range map[string]*proto.SmoothedSeries{"category": result.Categories[0].Smoothed, "overall": result.OverallSmoothed} {
+		if smoothed == nil || len(smoothed.Cumulative) != 2 {
+			t.Fatalf("Expected 2 cumulative %s points, got %v", name, smoothed)
+		}
+		if got := smoothed.Cumulative[1].Score; got != result.Categories[0].PeriodScore {
+			t.Errorf("Expected cumulative %s score %v, got %v", name, result.Categories[0].PeriodScore, got)
+		}
+	}
+	if len(result.Categories[0].Scores) != 2 || result.Categories[0].Scores[1].Score != 80 {
+		t.Errorf("Expected the raw series unchanged, got %v", result.Categories[0].Scores)
+	}
+
+	result, err = GetAggregatedCategoryScores(context.Background(), mockRepo, models.NewDateRange(startDate, endDate), AggregationOptions{})
+	if err != nil {
+		t.Fatalf("GetAggregatedCategoryScores() error = %v", err)
+	}
+	if result.Categories[0].Smoothed != nil || result.OverallSmoothed != nil {
+		t.Error("Expected no smoothed series without smoothing options")
+	}
+}
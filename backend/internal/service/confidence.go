@@ -0,0 +1,60 @@
+package service
+
+import (
+	"math"
+
+	"go-grpc-backend/internal/models"
+	"go-grpc-backend/proto"
+)
+
+// DefaultMinSampleSize is the rating count below which scores are flagged low_sample
+const DefaultMinSampleSize = 10
+
+// confidenceZ is the standard normal quantile for a two-sided 95% interval
+const confidenceZ = 1.959963984540054
+
+// ConfidenceOptions controls the ScoreConfidence attached to scores; the zero value attaches none
+type ConfidenceOptions struct {
+	// Enabled attaches a ScoreConfidence to each score
+	Enabled bool
+	// MinSampleSize flags scores from fewer ratings as low_sample
+	MinSampleSize int
+}
+
+// scoreConfidence describes the uncertainty of CalculateCategoryScore(avg, weight) for count ratings
+// whose population variance is variance. Returns nil unless opts.Enabled.
+//
+// The interval is a Wilson score interval on avg/5, treating each rating as a fraction of the
+// maximum; unlike a normal interval it stays within 0-5 and doesn't collapse for one rating
+func scoreConfidence(avg, variance float64, count int, weight float64, opts ConfidenceOptions) *proto.ScoreConfidence {
+	if !opts.Enabled {
+		return nil
+	}
+
+	minSample := opts.MinSampleSize
+	if minSample <= 0 {
+		minSample = DefaultMinSampleSize
+	}
+
+	conf := &proto.ScoreConfidence{LowSample: count < minSample}
+	if count == 0 {
+		return conf
+	}
+
+	n := float64(count)
+	p := math.Min(math.Max(avg/models.MaxRatingValue, 0), 1)
+	z2 := confidenceZ * confidenceZ
+	denom := 1 + z2/n
+	center := (p + z2/(2*n)) / denom
+	half := confidenceZ * math.Sqrt(p*(1-p)/n+z2/(4*n*n)) / denom
+
+	conf.Lower = float32(CalculateCategoryScore(math.Max(center-half, 0)*models.MaxRatingValue, weight))
+	conf.Upper = float32(CalculateCategoryScore(math.Min(center+half, 1)*models.MaxRatingValue, weight))
+
+	// Sample standard error of the mean, from the population variance
+	if count > 1 {
+		conf.StandardError = float32(CalculateCategoryScore(math.Sqrt(variance/(n-1)), weight))
+	}
+
+	return conf
+}
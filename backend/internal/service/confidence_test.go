@@ -0,0 +1,53 @@
+package service
+
+import (
+	"math"
+	"testing"
+)
+
+func TestScoreService_ScoreConfidence_Disabled(t *testing.T) {
+	if conf := scoreConfidence(4, 1, 10, 1, ConfidenceOptions{}); conf != nil {
+		t.Errorf("Expected no confidence when disabled, got %v", conf)
+	}
+}
+
+func TestScoreService_ScoreConfidence_NarrowsWithSampleSize(t *testing.T) {
+	opts := ConfidenceOptions{Enabled: true, MinSampleSize: 30}
+
+	// A perfect score from one rating is far less certain than from 500
+	one := scoreConfidence(5, 0, 1, 1, opts)
+	many := scoreConfidence(5, 0, 500, 1, opts)
+
+	if !one.LowSample || many.LowSample {
+		t.Errorf("Expected only the single rating to be low_sample, got %v and %v", one.LowSample, many.LowSample)
+	}
+	if one.Upper != 100 || many.Upper != 100 {
+		t.Errorf("Expected upper bounds capped at 100, got %v and %v", one.Upper, many.Upper)
+	}
+	if one.Lower >= many.Lower {
+		t.Errorf("Expected the interval to narrow with more ratings, got lower %v for 1 and %v for 500", one.Lower, many.Lower)
+	}
+	// Wilson lower bound for 1 success in 1 trial: 1 / (1 + z²)
+	expected := 100 / (1 + confidenceZ*confidenceZ)
+	if math.Abs(float64(one.Lower)-expected) > 1e-3 {
+		t.Errorf("Expected lower bound %v, got %v", expected, one.Lower)
+	}
+}
+
+func TestScoreService_ScoreConfidence_StandardError(t *testing.T) {
+	// Ratings 4 and 2: population variance 1, sample variance 2, SE = sqrt(2/2) = 1 rating point
+	conf := scoreConfidence(3, 1, 2, 0.5, ConfidenceOptions{Enabled: true})
+
+	if expected := float32(CalculateCategoryScore(1, 0.5)); conf.StandardError != expected {
+		t.Errorf("Expected standard error %v, got %v", expected, conf.StandardError)
+	}
+	if !conf.LowSample {
+		t.Errorf("Expected 2 ratings to be low_sample with the default minimum of %d", DefaultMinSampleSize)
+	}
+	if score := float32(CalculateCategoryScore(3, 0.5)); conf.Lower >= score || conf.Upper <= score {
+		t.Errorf("Expected [%v, %v] to contain %v", conf.Lower, conf.Upper, score)
+	}
+	if single := scoreConfidence(3, 0, 1, 1, ConfidenceOptions{Enabled: true}); single.StandardError != 0 {
+		t.Errorf("Expected no standard error for one rating, got %v", single.StandardError)
+	}
+}
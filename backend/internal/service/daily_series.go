@@ -0,0 +1,81 @@
+package service
+
+import (
+	"sort"
+	"time"
+
+	"go-grpc-backend/internal/models"
+)
+
+// seriesPoint is one observed day of a score series
+type seriesPoint struct {
+	date  time.Time
+	score float64
+	count int
+}
+
+// scoreSeries is the daily score of one category, or of the overall quality score
+type scoreSeries struct {
+	overall      bool
+	categoryID   int
+	categoryName string
+	maxScore     float64       // Score of a day where every rating is the highest value
+	points       []seriesPoint // Days with ratings only, ordered by date
+}
+
+// dailyScoreSeries turns the repository's daily rows into the overall series followed by one series
+// per category, ordered by name. Overall points use the GetOverallQualityScore formula over the
+// categories rated that day
+func dailyScoreSeries(rows []models.CategoryRatingOverTimePeriod) []scoreSeries {
+	byCat := make(map[int]*scoreSeries)
+	byDay := make(map[time.Time][]models.CategoryScore)
+	for _, r := range rows {
+		s, ok := byCat[r.CategoryID]
+		if !ok {
+			s = &scoreSeries{
+				categoryID:   r.CategoryID,
+				categoryName: r.CategoryName,
+				maxScore:     CalculateCategoryScore(models.MaxRatingValue, r.CategoryWeight),
+			}
+			byCat[r.CategoryID] = s
+		}
+		s.points = append(s.points, seriesPoint{
+			date:  r.Date,
+			score: CalculateCategoryScore(r.AvgPercent, r.CategoryWeight),
+			count: r.RatingCount,
+		})
+
+		byDay[r.Date] = append(byDay[r.Date], models.CategoryScore{
+			CategoryID:     r.CategoryID,
+			CategoryName:   r.CategoryName,
+			CategoryWeight: r.CategoryWeight,
+			Score:          r.AvgPercent,
+			RatingCount:    r.RatingCount,
+		})
+	}
+
+	overall := scoreSeries{overall: true}
+	for day, categoryScores := range byDay {
+		score, count := CalculateOverallScore(categoryScores)
+		overall.points = append(overall.points, seriesPoint{date: day, score: score, count: count})
+	}
+	for _, s := range byCat {
+		overall.maxScore += s.maxScore / float64(len(byCat))
+	}
+
+	series := []scoreSeries{overall}
+	for _, s := range byCat {
+		series = append(series, *s)
+	}
+	sort.Slice(series[1:], func(i, j int) bool {
+		a, b := series[1+i], series[1+j]
+		if a.categoryName != b.categoryName {
+			return a.categoryName < b.categoryName
+		}
+		return a.categoryID < b.categoryID
+	})
+	for _, s := range series {
+		sort.Slice(s.points, func(i, j int) bool { return s.points[i].date.Before(s.points[j].date) })
+	}
+	return series
+}
@@ -0,0 +1,34 @@
+package service
+
+import (
+	"go-grpc-backend/internal/models"
+	"go-grpc-backend/proto"
+
+	"google.golang.org/protobuf/types/known/timestamppb"
+)
+
+// DateBasisFromProto maps a request's date basis; UNSPECIFIED is the rating's created_at.
+// ok is false for unknown values
+func DateBasisFromProto(basis proto.DateBasis) (b models.DateBasis, ok bool) {
+	switch basis {
+	case proto.DateBasis_DATE_BASIS_UNSPECIFIED, proto.DateBasis_DATE_BASIS_RATING_CREATED:
+		return models.DateBasisRatingCreated, true
+	case proto.DateBasis_DATE_BASIS_TICKET_CREATED:
+		return models.DateBasisTicketCreated, true
+	}
+	return "", false
+}
+
+// dateRangeToProto echoes the range a response was computed over
+func dateRangeToProto(rng models.DateRange) *proto.DateRange {
+	basis := proto.DateBasis_DATE_BASIS_RATING_CREATED
+	if rng.Basis == models.DateBasisTicketCreated {
+		basis = proto.DateBasis_DATE_BASIS_TICKET_CREATED
+	}
+	return &proto.DateRange{
+		Start:        timestamppb.New(rng.Start),
+		End:          timestamppb.New(rng.End),
+		InclusiveEnd: rng.InclusiveEnd,
+		Basis:        basis,
+	}
+}
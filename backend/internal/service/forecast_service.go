@@ -0,0 +1,257 @@
+package service
+
+import (
+	"context"
+	"math"
+	"time"
+
+	"go-grpc-backend/internal/models"
+	"go-grpc-backend/internal/repository"
+	"go-grpc-backend/proto"
+
+	"google.golang.org/protobuf/types/known/timestamppb"
+)
+
+const (
+	// MaxForecastHorizonDays caps how far ahead GetScoreForecast projects
+	MaxForecastHorizonDays = 90
+	// MinForecastHistory is the number of days with ratings a series needs to be forecast
+	MinForecastHistory = 7
+)
+
+// GetScoreForecast fits a linear trend with day-of-week offsets to the daily overall and category
+// series in rng, and projects each over the horizonDays days that follow the range
+func GetScoreForecast(ctx context.Context, repo repository.AnalyticsRepositoryInterface, rng models.DateRange, horizonDays int) (*proto.ScoreForecastResponse, error) {
+	resp := &proto.ScoreForecastResponse{Range: dateRangeToProto(rng)}
+
+	// The horizon starts with the first day after the last bucket of the range.
+	// A range without buckets has no history to forecast from
+	lastDay, ok := lastBucketDay(rng)
+	if !ok {
+		return resp, nil
+	}
+	firstDay := lastDay.AddDate(0, 0, 1)
+
+	rows, err := repo.GetDailyAggregatedCategoryRatings(ctx, rng)
+	if err != nil {
+		return nil, err
+	}
+
+	for _, series := range dailyScoreSeries(rows) {
+		forecast := forecastSeries(series, firstDay, horizonDays)
+		if series.overall {
+			resp.Overall = forecast
+			continue
+		}
+		resp.Categories = append(resp.Categories, forecast)
+	}
+	return resp, nil
+}
+
+// lastBucketDay is the UTC day of the last daily bucket in rng, matching the end of bucketGrid.
+// It reports false when the range has no buckets
+func lastBucketDay(rng models.DateRange) (time.Time, bool) {
+	start, end := rng.Start.UTC(), rng.End.UTC()
+	first := time.Date(start.Year(), start.Month(), start.Day(), 0, 0, 0, 0, time.UTC)
+	last := time.Date(end.Year(), end.Month(), end.Day(), 0, 0, 0, 0, time.UTC)
+	// A half-open range ending at midnight doesn't reach that day
+	if last.Equal(end) && !rng.InclusiveEnd {
+		last = last.AddDate(0, 0, -1)
+	}
+	return last, !last.Before(first)
+}
+
+// forecastSeries projects one series over horizonDays days from firstDay.
+// Values and interval bounds are kept within the scores the series can take
+func forecastSeries(series scoreSeries, firstDay time.Time, horizonDays int) *proto.SeriesForecast {
+	forecast := &proto.SeriesForecast{
+		Overall:     series.overall,
+		HistoryDays: int32(len(series.points)),
+	}
+	if !series.overall {
+		forecast.CategoryId = int32(series.categoryID)
+		forecast.CategoryName = series.categoryName
+	}
+
+	model, ok := fitTrendModel(series.points)
+	if !ok {
+		return forecast
+	}
+	forecast.TrendPerDay = float32(model.slope())
+
+	clamp := func(v float64) float32 {
+		return float32(math.Min(math.Max(v, 0), series.maxScore))
+	}
+	for i := 0; i < horizonDays; i++ {
+		day := firstDay.AddDate(0, 0, i)
+		value, margin := model.predict(day)
+		forecast.Points = append(forecast.Points, &proto.ForecastPoint{
+			Date:  timestamppb.New(day),
+			Value: clamp(value),
+			Lower: clamp(value - margin),
+			Upper: clamp(value + margin),
+		})
+	}
+	return forecast
+}
+
+// trendModel is a least-squares fit of score = intercept + slope*day + weekday offset.
+// Weekdays without enough history, and the first one with enough, share the baseline
+type trendModel struct {
+	origin     time.Time
+	weekdays   []time.Weekday // Weekdays with their own offset column, after intercept and slope
+	coef       []float64
+	xtxInv     [][]float64 // Inverse of XᵀX, for prediction intervals
+	residualSD float64
+}
+
+// fitTrendModel fits points by ordinary least squares. A weekday needs minSeasonalSamples days
+// for an offset. Returns false when the history is too short, leaves no degrees of freedom for
+// the residuals, or cannot separate trend from seasonality
+func fitTrendModel(points []seriesPoint) (trendModel, bool) {
+	if len(points) < MinForecastHistory {
+		return trendModel{}, false
+	}
+
+	m := trendModel{origin: points[0].date}
+	var counts [7]int
+	for _, p := range points {
+		counts[p.date.Weekday()]++
+	}
+	baseline := true
+	for wd, count := range counts {
+		if count < minSeasonalSamples {
+			continue
+		}
+		if baseline {
+			baseline = false
+			continue
+		}
+		m.weekdays = append(m.weekdays, time.Weekday(wd))
+	}
+
+	k := 2 + len(m.weekdays)
+	df := len(points) - k
+	if df < 1 {
+		return trendModel{}, false
+	}
+
+	xtx := make([][]float64, k)
+	for i := range xtx {
+		xtx[i] = make([]float64, k)
+	}
+	xty := make([]float64, k)
+	rows := make([][]float64, len(points))
+	for i, p := range points {
+		rows[i] = m.row(p.date)
+		for a, va := range rows[i] {
+			xty[a] += va * p.score
+			for b, vb := range rows[i] {
+				xtx[a][b] += va * vb
+			}
+		}
+	}
+
+	var ok bool
+	if m.xtxInv, ok = invertMatrix(xtx); !ok {
+		return trendModel{}, false
+	}
+	m.coef = make([]float64, k)
+	for a := range m.coef {
+		for b, v := range xty {
+			m.coef[a] += m.xtxInv[a][b] * v
+		}
+	}
+
+	var ssr float64
+	for i, p := range points {
+		r := p.score - dot(m.coef, rows[i])
+		ssr += r * r
+	}
+	m.residualSD = math.Sqrt(ssr / float64(df))
+	return m, true
+}
+
+// slope is the fitted trend in score points per day
+func (m trendModel) slope() float64 {
+	return m.coef[1]
+}
+
+// row is day's line of the design matrix: intercept, days since the first point, weekday indicators
+func (m trendModel) row(day time.Time) []float64 {
+	row := make([]float64, 2+len(m.weekdays))
+	row[0] = 1
+	row[1] = day.Sub(m.origin).Hours() / 24
+	for i, wd := range m.weekdays {
+		if day.Weekday() == wd {
+			row[2+i] = 1
+		}
+	}
+	return row
+}
+
+// predict returns the expected score on day and the half-width of its 95% prediction interval,
+// which widens the further day lies from the fitted history
+func (m trendModel) predict(day time.Time) (value, margin float64) {
+	row := m.row(day)
+	var leverage float64
+	for a, va := range row {
+		for b, vb := range row {
+			leverage += va * m.xtxInv[a][b] * vb
+		}
+	}
+	return dot(m.coef, row), confidenceZ * m.residualSD * math.Sqrt(1+leverage)
+}
+
+func dot(a, b []float64) float64 {
+	var sum float64
+	for i := range a {
+		sum += a[i] * b[i]
+	}
+	return sum
+}
+
+// invertMatrix inverts a square matrix by Gauss-Jordan elimination with partial pivoting.
+// a is not modified; returns false when a is singular
+func invertMatrix(a [][]float64) ([][]float64, bool) {
+	n := len(a)
+	work := make([][]float64, n)
+	for i := range a {
+		work[i] = make([]float64, 2*n)
+		copy(work[i], a[i])
+		work[i][n+i] = 1
+	}
+
+	for col := 0; col < n; col++ {
+		pivot := col
+		for r := col + 1; r < n; r++ {
+			if math.Abs(work[r][col]) > math.Abs(work[pivot][col]) {
+				pivot = r
+			}
+		}
+		if math.Abs(work[pivot][col]) < 1e-12 {
+			return nil, false
+		}
+		work[col], work[pivot] = work[pivot], work[col]
+
+		scale := work[col][col]
+		for c := range work[col] {
+			work[col][c] /= scale
+		}
+		for r := 0; r < n; r++ {
+			if r == col || work[r][col] == 0 {
+				continue
+			}
+			factor := work[r][col]
+			for c := range work[r] {
+				work[r][c] -= factor * work[col][c]
+			}
+		}
+	}
+
+	inv := make([][]float64, n)
+	for i := range work {
+		inv[i] = work[i][n:]
+	}
+	return inv, true
+}
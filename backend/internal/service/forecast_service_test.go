@@ -0,0 +1,181 @@
+package service
+
+import (
+	"context"
+	"errors"
+	"math"
+	"testing"
+	"time"
+
+	"go-grpc-backend/internal/models"
+	"go-grpc-backend/proto"
+)
+
+// forecastRatings is four weeks of Spelling from Monday 2025-01-06 on an exact trend:
+// 60 points plus 0.5 a day, 10 points lower on weekends
+func forecastRatings() []models.CategoryRatingOverTimePeriod {
+	origin := time.Date(2025, 1, 6, 0, 0, 0, 0, time.UTC)
+
+	var rows []models.CategoryRatingOverTimePeriod
+	for i := 0; i < 28; i++ {
+		day := origin.AddDate(0, 0, i)
+		score := 60 + 0.5*float64(i)
+		if day.Weekday() == time.Saturday || day.Weekday() == time.Sunday {
+			score -= 10
+		}
+		rows = append(rows, models.CategoryRatingOverTimePeriod{
+			CategoryID: 1, CategoryName: "Spelling", AvgPercent: score / RATING_TO_PERCENT_MODIFICATOR, CategoryWeight: 1, RatingCount: 5, Date: day,
+		})
+	}
+	return rows
+}
+
+func TestScoreService_GetScoreForecast(t *testing.T) {
+	mockRepo := &mockCategoryScoresRepository{dailyRatings: forecastRatings()}
+	rng := models.NewDateRange(time.Date(2025, 1, 6, 0, 0, 0, 0, time.UTC), time.Date(2025, 2, 3, 0, 0, 0, 0, time.UTC))
+
+	result, err := GetScoreForecast(context.Background(), mockRepo, rng, 7)
+	if err != nil {
+		t.Fatalf("GetScoreForecast() error = %v", err)
+	}
+
+	if len(result.Categories) != 1 || result.Overall == nil {
+		t.Fatalf("Expected an overall and one category forecast, got %v", result)
+	}
+	for _, f := range []*proto.SeriesForecast{result.Overall, result.Categories[0]} {
+		if f.HistoryDays != 28 || math.Abs(float64(f.TrendPerDay)-0.5) > 1e-6 {
+			t.Errorf("Expected 28 days trending 0.5 a day, got %d days trending %v", f.HistoryDays, f.TrendPerDay)
+		}
+		if len(f.Points) != 7 {
+			t.Fatalf("Expected 7 forecast points, got %d", len(f.Points))
+		}
+
+		// The horizon starts Monday 2025-02-03, day 28 of the trend
+		for i, p := range f.Points {
+			day := time.Date(2025, 2, 3+i, 0, 0, 0, 0, time.UTC)
+			expected := 60 + 0.5*float64(28+i)
+			if day.Weekday() == time.Saturday || day.Weekday() == time.Sunday {
+				expected -= 10
+			}
+			if !p.Date.AsTime().Equal(day) || math.Abs(float64(p.Value)-expected) > 1e-3 {
+				t.Errorf("Point %d: expected %v on %v, got %v on %v", i, expected, day, p.Value, p.Date.AsTime())
+			}
+			// An exact fit leaves no residual spread
+			if p.Upper-p.Lower > 1e-3 {
+				t.Errorf("Point %d: expected a collapsed interval, got %v - %v", i, p.Lower, p.Upper)
+			}
+		}
+	}
+}
+
+func TestScoreService_GetScoreForecast_IntervalWidens(t *testing.T) {
+	rows := forecastRatings()
+	for i := range rows {
+		// Alternate ±2 points of noise
+		rows[i].AvgPercent += 0.1 * float64(1-2*(i/2%2))
+	}
+	mockRepo := &mockCategoryScoresRepository{dailyRatings: rows}
+	rng := models.NewDateRange(time.Date(2025, 1, 6, 0, 0, 0, 0, time.UTC), time.Date(2025, 2, 3, 0, 0, 0, 0, time.UTC))
+
+	result, err := GetScoreForecast(context.Background(), mockRepo, rng, 30)
+	if err != nil {
+		t.Fatalf("GetScoreForecast() error = %v", err)
+	}
+
+	points := result.Categories[0].Points
+	first, last := points[0], points[len(points)-1]
+	if first.Lower >= first.Value || first.Upper <= first.Value {
+		t.Errorf("Expected the value inside its interval, got %v in %v - %v", first.Value, first.Lower, first.Upper)
+	}
+	if last.Upper-last.Lower <= first.Upper-first.Lower {
+		t.Errorf("Expected the interval to widen over the horizon, got %v then %v", first.Upper-first.Lower, last.Upper-last.Lower)
+	}
+	// Bounds never leave the scores a weight 1 category can take
+	for _, p := range points {
+		if p.Lower < 0 || p.Upper > 100 {
+			t.Errorf("Expected bounds within 0-100, got %v - %v", p.Lower, p.Upper)
+		}
+	}
+}
+
+func TestScoreService_GetScoreForecast_ShortHistory(t *testing.T) {
+	mockRepo := &mockCategoryScoresRepository{dailyRatings: forecastRatings()[:MinForecastHistory-1]}
+	rng := models.NewDateRange(time.Date(2025, 1, 6, 0, 0, 0, 0, time.UTC), time.Date(2025, 1, 12, 0, 0, 0, 0, time.UTC))
+
+	result, err := GetScoreForecast(context.Background(), mockRepo, rng, 7)
+	if err != nil {
+		t.Fatalf("GetScoreForecast() error = %v", err)
+	}
+	if len(result.Overall.Points) != 0 || result.Overall.HistoryDays != int32(MinForecastHistory-1) {
+		t.Errorf("Expected no forecast from %d days, got %v", MinForecastHistory-1, result.Overall)
+	}
+}
+
+func TestScoreService_GetScoreForecast_EmptyRange(t *testing.T) {
+	mockRepo := &mockCategoryScoresRepository{dailyRatings: forecastRatings()}
+	day := time.Date(2025, 1, 6, 0, 0, 0, 0, time.UTC)
+
+	for name, rng := range map[string]models.DateRange{
+		"start equals end": models.NewDateRange(day, day),
+		"unset":            {},
+	} {
+		result, err := GetScoreForecast(context.Background(), mockRepo, rng, 7)
+		if err != nil {
+			t.Fatalf("%s: GetScoreForecast() error = %v", name, err)
+		}
+		if result.Overall != nil || len(result.Categories) != 0 {
+			t.Errorf("%s: expected no forecast, got %v", name, result)
+		}
+	}
+}
+
+func TestScoreService_LastBucketDay(t *testing.T) {
+	day := time.Date(2025, 1, 6, 0, 0, 0, 0, time.UTC)
+	inclusive := models.NewDateRange(day, day)
+	inclusive.InclusiveEnd = true
+
+	for name, rng := range map[string]models.DateRange{
+		"midnight end":         models.NewDateRange(day, day.AddDate(0, 0, 3)),
+		"mid-day end":          models.NewDateRange(day.Add(5*time.Hour), day.AddDate(0, 0, 3).Add(time.Hour)),
+		"inclusive single day": inclusive,
+		"start equals end":     models.NewDateRange(day, day),
+		"end before start":     models.NewDateRange(day, day.AddDate(0, 0, -2)),
+	} {
+		grid := bucketGrid(rng, false)
+		last, ok := lastBucketDay(rng)
+		if ok != (len(grid) > 0) {
+			t.Errorf("%s: expected ok = %v, got %v", name, len(grid) > 0, ok)
+			continue
+		}
+		if ok && !last.Equal(grid[len(grid)-1]) {
+			t.Errorf("%s: expected last bucket %v, got %v", name, grid[len(grid)-1], last)
+		}
+	}
+}
+
+func TestScoreService_GetScoreForecast_Error(t *testing.T) {
+	mockRepo := &mockCategoryScoresRepository{dailyRatingsError: errors.New("database error")}
+
+	if _, err := GetScoreForecast(context.Background(), mockRepo, models.NewDateRange(time.Now().Add(-time.Hour), time.Now()), 7); err == nil {
+		t.Error("Expected error, got nil")
+	}
+}
+
+func TestScoreService_InvertMatrix(t *testing.T) {
+	inv, ok := invertMatrix([][]float64{{4, 7}, {2, 6}})
+	if !ok {
+		t.Fatal("Expected an invertible matrix")
+	}
+	expected := [][]float64{{0.6, -0.7}, {-0.2, 0.4}}
+	for i := range expected {
+		for j := range expected[i] {
+			if math.Abs(inv[i][j]-expected[i][j]) > 1e-12 {
+				t.Errorf("inv[%d][%d] = %v, expected %v", i, j, inv[i][j], expected[i][j])
+			}
+		}
+	}
+
+	if _, ok := invertMatrix([][]float64{{1, 2}, {2, 4}}); ok {
+		t.Error("Expected a singular matrix to be rejected")
+	}
+}
@@ -0,0 +1,112 @@
+package service
+
+import (
+	"sort"
+	"time"
+
+	"go-grpc-backend/internal/models"
+	"go-grpc-backend/proto"
+
+	"google.golang.org/protobuf/types/known/timestamppb"
+	"google.golang.org/protobuf/types/known/wrapperspb"
+)
+
+// bucketGrid lists the start of every bucket overlapping rng, matching the repository's
+// bucketing: UTC days, or UTC weeks starting on Monday
+func bucketGrid(rng models.DateRange, weekly bool) []time.Time {
+	start := rng.Start.UTC()
+	first := time.Date(start.Year(), start.Month(), start.Day(), 0, 0, 0, 0, time.UTC)
+	step := 1
+	if weekly {
+		first = first.AddDate(0, 0, -(int(first.Weekday())+6)%7)
+		step = 7
+	}
+
+	var grid []time.Time
+	for b := first; b.Before(rng.End) || (rng.InclusiveEnd && b.Equal(rng.End)); b = b.AddDate(0, 0, step) {
+		grid = append(grid, b)
+	}
+	return grid
+}
+
+// fillSeries aligns date-sorted points to grid according to mode. Points outside the grid are
+// kept and merged in date order, so the result stays sorted; FILL_MODE_OMIT returns points unchanged
+func fillSeries(points []*proto.ScorePoint, grid []time.Time, mode proto.FillMode) []*proto.ScorePoint {
+	if mode == proto.FillMode_FILL_MODE_UNSPECIFIED || mode == proto.FillMode_FILL_MODE_OMIT {
+		return points
+	}
+
+	observed := make(map[time.Time]*proto.ScorePoint, len(points))
+	for _, p := range points {
+		observed[p.Date.AsTime()] = p
+	}
+
+	out := make([]*proto.ScorePoint, len(grid))
+	for i, b := range grid {
+		if p, ok := observed[b]; ok {
+			out[i] = p
+			delete(observed, b)
+			continue
+		}
+		out[i] = &proto.ScorePoint{
+			Date:   timestamppb.New(b),
+			Count:  wrapperspb.Int32(0),
+			Source: proto.ScorePointSource_SCORE_POINT_SOURCE_NULL,
+		}
+	}
+
+	// Buckets the grid doesn't cover are unexpected, but dropping ratings silently would be worse
+	if len(observed) > 0 {
+		for _, p := range points {
+			if _, ok := observed[p.Date.AsTime()]; ok {
+				out = append(out, p)
+			}
+		}
+		sort.SliceStable(out, func(i, j int) bool {
+			return out[i].Date.AsTime().Before(out[j].Date.AsTime())
+		})
+	}
+
+	switch mode {
+	case proto.FillMode_FILL_MODE_CARRY_FORWARD:
+		carryForward(out)
+	case proto.FillMode_FILL_MODE_LINEAR:
+		interpolate(out)
+	}
+	return out
+}
+
+// carryForward copies the last observed score into the null points after it.
+// Null points before the first observation stay null
+func carryForward(points []*proto.ScorePoint) {
+	var last *proto.ScorePoint
+	for _, p := range points {
+		switch {
+		case p.Source == proto.ScorePointSource_SCORE_POINT_SOURCE_OBSERVED:
+			last = p
+		case last != nil:
+			p.Score = last.Score
+			p.Source = proto.ScorePointSource_SCORE_POINT_SOURCE_CARRIED
+		}
+	}
+}
+
+// interpolate fills null points between two observations on the straight line joining them.
+// Null points before the first or after the last observation stay null
+func interpolate(points []*proto.ScorePoint) {
+	prev := -1
+	for i, p := range points {
+		if p.Source != proto.ScorePointSource_SCORE_POINT_SOURCE_OBSERVED {
+			continue
+		}
+		if prev >= 0 && i-prev > 1 {
+			from, to := points[prev].Score, p.Score
+			for j := prev + 1; j < i; j++ {
+				frac := float32(j-prev) / float32(i-prev)
+				points[j].Score = from + (to-from)*frac
+				points[j].Source = proto.ScorePointSource_SCORE_POINT_SOURCE_INTERPOLATED
+			}
+		}
+		prev = i
+	}
+}
@@ -0,0 +1,80 @@
+package service
+
+import (
+	"context"
+	"slices"
+
+	"go-grpc-backend/internal/models"
+	"go-grpc-backend/internal/repository"
+	"go-grpc-backend/proto"
+
+	"google.golang.org/protobuf/types/known/timestamppb"
+)
+
+// MaxGroupByAttributes caps the ticket attributes a single GetScoresGroupedBy request groups by
+const MaxGroupByAttributes = 5
+
+// GetScoresGroupedBy scores every combination of the grouping's dimensions that has ratings.
+// With byCategory each category is its own group scored with CalculateCategoryScore; otherwise
+// the categories of a group are combined with CalculateOverallScore, so an empty grouping
+// returns the same score as GetOverallQualityScore
+func GetScoresGroupedBy(ctx context.Context, repo repository.GroupedScoresRepositoryInterface, rng models.DateRange, grouping models.ScoreGrouping, byCategory bool) (*proto.ScoresGroupedByResponse, error) {
+	rows, err := repo.GetGroupedCategoryScores(ctx, rng, grouping)
+	if err != nil {
+		return nil, err
+	}
+
+	resp := &proto.ScoresGroupedByResponse{Range: dateRangeToProto(rng)}
+	if byCategory {
+		for _, row := range rows {
+			group := scoreGroup(grouping, row)
+			group.CategoryId = int32(row.CategoryID)
+			group.CategoryName = row.CategoryName
+			group.Score = CalculateCategoryScore(row.Score, row.CategoryWeight)
+			group.RatingCount = int32(row.RatingCount)
+			resp.Groups = append(resp.Groups, group)
+		}
+		return resp, nil
+	}
+
+	// The repository orders rows by group first, so the categories of a group are adjacent
+	for start := 0; start < len(rows); {
+		end := start + 1
+		for end < len(rows) && sameGroup(rows[start], rows[end]) {
+			end++
+		}
+
+		categories := make([]models.CategoryScore, 0, end-start)
+		for _, row := range rows[start:end] {
+			categories = append(categories, row.CategoryScore)
+		}
+		score, total := CalculateOverallScore(categories)
+
+		group := scoreGroup(grouping, rows[start])
+		group.Score = score
+		group.RatingCount = int32(total)
+		resp.Groups = append(resp.Groups, group)
+		start = end
+	}
+	return resp, nil
+}
+
+// scoreGroup fills in the dimensions of the group row belongs to, except category
+func scoreGroup(grouping models.ScoreGrouping, row models.GroupedCategoryScore) *proto.ScoreGroup {
+	group := &proto.ScoreGroup{}
+	for i, key := range grouping.AttributeKeys {
+		group.Attributes = append(group.Attributes, &proto.AttributeValue{Key: key, Value: row.AttributeValues[i]})
+	}
+	if grouping.Reviewee {
+		group.RevieweeId = int32(row.RevieweeID)
+		group.RevieweeName = row.RevieweeName
+	}
+	if grouping.Granularity != "" {
+		group.Date = timestamppb.New(row.Date)
+	}
+	return group
+}
+
+func sameGroup(a, b models.GroupedCategoryScore) bool {
+	return slices.Equal(a.AttributeValues, b.AttributeValues) && a.RevieweeID == b.RevieweeID && a.Date.Equal(b.Date)
+}
@@ -0,0 +1,96 @@
+package service
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+
+	"go-grpc-backend/internal/models"
+)
+
+// mockGroupedScoresRepository serves canned grouped and per-team rows
+type mockGroupedScoresRepository struct {
+	rows       []models.GroupedCategoryScore
+	teamScores []models.TeamCategoryScore
+	err        error
+}
+
+func (m *mockGroupedScoresRepository) GetGroupedCategoryScores(ctx context.Context, rng models.DateRange, grouping models.ScoreGrouping) ([]models.GroupedCategoryScore, error) {
+	return m.rows, m.err
+}
+
+func (m *mockGroupedScoresRepository) GetTeamCategoryScores(ctx context.Context, rng models.DateRange) ([]models.TeamCategoryScore, error) {
+	return m.teamScores, m.err
+}
+
+func groupedRow(channel string, revieweeID int, categoryID int, weight, avg float64, count int) models.GroupedCategoryScore {
+	return models.GroupedCategoryScore{
+		AttributeValues: []string{channel},
+		RevieweeID:      revieweeID,
+		RevieweeName:    map[int]string{1: "Alice", 2: "Bob"}[revieweeID],
+		CategoryScore:   models.CategoryScore{CategoryID: categoryID, CategoryName: "Category", CategoryWeight: weight, Score: avg, RatingCount: count},
+	}
+}
+
+func TestGetScoresGroupedBy(t *testing.T) {
+	repo := &mockGroupedScoresRepository{rows: []models.GroupedCategoryScore{
+		groupedRow("chat", 1, 2, 0.5, 3, 1),
+		groupedRow("chat", 1, 1, 1, 5, 1),
+		groupedRow("chat", 2, 2, 0.5, 1, 1),
+		groupedRow("email", 2, 1, 1, 3, 2),
+	}}
+	rng := models.NewDateRange(time.Date(2025, 1, 1, 0, 0, 0, 0, time.UTC), time.Date(2025, 1, 13, 0, 0, 0, 0, time.UTC))
+	grouping := models.ScoreGrouping{AttributeKeys: []string{"channel"}, Reviewee: true}
+
+	t.Run("overall score per group", func(t *testing.T) {
+		resp, err := GetScoresGroupedBy(context.Background(), repo, rng, grouping, false)
+		if err != nil {
+			t.Fatalf("GetScoresGroupedBy() error = %v", err)
+		}
+
+		// chat/Alice: (3*0.5*20 + 5*1*20) / 2 = 65; chat/Bob: 1*0.5*20 = 10; email/Bob: 3*1*20 = 60
+		expected := []struct {
+			channel  string
+			reviewee string
+			score    float64
+			count    int32
+		}{
+			{"chat", "Alice", 65, 2},
+			{"chat", "Bob", 10, 1},
+			{"email", "Bob", 60, 2},
+		}
+		if len(resp.Groups) != len(expected) {
+			t.Fatalf("Expected %d groups, got %v", len(expected), resp.Groups)
+		}
+		for i, want := range expected {
+			got := resp.Groups[i]
+			if got.Attributes[0].Key != "channel" || got.Attributes[0].Value != want.channel || got.RevieweeName != want.reviewee ||
+				got.Score != want.score || got.RatingCount != want.count {
+				t.Errorf("Group %d: expected %+v, got %v", i, want, got)
+			}
+			if got.CategoryId != 0 || got.Date != nil {
+				t.Errorf("Group %d: expected no category or date, got %v", i, got)
+			}
+		}
+	})
+
+	t.Run("by category", func(t *testing.T) {
+		resp, err := GetScoresGroupedBy(context.Background(), repo, rng, grouping, true)
+		if err != nil {
+			t.Fatalf("GetScoresGroupedBy() error = %v", err)
+		}
+		if len(resp.Groups) != 4 {
+			t.Fatalf("Expected a group per row, got %v", resp.Groups)
+		}
+		if got := resp.Groups[0]; got.CategoryId != 2 || got.Score != 30 || got.RatingCount != 1 {
+			t.Errorf("Expected Grammar scoring 30, got %v", got)
+		}
+	})
+
+	t.Run("repository error", func(t *testing.T) {
+		if _, err := GetScoresGroupedBy(context.Background(), &mockGroupedScoresRepository{err: errors.New("database error")}, rng, grouping, false); err == nil {
+			t.Error("Expected error, got nil")
+		}
+	})
+}
@@ -0,0 +1,107 @@
+package service
+
+import (
+	"context"
+	"sort"
+
+	"go-grpc-backend/internal/models"
+	"go-grpc-backend/internal/repository"
+	"go-grpc-backend/proto"
+
+	"google.golang.org/protobuf/types/known/timestamppb"
+)
+
+const (
+	// DefaultLowestScoringLimit is the number of tickets returned when the request doesn't say
+	DefaultLowestScoringLimit = 10
+	// MaxLowestScoringLimit caps the tickets one request may ask for
+	MaxLowestScoringLimit = 1000
+)
+
+// LowestScoringOptions narrows GetLowestScoringTickets; the zero value ranks every ticket
+// and returns DefaultLowestScoringLimit of them
+type LowestScoringOptions struct {
+	Limit      int
+	MinRatings int
+	// CategoryIDs restricts the score to these categories; empty means all
+	CategoryIDs []int
+}
+
+// GetLowestScoringTickets ranks tickets by their overall score in rng, worst first.
+// A ticket's score is the GetOverallQualityScore formula over its own category scores
+func GetLowestScoringTickets(ctx context.Context, repo repository.AnalyticsRepositoryInterface, rng models.DateRange, opts LowestScoringOptions) (*proto.LowestScoringTicketsResponse, error) {
+	limit := opts.Limit
+	if limit <= 0 {
+		limit = DefaultLowestScoringLimit
+	}
+
+	rows, err := repo.GetScoresByTicket(ctx, rng)
+	if err != nil {
+		return nil, err
+	}
+
+	wanted := make(map[int]bool, len(opts.CategoryIDs))
+	for _, id := range opts.CategoryIDs {
+		wanted[id] = true
+	}
+
+	// Rows come grouped by ticket, with categories ordered by name
+	var (
+		tickets        []*proto.RankedTicket
+		categoryScores = make(map[int32][]models.CategoryScore)
+	)
+	for _, row := range rows {
+		if len(wanted) > 0 && !wanted[row.CategoryID] {
+			continue
+		}
+
+		ticketID := int32(row.TicketID)
+		if len(tickets) == 0 || tickets[len(tickets)-1].TicketId != ticketID {
+			tickets = append(tickets, &proto.RankedTicket{
+				TicketId:  ticketID,
+				Subject:   row.TicketSubject,
+				CreatedAt: timestamppb.New(row.TicketCreatedAt),
+			})
+		}
+		ticket := tickets[len(tickets)-1]
+
+		ticket.CategoryScores = append(ticket.CategoryScores, &proto.CategoryScoreForTicket{
+			CategoryId:   int32(row.CategoryID),
+			CategoryName: row.CategoryName,
+			Score:        float32(CalculateCategoryScore(row.Score, row.CategoryWeight)),
+			RatingCount:  int32(row.RatingCount),
+		})
+		categoryScores[ticketID] = append(categoryScores[ticketID], ticketCategoryScore(row))
+	}
+
+	ranked := tickets[:0]
+	for _, ticket := range tickets {
+		score, total := CalculateOverallScore(categoryScores[ticket.TicketId])
+		if total < opts.MinRatings {
+			continue
+		}
+		ticket.Score = float32(score)
+		ticket.RatingCount = int32(total)
+		ranked = append(ranked, ticket)
+	}
+
+	// On equal scores the ticket with more ratings is the more certain problem
+	sort.SliceStable(ranked, func(i, j int) bool {
+		a, b := ranked[i], ranked[j]
+		if a.Score != b.Score {
+			return a.Score < b.Score
+		}
+		if a.RatingCount != b.RatingCount {
+			return a.RatingCount > b.RatingCount
+		}
+		return a.TicketId < b.TicketId
+	})
+	if len(ranked) > limit {
+		ranked = ranked[:limit]
+	}
+
+	return &proto.LowestScoringTicketsResponse{
+		Tickets: ranked,
+		Range:   dateRangeToProto(rng),
+	}, nil
+}
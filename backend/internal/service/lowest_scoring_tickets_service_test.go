@@ -0,0 +1,139 @@
+package service
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+
+	"go-grpc-backend/internal/models"
+)
+
+// mockTicketScoresRepository serves canned per-ticket rows
+type mockTicketScoresRepository struct {
+	ticketScores []models.TicketCategoryScore
+	err          error
+}
+
+func (m *mockTicketScoresRepository) GetScoresByTicket(ctx context.Context, rng models.DateRange) ([]models.TicketCategoryScore, error) {
+	return m.ticketScores, m.err
+}
+
+func (m *mockTicketScoresRepository) GetOverallQualityScore(ctx context.Context, rng models.DateRange) ([]models.CategoryScore, error) {
+	return nil, nil
+}
+
+func (m *mockTicketScoresRepository) GetDailyAggregatedCategoryRatings(ctx context.Context, rng models.DateRange) ([]models.CategoryRatingOverTimePeriod, error) {
+	return nil, nil
+}
+
+func (m *mockTicketScoresRepository) GetWeeklyAggregatedCategoryRatings(ctx context.Context, rng models.DateRange) ([]models.CategoryRatingOverTimePeriod, error) {
+	return nil, nil
+}
+
+func (m *mockTicketScoresRepository) GetRatingDistribution(ctx context.Context, rng models.DateRange, granularity models.Granularity) ([]models.RatingDistribution, error) {
+	return nil, nil
+}
+
+func (m *mockTicketScoresRepository) GetCategoryScoresByPeriod(ctx context.Context, periods []models.DateRange) ([]models.PeriodCategoryScore, error) {
+	return nil, nil
+}
+
+func lowestScoringFixture() *mockTicketScoresRepository {
+	created := time.Date(2025, 1, 1, 9, 0, 0, 0, time.UTC)
+	row := func(ticketID, categoryID int, name string, weight, avg float64, count int) models.TicketCategoryScore {
+		return models.TicketCategoryScore{
+			TicketID:        ticketID,
+			TicketSubject:   "Ticket",
+			TicketCreatedAt: created,
+			CategoryID:      categoryID,
+			CategoryName:    name,
+			CategoryWeight:  weight,
+			Score:           avg,
+			RatingCount:     count,
+		}
+	}
+	// Ticket 1: (4*1*20 + 2*1*20) / 2 = 60 over 4 ratings
+	// Ticket 2: 3*1*20 = 60 over 6 ratings
+	// Ticket 3: (5*1*20 + 1*1*20) / 2 = 60 over 2 ratings
+	// Ticket 4: 2*1*20 = 40 over 1 rating
+	return &mockTicketScoresRepository{ticketScores: []models.TicketCategoryScore{
+		row(1, 2, "Grammar", 1, 2, 2),
+		row(1, 1, "Spelling", 1, 4, 2),
+		row(2, 1, "Spelling", 1, 3, 6),
+		row(3, 2, "Grammar", 1, 1, 1),
+		row(3, 1, "Spelling", 1, 5, 1),
+		row(4, 2, "Grammar", 1, 2, 1),
+	}}
+}
+
+func ticketIDs(t *testing.T, opts LowestScoringOptions) []int32 {
+	t.Helper()
+	rng := models.NewDateRange(time.Date(2025, 1, 1, 0, 0, 0, 0, time.UTC), time.Date(2025, 2, 1, 0, 0, 0, 0, time.UTC))
+	resp, err := GetLowestScoringTickets(context.Background(), lowestScoringFixture(), rng, opts)
+	if err != nil {
+		t.Fatalf("GetLowestScoringTickets() error = %v", err)
+	}
+	var ids []int32
+	for _, ticket := range resp.Tickets {
+		ids = append(ids, ticket.TicketId)
+	}
+	return ids
+}
+
+func TestLowestScoringTicketsService_Ranking(t *testing.T) {
+	tests := []struct {
+		name string
+		opts LowestScoringOptions
+		want []int32
+	}{
+		// Tickets 1, 2 and 3 tie at 60: more ratings first
+		{name: "all tickets", want: []int32{4, 2, 1, 3}},
+		{name: "limit", opts: LowestScoringOptions{Limit: 2}, want: []int32{4, 2}},
+		{name: "min ratings", opts: LowestScoringOptions{MinRatings: 4}, want: []int32{2, 1}},
+		// Spelling alone: ticket 2 scores 60, ticket 1 80, ticket 3 100; ticket 4 has no Spelling ratings
+		{name: "category filter", opts: LowestScoringOptions{CategoryIDs: []int{1}}, want: []int32{2, 1, 3}},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got := ticketIDs(t, tt.opts)
+			if len(got) != len(tt.want) {
+				t.Fatalf("Expected tickets %v, got %v", tt.want, got)
+			}
+			for i := range got {
+				if got[i] != tt.want[i] {
+					t.Fatalf("Expected tickets %v, got %v", tt.want, got)
+				}
+			}
+		})
+	}
+}
+
+func TestLowestScoringTicketsService_TicketFields(t *testing.T) {
+	rng := models.NewDateRange(time.Date(2025, 1, 1, 0, 0, 0, 0, time.UTC), time.Date(2025, 2, 1, 0, 0, 0, 0, time.UTC))
+	resp, err := GetLowestScoringTickets(context.Background(), lowestScoringFixture(), rng, LowestScoringOptions{MinRatings: 4})
+	if err != nil {
+		t.Fatalf("GetLowestScoringTickets() error = %v", err)
+	}
+
+	ticket := resp.Tickets[1]
+	if ticket.TicketId != 1 || ticket.Score != 60 || ticket.RatingCount != 4 || ticket.Subject != "Ticket" {
+		t.Errorf("Unexpected ticket %v", ticket)
+	}
+	if !ticket.CreatedAt.AsTime().Equal(time.Date(2025, 1, 1, 9, 0, 0, 0, time.UTC)) {
+		t.Errorf("Expected the ticket creation time, got %v", ticket.CreatedAt.AsTime())
+	}
+	if len(ticket.CategoryScores) != 2 || ticket.CategoryScores[0].CategoryName != "Grammar" || ticket.CategoryScores[0].Score != 40 {
+		t.Errorf("Unexpected category scores %v", ticket.CategoryScores)
+	}
+}
+
+func TestLowestScoringTicketsService_RepositoryError(t *testing.T) {
+	repo := &mockTicketScoresRepository{err: errors.New("database error")}
+	rng := models.NewDateRange(time.Date(2025, 1, 1, 0, 0, 0, 0, time.UTC), time.Date(2025, 2, 1, 0, 0, 0, 0, time.UTC))
+
+	if _, err := GetLowestScoringTickets(context.Background(), repo, rng, LowestScoringOptions{}); err == nil {
+		t.Error("Expected error, got nil")
+	}
+}
@@ -1,8 +1,8 @@
 package service
 
 import (
-	"time"
-
+	"context"
+	"go-grpc-backend/internal/models"
 	"go-grpc-backend/internal/repository"
 	"go-grpc-backend/proto"
 
@@ -13,9 +13,9 @@ import (
 // Calculates the average of all category scores (weighted by category weight)
 // Formula: (sum of all category scores) / number of categories
 // Where each category score = AvgPercent * CategoryWeight * RATING_TO_PERCENT_MODIFICATOR
-func GetOverallQualityScore(repo repository.AnalyticsRepositoryInterface, startDate, endDate time.Time) (*proto.OverallQualityScoreResponse, error) {
+func GetOverallQualityScore(ctx context.Context, repo repository.AnalyticsRepositoryInterface, rng models.DateRange) (*proto.OverallQualityScoreResponse, error) {
 	// Get category-level data from repository
-	categoryScores, err := repo.GetOverallQualityScore(startDate, endDate)
+	categoryScores, err := repo.GetOverallQualityScore(ctx, rng)
 	if err != nil {
 		return nil, err
 	}
@@ -25,31 +25,22 @@ func GetOverallQualityScore(repo repository.AnalyticsRepositoryInterface, startD
 		return &proto.OverallQualityScoreResponse{
 			OverallScore: 0,
 			TotalRatings: 0,
-			StartDate:    timestamppb.New(startDate),
-			EndDate:      timestamppb.New(endDate),
+			StartDate:    timestamppb.New(rng.Start),
+			EndDate:      timestamppb.New(rng.End),
+			Range:        dateRangeToProto(rng),
 		}, nil
 	}
 
-	// Calculate weighted score for each category and sum them
-	var totalScore float64
-	var totalRatings int32
-
-	for _, cs := range categoryScores {
-		// Use the standard formula: AvgPercent * CategoryWeight * RATING_TO_PERCENT_MODIFICATOR
-		categoryScore := CalculateCategoryScore(cs.Score, cs.CategoryWeight)
-		totalScore += categoryScore
-		totalRatings += int32(cs.RatingCount)
-	}
-
-	// Calculate the overall score as average of all category scores
-	overallScore := totalScore / float64(len(categoryScores))
+	// Average of the weighted category scores
+	overallScore, totalRatings := CalculateOverallScore(categoryScores)
 
 	// Create and return response
 	resp := &proto.OverallQualityScoreResponse{
 		OverallScore: float32(overallScore),
-		TotalRatings: totalRatings,
-		StartDate:    timestamppb.New(startDate),
-		EndDate:      timestamppb.New(endDate),
+		TotalRatings: int32(totalRatings),
+		StartDate:    timestamppb.New(rng.Start),
+		EndDate:      timestamppb.New(rng.End),
+		Range:        dateRangeToProto(rng),
 	}
 
 	return resp, nil
@@ -1,6 +1,7 @@
 package service
 
 import (
+	"context"
 	"errors"
 	"testing"
 	"time"
@@ -14,22 +15,30 @@ type mockOverallQualityScoreRepository struct {
 	overallScoreError error
 }
 
-func (m *mockOverallQualityScoreRepository) GetOverallQualityScore(startDate, endDate time.Time) ([]models.CategoryScore, error) {
+func (m *mockOverallQualityScoreRepository) GetOverallQualityScore(ctx context.Context, rng models.DateRange) ([]models.CategoryScore, error) {
 	if m.overallScoreError != nil {
 		return nil, m.overallScoreError
 	}
 	return m.categoryScores, nil
 }
 
-func (m *mockOverallQualityScoreRepository) GetDailyAggregatedCategoryRatings(startDate, endDate time.Time) ([]models.CategoryRatingOverTimePeriod, error) {
+func (m *mockOverallQualityScoreRepository) GetRatingDistribution(ctx context.Context, rng models.DateRange, granularity models.Granularity) ([]models.RatingDistribution, error) {
 	return nil, nil
 }
 
-func (m *mockOverallQualityScoreRepository) GetWeeklyAggregatedCategoryRatings(startDate, endDate time.Time) ([]models.CategoryRatingOverTimePeriod, error) {
+func (m *mockOverallQualityScoreRepository) GetCategoryScoresByPeriod(ctx context.Context, periods []models.DateRange) ([]models.PeriodCategoryScore, error) {
 	return nil, nil
 }
 
-func (m *mockOverallQualityScoreRepository) GetScoresByTicket(startDate, endDate time.Time) ([]models.TicketCategoryScore, error) {
+func (m *mockOverallQualityScoreRepository) GetDailyAggregatedCategoryRatings(ctx context.Context, rng models.DateRange) ([]models.CategoryRatingOverTimePeriod, error) {
+	return nil, nil
+}
+
+func (m *mockOverallQualityScoreRepository) GetWeeklyAggregatedCategoryRatings(ctx context.Context, rng models.DateRange) ([]models.CategoryRatingOverTimePeriod, error) {
+	return nil, nil
+}
+
+func (m *mockOverallQualityScoreRepository) GetScoresByTicket(ctx context.Context, rng models.DateRange) ([]models.TicketCategoryScore, error) {
 	return nil, nil
 }
 
@@ -48,7 +57,7 @@ func TestScoreService_GetOverallQualityScore_Success(t *testing.T) {
 		},
 	}
 
-	result, err := GetOverallQualityScore(mockRepo, startDate, endDate)
+	result, err := GetOverallQualityScore(context.Background(), mockRepo, models.NewDateRange(startDate, endDate))
 
 	if err != nil {
 		t.Fatalf("GetOverallQualityScore() error = %v", err)
@@ -75,7 +84,7 @@ func TestScoreService_GetOverallQualityScore_SingleCategory(t *testing.T) {
 		},
 	}
 
-	result, err := GetOverallQualityScore(mockRepo, startDate, endDate)
+	result, err := GetOverallQualityScore(context.Background(), mockRepo, models.NewDateRange(startDate, endDate))
 
 	if err != nil {
 		t.Fatalf("GetOverallQualityScore() error = %v", err)
@@ -108,7 +117,7 @@ func TestScoreService_GetOverallQualityScore_ThreeCategories(t *testing.T) {
 		},
 	}
 
-	result, err := GetOverallQualityScore(mockRepo, startDate, endDate)
+	result, err := GetOverallQualityScore(context.Background(), mockRepo, models.NewDateRange(startDate, endDate))
 
 	if err != nil {
 		t.Fatalf("GetOverallQualityScore() error = %v", err)
@@ -132,7 +141,7 @@ func TestScoreService_GetOverallQualityScore_ZeroCategories(t *testing.T) {
 		categoryScores: []models.CategoryScore{},
 	}
 
-	result, err := GetOverallQualityScore(mockRepo, startDate, endDate)
+	result, err := GetOverallQualityScore(context.Background(), mockRepo, models.NewDateRange(startDate, endDate))
 
 	if err != nil {
 		t.Fatalf("GetOverallQualityScore() error = %v", err)
@@ -156,7 +165,7 @@ func TestScoreService_GetOverallQualityScore_Error(t *testing.T) {
 		overallScoreError: expectedError,
 	}
 
-	result, err := GetOverallQualityScore(mockRepo, startDate, endDate)
+	result, err := GetOverallQualityScore(context.Background(), mockRepo, models.NewDateRange(startDate, endDate))
 
 	if err == nil {
 		t.Fatal("Expected error, got nil")
@@ -195,7 +204,7 @@ func TestScoreService_GetOverallQualityScore_ExampleUseCase_96Percent(t *testing
 		},
 	}
 
-	result, err := GetOverallQualityScore(mockRepo, startDate, endDate)
+	result, err := GetOverallQualityScore(context.Background(), mockRepo, models.NewDateRange(startDate, endDate))
 
 	if err != nil {
 		t.Fatalf("GetOverallQualityScore() error = %v", err)
@@ -226,7 +235,7 @@ func TestScoreService_GetOverallQualityScore_DifferentWeights(t *testing.T) {
 		},
 	}
 
-	result, err := GetOverallQualityScore(mockRepo, startDate, endDate)
+	result, err := GetOverallQualityScore(context.Background(), mockRepo, models.NewDateRange(startDate, endDate))
 
 	if err != nil {
 		t.Fatalf("GetOverallQualityScore() error = %v", err)
@@ -256,7 +265,7 @@ func TestScoreService_GetOverallQualityScore_AllPerfectScores(t *testing.T) {
 		},
 	}
 
-	result, err := GetOverallQualityScore(mockRepo, startDate, endDate)
+	result, err := GetOverallQualityScore(context.Background(), mockRepo, models.NewDateRange(startDate, endDate))
 
 	if err != nil {
 		t.Fatalf("GetOverallQualityScore() error = %v", err)
@@ -287,7 +296,7 @@ func TestScoreService_GetOverallQualityScore_LowScores(t *testing.T) {
 		},
 	}
 
-	result, err := GetOverallQualityScore(mockRepo, startDate, endDate)
+	result, err := GetOverallQualityScore(context.Background(), mockRepo, models.NewDateRange(startDate, endDate))
 
 	if err != nil {
 		t.Fatalf("GetOverallQualityScore() error = %v", err)
@@ -345,7 +354,7 @@ func TestScoreService_GetOverallQualityScore_FormulaVerification(t *testing.T) {
 				categoryScores: tt.categories,
 			}
 
-			result, err := GetOverallQualityScore(mockRepo, startDate, endDate)
+			result, err := GetOverallQualityScore(context.Background(), mockRepo, models.NewDateRange(startDate, endDate))
 
 			if err != nil {
 				t.Fatalf("GetOverallQualityScore() error = %v", err)
@@ -361,3 +370,23 @@ func TestScoreService_GetOverallQualityScore_FormulaVerification(t *testing.T) {
 		})
 	}
 }
+
+func TestScoreService_GetOverallQualityScore_EchoesRange(t *testing.T) {
+	rng := models.DateRange{
+		Start:        time.Date(2025, 1, 1, 0, 0, 0, 0, time.UTC),
+		End:          time.Date(2025, 1, 31, 0, 0, 0, 0, time.UTC),
+		InclusiveEnd: true,
+	}
+
+	result, err := GetOverallQualityScore(context.Background(), &mockOverallQualityScoreRepository{}, rng)
+	if err != nil {
+		t.Fatalf("GetOverallQualityScore() error = %v", err)
+	}
+
+	if !result.Range.GetInclusiveEnd() {
+		t.Error("Expected inclusive_end to be echoed back")
+	}
+	if !result.Range.GetStart().AsTime().Equal(rng.Start) || !result.Range.GetEnd().AsTime().Equal(rng.End) {
+		t.Errorf("Expected range %v - %v, got %v", rng.Start, rng.End, result.Range)
+	}
+}
@@ -1,8 +1,10 @@
 package service
 
 import (
-	"time"
+	"context"
+	"sort"
 
+	"go-grpc-backend/internal/models"
 	"go-grpc-backend/internal/repository"
 	"go-grpc-backend/proto"
 
@@ -10,49 +12,165 @@ import (
 )
 
 // GetPeriodOverPeriodChange retrieves the overall quality score for two periods and calculates the change
-// Returns the current period score, previous period score, and the percentage change
-// Formula: ((currentScore - previousScore) / previousScore) * 100
+// Returns the current period score, previous period score, and the change in the requested unit
+// Formula: ((currentScore - previousScore) / previousScore) * 100, or currentScore - previousScore in points
 // Uses the same scoring algorithm as GetOverallQualityScore for consistency
+// The change is tested for significance and broken down by category
 func GetPeriodOverPeriodChange(
+	ctx context.Context,
 	repo repository.AnalyticsRepositoryInterface,
-	currentStart, currentEnd, previousStart, previousEnd time.Time,
+	current, previous models.DateRange,
+	unit proto.ChangeUnit,
 ) (*proto.PeriodOverPeriodChangeResponse, error) {
-	// Get overall quality score for current period
-	currentResponse, err := GetOverallQualityScore(repo, currentStart, currentEnd)
+	// Get category scores for current period
+	currentScores, err := repo.GetOverallQualityScore(ctx, current)
 	if err != nil {
 		return nil, err
 	}
 
-	// Get overall quality score for previous period
-	previousResponse, err := GetOverallQualityScore(repo, previousStart, previousEnd)
+	// Get category scores for previous period
+	previousScores, err := repo.GetOverallQualityScore(ctx, previous)
 	if err != nil {
 		return nil, err
 	}
 
-	// Calculate percentage change
-	// Formula: ((current - previous) / previous) * 100
-	var changePercentage float32
-	if previousResponse.OverallScore != 0 {
-		changePercentage = ((currentResponse.OverallScore - previousResponse.OverallScore) / previousResponse.OverallScore) * 100
-	} else {
-		// If previous score is 0, we can't calculate percentage change
-		// If current score is also 0, change is 0
-		// If current score is > 0, we could say it's infinite growth, but we'll just set to 0
-		changePercentage = 0
-	}
+	// Same aggregation as GetOverallQualityScore
+	currentOverall, currentTotal := CalculateOverallScore(currentScores)
+	previousOverall, previousTotal := CalculateOverallScore(previousScores)
+	currentScore, previousScore := float32(currentOverall), float32(previousOverall)
+
+	unit = resolveChangeUnit(unit)
+	change := compareScores(currentScore, previousScore, currentTotal, previousTotal, unit)
 
 	// Create and return response
 	resp := &proto.PeriodOverPeriodChangeResponse{
-		CurrentPeriodScore:   currentResponse.OverallScore,
-		PreviousPeriodScore:  previousResponse.OverallScore,
-		ChangePercentage:     changePercentage,
-		CurrentTotalRatings:  currentResponse.TotalRatings,
-		PreviousTotalRatings: previousResponse.TotalRatings,
-		CurrentStart:         timestamppb.New(currentStart),
-		CurrentEnd:           timestamppb.New(currentEnd),
-		PreviousStart:        timestamppb.New(previousStart),
-		PreviousEnd:          timestamppb.New(previousEnd),
+		CurrentPeriodScore:   currentScore,
+		PreviousPeriodScore:  previousScore,
+		ChangePercentage:     change.change,
+		CurrentTotalRatings:  int32(currentTotal),
+		PreviousTotalRatings: int32(previousTotal),
+		CurrentStart:         timestamppb.New(current.Start),
+		CurrentEnd:           timestamppb.New(current.End),
+		PreviousStart:        timestamppb.New(previous.Start),
+		PreviousEnd:          timestamppb.New(previous.End),
+		CurrentRange:         dateRangeToProto(current),
+		PreviousRange:        dateRangeToProto(previous),
+		Significance:         welchTTest(weightedRatingStats(currentScores), weightedRatingStats(previousScores)),
+		CategoryChanges:      categoryChanges(currentScores, previousScores),
+		Status:               change.status,
+		PointDifference:      change.pointDifference,
+		ChangeUnit:           unit,
 	}
 
 	return resp, nil
 }
+
+// scoreChange is how an overall score moved from one period to the next
+type scoreChange struct {
+	status          proto.ChangeStatus
+	pointDifference float32
+	change          float32 // In the requested ChangeUnit
+}
+
+// resolveChangeUnit applies the default unit, relative percent
+func resolveChangeUnit(unit proto.ChangeUnit) proto.ChangeUnit {
+	if unit == proto.ChangeUnit_CHANGE_UNIT_UNSPECIFIED {
+		return proto.ChangeUnit_CHANGE_UNIT_RELATIVE_PERCENT
+	}
+	return unit
+}
+
+// compareScores computes the change between two overall scores.
+// A change is only reported when both periods have ratings
+func compareScores(currentScore, previousScore float32, currentTotal, previousTotal int, unit proto.ChangeUnit) scoreChange {
+	result := scoreChange{status: changeStatus(currentTotal, previousTotal)}
+	if result.status != proto.ChangeStatus_CHANGE_STATUS_COMPARABLE {
+		return result
+	}
+
+	result.pointDifference = currentScore - previousScore
+	switch {
+	case unit == proto.ChangeUnit_CHANGE_UNIT_PERCENTAGE_POINTS:
+		result.change = result.pointDifference
+	case previousScore != 0:
+		// Formula: ((current - previous) / previous) * 100
+		result.change = ((currentScore - previousScore) / previousScore) * 100
+	default:
+		// Every previous rating was 0: relative change is undefined, point_difference still holds
+		result.change = 0
+	}
+	return result
+}
+
+// changeStatus tells which of the two periods have ratings
+func changeStatus(currentTotal, previousTotal int) proto.ChangeStatus {
+	switch {
+	case currentTotal > 0 && previousTotal > 0:
+		return proto.ChangeStatus_CHANGE_STATUS_COMPARABLE
+	case currentTotal > 0:
+		return proto.ChangeStatus_CHANGE_STATUS_NO_PREVIOUS_DATA
+	case previousTotal > 0:
+		return proto.ChangeStatus_CHANGE_STATUS_NO_CURRENT_DATA
+	default:
+		return proto.ChangeStatus_CHANGE_STATUS_NO_DATA
+	}
+}
+
+// categoryChanges pairs up the categories of both periods. A category's contribution is the
+// change of its term in the overall mean, so contributions sum to the overall point change
+func categoryChanges(currentScores, previousScores []models.CategoryScore) []*proto.CategoryChange {
+	type pair struct {
+		current, previous *models.CategoryScore
+	}
+	pairs := make(map[int]*pair)
+	get := func(cs models.CategoryScore) *pair {
+		p, ok := pairs[cs.CategoryID]
+		if !ok {
+			p = &pair{}
+			pairs[cs.CategoryID] = p
+		}
+		return p
+	}
+	for i := range currentScores {
+		get(currentScores[i]).current = &currentScores[i]
+	}
+	for i := range previousScores {
+		get(previousScores[i]).previous = &previousScores[i]
+	}
+
+	changes := make([]*proto.CategoryChange, 0, len(pairs))
+	for id, p := range pairs {
+		change := &proto.CategoryChange{CategoryId: int32(id)}
+		var currentStats, previousStats sampleStats
+		var contribution float64
+
+		if cs := p.current; cs != nil {
+			score := CalculateCategoryScore(cs.Score, cs.CategoryWeight)
+			change.CategoryName = cs.CategoryName
+			change.CurrentScore = float32(score)
+			change.CurrentRatings = int32(cs.RatingCount)
+			currentStats = categoryRatingStats(*cs)
+			contribution += score / float64(len(currentScores))
+		}
+		if cs := p.previous; cs != nil {
+			score := CalculateCategoryScore(cs.Score, cs.CategoryWeight)
+			change.CategoryName = cs.CategoryName
+			change.PreviousScore = float32(score)
+			change.PreviousRatings = int32(cs.RatingCount)
+			previousStats = categoryRatingStats(*cs)
+			contribution -= score / float64(len(previousScores))
+		}
+
+		change.Contribution = float32(contribution)
+		change.Significance = welchTTest(currentStats, previousStats)
+		changes = append(changes, change)
+	}
+
+	sort.Slice(changes, func(i, j int) bool {
+		if changes[i].CategoryName != changes[j].CategoryName {
+			return changes[i].CategoryName < changes[j].CategoryName
+		}
+		return changes[i].CategoryId < changes[j].CategoryId
+	})
+	return changes
+}
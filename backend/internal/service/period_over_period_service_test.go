@@ -1,11 +1,13 @@
 package service
 
 import (
+	"context"
 	"errors"
 	"testing"
 	"time"
 
 	"go-grpc-backend/internal/models"
+	"go-grpc-backend/proto"
 )
 
 // Mock repository for period over period testing
@@ -16,7 +18,7 @@ type mockPeriodOverPeriodRepository struct {
 	callCount              int
 }
 
-func (m *mockPeriodOverPeriodRepository) GetOverallQualityScore(startDate, endDate time.Time) ([]models.CategoryScore, error) {
+func (m *mockPeriodOverPeriodRepository) GetOverallQualityScore(ctx context.Context, rng models.DateRange) ([]models.CategoryScore, error) {
 	if m.overallScoreError != nil {
 		return nil, m.overallScoreError
 	}
@@ -29,15 +31,23 @@ func (m *mockPeriodOverPeriodRepository) GetOverallQualityScore(startDate, endDa
 	return m.previousCategoryScores, nil
 }
 
-func (m *mockPeriodOverPeriodRepository) GetDailyAggregatedCategoryRatings(startDate, endDate time.Time) ([]models.CategoryRatingOverTimePeriod, error) {
+func (m *mockPeriodOverPeriodRepository) GetRatingDistribution(ctx context.Context, rng models.DateRange, granularity models.Granularity) ([]models.RatingDistribution, error) {
 	return nil, nil
 }
 
-func (m *mockPeriodOverPeriodRepository) GetWeeklyAggregatedCategoryRatings(startDate, endDate time.Time) ([]models.CategoryRatingOverTimePeriod, error) {
+func (m *mockPeriodOverPeriodRepository) GetCategoryScoresByPeriod(ctx context.Context, periods []models.DateRange) ([]models.PeriodCategoryScore, error) {
 	return nil, nil
 }
 
-func (m *mockPeriodOverPeriodRepository) GetScoresByTicket(startDate, endDate time.Time) ([]models.TicketCategoryScore, error) {
+func (m *mockPeriodOverPeriodRepository) GetDailyAggregatedCategoryRatings(ctx context.Context, rng models.DateRange) ([]models.CategoryRatingOverTimePeriod, error) {
+	return nil, nil
+}
+
+func (m *mockPeriodOverPeriodRepository) GetWeeklyAggregatedCategoryRatings(ctx context.Context, rng models.DateRange) ([]models.CategoryRatingOverTimePeriod, error) {
+	return nil, nil
+}
+
+func (m *mockPeriodOverPeriodRepository) GetScoresByTicket(ctx context.Context, rng models.DateRange) ([]models.TicketCategoryScore, error) {
 	return nil, nil
 }
 
@@ -59,7 +69,7 @@ func TestScoreService_GetPeriodOverPeriodChange_PositiveGrowth(t *testing.T) {
 		},
 	}
 
-	result, err := GetPeriodOverPeriodChange(mockRepo, currentStart, currentEnd, previousStart, previousEnd)
+	result, err := GetPeriodOverPeriodChange(context.Background(), mockRepo, models.NewDateRange(currentStart, currentEnd), models.NewDateRange(previousStart, previousEnd), proto.ChangeUnit_CHANGE_UNIT_UNSPECIFIED)
 
 	if err != nil {
 		t.Fatalf("GetPeriodOverPeriodChange() error = %v", err)
@@ -108,7 +118,7 @@ func TestScoreService_GetPeriodOverPeriodChange_NegativeGrowth(t *testing.T) {
 		},
 	}
 
-	result, err := GetPeriodOverPeriodChange(mockRepo, currentStart, currentEnd, previousStart, previousEnd)
+	result, err := GetPeriodOverPeriodChange(context.Background(), mockRepo, models.NewDateRange(currentStart, currentEnd), models.NewDateRange(previousStart, previousEnd), proto.ChangeUnit_CHANGE_UNIT_UNSPECIFIED)
 
 	if err != nil {
 		t.Fatalf("GetPeriodOverPeriodChange() error = %v", err)
@@ -138,7 +148,7 @@ func TestScoreService_GetPeriodOverPeriodChange_NoChange(t *testing.T) {
 		},
 	}
 
-	result, err := GetPeriodOverPeriodChange(mockRepo, currentStart, currentEnd, previousStart, previousEnd)
+	result, err := GetPeriodOverPeriodChange(context.Background(), mockRepo, models.NewDateRange(currentStart, currentEnd), models.NewDateRange(previousStart, previousEnd), proto.ChangeUnit_CHANGE_UNIT_UNSPECIFIED)
 
 	if err != nil {
 		t.Fatalf("GetPeriodOverPeriodChange() error = %v", err)
@@ -167,7 +177,7 @@ func TestScoreService_GetPeriodOverPeriodChange_PreviousPeriodZero(t *testing.T)
 		previousCategoryScores: []models.CategoryScore{}, // Empty = score 0
 	}
 
-	result, err := GetPeriodOverPeriodChange(mockRepo, currentStart, currentEnd, previousStart, previousEnd)
+	result, err := GetPeriodOverPeriodChange(context.Background(), mockRepo, models.NewDateRange(currentStart, currentEnd), models.NewDateRange(previousStart, previousEnd), proto.ChangeUnit_CHANGE_UNIT_UNSPECIFIED)
 
 	if err != nil {
 		t.Fatalf("GetPeriodOverPeriodChange() error = %v", err)
@@ -201,7 +211,7 @@ func TestScoreService_GetPeriodOverPeriodChange_BothPeriodsZero(t *testing.T) {
 		previousCategoryScores: []models.CategoryScore{},
 	}
 
-	result, err := GetPeriodOverPeriodChange(mockRepo, currentStart, currentEnd, previousStart, previousEnd)
+	result, err := GetPeriodOverPeriodChange(context.Background(), mockRepo, models.NewDateRange(currentStart, currentEnd), models.NewDateRange(previousStart, previousEnd), proto.ChangeUnit_CHANGE_UNIT_UNSPECIFIED)
 
 	if err != nil {
 		t.Fatalf("GetPeriodOverPeriodChange() error = %v", err)
@@ -248,7 +258,7 @@ func TestScoreService_GetPeriodOverPeriodChange_MultipleCategories(t *testing.T)
 		},
 	}
 
-	result, err := GetPeriodOverPeriodChange(mockRepo, currentStart, currentEnd, previousStart, previousEnd)
+	result, err := GetPeriodOverPeriodChange(context.Background(), mockRepo, models.NewDateRange(currentStart, currentEnd), models.NewDateRange(previousStart, previousEnd), proto.ChangeUnit_CHANGE_UNIT_UNSPECIFIED)
 
 	if err != nil {
 		t.Fatalf("GetPeriodOverPeriodChange() error = %v", err)
@@ -292,7 +302,7 @@ func TestScoreService_GetPeriodOverPeriodChange_CurrentPeriodError(t *testing.T)
 		overallScoreError: expectedError,
 	}
 
-	result, err := GetPeriodOverPeriodChange(mockRepo, currentStart, currentEnd, previousStart, previousEnd)
+	result, err := GetPeriodOverPeriodChange(context.Background(), mockRepo, models.NewDateRange(currentStart, currentEnd), models.NewDateRange(previousStart, previousEnd), proto.ChangeUnit_CHANGE_UNIT_UNSPECIFIED)
 
 	if err == nil {
 		t.Fatal("Expected error, got nil")
@@ -324,7 +334,7 @@ func TestScoreService_GetPeriodOverPeriodChange_ExampleUseCase_WeekOverWeek(t *t
 		},
 	}
 
-	result, err := GetPeriodOverPeriodChange(mockRepo, currentStart, currentEnd, previousStart, previousEnd)
+	result, err := GetPeriodOverPeriodChange(context.Background(), mockRepo, models.NewDateRange(currentStart, currentEnd), models.NewDateRange(previousStart, previousEnd), proto.ChangeUnit_CHANGE_UNIT_UNSPECIFIED)
 
 	if err != nil {
 		t.Fatalf("GetPeriodOverPeriodChange() error = %v", err)
@@ -366,7 +376,7 @@ func TestScoreService_GetPeriodOverPeriodChange_LargePositiveChange(t *testing.T
 		},
 	}
 
-	result, err := GetPeriodOverPeriodChange(mockRepo, currentStart, currentEnd, previousStart, previousEnd)
+	result, err := GetPeriodOverPeriodChange(context.Background(), mockRepo, models.NewDateRange(currentStart, currentEnd), models.NewDateRange(previousStart, previousEnd), proto.ChangeUnit_CHANGE_UNIT_UNSPECIFIED)
 
 	if err != nil {
 		t.Fatalf("GetPeriodOverPeriodChange() error = %v", err)
@@ -396,7 +406,7 @@ func TestScoreService_GetPeriodOverPeriodChange_LargeNegativeChange(t *testing.T
 		},
 	}
 
-	result, err := GetPeriodOverPeriodChange(mockRepo, currentStart, currentEnd, previousStart, previousEnd)
+	result, err := GetPeriodOverPeriodChange(context.Background(), mockRepo, models.NewDateRange(currentStart, currentEnd), models.NewDateRange(previousStart, previousEnd), proto.ChangeUnit_CHANGE_UNIT_UNSPECIFIED)
 
 	if err != nil {
 		t.Fatalf("GetPeriodOverPeriodChange() error = %v", err)
@@ -408,3 +418,132 @@ func TestScoreService_GetPeriodOverPeriodChange_LargeNegativeChange(t *testing.T
 		t.Errorf("Expected change percentage %v, got %v", expectedChange, result.ChangePercentage)
 	}
 }
+
+func TestScoreService_GetPeriodOverPeriodChange_Significance(t *testing.T) {
+	currentStart := time.Date(2025, 2, 1, 0, 0, 0, 0, time.UTC)
+	currentEnd := time.Date(2025, 2, 28, 0, 0, 0, 0, time.UTC)
+	previousStart := time.Date(2025, 1, 1, 0, 0, 0, 0, time.UTC)
+	previousEnd := time.Date(2025, 1, 31, 0, 0, 0, 0, time.UTC)
+
+	// Spelling improved a lot over many ratings; Grammar moved a little over a few
+	mockRepo := &mockPeriodOverPeriodRepository{
+		currentCategoryScores: []models.CategoryScore{
+			{CategoryID: 2, CategoryName: "Grammar", CategoryWeight: 1, Score: 3.2, RatingCount: 5, RatingVariance: 1.5, WeightedVariance: 1.5},
+			{CategoryID: 1, CategoryName: "Spelling", CategoryWeight: 1, Score: 4.5, RatingCount: 200, RatingVariance: 0.5, WeightedVariance: 0.5},
+		},
+		previousCategoryScores: []models.CategoryScore{
+			{CategoryID: 2, CategoryName: "Grammar", CategoryWeight: 1, Score: 3, RatingCount: 5, RatingVariance: 1.5, WeightedVariance: 1.5},
+			{CategoryID: 1, CategoryName: "Spelling", CategoryWeight: 1, Score: 3.5, RatingCount: 200, RatingVariance: 0.5, WeightedVariance: 0.5},
+			{CategoryID: 3, CategoryName: "Tone", CategoryWeight: 1, Score: 4, RatingCount: 1},
+		},
+	}
+
+	result, err := GetPeriodOverPeriodChange(context.Background(), mockRepo, models.NewDateRange(currentStart, currentEnd), models.NewDateRange(previousStart, previousEnd), proto.ChangeUnit_CHANGE_UNIT_UNSPECIFIED)
+	if err != nil {
+		t.Fatalf("GetPeriodOverPeriodChange() error = %v", err)
+	}
+
+	if result.Significance == nil || !result.Significance.Significant || result.Significance.EffectSize <= 0 {
+		t.Errorf("Expected a significant improvement, got %v", result.Significance)
+	}
+
+	if len(result.CategoryChanges) != 3 {
+		t.Fatalf("Expected 3 category changes, got %d", len(result.CategoryChanges))
+	}
+	grammar, spelling, tone := result.CategoryChanges[0], result.CategoryChanges[1], result.CategoryChanges[2]
+	if grammar.CategoryName != "Grammar" || spelling.CategoryName != "Spelling" || tone.CategoryName != "Tone" {
+		t.Fatalf("Expected categories ordered by name, got %v", result.CategoryChanges)
+	}
+
+	if !spelling.Significance.GetSignificant() {
+		t.Errorf("Expected Spelling's change to be significant, got %v", spelling.Significance)
+	}
+	if grammar.Significance == nil || grammar.Significance.Significant {
+		t.Errorf("Expected Grammar's change to be tested and not significant, got %v", grammar.Significance)
+	}
+	if tone.CurrentRatings != 0 || tone.PreviousScore != 80 || tone.Significance != nil {
+		t.Errorf("Expected Tone only in the previous period, got %v", tone)
+	}
+
+	var sum float32
+	for _, c := range result.CategoryChanges {
+		sum += c.Contribution
+	}
+	if diff := sum - (result.CurrentPeriodScore - result.PreviousPeriodScore); diff < -0.001 || diff > 0.001 {
+		t.Errorf("Expected contributions to add up to %v, got %v", result.CurrentPeriodScore-result.PreviousPeriodScore, sum)
+	}
+}
+
+func TestScoreService_GetPeriodOverPeriodChange_Status(t *testing.T) {
+	current := models.NewDateRange(time.Date(2025, 2, 1, 0, 0, 0, 0, time.UTC), time.Date(2025, 3, 1, 0, 0, 0, 0, time.UTC))
+	previous := models.NewDateRange(time.Date(2025, 1, 1, 0, 0, 0, 0, time.UTC), time.Date(2025, 2, 1, 0, 0, 0, 0, time.UTC))
+	rated := []models.CategoryScore{{CategoryID: 1, CategoryName: "Service", CategoryWeight: 0.5, Score: 4, RatingCount: 10}}
+	zeros := []models.CategoryScore{{CategoryID: 1, CategoryName: "Service", CategoryWeight: 0.5, Score: 0, RatingCount: 10}}
+
+	tests := []struct {
+		name             string
+		current          []models.CategoryScore
+		previous         []models.CategoryScore
+		expectedStatus   proto.ChangeStatus
+		expectedPoints   float32
+		expectedRelative float32
+	}{
+		{"both rated", rated, rated, proto.ChangeStatus_CHANGE_STATUS_COMPARABLE, 0, 0},
+		{"no previous data", rated, nil, proto.ChangeStatus_CHANGE_STATUS_NO_PREVIOUS_DATA, 0, 0},
+		{"no current data", nil, rated, proto.ChangeStatus_CHANGE_STATUS_NO_CURRENT_DATA, 0, 0},
+		{"no data", nil, nil, proto.ChangeStatus_CHANGE_STATUS_NO_DATA, 0, 0},
+		// Ratings of 0 are data: the periods compare, only the relative change is undefined
+		{"previous all zero", rated, zeros, proto.ChangeStatus_CHANGE_STATUS_COMPARABLE, 40, 0},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			mockRepo := &mockPeriodOverPeriodRepository{currentCategoryScores: tt.current, previousCategoryScores: tt.previous}
+
+			result, err := GetPeriodOverPeriodChange(context.Background(), mockRepo, current, previous, proto.ChangeUnit_CHANGE_UNIT_UNSPECIFIED)
+			if err != nil {
+				t.Fatalf("GetPeriodOverPeriodChange() error = %v", err)
+			}
+
+			if result.Status != tt.expectedStatus {
+				t.Errorf("Expected status %v, got %v", tt.expectedStatus, result.Status)
+			}
+			if result.PointDifference != tt.expectedPoints {
+				t.Errorf("Expected point difference %v, got %v", tt.expectedPoints, result.PointDifference)
+			}
+			if result.ChangePercentage != tt.expectedRelative {
+				t.Errorf("Expected change percentage %v, got %v", tt.expectedRelative, result.ChangePercentage)
+			}
+			if result.ChangeUnit != proto.ChangeUnit_CHANGE_UNIT_RELATIVE_PERCENT {
+				t.Errorf("Expected relative percent by default, got %v", result.ChangeUnit)
+			}
+		})
+	}
+}
+
+func TestScoreService_GetPeriodOverPeriodChange_PercentagePoints(t *testing.T) {
+	current := models.NewDateRange(time.Date(2025, 2, 1, 0, 0, 0, 0, time.UTC), time.Date(2025, 3, 1, 0, 0, 0, 0, time.UTC))
+	previous := models.NewDateRange(time.Date(2025, 1, 1, 0, 0, 0, 0, time.UTC), time.Date(2025, 2, 1, 0, 0, 0, 0, time.UTC))
+
+	// Current: 50, previous: 40. Relative change would be 25%, the point change is 10
+	mockRepo := &mockPeriodOverPeriodRepository{
+		currentCategoryScores: []models.CategoryScore{
+			{CategoryID: 1, CategoryName: "Service", CategoryWeight: 0.5, Score: 5.0, RatingCount: 100},
+		},
+		previousCategoryScores: []models.CategoryScore{
+			{CategoryID: 1, CategoryName: "Service", CategoryWeight: 0.5, Score: 4.0, RatingCount: 80},
+		},
+	}
+
+	result, err := GetPeriodOverPeriodChange(context.Background(), mockRepo, current, previous, proto.ChangeUnit_CHANGE_UNIT_PERCENTAGE_POINTS)
+	if err != nil {
+		t.Fatalf("GetPeriodOverPeriodChange() error = %v", err)
+	}
+
+	if result.ChangePercentage != 10 || result.PointDifference != 10 {
+		t.Errorf("Expected a 10 point change, got change %v and difference %v", result.ChangePercentage, result.PointDifference)
+	}
+	if result.ChangeUnit != proto.ChangeUnit_CHANGE_UNIT_PERCENTAGE_POINTS {
+		t.Errorf("Expected CHANGE_UNIT_PERCENTAGE_POINTS, got %v", result.ChangeUnit)
+	}
+}
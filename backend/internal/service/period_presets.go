@@ -0,0 +1,130 @@
+package service
+
+import (
+	"fmt"
+	"time"
+
+	"go-grpc-backend/internal/models"
+	"go-grpc-backend/proto"
+)
+
+// calendarUnit describes one PeriodUnit: where its periods start and how to step between them
+type calendarUnit struct {
+	start func(day time.Time) time.Time // First day of the period containing day
+	add   func(t time.Time, n int) time.Time
+}
+
+func stepDays(days int) func(time.Time, int) time.Time {
+	return func(t time.Time, n int) time.Time { return t.AddDate(0, 0, days*n) }
+}
+
+func stepMonths(months int) func(time.Time, int) time.Time {
+	return func(t time.Time, n int) time.Time { return t.AddDate(0, months*n, 0) }
+}
+
+func dayStart(day time.Time) time.Time {
+	return day
+}
+
+func weekStart(day time.Time) time.Time {
+	return day.AddDate(0, 0, -(int(day.Weekday())+6)%7)
+}
+
+func monthStart(day time.Time) time.Time {
+	return time.Date(day.Year(), day.Month(), 1, 0, 0, 0, 0, day.Location())
+}
+
+func quarterStart(day time.Time) time.Time {
+	month := time.Month((int(day.Month())-1)/3*3 + 1)
+	return time.Date(day.Year(), month, 1, 0, 0, 0, 0, day.Location())
+}
+
+func yearStart(day time.Time) time.Time {
+	return time.Date(day.Year(), time.January, 1, 0, 0, 0, 0, day.Location())
+}
+
+var calendarUnits = map[proto.PeriodUnit]calendarUnit{
+	proto.PeriodUnit_PERIOD_UNIT_DAY:     {start: dayStart, add: stepDays(1)},
+	proto.PeriodUnit_PERIOD_UNIT_WEEK:    {start: weekStart, add: stepDays(7)},
+	proto.PeriodUnit_PERIOD_UNIT_MONTH:   {start: monthStart, add: stepMonths(1)},
+	proto.PeriodUnit_PERIOD_UNIT_QUARTER: {start: quarterStart, add: stepMonths(3)},
+	proto.PeriodUnit_PERIOD_UNIT_YEAR:    {start: yearStart, add: stepMonths(12)},
+}
+
+// periodPreset is the calendar unit a preset compares, and whether the previous
+// period is the same one a year earlier rather than the one just before
+type periodPreset struct {
+	unit     proto.PeriodUnit
+	lastYear bool
+}
+
+var periodPresets = map[proto.PeriodPreset]periodPreset{
+	proto.PeriodPreset_PERIOD_PRESET_WEEK_OVER_WEEK:        {unit: proto.PeriodUnit_PERIOD_UNIT_WEEK},
+	proto.PeriodPreset_PERIOD_PRESET_MONTH_OVER_MONTH:      {unit: proto.PeriodUnit_PERIOD_UNIT_MONTH},
+	proto.PeriodPreset_PERIOD_PRESET_QUARTER_OVER_QUARTER:  {unit: proto.PeriodUnit_PERIOD_UNIT_QUARTER},
+	proto.PeriodPreset_PERIOD_PRESET_YEAR_OVER_YEAR:        {unit: proto.PeriodUnit_PERIOD_UNIT_YEAR},
+	proto.PeriodPreset_PERIOD_PRESET_SAME_PERIOD_LAST_YEAR: {unit: proto.PeriodUnit_PERIOD_UNIT_MONTH, lastYear: true},
+}
+
+// anchorDay is midnight in loc of the day containing anchor
+func anchorDay(anchor time.Time, loc *time.Location) time.Time {
+	local := anchor.In(loc)
+	return time.Date(local.Year(), local.Month(), local.Day(), 0, 0, 0, 0, loc)
+}
+
+// ResolvePeriodPreset turns a preset into the current and previous ranges, with calendar
+// boundaries at midnight in loc. The current period runs from the start of the calendar period
+// containing anchor up to the end of the anchor's day. The previous period starts at the same
+// point one step back and covers the same stretch, cut off where its calendar period ends,
+// so comparing March 1-15 against February 1-15 never spills into March
+func ResolvePeriodPreset(preset proto.PeriodPreset, anchor time.Time, loc *time.Location) (current, previous models.DateRange, err error) {
+	p, ok := periodPresets[preset]
+	if !ok {
+		return current, previous, fmt.Errorf("unknown period preset %v", preset)
+	}
+	unit := calendarUnits[p.unit]
+
+	back := func(t time.Time) time.Time { return unit.add(t, -1) }
+	if p.lastYear {
+		back = func(t time.Time) time.Time { return t.AddDate(-1, 0, 0) }
+	}
+
+	day := anchorDay(anchor, loc)
+	currentStart := unit.start(day)
+	currentEnd := day.AddDate(0, 0, 1)
+
+	previousStart := back(currentStart)
+	previousEnd := back(currentEnd)
+	if limit := unit.add(previousStart, 1); previousEnd.After(limit) {
+		previousEnd = limit
+	}
+
+	return models.NewDateRange(currentStart, currentEnd), models.NewDateRange(previousStart, previousEnd), nil
+}
+
+// ResolvePeriodSeries returns count consecutive calendar periods of the given unit, oldest first.
+// The last one contains anchor and ends at the end of the anchor's day; the others are whole periods
+func ResolvePeriodSeries(periodUnit proto.PeriodUnit, count int, anchor time.Time, loc *time.Location) ([]models.DateRange, error) {
+	unit, ok := calendarUnits[periodUnit]
+	if !ok {
+		return nil, fmt.Errorf("unknown period unit %v", periodUnit)
+	}
+	if count <= 0 {
+		return nil, fmt.Errorf("period count must be positive, got %d", count)
+	}
+
+	day := anchorDay(anchor, loc)
+	last := unit.start(day)
+
+	periods := make([]models.DateRange, count)
+	for i := range periods {
+		// Step from the last period's start each time so month lengths never accumulate drift
+		start := unit.add(last, i-count+1)
+		end := unit.add(last, i-count+2)
+		if i == count-1 {
+			end = day.AddDate(0, 0, 1)
+		}
+		periods[i] = models.NewDateRange(start, end)
+	}
+	return periods, nil
+}
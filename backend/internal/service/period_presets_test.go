@@ -0,0 +1,153 @@
+package service
+
+import (
+	"testing"
+	"time"
+
+	"go-grpc-backend/internal/models"
+	"go-grpc-backend/proto"
+)
+
+func TestScoreService_ResolvePeriodPreset(t *testing.T) {
+	utc := func(y int, m time.Month, d int) time.Time { return time.Date(y, m, d, 0, 0, 0, 0, time.UTC) }
+
+	tests := []struct {
+		name     string
+		preset   proto.PeriodPreset
+		anchor   time.Time
+		current  models.DateRange
+		previous models.DateRange
+	}{
+		{
+			// Wednesday: Monday to end of Wednesday against the same days a week earlier
+			name:     "week over week",
+			preset:   proto.PeriodPreset_PERIOD_PRESET_WEEK_OVER_WEEK,
+			anchor:   time.Date(2025, 3, 12, 15, 30, 0, 0, time.UTC),
+			current:  models.NewDateRange(utc(2025, 3, 10), utc(2025, 3, 13)),
+			previous: models.NewDateRange(utc(2025, 3, 3), utc(2025, 3, 6)),
+		},
+		{
+			name:     "week over week on a Sunday",
+			preset:   proto.PeriodPreset_PERIOD_PRESET_WEEK_OVER_WEEK,
+			anchor:   utc(2025, 3, 16),
+			current:  models.NewDateRange(utc(2025, 3, 10), utc(2025, 3, 17)),
+			previous: models.NewDateRange(utc(2025, 3, 3), utc(2025, 3, 10)),
+		},
+		{
+			name:     "month over month",
+			preset:   proto.PeriodPreset_PERIOD_PRESET_MONTH_OVER_MONTH,
+			anchor:   utc(2025, 3, 15),
+			current:  models.NewDateRange(utc(2025, 3, 1), utc(2025, 3, 16)),
+			previous: models.NewDateRange(utc(2025, 2, 1), utc(2025, 2, 16)),
+		},
+		{
+			// February is shorter: the previous period stops at the end of February
+			name:     "month over month past the end of the previous month",
+			preset:   proto.PeriodPreset_PERIOD_PRESET_MONTH_OVER_MONTH,
+			anchor:   utc(2025, 3, 30),
+			current:  models.NewDateRange(utc(2025, 3, 1), utc(2025, 3, 31)),
+			previous: models.NewDateRange(utc(2025, 2, 1), utc(2025, 3, 1)),
+		},
+		{
+			name:     "quarter over quarter",
+			preset:   proto.PeriodPreset_PERIOD_PRESET_QUARTER_OVER_QUARTER,
+			anchor:   utc(2025, 5, 20),
+			current:  models.NewDateRange(utc(2025, 4, 1), utc(2025, 5, 21)),
+			previous: models.NewDateRange(utc(2025, 1, 1), utc(2025, 2, 21)),
+		},
+		{
+			name:     "year over year",
+			preset:   proto.PeriodPreset_PERIOD_PRESET_YEAR_OVER_YEAR,
+			anchor:   utc(2025, 2, 28),
+			current:  models.NewDateRange(utc(2025, 1, 1), utc(2025, 3, 1)),
+			previous: models.NewDateRange(utc(2024, 1, 1), utc(2024, 3, 1)),
+		},
+		{
+			name:     "same period last year",
+			preset:   proto.PeriodPreset_PERIOD_PRESET_SAME_PERIOD_LAST_YEAR,
+			anchor:   utc(2025, 3, 15),
+			current:  models.NewDateRange(utc(2025, 3, 1), utc(2025, 3, 16)),
+			previous: models.NewDateRange(utc(2024, 3, 1), utc(2024, 3, 16)),
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			current, previous, err := ResolvePeriodPreset(tt.preset, tt.anchor, time.UTC)
+			if err != nil {
+				t.Fatalf("ResolvePeriodPreset() error = %v", err)
+			}
+			if !current.Start.Equal(tt.current.Start) || !current.End.Equal(tt.current.End) {
+				t.Errorf("Expected current %v - %v, got %v - %v", tt.current.Start, tt.current.End, current.Start, current.End)
+			}
+			if !previous.Start.Equal(tt.previous.Start) || !previous.End.Equal(tt.previous.End) {
+				t.Errorf("Expected previous %v - %v, got %v - %v", tt.previous.Start, tt.previous.End, previous.Start, previous.End)
+			}
+		})
+	}
+}
+
+func TestScoreService_ResolvePeriodPreset_TimeZone(t *testing.T) {
+	loc, err := time.LoadLocation("America/New_York")
+	if err != nil {
+		t.Fatalf("LoadLocation() error = %v", err)
+	}
+
+	// 2025-03-01T03:00Z is still February 28 in New York
+	anchor := time.Date(2025, 3, 1, 3, 0, 0, 0, time.UTC)
+	current, previous, err := ResolvePeriodPreset(proto.PeriodPreset_PERIOD_PRESET_MONTH_OVER_MONTH, anchor, loc)
+	if err != nil {
+		t.Fatalf("ResolvePeriodPreset() error = %v", err)
+	}
+
+	if expected := time.Date(2025, 2, 1, 0, 0, 0, 0, loc); !current.Start.Equal(expected) {
+		t.Errorf("Expected current start %v, got %v", expected, current.Start)
+	}
+	// The range ends at local midnight, which is 05:00 UTC
+	if expected := time.Date(2025, 3, 1, 5, 0, 0, 0, time.UTC); !current.End.Equal(expected) {
+		t.Errorf("Expected current end %v, got %v", expected, current.End.UTC())
+	}
+	if expected := time.Date(2025, 1, 1, 0, 0, 0, 0, loc); !previous.Start.Equal(expected) {
+		t.Errorf("Expected previous start %v, got %v", expected, previous.Start)
+	}
+}
+
+func TestScoreService_ResolvePeriodPreset_Unknown(t *testing.T) {
+	if _, _, err := ResolvePeriodPreset(proto.PeriodPreset_PERIOD_PRESET_UNSPECIFIED, time.Now(), time.UTC); err == nil {
+		t.Error("Expected error for an unspecified preset, got nil")
+	}
+}
+
+func TestScoreService_ResolvePeriodSeries(t *testing.T) {
+	utc := func(y int, m time.Month, d int) time.Time { return time.Date(y, m, d, 0, 0, 0, 0, time.UTC) }
+
+	// Wednesday 2025-03-12
+	periods, err := ResolvePeriodSeries(proto.PeriodUnit_PERIOD_UNIT_MONTH, 3, utc(2025, 3, 12), time.UTC)
+	if err != nil {
+		t.Fatalf("ResolvePeriodSeries() error = %v", err)
+	}
+
+	expected := []models.DateRange{
+		models.NewDateRange(utc(2025, 1, 1), utc(2025, 2, 1)),
+		models.NewDateRange(utc(2025, 2, 1), utc(2025, 3, 1)),
+		// The current period is cut at the end of the anchor day
+		models.NewDateRange(utc(2025, 3, 1), utc(2025, 3, 13)),
+	}
+	if len(periods) != len(expected) {
+		t.Fatalf("Expected %d periods, got %d", len(expected), len(periods))
+	}
+	for i, e := range expected {
+		if !periods[i].Start.Equal(e.Start) || !periods[i].End.Equal(e.End) {
+			t.Errorf("Period %d: expected %v - %v, got %v - %v", i, e.Start, e.End, periods[i].Start, periods[i].End)
+		}
+	}
+
+	for _, count := range []int{0, -1} {
+		if _, err := ResolvePeriodSeries(proto.PeriodUnit_PERIOD_UNIT_WEEK, count, utc(2025, 3, 12), time.UTC); err == nil {
+			t.Errorf("Expected error for count %d, got nil", count)
+		}
+	}
+	if _, err := ResolvePeriodSeries(proto.PeriodUnit_PERIOD_UNIT_UNSPECIFIED, 1, utc(2025, 3, 12), time.UTC); err == nil {
+		t.Error("Expected error for an unspecified unit, got nil")
+	}
+}
@@ -0,0 +1,62 @@
+package service
+
+import (
+	"context"
+	"go-grpc-backend/internal/models"
+	"go-grpc-backend/internal/repository"
+	"go-grpc-backend/proto"
+)
+
+// MaxPeriodSeriesCount caps the number of periods GetPeriodSeries computes at once
+const MaxPeriodSeriesCount = 366
+
+// GetPeriodSeries computes the overall quality score of consecutive periods and the change between
+// neighbours, with one repository query. periods must be ordered oldest first; the first one is only
+// used as the baseline of the second and is not returned
+func GetPeriodSeries(
+	ctx context.Context,
+	repo repository.AnalyticsRepositoryInterface,
+	periods []models.DateRange,
+	periodUnit proto.PeriodUnit,
+	changeUnit proto.ChangeUnit,
+) (*proto.PeriodSeriesResponse, error) {
+	rows, err := repo.GetCategoryScoresByPeriod(ctx, periods)
+	if err != nil {
+		return nil, err
+	}
+
+	byPeriod := make([][]models.CategoryScore, len(periods))
+	for _, row := range rows {
+		byPeriod[row.PeriodIndex] = append(byPeriod[row.PeriodIndex], row.CategoryScore)
+	}
+
+	changeUnit = resolveChangeUnit(changeUnit)
+	resp := &proto.PeriodSeriesResponse{
+		Unit:       periodUnit,
+		ChangeUnit: changeUnit,
+	}
+
+	var previousScore float32
+	var previousTotal int
+	for i, rng := range periods {
+		// Same aggregation as GetOverallQualityScore
+		overall, total := CalculateOverallScore(byPeriod[i])
+		score := float32(overall)
+
+		if i > 0 {
+			change := compareScores(score, previousScore, total, previousTotal, changeUnit)
+			resp.Periods = append(resp.Periods, &proto.PeriodSeriesPoint{
+				Range:           dateRangeToProto(rng),
+				OverallScore:    score,
+				TotalRatings:    int32(total),
+				Status:          change.status,
+				Change:          change.change,
+				PointDifference: change.pointDifference,
+			})
+		}
+
+		previousScore, previousTotal = score, total
+	}
+
+	return resp, nil
+}
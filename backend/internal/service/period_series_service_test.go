@@ -0,0 +1,128 @@
+package service
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+
+	"go-grpc-backend/internal/models"
+	"go-grpc-backend/proto"
+)
+
+// Mock repository for period series testing
+type mockPeriodSeriesRepository struct {
+	rows    []models.PeriodCategoryScore
+	err     error
+	periods []models.DateRange
+	calls   int
+}
+
+func (m *mockPeriodSeriesRepository) GetCategoryScoresByPeriod(ctx context.Context, periods []models.DateRange) ([]models.PeriodCategoryScore, error) {
+	m.calls++
+	m.periods = periods
+	return m.rows, m.err
+}
+
+func (m *mockPeriodSeriesRepository) GetOverallQualityScore(ctx context.Context, rng models.DateRange) ([]models.CategoryScore, error) {
+	return nil, nil
+}
+
+func (m *mockPeriodSeriesRepository) GetRatingDistribution(ctx context.Context, rng models.DateRange, granularity models.Granularity) ([]models.RatingDistribution, error) {
+	return nil, nil
+}
+
+func (m *mockPeriodSeriesRepository) GetDailyAggregatedCategoryRatings(ctx context.Context, rng models.DateRange) ([]models.CategoryRatingOverTimePeriod, error) {
+	return nil, nil
+}
+
+func (m *mockPeriodSeriesRepository) GetWeeklyAggregatedCategoryRatings(ctx context.Context, rng models.DateRange) ([]models.CategoryRatingOverTimePeriod, error) {
+	return nil, nil
+}
+
+func (m *mockPeriodSeriesRepository) GetScoresByTicket(ctx context.Context, rng models.DateRange) ([]models.TicketCategoryScore, error) {
+	return nil, nil
+}
+
+func weeklyPeriods(count int) []models.DateRange {
+	periods, err := ResolvePeriodSeries(proto.PeriodUnit_PERIOD_UNIT_WEEK, count, time.Date(2025, 3, 12, 0, 0, 0, 0, time.UTC), time.UTC)
+	if err != nil {
+		panic(err)
+	}
+	return periods
+}
+
+func periodScore(index int, avg float64, count int) models.PeriodCategoryScore {
+	return models.PeriodCategoryScore{
+		PeriodIndex:   index,
+		CategoryScore: models.CategoryScore{CategoryID: 1, CategoryName: "Spelling", CategoryWeight: 1, Score: avg, RatingCount: count},
+	}
+}
+
+func TestScoreService_GetPeriodSeries(t *testing.T) {
+	// Period 0 is the baseline; period 2 has no ratings
+	repo := &mockPeriodSeriesRepository{rows: []models.PeriodCategoryScore{
+		periodScore(0, 4, 2),
+		periodScore(1, 5, 3),
+		periodScore(3, 3, 1),
+	}}
+	periods := weeklyPeriods(4)
+
+	resp, err := GetPeriodSeries(context.Background(), repo, periods, proto.PeriodUnit_PERIOD_UNIT_WEEK, proto.ChangeUnit_CHANGE_UNIT_UNSPECIFIED)
+	if err != nil {
+		t.Fatalf("Expected no error, got %v", err)
+	}
+	if repo.calls != 1 || len(repo.periods) != 4 {
+		t.Errorf("Expected one repository call with 4 periods, got %d calls with %d periods", repo.calls, len(repo.periods))
+	}
+	if resp.ChangeUnit != proto.ChangeUnit_CHANGE_UNIT_RELATIVE_PERCENT || resp.Unit != proto.PeriodUnit_PERIOD_UNIT_WEEK {
+		t.Errorf("Expected WEEK in RELATIVE_PERCENT, got %v in %v", resp.Unit, resp.ChangeUnit)
+	}
+
+	expected := []struct {
+		score  float32
+		total  int32
+		status proto.ChangeStatus
+		change float32
+	}{
+		{100, 3, proto.ChangeStatus_CHANGE_STATUS_COMPARABLE, 25},
+		{0, 0, proto.ChangeStatus_CHANGE_STATUS_NO_CURRENT_DATA, 0},
+		{60, 1, proto.ChangeStatus_CHANGE_STATUS_NO_PREVIOUS_DATA, 0},
+	}
+	if len(resp.Periods) != len(expected) {
+		t.Fatalf("Expected %d periods, got %d", len(expected), len(resp.Periods))
+	}
+	for i, e := range expected {
+		p := resp.Periods[i]
+		if p.OverallScore != e.score || p.TotalRatings != e.total || p.Status != e.status || p.Change != e.change {
+			t.Errorf("Period %d: expected %v over %d (%v, %v), got %v over %d (%v, %v)",
+				i, e.score, e.total, e.status, e.change, p.OverallScore, p.TotalRatings, p.Status, p.Change)
+		}
+		if !p.Range.Start.AsTime().Equal(periods[i+1].Start) {
+			t.Errorf("Period %d: expected start %v, got %v", i, periods[i+1].Start, p.Range.Start.AsTime())
+		}
+	}
+}
+
+func TestScoreService_GetPeriodSeries_PercentagePoints(t *testing.T) {
+	repo := &mockPeriodSeriesRepository{rows: []models.PeriodCategoryScore{
+		periodScore(0, 4, 2),
+		periodScore(1, 3, 2),
+	}}
+
+	resp, err := GetPeriodSeries(context.Background(), repo, weeklyPeriods(2), proto.PeriodUnit_PERIOD_UNIT_WEEK, proto.ChangeUnit_CHANGE_UNIT_PERCENTAGE_POINTS)
+	if err != nil {
+		t.Fatalf("Expected no error, got %v", err)
+	}
+	if p := resp.Periods[0]; p.Change != -20 || p.PointDifference != -20 {
+		t.Errorf("Expected -20 points, got change %v, difference %v", p.Change, p.PointDifference)
+	}
+}
+
+func TestScoreService_GetPeriodSeries_Error(t *testing.T) {
+	repo := &mockPeriodSeriesRepository{err: errors.New("database error")}
+
+	if _, err := GetPeriodSeries(context.Background(), repo, weeklyPeriods(2), proto.PeriodUnit_PERIOD_UNIT_WEEK, proto.ChangeUnit_CHANGE_UNIT_UNSPECIFIED); err == nil {
+		t.Error("Expected error, got nil")
+	}
+}
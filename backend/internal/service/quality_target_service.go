@@ -0,0 +1,180 @@
+package service
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"go-grpc-backend/internal/models"
+	"go-grpc-backend/internal/repository"
+	"go-grpc-backend/proto"
+
+	"google.golang.org/protobuf/types/known/durationpb"
+	"google.golang.org/protobuf/types/known/timestamppb"
+)
+
+// DefaultBurnWindow is the recent window GetTargetStatus measures burn rate over when the request doesn't say
+const DefaultBurnWindow = 24 * time.Hour
+
+// ErrInvalidQualityTarget is returned for targets the category can't be held to
+var ErrInvalidQualityTarget = errors.New("invalid quality target")
+
+// ListQualityTargets returns every quality target
+func ListQualityTargets(ctx context.Context, repo repository.QualityTargetRepositoryInterface) (*proto.ListQualityTargetsResponse, error) {
+	targets, err := repo.ListQualityTargets(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	resp := &proto.ListQualityTargetsResponse{}
+	for _, t := range targets {
+		resp.Targets = append(resp.Targets, qualityTargetToProto(t))
+	}
+	return resp, nil
+}
+
+// CreateQualityTarget stores a new target after checking it against its category
+func CreateQualityTarget(ctx context.Context, repo repository.QualityTargetRepositoryInterface, target models.QualityTarget) (*proto.QualityTarget, error) {
+	if err := validateQualityTarget(ctx, repo, target); err != nil {
+		return nil, err
+	}
+	created, err := repo.CreateQualityTarget(ctx, target)
+	if err != nil {
+		return nil, err
+	}
+	return qualityTargetToProto(created), nil
+}
+
+// UpdateQualityTarget replaces a target's category, score and window
+func UpdateQualityTarget(ctx context.Context, repo repository.QualityTargetRepositoryInterface, target models.QualityTarget) (*proto.QualityTarget, error) {
+	if err := validateQualityTarget(ctx, repo, target); err != nil {
+		return nil, err
+	}
+	updated, err := repo.UpdateQualityTarget(ctx, target)
+	if err != nil {
+		return nil, err
+	}
+	return qualityTargetToProto(updated), nil
+}
+
+func DeleteQualityTarget(ctx context.Context, repo repository.QualityTargetRepositoryInterface, id int) (*proto.DeleteQualityTargetResponse, error) {
+	if err := repo.DeleteQualityTarget(ctx, id); err != nil {
+		return nil, err
+	}
+	return &proto.DeleteQualityTargetResponse{}, nil
+}
+
+// validateQualityTarget rejects unknown categories and targets at or above the category's
+// maximum score, which would leave no error budget
+func validateQualityTarget(ctx context.Context, repo repository.QualityTargetRepositoryInterface, target models.QualityTarget) error {
+	category, err := repo.GetRatingCategory(ctx, target.CategoryID)
+	if errors.Is(err, repository.ErrNotFound) {
+		return fmt.Errorf("%w: unknown rating category %d", ErrInvalidQualityTarget, target.CategoryID)
+	}
+	if err != nil {
+		return err
+	}
+
+	maxScore := CalculateCategoryScore(models.MaxRatingValue, category.Weight)
+	if target.TargetScore >= maxScore {
+		return fmt.Errorf("%w: target_score %g must be below %g, the maximum score of category %q",
+			ErrInvalidQualityTarget, target.TargetScore, maxScore, category.Name)
+	}
+	return nil
+}
+
+// GetTargetStatus measures every target over its window ending at asOf, and its burn rate over
+// the last burnWindow of that window. Category scores come from the same per-category rows and
+// CalculateCategoryScore as GetOverallQualityScore; each distinct window is queried once
+func GetTargetStatus(ctx context.Context, targets repository.QualityTargetRepositoryInterface, analytics repository.AnalyticsRepositoryInterface, asOf time.Time, burnWindow time.Duration) (*proto.TargetStatusResponse, error) {
+	if burnWindow <= 0 {
+		burnWindow = DefaultBurnWindow
+	}
+
+	list, err := targets.ListQualityTargets(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	byWindow := make(map[time.Duration]map[int]models.CategoryScore)
+	categoryScores := func(window time.Duration) (models.DateRange, map[int]models.CategoryScore, error) {
+		rng := models.NewDateRange(asOf.Add(-window), asOf)
+		if scores, ok := byWindow[window]; ok {
+			return rng, scores, nil
+		}
+		rows, err := analytics.GetOverallQualityScore(ctx, rng)
+		if err != nil {
+			return rng, nil, err
+		}
+		scores := make(map[int]models.CategoryScore, len(rows))
+		for _, cs := range rows {
+			scores[cs.CategoryID] = cs
+		}
+		byWindow[window] = scores
+		return rng, scores, nil
+	}
+
+	resp := &proto.TargetStatusResponse{AsOf: timestamppb.New(asOf)}
+	for _, t := range list {
+		rng, scores, err := categoryScores(t.Window)
+		if err != nil {
+			return nil, err
+		}
+		burnRng, burnScores, err := categoryScores(min(burnWindow, t.Window))
+		if err != nil {
+			return nil, err
+		}
+
+		// Scores weigh each rating by the weight it was given under, so the maximum and the budget
+		// use the same effective weight. Without ratings in the window only the current one is known
+		cs := scores[t.CategoryID]
+		weight := t.CategoryWeight
+		if cs.RatingCount > 0 {
+			weight = cs.CategoryWeight
+		}
+		maxScore := CalculateCategoryScore(models.MaxRatingValue, weight)
+		budget := maxScore - t.TargetScore
+		status := &proto.TargetStatus{
+			Target:      qualityTargetToProto(t),
+			Window:      dateRangeToProto(rng),
+			MaxScore:    maxScore,
+			ErrorBudget: budget,
+			BurnWindow:  dateRangeToProto(burnRng),
+		}
+
+		if cs.RatingCount > 0 {
+			score := CalculateCategoryScore(cs.Score, cs.CategoryWeight)
+			status.Score = score
+			status.RatingCount = int32(cs.RatingCount)
+			status.Met = score >= t.TargetScore
+			status.Attainment = score / t.TargetScore
+			// A category reweighted since the target was set may have no budget left to divide by
+			if budget > 0 {
+				status.RemainingErrorBudget = (score - t.TargetScore) / budget
+			}
+		}
+		if burn := burnScores[t.CategoryID]; burn.RatingCount > 0 {
+			status.BurnRatingCount = int32(burn.RatingCount)
+			// The burn window may straddle a weight change differently from the full window
+			burnMax := CalculateCategoryScore(models.MaxRatingValue, burn.CategoryWeight)
+			if burnBudget := burnMax - t.TargetScore; burnBudget > 0 {
+				status.BurnRate = (burnMax - CalculateCategoryScore(burn.Score, burn.CategoryWeight)) / burnBudget
+			}
+		}
+
+		resp.Statuses = append(resp.Statuses, status)
+	}
+	return resp, nil
+}
+
+func qualityTargetToProto(t models.QualityTarget) *proto.QualityTarget {
+	return &proto.QualityTarget{
+		Id:           int32(t.ID),
+		CategoryId:   int32(t.CategoryID),
+		CategoryName: t.CategoryName,
+		TargetScore:  t.TargetScore,
+		Window:       durationpb.New(t.Window),
+		CreatedAt:    timestamppb.New(t.CreatedAt),
+	}
+}
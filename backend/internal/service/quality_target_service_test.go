@@ -0,0 +1,238 @@
+package service
+
+import (
+	"context"
+	"errors"
+	"math"
+	"testing"
+	"time"
+
+	"go-grpc-backend/internal/models"
+	"go-grpc-backend/internal/repository"
+)
+
+// mockQualityTargetRepository serves canned targets and categories
+type mockQualityTargetRepository struct {
+	targets    []models.QualityTarget
+	categories map[int]models.RatingCategory
+	created    []models.QualityTarget
+}
+
+func (m *mockQualityTargetRepository) ListQualityTargets(ctx context.Context) ([]models.QualityTarget, error) {
+	return m.targets, nil
+}
+
+func (m *mockQualityTargetRepository) GetQualityTarget(ctx context.Context, id int) (models.QualityTarget, error) {
+	for _, t := range m.targets {
+		if t.ID == id {
+			return t, nil
+		}
+	}
+	return models.QualityTarget{}, repository.ErrNotFound
+}
+
+func (m *mockQualityTargetRepository) CreateQualityTarget(ctx context.Context, target models.QualityTarget) (models.QualityTarget, error) {
+	target.ID = len(m.created) + 1
+	m.created = append(m.created, target)
+	return target, nil
+}
+
+func (m *mockQualityTargetRepository) UpdateQualityTarget(ctx context.Context, target models.QualityTarget) (models.QualityTarget, error) {
+	return target, nil
+}
+
+func (m *mockQualityTargetRepository) DeleteQualityTarget(ctx context.Context, id int) error {
+	return nil
+}
+
+func (m *mockQualityTargetRepository) GetRatingCategory(ctx context.Context, id int) (models.RatingCategory, error) {
+	category, ok := m.categories[id]
+	if !ok {
+		return category, repository.ErrNotFound
+	}
+	return category, nil
+}
+
+// mockWindowScoresRepository answers GetOverallQualityScore by the length of the requested range
+type mockWindowScoresRepository struct {
+	byDuration map[time.Duration][]models.CategoryScore
+	queries    []models.DateRange
+}
+
+func (m *mockWindowScoresRepository) GetOverallQualityScore(ctx context.Context, rng models.DateRange) ([]models.CategoryScore, error) {
+	m.queries = append(m.queries, rng)
+	return m.byDuration[rng.Duration()], nil
+}
+
+func (m *mockWindowScoresRepository) GetDailyAggregatedCategoryRatings(ctx context.Context, rng models.DateRange) ([]models.CategoryRatingOverTimePeriod, error) {
+	return nil, nil
+}
+
+func (m *mockWindowScoresRepository) GetWeeklyAggregatedCategoryRatings(ctx context.Context, rng models.DateRange) ([]models.CategoryRatingOverTimePeriod, error) {
+	return nil, nil
+}
+
+func (m *mockWindowScoresRepository) GetScoresByTicket(ctx context.Context, rng models.DateRange) ([]models.TicketCategoryScore, error) {
+	return nil, nil
+}
+
+func (m *mockWindowScoresRepository) GetRatingDistribution(ctx context.Context, rng models.DateRange, granularity models.Granularity) ([]models.RatingDistribution, error) {
+	return nil, nil
+}
+
+func (m *mockWindowScoresRepository) GetCategoryScoresByPeriod(ctx context.Context, periods []models.DateRange) ([]models.PeriodCategoryScore, error) {
+	return nil, nil
+}
+
+func TestQualityTargetService_CreateQualityTarget_Validation(t *testing.T) {
+	repo := &mockQualityTargetRepository{categories: map[int]models.RatingCategory{
+		2: {ID: 2, Name: "Grammar", Weight: 0.5},
+	}}
+
+	// Weight 0.5 caps the category score at 5 * 0.5 * 20 = 50
+	tests := []struct {
+		name    string
+		target  models.QualityTarget
+		wantErr bool
+	}{
+		{name: "reachable", target: models.QualityTarget{CategoryID: 2, TargetScore: 45, Window: time.Hour}},
+		{name: "at the maximum", target: models.QualityTarget{CategoryID: 2, TargetScore: 50, Window: time.Hour}, wantErr: true},
+		{name: "unknown category", target: models.QualityTarget{CategoryID: 9, TargetScore: 10, Window: time.Hour}, wantErr: true},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			_, err := CreateQualityTarget(context.Background(), repo, tt.target)
+			if tt.wantErr != errors.Is(err, ErrInvalidQualityTarget) {
+				t.Errorf("CreateQualityTarget() error = %v, wantErr %v", err, tt.wantErr)
+			}
+		})
+	}
+	if len(repo.created) != 1 {
+		t.Errorf("Expected only the valid target to be stored, got %d", len(repo.created))
+	}
+}
+
+func TestQualityTargetService_GetTargetStatus(t *testing.T) {
+	asOf := time.Date(2025, 3, 1, 12, 0, 0, 0, time.UTC)
+	week := 7 * 24 * time.Hour
+	targets := &mockQualityTargetRepository{targets: []models.QualityTarget{
+		{ID: 1, CategoryID: 1, CategoryName: "Spelling", CategoryWeight: 1, TargetScore: 80, Window: week},
+		{ID: 2, CategoryID: 2, CategoryName: "Grammar", CategoryWeight: 0.5, TargetScore: 40, Window: 24 * time.Hour},
+	}}
+	analytics := &mockWindowScoresRepository{byDuration: map[time.Duration][]models.CategoryScore{
+		// Spelling over the week: 4.5 * 1 * 20 = 90
+		week: {{CategoryID: 1, CategoryName: "Spelling", CategoryWeight: 1, Score: 4.5, RatingCount: 20}},
+		// Spelling over the last day: 3.5 * 1 * 20 = 70; Grammar has no ratings
+		24 * time.Hour: {{CategoryID: 1, CategoryName: "Spelling", CategoryWeight: 1, Score: 3.5, RatingCount: 4}},
+	}}
+
+	resp, err := GetTargetStatus(context.Background(), targets, analytics, asOf, 0)
+	if err != nil {
+		t.Fatalf("GetTargetStatus() error = %v", err)
+	}
+	if len(resp.Statuses) != 2 {
+		t.Fatalf("Expected 2 statuses, got %d", len(resp.Statuses))
+	}
+	// The day window serves both the first target's burn rate and the second target
+	if len(analytics.queries) != 2 {
+		t.Errorf("Expected one query per distinct window, got %d", len(analytics.queries))
+	}
+
+	spelling := resp.Statuses[0]
+	if !spelling.Window.Start.AsTime().Equal(asOf.Add(-week)) || !spelling.BurnWindow.Start.AsTime().Equal(asOf.Add(-DefaultBurnWindow)) {
+		t.Errorf("Unexpected windows %v and %v", spelling.Window, spelling.BurnWindow)
+	}
+	checks := []struct {
+		name      string
+		got, want float64
+	}{
+		{"score", spelling.Score, 90},
+		{"max_score", spelling.MaxScore, 100},
+		{"attainment", spelling.Attainment, 90.0 / 80},
+		{"error_budget", spelling.ErrorBudget, 20},
+		// Half of the 20 point budget is left
+		{"remaining_error_budget", spelling.RemainingErrorBudget, 0.5},
+		// The last day fell 30 points short of the maximum: 1.5× the sustainable pace
+		{"burn_rate", spelling.BurnRate, 1.5},
+	}
+	for _, c := range checks {
+		if math.Abs(c.got-c.want) > 1e-9 {
+			t.Errorf("Spelling %s: expected %v, got %v", c.name, c.want, c.got)
+		}
+	}
+	if !spelling.Met || spelling.RatingCount != 20 || spelling.BurnRatingCount != 4 {
+		t.Errorf("Unexpected Spelling status %+v", spelling)
+	}
+
+	grammar := resp.Statuses[1]
+	if grammar.RatingCount != 0 || grammar.Met || grammar.Attainment != 0 || grammar.BurnRate != 0 {
+		t.Errorf("Expected an empty status for a category without ratings, got %+v", grammar)
+	}
+	if grammar.MaxScore != 50 || grammar.ErrorBudget != 10 {
+		t.Errorf("Expected the budget to be reported without ratings, got %+v", grammar)
+	}
+}
+
+func TestQualityTargetService_GetTargetStatus_WeightChange(t *testing.T) {
+	asOf := time.Date(2025, 3, 1, 12, 0, 0, 0, time.UTC)
+	week := 7 * 24 * time.Hour
+	// Spelling was halved from 1 to 0.5 during the week
+	targets := &mockQualityTargetRepository{targets: []models.QualityTarget{
+		{ID: 1, CategoryID: 1, CategoryName: "Spelling", CategoryWeight: 0.5, TargetScore: 40, Window: week},
+	}}
+	analytics := &mockWindowScoresRepository{byDuration: map[time.Duration][]models.CategoryScore{
+		// Half of the week's ratings were given under each weight: 4 * 0.75 * 20 = 60 of at most 75
+		week: {{CategoryID: 1, CategoryName: "Spelling", CategoryWeight: 0.75, Score: 4, RatingCount: 20}},
+		// The last day is all on the new weight: 3.5 * 0.5 * 20 = 35 of at most 50
+		DefaultBurnWindow: {{CategoryID: 1, CategoryName: "Spelling", CategoryWeight: 0.5, Score: 3.5, RatingCount: 4}},
+	}}
+
+	resp, err := GetTargetStatus(context.Background(), targets, analytics, asOf, 0)
+	if err != nil {
+		t.Fatalf("GetTargetStatus() error = %v", err)
+	}
+
+	status := resp.Statuses[0]
+	checks := []struct {
+		name      string
+		got, want float64
+	}{
+		{"score", status.Score, 60},
+		{"max_score", status.MaxScore, 75},
+		{"error_budget", status.ErrorBudget, 35},
+		{"remaining_error_budget", status.RemainingErrorBudget, 20.0 / 35},
+		// 15 points short of the day's maximum of 50, against a 10 point budget
+		{"burn_rate", status.BurnRate, 1.5},
+	}
+	for _, c := range checks {
+		if math.Abs(c.got-c.want) > 1e-9 {
+			t.Errorf("%s: expected %v, got %v", c.name, c.want, c.got)
+		}
+	}
+}
+
+func TestQualityTargetService_GetTargetStatus_OverspentBudget(t *testing.T) {
+	asOf := time.Date(2025, 3, 1, 12, 0, 0, 0, time.UTC)
+	targets := &mockQualityTargetRepository{targets: []models.QualityTarget{
+		{ID: 1, CategoryID: 1, CategoryName: "Spelling", CategoryWeight: 1, TargetScore: 80, Window: time.Hour},
+	}}
+	// 3 * 1 * 20 = 60: the target is missed by the size of its budget
+	analytics := &mockWindowScoresRepository{byDuration: map[time.Duration][]models.CategoryScore{
+		time.Hour: {{CategoryID: 1, CategoryName: "Spelling", CategoryWeight: 1, Score: 3, RatingCount: 5}},
+	}}
+
+	// A burn window longer than the target's is capped at it
+	resp, err := GetTargetStatus(context.Background(), targets, analytics, asOf, 48*time.Hour)
+	if err != nil {
+		t.Fatalf("GetTargetStatus() error = %v", err)
+	}
+
+	status := resp.Statuses[0]
+	if status.Met || status.RemainingErrorBudget != -1 || status.BurnRate != 2 {
+		t.Errorf("Expected an overspent budget, got %+v", status)
+	}
+	if !status.BurnWindow.Start.AsTime().Equal(asOf.Add(-time.Hour)) {
+		t.Errorf("Expected the burn window capped at the target window, got %v", status.BurnWindow)
+	}
+}
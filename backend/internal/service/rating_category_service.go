@@ -0,0 +1,124 @@
+package service
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"go-grpc-backend/internal/models"
+	"go-grpc-backend/internal/repository"
+	"go-grpc-backend/proto"
+
+	"google.golang.org/protobuf/types/known/timestamppb"
+)
+
+// ErrInvalidRatingCategory is returned for changes to archived categories and for names another active category has
+var ErrInvalidRatingCategory = errors.New("invalid rating category")
+
+// ListRatingCategories returns the active categories, or every category with includeArchived,
+// each with its weight history
+func ListRatingCategories(ctx context.Context, repo repository.RatingCategoryRepositoryInterface, includeArchived bool) (*proto.ListRatingCategoriesResponse, error) {
+	categories, err := repo.ListRatingCategories(ctx, includeArchived)
+	if err != nil {
+		return nil, err
+	}
+	weights, err := repo.ListRatingCategoryWeights(ctx, 0)
+	if err != nil {
+		return nil, err
+	}
+	history := make(map[int][]models.RatingCategoryWeight)
+	for _, w := range weights {
+		history[w.CategoryID] = append(history[w.CategoryID], w)
+	}
+
+	resp := &proto.ListRatingCategoriesResponse{}
+	for _, c := range categories {
+		resp.Categories = append(resp.Categories, ratingCategoryToProto(c, history[c.ID]))
+	}
+	return resp, nil
+}
+
+// CreateRatingCategory stores a new active category
+func CreateRatingCategory(ctx context.Context, repo repository.RatingCategoryRepositoryInterface, category models.RatingCategory) (*proto.RatingCategory, error) {
+	if err := validateRatingCategoryName(ctx, repo, category); err != nil {
+		return nil, err
+	}
+	created, err := repo.CreateRatingCategory(ctx, category)
+	if err != nil {
+		return nil, err
+	}
+	return ratingCategoryToProto(created, nil), nil
+}
+
+// UpdateRatingCategory renames an active category and applies its weight to ratings created
+// from effectiveFrom on, up to the next weight change already recorded after it
+func UpdateRatingCategory(ctx context.Context, repo repository.RatingCategoryRepositoryInterface, category models.RatingCategory, effectiveFrom time.Time) (*proto.RatingCategory, error) {
+	existing, err := repo.GetRatingCategory(ctx, category.ID)
+	if err != nil {
+		return nil, err
+	}
+	if existing.Archived() {
+		return nil, fmt.Errorf("%w: rating category %d is archived", ErrInvalidRatingCategory, category.ID)
+	}
+	if err := validateRatingCategoryName(ctx, repo, category); err != nil {
+		return nil, err
+	}
+
+	updated, err := repo.UpdateRatingCategory(ctx, category, effectiveFrom)
+	if err != nil {
+		return nil, err
+	}
+	return ratingCategoryWithHistory(ctx, repo, updated)
+}
+
+// ArchiveRatingCategory hides a category from ListRatingCategories and freezes it. Its ratings
+// still count towards every score
+func ArchiveRatingCategory(ctx context.Context, repo repository.RatingCategoryRepositoryInterface, id int) (*proto.RatingCategory, error) {
+	archived, err := repo.ArchiveRatingCategory(ctx, id)
+	if err != nil {
+		return nil, err
+	}
+	return ratingCategoryWithHistory(ctx, repo, archived)
+}
+
+// validateRatingCategoryName rejects a name another active category already has
+func validateRatingCategoryName(ctx context.Context, repo repository.RatingCategoryRepositoryInterface, category models.RatingCategory) error {
+	active, err := repo.ListRatingCategories(ctx, false)
+	if err != nil {
+		return err
+	}
+	for _, c := range active {
+		if c.ID != category.ID && c.Name == category.Name {
+			return fmt.Errorf("%w: rating category %d is already named %q", ErrInvalidRatingCategory, c.ID, c.Name)
+		}
+	}
+	return nil
+}
+
+func ratingCategoryWithHistory(ctx context.Context, repo repository.RatingCategoryRepositoryInterface, c models.RatingCategory) (*proto.RatingCategory, error) {
+	history, err := repo.ListRatingCategoryWeights(ctx, c.ID)
+	if err != nil {
+		return nil, err
+	}
+	return ratingCategoryToProto(c, history), nil
+}
+
+func ratingCategoryToProto(c models.RatingCategory, history []models.RatingCategoryWeight) *proto.RatingCategory {
+	out := &proto.RatingCategory{
+		Id:     int32(c.ID),
+		Name:   c.Name,
+		Weight: c.Weight,
+	}
+	if c.Archived() {
+		out.ArchivedAt = timestamppb.New(c.ArchivedAt)
+	}
+	for _, w := range history {
+		weight := &proto.RatingCategoryWeight{Weight: w.Weight}
+		if !w.EffectiveFrom.IsZero() {
+			weight.EffectiveFrom = timestamppb.New(w.EffectiveFrom)
+		}
+		out.WeightHistory = append(out.WeightHistory, weight)
+	}
+	return out
+}
@@ -0,0 +1,147 @@
+package service
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"testing"
+	"time"
+
+	"go-grpc-backend/internal/models"
+	"go-grpc-backend/internal/repository"
+)
+
+// mockRatingCategoryRepository serves categories and weight history from memory
+type mockRatingCategoryRepository struct {
+	categories []models.RatingCategory
+	weights    []models.RatingCategoryWeight
+	updated    models.RatingCategory
+}
+
+func (m *mockRatingCategoryRepository) ListRatingCategories(ctx context.Context, includeArchived bool) ([]models.RatingCategory, error) {
+	var out []models.RatingCategory
+	for _, c := range m.categories {
+		if includeArchived || !c.Archived() {
+			out = append(out, c)
+		}
+	}
+	return out, nil
+}
+
+func (m *mockRatingCategoryRepository) GetRatingCategory(ctx context.Context, id int) (models.RatingCategory, error) {
+	for _, c := range m.categories {
+		if c.ID == id {
+			return c, nil
+		}
+	}
+	return models.RatingCategory{}, fmt.Errorf("rating category %d: %w", id, repository.ErrNotFound)
+}
+
+func (m *mockRatingCategoryRepository) CreateRatingCategory(ctx context.Context, category models.RatingCategory) (models.RatingCategory, error) {
+	category.ID = len(m.categories) + 1
+	m.categories = append(m.categories, category)
+	return category, nil
+}
+
+func (m *mockRatingCategoryRepository) UpdateRatingCategory(ctx context.Context, category models.RatingCategory, effectiveFrom time.Time) (models.RatingCategory, error) {
+	m.updated = category
+	return category, nil
+}
+
+func (m *mockRatingCategoryRepository) ArchiveRatingCategory(ctx context.Context, id int) (models.RatingCategory, error) {
+	category, err := m.GetRatingCategory(ctx, id)
+	category.ArchivedAt = time.Date(2025, 3, 1, 0, 0, 0, 0, time.UTC)
+	return category, err
+}
+
+func (m *mockRatingCategoryRepository) ListRatingCategoryWeights(ctx context.Context, categoryID int) ([]models.RatingCategoryWeight, error) {
+	var out []models.RatingCategoryWeight
+	for _, w := range m.weights {
+		if categoryID == 0 || w.CategoryID == categoryID {
+			out = append(out, w)
+		}
+	}
+	return out, nil
+}
+
+func testRatingCategories() *mockRatingCategoryRepository {
+	return &mockRatingCategoryRepository{
+		categories: []models.RatingCategory{
+			{ID: 1, Name: "Spelling", Weight: 2},
+			{ID: 2, Name: "Grammar", Weight: 0.5, ArchivedAt: time.Date(2025, 2, 1, 0, 0, 0, 0, time.UTC)},
+		},
+		weights: []models.RatingCategoryWeight{
+			{ID: 1, CategoryID: 1, Weight: 1},
+			{ID: 2, CategoryID: 1, Weight: 2, EffectiveFrom: time.Date(2025, 1, 6, 0, 0, 0, 0, time.UTC)},
+		},
+	}
+}
+
+func TestListRatingCategories(t *testing.T) {
+	resp, err := ListRatingCategories(context.Background(), testRatingCategories(), true)
+	if err != nil {
+		t.Fatalf("ListRatingCategories() error = %v", err)
+	}
+	if len(resp.Categories) != 2 {
+		t.Fatalf("Expected 2 categories, got %v", resp.Categories)
+	}
+
+	spelling := resp.Categories[0]
+	if len(spelling.WeightHistory) != 2 || spelling.ArchivedAt != nil {
+		t.Fatalf("Unexpected Spelling %v", spelling)
+	}
+	if first := spelling.WeightHistory[0]; first.Weight != 1 || first.EffectiveFrom != nil {
+		t.Errorf("Expected the starting weight without effective_from, got %v", first)
+	}
+	if second := spelling.WeightHistory[1]; second.Weight != 2 || !second.EffectiveFrom.AsTime().Equal(time.Date(2025, 1, 6, 0, 0, 0, 0, time.UTC)) {
+		t.Errorf("Unexpected weight change %v", second)
+	}
+
+	if grammar := resp.Categories[1]; grammar.ArchivedAt == nil || len(grammar.WeightHistory) != 0 {
+		t.Errorf("Unexpected Grammar %v", grammar)
+	}
+}
+
+func TestCreateRatingCategory_DuplicateName(t *testing.T) {
+	repo := testRatingCategories()
+
+	if _, err := CreateRatingCategory(context.Background(), repo, models.RatingCategory{Name: "Spelling", Weight: 1}); !errors.Is(err, ErrInvalidRatingCategory) {
+		t.Errorf("Expected ErrInvalidRatingCategory for an active category's name, got %v", err)
+	}
+	// Archived categories free their name
+	created, err := CreateRatingCategory(context.Background(), repo, models.RatingCategory{Name: "Grammar", Weight: 1})
+	if err != nil {
+		t.Fatalf("CreateRatingCategory() error = %v", err)
+	}
+	if created.Id != 3 || created.Weight != 1 {
+		t.Errorf("Unexpected created category %v", created)
+	}
+}
+
+func TestUpdateRatingCategory_Validation(t *testing.T) {
+	effectiveFrom := time.Date(2025, 1, 6, 0, 0, 0, 0, time.UTC)
+	repo := testRatingCategories()
+	repo.categories = append(repo.categories, models.RatingCategory{ID: 3, Name: "Tone", Weight: 2})
+
+	tests := []struct {
+		name     string
+		category models.RatingCategory
+		wantErr  error
+	}{
+		{name: "reweighted", category: models.RatingCategory{ID: 1, Name: "Spelling", Weight: 3}},
+		{name: "another category's name", category: models.RatingCategory{ID: 1, Name: "Tone", Weight: 2}, wantErr: ErrInvalidRatingCategory},
+		{name: "archived", category: models.RatingCategory{ID: 2, Name: "Grammar", Weight: 1}, wantErr: ErrInvalidRatingCategory},
+		{name: "unknown", category: models.RatingCategory{ID: 42, Name: "Ghost", Weight: 1}, wantErr: repository.ErrNotFound},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			_, err := UpdateRatingCategory(context.Background(), repo, tt.category, effectiveFrom)
+			if tt.wantErr == nil && err != nil || tt.wantErr != nil && !errors.Is(err, tt.wantErr) {
+				t.Errorf("UpdateRatingCategory() error = %v, want %v", err, tt.wantErr)
+			}
+		})
+	}
+	if repo.updated.Weight != 3 {
+		t.Errorf("Expected only the valid update to reach the repository, got %+v", repo.updated)
+	}
+}
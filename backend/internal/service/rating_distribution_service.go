@@ -0,0 +1,85 @@
+package service
+
+import (
+	"context"
+	"go-grpc-backend/internal/models"
+	"go-grpc-backend/internal/repository"
+	"go-grpc-backend/proto"
+
+	"google.golang.org/protobuf/types/known/timestamppb"
+)
+
+// DefaultPercentiles are reported by GetRatingDistribution when the request names none
+var DefaultPercentiles = []float64{10, 25, 50, 75, 90}
+
+// GetRatingDistribution returns the histogram of rating values per category over the range,
+// and per time bucket when granularity is set. Category totals merge the bucket histograms
+func GetRatingDistribution(ctx context.Context, repo repository.AnalyticsRepositoryInterface, rng models.DateRange, granularity models.Granularity, percentiles []float64) (*proto.RatingDistributionResponse, error) {
+	rows, err := repo.GetRatingDistribution(ctx, rng, granularity)
+	if err != nil {
+		return nil, err
+	}
+
+	if len(percentiles) == 0 {
+		percentiles = DefaultPercentiles
+	}
+
+	// Rows arrive ordered by category then bucket, so categories keep the repository order
+	var categories []*proto.CategoryDistribution
+	totals := make(map[int]*models.RatingDistribution)
+	for _, row := range rows {
+		total, ok := totals[row.CategoryID]
+		if !ok {
+			total = &models.RatingDistribution{CategoryID: row.CategoryID, CategoryName: row.CategoryName}
+			totals[row.CategoryID] = total
+			categories = append(categories, &proto.CategoryDistribution{
+				CategoryId:   int32(row.CategoryID),
+				CategoryName: row.CategoryName,
+			})
+		}
+		total.Add(row)
+
+		if granularity != "" {
+			cat := categories[len(categories)-1]
+			cat.Buckets = append(cat.Buckets, distributionToProto(row, percentiles))
+		}
+	}
+
+	for _, cat := range categories {
+		cat.Total = distributionToProto(*totals[int(cat.CategoryId)], percentiles)
+	}
+
+	gran := proto.Granularity_GRANULARITY_UNSPECIFIED
+	switch granularity {
+	case models.GranularityDay:
+		gran = proto.Granularity_GRANULARITY_DAY
+	case models.GranularityWeek:
+		gran = proto.Granularity_GRANULARITY_WEEK
+	}
+
+	return &proto.RatingDistributionResponse{
+		Granularity: gran,
+		Categories:  categories,
+		Range:       dateRangeToProto(rng),
+	}, nil
+}
+
+func distributionToProto(d models.RatingDistribution, percentiles []float64) *proto.RatingDistribution {
+	out := &proto.RatingDistribution{
+		Counts: make([]int32, len(d.Counts)),
+		Total:  int32(d.Total),
+		Mean:   d.Mean,
+		Median: d.Median,
+		StdDev: d.StdDev,
+	}
+	if !d.Date.IsZero() {
+		out.Date = timestamppb.New(d.Date)
+	}
+	for v, n := range d.Counts {
+		out.Counts[v] = int32(n)
+	}
+	for _, p := range percentiles {
+		out.Percentiles = append(out.Percentiles, &proto.Percentile{Percentile: p, Value: d.Percentile(p)})
+	}
+	return out
+}
@@ -0,0 +1,152 @@
+package service
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+
+	"go-grpc-backend/internal/models"
+	"go-grpc-backend/proto"
+)
+
+// Mock repository for rating distribution testing
+type mockRatingDistributionRepository struct {
+	distribution      []models.RatingDistribution
+	distributionError error
+	granularity       models.Granularity
+}
+
+func (m *mockRatingDistributionRepository) GetRatingDistribution(ctx context.Context, rng models.DateRange, granularity models.Granularity) ([]models.RatingDistribution, error) {
+	m.granularity = granularity
+	if m.distributionError != nil {
+		return nil, m.distributionError
+	}
+	return m.distribution, nil
+}
+
+func (m *mockRatingDistributionRepository) GetCategoryScoresByPeriod(ctx context.Context, periods []models.DateRange) ([]models.PeriodCategoryScore, error) {
+	return nil, nil
+}
+
+func (m *mockRatingDistributionRepository) GetDailyAggregatedCategoryRatings(ctx context.Context, rng models.DateRange) ([]models.CategoryRatingOverTimePeriod, error) {
+	return nil, nil
+}
+
+func (m *mockRatingDistributionRepository) GetWeeklyAggregatedCategoryRatings(ctx context.Context, rng models.DateRange) ([]models.CategoryRatingOverTimePeriod, error) {
+	return nil, nil
+}
+
+func (m *mockRatingDistributionRepository) GetScoresByTicket(ctx context.Context, rng models.DateRange) ([]models.TicketCategoryScore, error) {
+	return nil, nil
+}
+
+func (m *mockRatingDistributionRepository) GetOverallQualityScore(ctx context.Context, rng models.DateRange) ([]models.CategoryScore, error) {
+	return nil, nil
+}
+
+func distribution(categoryID int, name string, date time.Time, counts [models.MaxRatingValue + 1]int) models.RatingDistribution {
+	d := models.RatingDistribution{CategoryID: categoryID, CategoryName: name, Date: date, Counts: counts}
+	d.Summarize()
+	return d
+}
+
+func TestScoreService_GetRatingDistribution_Totals(t *testing.T) {
+	startDate := time.Date(2025, 1, 1, 0, 0, 0, 0, time.UTC)
+	endDate := time.Date(2025, 1, 31, 0, 0, 0, 0, time.UTC)
+
+	// Two 5s and two 1s: same mean as four 3s, very different spread
+	mockRepo := &mockRatingDistributionRepository{
+		distribution: []models.RatingDistribution{
+			distribution(1, "Spelling", time.Time{}, [6]int{0, 2, 0, 0, 0, 2}),
+			distribution(2, "Grammar", time.Time{}, [6]int{0, 0, 0, 4, 0, 0}),
+		},
+	}
+
+	result, err := GetRatingDistribution(context.Background(), mockRepo, models.NewDateRange(startDate, endDate), "", []float64{25, 50, 100})
+	if err != nil {
+		t.Fatalf("GetRatingDistribution() error = %v", err)
+	}
+
+	if result.Granularity != proto.Granularity_GRANULARITY_UNSPECIFIED {
+		t.Errorf("Expected GRANULARITY_UNSPECIFIED, got %v", result.Granularity)
+	}
+	if len(result.Categories) != 2 || result.Categories[0].CategoryName != "Spelling" {
+		t.Fatalf("Expected Spelling then Grammar, got %v", result.Categories)
+	}
+
+	spelling := result.Categories[0].Total
+	if spelling.Total != 4 || spelling.Mean != 3 || spelling.Median != 3 || spelling.StdDev != 2 {
+		t.Errorf("Expected total 4, mean 3, median 3, std dev 2, got %d, %v, %v, %v",
+			spelling.Total, spelling.Mean, spelling.Median, spelling.StdDev)
+	}
+	if got := spelling.Counts; len(got) != 6 || got[1] != 2 || got[5] != 2 {
+		t.Errorf("Expected counts [0 2 0 0 0 2], got %v", got)
+	}
+
+	// Sorted values are 1 1 5 5: P25 falls between the 1s, P50 halfway between 1 and 5, P100 is the max
+	expected := []float64{1, 3, 5}
+	for i, p := range spelling.Percentiles {
+		if p.Value != expected[i] {
+			t.Errorf("P%v: expected %v, got %v", p.Percentile, expected[i], p.Value)
+		}
+	}
+
+	grammar := result.Categories[1].Total
+	if grammar.Mean != 3 || grammar.StdDev != 0 {
+		t.Errorf("Expected Grammar mean 3 and std dev 0, got %v and %v", grammar.Mean, grammar.StdDev)
+	}
+	if len(result.Categories[0].Buckets) != 0 {
+		t.Errorf("Expected no buckets without granularity, got %d", len(result.Categories[0].Buckets))
+	}
+}
+
+func TestScoreService_GetRatingDistribution_Buckets(t *testing.T) {
+	startDate := time.Date(2025, 1, 1, 0, 0, 0, 0, time.UTC)
+	endDate := time.Date(2025, 1, 31, 0, 0, 0, 0, time.UTC)
+	day1 := time.Date(2025, 1, 1, 0, 0, 0, 0, time.UTC)
+	day2 := time.Date(2025, 1, 2, 0, 0, 0, 0, time.UTC)
+
+	mockRepo := &mockRatingDistributionRepository{
+		distribution: []models.RatingDistribution{
+			distribution(1, "Spelling", day1, [6]int{1, 0, 0, 0, 0, 0}),
+			distribution(1, "Spelling", day2, [6]int{0, 0, 0, 0, 2, 0}),
+		},
+	}
+
+	result, err := GetRatingDistribution(context.Background(), mockRepo, models.NewDateRange(startDate, endDate), models.GranularityDay, nil)
+	if err != nil {
+		t.Fatalf("GetRatingDistribution() error = %v", err)
+	}
+
+	if mockRepo.granularity != models.GranularityDay {
+		t.Errorf("Expected daily granularity passed to repository, got %q", mockRepo.granularity)
+	}
+	if result.Granularity != proto.Granularity_GRANULARITY_DAY {
+		t.Errorf("Expected GRANULARITY_DAY, got %v", result.Granularity)
+	}
+
+	cat := result.Categories[0]
+	if len(cat.Buckets) != 2 || !cat.Buckets[1].Date.AsTime().Equal(day2) {
+		t.Fatalf("Expected buckets for both days, got %v", cat.Buckets)
+	}
+	// The total merges both days: 0, 4, 4
+	if cat.Total.Total != 3 || cat.Total.Median != 4 || cat.Total.Date != nil {
+		t.Errorf("Expected undated total of 3 with median 4, got %v", cat.Total)
+	}
+	if len(cat.Total.Percentiles) != len(DefaultPercentiles) {
+		t.Errorf("Expected default percentiles, got %v", cat.Total.Percentiles)
+	}
+}
+
+func TestScoreService_GetRatingDistribution_RepositoryError(t *testing.T) {
+	mockRepo := &mockRatingDistributionRepository{distributionError: errors.New("database error")}
+
+	result, err := GetRatingDistribution(context.Background(), mockRepo, models.NewDateRange(time.Now().AddDate(0, 0, -7), time.Now()), "", nil)
+	if err == nil {
+		t.Error("Expected error, got nil")
+	}
+	if result != nil {
+		t.Errorf("Expected nil result on error, got %v", result)
+	}
+}
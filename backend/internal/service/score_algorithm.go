@@ -1,5 +1,7 @@
 package service
 
+import "go-grpc-backend/internal/models"
+
 // RATING_TO_PERCENT_MODIFICATOR converts rating (0-5) to percentage (0-100)
 const RATING_TO_PERCENT_MODIFICATOR = 20
 
@@ -8,3 +10,20 @@ const RATING_TO_PERCENT_MODIFICATOR = 20
 func CalculateCategoryScore(avgPercent float64, categoryWeight float64) float64 {
 	return avgPercent * categoryWeight * RATING_TO_PERCENT_MODIFICATOR
 }
+
+// CalculateOverallScore combines per-category averages into the overall quality score
+// Formula: (sum of CalculateCategoryScore for each category) / number of categories
+// Categories without ratings must not be passed in. Returns 0 for an empty slice
+func CalculateOverallScore(categoryScores []models.CategoryScore) (score float64, totalRatings int) {
+	if len(categoryScores) == 0 {
+		return 0, 0
+	}
+
+	var totalScore float64
+	for _, cs := range categoryScores {
+		totalScore += CalculateCategoryScore(cs.Score, cs.CategoryWeight)
+		totalRatings += cs.RatingCount
+	}
+
+	return totalScore / float64(len(categoryScores)), totalRatings
+}
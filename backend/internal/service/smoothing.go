@@ -0,0 +1,127 @@
+package service
+
+import (
+	"go-grpc-backend/proto"
+
+	"google.golang.org/protobuf/types/known/timestamppb"
+	"google.golang.org/protobuf/types/known/wrapperspb"
+)
+
+// SmoothingOptions selects the smoothed series GetAggregatedCategoryScores returns next to the raw
+// ones; the zero value returns none
+type SmoothingOptions struct {
+	// RollingWindow is the number of buckets in the rolling mean; 0 disables it
+	RollingWindow int
+	// EMAAlpha is the weight of the newest bucket in the exponential moving average; 0 disables it
+	EMAAlpha float64
+	// Cumulative adds the score of every rating up to each bucket
+	Cumulative bool
+}
+
+func (o SmoothingOptions) enabled() bool {
+	return o.RollingWindow > 0 || o.EMAAlpha > 0 || o.Cumulative
+}
+
+// weightedSum accumulates count-weighted scores
+type weightedSum struct {
+	sum   float64
+	count int
+}
+
+func (w *weightedSum) add(p *proto.ScorePoint, sign int) {
+	n := int(p.Count.GetValue())
+	w.sum += float64(sign) * float64(p.Score) * float64(n)
+	w.count += sign * n
+}
+
+// point is the smoothed counterpart of raw: the weighted mean at raw's date, or a null point when empty
+func (w weightedSum) point(raw *proto.ScorePoint) *proto.ScorePoint {
+	if w.count <= 0 {
+		return smoothedPoint(raw.Date, 0, 0, false)
+	}
+	return smoothedPoint(raw.Date, w.sum/float64(w.count), w.count, true)
+}
+
+func smoothedPoint(date *timestamppb.Timestamp, score float64, count int, ok bool) *proto.ScorePoint {
+	p := &proto.ScorePoint{
+		Date:  date,
+		Score: float32(score),
+		Count: wrapperspb.Int32(int32(count)),
+	}
+	if !ok {
+		p.Score = 0
+		p.Source = proto.ScorePointSource_SCORE_POINT_SOURCE_NULL
+	}
+	return p
+}
+
+// hasRatings tells whether p was observed; filled points carry no ratings and never feed smoothing
+func hasRatings(p *proto.ScorePoint) bool {
+	return p.Source == proto.ScorePointSource_SCORE_POINT_SOURCE_OBSERVED && p.Count.GetValue() > 0
+}
+
+// smoothSeries computes the smoothed versions of date-sorted points that opts asks for.
+// stepDays is the bucket width. Each bucket's score is weighted by its rating count, so a
+// low-volume day moves the rolling mean and the cumulative score less than a busy one.
+// The EMA only advances on buckets with ratings and holds its value across empty ones
+func smoothSeries(points []*proto.ScorePoint, stepDays int, opts SmoothingOptions) *proto.SmoothedSeries {
+	if !opts.enabled() {
+		return nil
+	}
+
+	out := &proto.SmoothedSeries{}
+
+	if opts.RollingWindow > 0 {
+		var window weightedSum
+		first := 0
+		for _, p := range points {
+			if hasRatings(p) {
+				window.add(p, 1)
+			}
+			// Buckets starting before this cutoff have left the window
+			cutoff := p.Date.AsTime().AddDate(0, 0, -stepDays*(opts.RollingWindow-1))
+			for ; points[first].Date.AsTime().Before(cutoff); first++ {
+				if hasRatings(points[first]) {
+					window.add(points[first], -1)
+				}
+			}
+			out.RollingMean = append(out.RollingMean, window.point(p))
+		}
+	}
+
+	if opts.EMAAlpha > 0 {
+		var ema float64
+		seen := 0
+		for _, p := range points {
+			if hasRatings(p) {
+				if seen == 0 {
+					ema = float64(p.Score)
+				} else {
+					ema = opts.EMAAlpha*float64(p.Score) + (1-opts.EMAAlpha)*ema
+				}
+				seen += int(p.Count.GetValue())
+			}
+			out.Ema = append(out.Ema, smoothedPoint(p.Date, ema, seen, seen > 0))
+		}
+	}
+
+	if opts.Cumulative {
+		var total weightedSum
+		for _, p := range points {
+			if hasRatings(p) {
+				total.add(p, 1)
+			}
+			out.Cumulative = append(out.Cumulative, total.point(p))
+		}
+	}
+
+	return out
+}
+
+// bucketDays is the width of a GetAggregatedCategoryScores bucket in days
+func bucketDays(weekly bool) int {
+	if weekly {
+		return 7
+	}
+	return 1
+}
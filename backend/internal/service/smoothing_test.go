@@ -0,0 +1,127 @@
+package service
+
+import (
+	"testing"
+	"time"
+
+	"go-grpc-backend/proto"
+
+	"google.golang.org/protobuf/types/known/timestamppb"
+	"google.golang.org/protobuf/types/known/wrapperspb"
+)
+
+func scorePoint(day int, score float32, count int32, source proto.ScorePointSource) *proto.ScorePoint {
+	return &proto.ScorePoint{
+		Date:   timestamppb.New(time.Date(2025, 1, day, 0, 0, 0, 0, time.UTC)),
+		Score:  score,
+		Count:  wrapperspb.Int32(count),
+		Source: source,
+	}
+}
+
+func TestScoreService_SmoothSeries(t *testing.T) {
+	const (
+		observed = proto.ScorePointSource_SCORE_POINT_SOURCE_OBSERVED
+		null     = proto.ScorePointSource_SCORE_POINT_SOURCE_NULL
+		carried  = proto.ScorePointSource_SCORE_POINT_SOURCE_CARRIED
+	)
+
+	// Jan 1 is empty, Jan 4 and Jan 6 are filled and carry no ratings
+	points := []*proto.ScorePoint{
+		scorePoint(1, 0, 0, null),
+		scorePoint(2, 40, 1, observed),
+		scorePoint(3, 80, 3, observed),
+		scorePoint(4, 80, 0, carried),
+		scorePoint(5, 60, 2, observed),
+		scorePoint(6, 0, 0, null),
+	}
+
+	smoothed := smoothSeries(points, 1, SmoothingOptions{RollingWindow: 2, EMAAlpha: 0.5, Cumulative: true})
+
+	type want struct {
+		score float32
+		count int32
+	}
+	tests := []struct {
+		name     string
+		series   []*proto.ScorePoint
+		expected []want
+	}{
+		// A 2-day window weighted by count: Jan 3 is (40*1 + 80*3) / 4
+		{"RollingMean", smoothed.RollingMean, []want{{0, 0}, {40, 1}, {70, 4}, {80, 3}, {60, 2}, {60, 2}}},
+		// Empty buckets hold the EMA; count is every rating seen so far
+		{"EMA", smoothed.Ema, []want{{0, 0}, {40, 1}, {60, 4}, {60, 4}, {60, 6}, {60, 6}}},
+		{"Cumulative", smoothed.Cumulative, []want{{0, 0}, {40, 1}, {70, 4}, {70, 4}, {float32(400.0 / 6), 6}, {float32(400.0 / 6), 6}}},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if len(tt.series) != len(points) {
+				t.Fatalf("Expected %d points, got %d", len(points), len(tt.series))
+			}
+			for i, p := range tt.series {
+				e := tt.expected[i]
+				if p.Score != e.score || p.Count.GetValue() != e.count {
+					t.Errorf("Point %d: expected %v (%d), got %v (%d)", i, e.score, e.count, p.Score, p.Count.GetValue())
+				}
+				if !p.Date.AsTime().Equal(points[i].Date.AsTime()) {
+					t.Errorf("Point %d: expected date %v, got %v", i, points[i].Date.AsTime(), p.Date.AsTime())
+				}
+				if expected := e.count == 0; (p.Source == null) != expected {
+					t.Errorf("Point %d: expected null source %v, got %v", i, expected, p.Source)
+				}
+			}
+		})
+	}
+}
+
+func TestScoreService_SmoothSeries_WeeklyWindow(t *testing.T) {
+	points := []*proto.ScorePoint{
+		scorePoint(6, 40, 1, proto.ScorePointSource_SCORE_POINT_SOURCE_OBSERVED),
+		scorePoint(20, 80, 1, proto.ScorePointSource_SCORE_POINT_SOURCE_OBSERVED),
+	}
+
+	// Weekly buckets two weeks apart never share a 2-week window, even though they are neighbours
+	smoothed := smoothSeries(points, 7, SmoothingOptions{RollingWindow: 2})
+	if got := smoothed.RollingMean[1].Score; got != 80 {
+		t.Errorf("Expected 80, got %v", got)
+	}
+	if smoothed.Ema != nil || smoothed.Cumulative != nil {
+		t.Errorf("Expected only the rolling mean, got %v", smoothed)
+	}
+}
+
+func TestScoreService_SmoothSeries_Disabled(t *testing.T) {
+	points := []*proto.ScorePoint{scorePoint(1, 40, 1, proto.ScorePointSource_SCORE_POINT_SOURCE_OBSERVED)}
+
+	if smoothed := smoothSeries(points, 1, SmoothingOptions{}); smoothed != nil {
+		t.Errorf("Expected no smoothed series, got %v", smoothed)
+	}
+}
+
+func TestScoreService_SmoothSeries_OffGridPoint(t *testing.T) {
+	const observed = proto.ScorePointSource_SCORE_POINT_SOURCE_OBSERVED
+
+	// The grid covers Jan 3 and 4, but a rating landed in Jan 1's bucket
+	grid := []time.Time{time.Date(2025, 1, 3, 0, 0, 0, 0, time.UTC), time.Date(2025, 1, 4, 0, 0, 0, 0, time.UTC)}
+	points := fillSeries([]*proto.ScorePoint{
+		scorePoint(1, 40, 1, observed),
+		scorePoint(3, 80, 1, observed),
+	}, grid, proto.FillMode_FILL_MODE_NULL)
+
+	days := make([]int, len(points))
+	for i, p := range points {
+		days[i] = p.Date.AsTime().Day()
+	}
+	if len(days) != 3 || days[0] != 1 || days[1] != 3 || days[2] != 4 {
+		t.Fatalf("Expected points on Jan 1, 3 and 4 in order, got days %v", days)
+	}
+
+	// A 2-day window: Jan 1 has left it by Jan 3
+	smoothed := smoothSeries(points, 1, SmoothingOptions{RollingWindow: 2})
+	for i, expected := range []float32{40, 80, 80} {
+		if got := smoothed.RollingMean[i].Score; got != expected {
+			t.Errorf("Point %d: expected rolling mean %v, got %v", i, expected, got)
+		}
+	}
+}
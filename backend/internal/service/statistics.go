@@ -0,0 +1,180 @@
+package service
+
+import (
+	"math"
+	"sort"
+
+	"go-grpc-backend/internal/models"
+	"go-grpc-backend/proto"
+)
+
+// SignificanceLevel is the p-value below which a change is reported as significant
+const SignificanceLevel = 0.05
+
+// sampleStats summarizes a sample of per-rating scores
+type sampleStats struct {
+	n        int
+	mean     float64
+	variance float64 // Population variance
+}
+
+// weightedRatingStats pools the per-rating weighted scores (rating * weight * 20) of every category.
+// A category's ratings may carry different weights from its weight history, so its spread comes
+// from WeightedVariance, the variance of rating * weight, rather than from the raw ratings
+func weightedRatingStats(categoryScores []models.CategoryScore) sampleStats {
+	scale := CalculateCategoryScore(1, 1)
+	var (
+		n          int
+		sum, sumSq float64
+	)
+	for _, cs := range categoryScores {
+		mean := CalculateCategoryScore(cs.Score, cs.CategoryWeight)
+		variance := cs.WeightedVariance * scale * scale
+
+		n += cs.RatingCount
+		sum += mean * float64(cs.RatingCount)
+		sumSq += (variance + mean*mean) * float64(cs.RatingCount)
+	}
+	if n == 0 {
+		return sampleStats{}
+	}
+
+	mean := sum / float64(n)
+	return sampleStats{n: n, mean: mean, variance: math.Max(sumSq/float64(n)-mean*mean, 0)}
+}
+
+// categoryRatingStats is the sample of per-rating weighted scores of a single category
+func categoryRatingStats(cs models.CategoryScore) sampleStats {
+	return weightedRatingStats([]models.CategoryScore{cs})
+}
+
+// welchTTest compares the means of two samples without assuming equal variances.
+// Returns nil when either sample has fewer than two ratings or both have no spread,
+// since the test is undefined there
+func welchTTest(current, previous sampleStats) *proto.SignificanceTest {
+	if current.n < 2 || previous.n < 2 {
+		return nil
+	}
+
+	n1, n2 := float64(current.n), float64(previous.n)
+	// Sample variances from the population variances
+	s1 := current.variance * n1 / (n1 - 1)
+	s2 := previous.variance * n2 / (n2 - 1)
+
+	v1, v2 := s1/n1, s2/n2
+	se := math.Sqrt(v1 + v2)
+	if se == 0 {
+		return nil
+	}
+
+	t := (current.mean - previous.mean) / se
+	df := (v1 + v2) * (v1 + v2) / (v1*v1/(n1-1) + v2*v2/(n2-1))
+	p := studentTTwoSided(t, df)
+
+	// Cohen's d with the pooled standard deviation
+	var effect float64
+	if pooled := math.Sqrt(((n1-1)*s1 + (n2-1)*s2) / (n1 + n2 - 2)); pooled > 0 {
+		effect = (current.mean - previous.mean) / pooled
+	}
+
+	return &proto.SignificanceTest{
+		TStatistic:       t,
+		DegreesOfFreedom: df,
+		PValue:           p,
+		EffectSize:       effect,
+		Significant:      p < SignificanceLevel,
+	}
+}
+
+// studentTTwoSided is P(|T| >= |t|) for Student's t distribution with df degrees of freedom
+func studentTTwoSided(t, df float64) float64 {
+	return regularizedIncompleteBeta(df/(df+t*t), df/2, 0.5)
+}
+
+// regularizedIncompleteBeta is I_x(a, b), evaluated with the continued fraction from
+// Numerical Recipes (betacf), using the symmetry relation where it converges faster
+func regularizedIncompleteBeta(x, a, b float64) float64 {
+	switch {
+	case x <= 0:
+		return 0
+	case x >= 1:
+		return 1
+	}
+
+	lga, _ := math.Lgamma(a)
+	lgb, _ := math.Lgamma(b)
+	lgab, _ := math.Lgamma(a + b)
+	front := math.Exp(lgab - lga - lgb + a*math.Log(x) + b*math.Log(1-x))
+
+	if x < (a+1)/(a+b+2) {
+		return front * betaContinuedFraction(x, a, b) / a
+	}
+	return 1 - front*betaContinuedFraction(1-x, b, a)/b
+}
+
+func betaContinuedFraction(x, a, b float64) float64 {
+	const (
+		maxIterations = 200
+		epsilon       = 1e-14
+		tiny          = 1e-300
+	)
+
+	qab, qap, qam := a+b, a+1, a-1
+	c, d := 1.0, 1-qab*x/qap
+	if math.Abs(d) < tiny {
+		d = tiny
+	}
+	d = 1 / d
+	h := d
+
+	for m := 1; m <= maxIterations; m++ {
+		fm := float64(m)
+		m2 := 2 * fm
+
+		aa := fm * (b - fm) * x / ((qam + m2) * (a + m2))
+		d = 1 + aa*d
+		if math.Abs(d) < tiny {
+			d = tiny
+		}
+		c = 1 + aa/c
+		if math.Abs(c) < tiny {
+			c = tiny
+		}
+		d = 1 / d
+		h *= d * c
+
+		aa = -(a + fm) * (qab + fm) * x / ((a + m2) * (qap + m2))
+		d = 1 + aa*d
+		if math.Abs(d) < tiny {
+			d = tiny
+		}
+		c = 1 + aa/c
+		if math.Abs(c) < tiny {
+			c = tiny
+		}
+		d = 1 / d
+		del := d * c
+		h *= del
+
+		if math.Abs(del-1) < epsilon {
+			break
+		}
+	}
+	return h
+}
+
+// median returns the middle value of values, averaging the two middle ones for an even count.
+// values is not modified; returns 0 when empty
+func median(values []float64) float64 {
+	if len(values) == 0 {
+		return 0
+	}
+	sorted := append([]float64(nil), values...)
+	sort.Float64s(sorted)
+
+	mid := len(sorted) / 2
+	if len(sorted)%2 == 0 {
+		return (sorted[mid-1] + sorted[mid]) / 2
+	}
+	return sorted[mid]
+}
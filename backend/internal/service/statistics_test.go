@@ -0,0 +1,114 @@
+package service
+
+import (
+	"math"
+	"testing"
+
+	"go-grpc-backend/internal/models"
+)
+
+func TestScoreService_StudentTTwoSided(t *testing.T) {
+	tests := []struct {
+		t, df, expected float64
+	}{
+		{0, 10, 1},
+		{2, 10, 0.073388},
+		{-2, 10, 0.073388},
+		{2.228139, 10, 0.05},
+		{1.959964, 1e7, 0.05},
+		{12.706205, 1, 0.05},
+	}
+
+	for _, tt := range tests {
+		if got := studentTTwoSided(tt.t, tt.df); math.Abs(got-tt.expected) > 1e-5 {
+			t.Errorf("studentTTwoSided(%v, %v): expected %v, got %v", tt.t, tt.df, tt.expected, got)
+		}
+	}
+}
+
+func TestScoreService_WelchTTest(t *testing.T) {
+	// Two samples of 30 with sample variance 100 and means 10 apart
+	popVariance := 100.0 * 29 / 30
+	result := welchTTest(
+		sampleStats{n: 30, mean: 80, variance: popVariance},
+		sampleStats{n: 30, mean: 70, variance: popVariance},
+	)
+	if result == nil {
+		t.Fatal("Expected a test result, got nil")
+	}
+
+	expectedT := 10 / math.Sqrt(100.0/30*2)
+	if math.Abs(result.TStatistic-expectedT) > 1e-9 {
+		t.Errorf("Expected t %v, got %v", expectedT, result.TStatistic)
+	}
+	if math.Abs(result.DegreesOfFreedom-58) > 1e-9 {
+		t.Errorf("Expected 58 degrees of freedom, got %v", result.DegreesOfFreedom)
+	}
+	if math.Abs(result.EffectSize-1) > 1e-9 {
+		t.Errorf("Expected effect size 1, got %v", result.EffectSize)
+	}
+	if !result.Significant || result.PValue > 0.001 {
+		t.Errorf("Expected a significant change with p < 0.001, got p = %v", result.PValue)
+	}
+}
+
+func TestScoreService_WelchTTest_Untestable(t *testing.T) {
+	tests := map[string][2]sampleStats{
+		"single rating": {{n: 1, mean: 100}, {n: 50, mean: 80, variance: 10}},
+		"no ratings":    {{}, {n: 50, mean: 80, variance: 10}},
+		"no spread":     {{n: 10, mean: 100}, {n: 10, mean: 80}},
+	}
+
+	for name, samples := range tests {
+		if result := welchTTest(samples[0], samples[1]); result != nil {
+			t.Errorf("%s: expected nil, got %v", name, result)
+		}
+	}
+}
+
+func TestScoreService_WeightedRatingStats(t *testing.T) {
+	// Spelling (weight 1) rated 4 and 2, Grammar (weight 0.5) rated 5 and 5:
+	// weighted scores 80, 40, 50, 50
+	stats := weightedRatingStats([]models.CategoryScore{
+		{CategoryWeight: 1, Score: 3, RatingCount: 2, RatingVariance: 1, WeightedVariance: 1},
+		{CategoryWeight: 0.5, Score: 5, RatingCount: 2, RatingVariance: 0, WeightedVariance: 0},
+	})
+
+	if stats.n != 4 || math.Abs(stats.mean-55) > 1e-9 {
+		t.Errorf("Expected 4 ratings with mean 55, got %d with mean %v", stats.n, stats.mean)
+	}
+	// ((80-55)² + (40-55)² + 2*(50-55)²) / 4 = 225
+	if math.Abs(stats.variance-225) > 1e-9 {
+		t.Errorf("Expected variance 225, got %v", stats.variance)
+	}
+}
+
+func TestScoreService_WeightedRatingStats_WeightChange(t *testing.T) {
+	// Spelling rated 4 twice, once at weight 1 and once at weight 2: the raw ratings don't vary,
+	// the weighted scores 80 and 160 do
+	stats := categoryRatingStats(models.CategoryScore{CategoryWeight: 1.5, Score: 4, RatingCount: 2, RatingVariance: 0, WeightedVariance: 4})
+
+	if stats.n != 2 || math.Abs(stats.mean-120) > 1e-9 {
+		t.Errorf("Expected 2 ratings with mean 120, got %d with mean %v", stats.n, stats.mean)
+	}
+	// ((80-120)² + (160-120)²) / 2 = 1600
+	if math.Abs(stats.variance-1600) > 1e-9 {
+		t.Errorf("Expected variance 1600, got %v", stats.variance)
+	}
+}
+
+func TestScoreService_Median(t *testing.T) {
+	values := []float64{5, 1, 3}
+	if got := median(values); got != 3 {
+		t.Errorf("Expected median 3, got %v", got)
+	}
+	if values[0] != 5 {
+		t.Error("Expected median to leave its input unsorted")
+	}
+	if got := median([]float64{4, 1, 3, 2}); got != 2.5 {
+		t.Errorf("Expected median 2.5, got %v", got)
+	}
+	if got := median(nil); got != 0 {
+		t.Errorf("Expected 0 for no values, got %v", got)
+	}
+}
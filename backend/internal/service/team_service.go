@@ -0,0 +1,288 @@
+package service
+
+import (
+	"context"
+	"errors"
+	"fmt"
+
+	"go-grpc-backend/internal/models"
+	"go-grpc-backend/internal/repository"
+	"go-grpc-backend/proto"
+
+	"google.golang.org/protobuf/types/known/timestamppb"
+)
+
+var (
+	// ErrInvalidTeam is returned for teams that don't fit the org > department > team hierarchy
+	ErrInvalidTeam = errors.New("invalid team")
+	// ErrInvalidTeamMembership is returned for memberships of unknown teams or users, or that overlap another
+	ErrInvalidTeamMembership = errors.New("invalid team membership")
+)
+
+// ListTeams returns every team
+func ListTeams(ctx context.Context, repo repository.TeamRepositoryInterface) (*proto.ListTeamsResponse, error) {
+	teams, err := repo.ListTeams(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	resp := &proto.ListTeamsResponse{}
+	for _, t := range teams {
+		resp.Teams = append(resp.Teams, teamToProto(t))
+	}
+	return resp, nil
+}
+
+// CreateTeam stores a new team after checking its parent is one level up
+func CreateTeam(ctx context.Context, repo repository.TeamRepositoryInterface, team models.Team) (*proto.Team, error) {
+	if err := validateTeamParent(ctx, repo, team); err != nil {
+		return nil, err
+	}
+	created, err := repo.CreateTeam(ctx, team)
+	if err != nil {
+		return nil, err
+	}
+	return teamToProto(created), nil
+}
+
+// UpdateTeam renames a team or moves it under another parent of the same kind
+func UpdateTeam(ctx context.Context, repo repository.TeamRepositoryInterface, team models.Team) (*proto.Team, error) {
+	existing, err := repo.GetTeam(ctx, team.ID)
+	if err != nil {
+		return nil, err
+	}
+	if team.Kind != existing.Kind {
+		return nil, fmt.Errorf("%w: the kind of team %d can't change from %s", ErrInvalidTeam, team.ID, existing.Kind)
+	}
+	if err := validateTeamParent(ctx, repo, team); err != nil {
+		return nil, err
+	}
+
+	updated, err := repo.UpdateTeam(ctx, team)
+	if err != nil {
+		return nil, err
+	}
+	return teamToProto(updated), nil
+}
+
+// DeleteTeam deletes a team without sub-teams, along with its memberships
+func DeleteTeam(ctx context.Context, repo repository.TeamRepositoryInterface, id int) (*proto.DeleteTeamResponse, error) {
+	err := repo.DeleteTeam(ctx, id)
+	if errors.Is(err, repository.ErrConflict) {
+		return nil, fmt.Errorf("%w: team %d still has sub-teams", ErrInvalidTeam, id)
+	}
+	if err != nil {
+		return nil, err
+	}
+	return &proto.DeleteTeamResponse{}, nil
+}
+
+// validateTeamParent checks that orgs have no parent and every other team sits under an existing
+// team exactly one level up
+func validateTeamParent(ctx context.Context, repo repository.TeamRepositoryInterface, team models.Team) error {
+	parentKind, ok := team.Kind.ParentKind()
+	if !ok {
+		if team.ParentID != 0 {
+			return fmt.Errorf("%w: a %s can't have a parent", ErrInvalidTeam, team.Kind)
+		}
+		return nil
+	}
+
+	if team.ParentID == 0 {
+		return fmt.Errorf("%w: a %s needs a parent %s", ErrInvalidTeam, team.Kind, parentKind)
+	}
+	parent, err := repo.GetTeam(ctx, team.ParentID)
+	if errors.Is(err, repository.ErrNotFound) {
+		return fmt.Errorf("%w: unknown parent team %d", ErrInvalidTeam, team.ParentID)
+	}
+	if err != nil {
+		return err
+	}
+	if parent.Kind != parentKind {
+		return fmt.Errorf("%w: a %s must sit under a %s, but team %d is a %s", ErrInvalidTeam, team.Kind, parentKind, parent.ID, parent.Kind)
+	}
+	return nil
+}
+
+// ListTeamMemberships returns the memberships of a team, of a user, or both when the IDs are non-zero
+func ListTeamMemberships(ctx context.Context, repo repository.TeamRepositoryInterface, teamID, userID int) (*proto.ListTeamMembershipsResponse, error) {
+	memberships, err := repo.ListTeamMemberships(ctx, teamID, userID)
+	if err != nil {
+		return nil, err
+	}
+
+	resp := &proto.ListTeamMembershipsResponse{}
+	for _, m := range memberships {
+		resp.Memberships = append(resp.Memberships, teamMembershipToProto(m))
+	}
+	return resp, nil
+}
+
+func CreateTeamMembership(ctx context.Context, repo repository.TeamRepositoryInterface, membership models.TeamMembership) (*proto.TeamMembership, error) {
+	if err := validateTeamMembership(ctx, repo, membership); err != nil {
+		return nil, err
+	}
+	created, err := repo.CreateTeamMembership(ctx, membership)
+	if errors.Is(err, repository.ErrConflict) {
+		return nil, overlapError(membership)
+	}
+	if err != nil {
+		return nil, err
+	}
+	return teamMembershipToProto(created), nil
+}
+
+// UpdateTeamMembership replaces a membership's team, user and dates, e.g. to end it
+func UpdateTeamMembership(ctx context.Context, repo repository.TeamRepositoryInterface, membership models.TeamMembership) (*proto.TeamMembership, error) {
+	if _, err := repo.GetTeamMembership(ctx, membership.ID); err != nil {
+		return nil, err
+	}
+	if err := validateTeamMembership(ctx, repo, membership); err != nil {
+		return nil, err
+	}
+	updated, err := repo.UpdateTeamMembership(ctx, membership)
+	if errors.Is(err, repository.ErrConflict) {
+		return nil, overlapError(membership)
+	}
+	if err != nil {
+		return nil, err
+	}
+	return teamMembershipToProto(updated), nil
+}
+
+func DeleteTeamMembership(ctx context.Context, repo repository.TeamRepositoryInterface, id int) (*proto.DeleteTeamMembershipResponse, error) {
+	if err := repo.DeleteTeamMembership(ctx, id); err != nil {
+		return nil, err
+	}
+	return &proto.DeleteTeamMembershipResponse{}, nil
+}
+
+// validateTeamMembership rejects unknown teams and users. Overlaps with another membership of the
+// same user in the same team are refused by the repository as part of the write
+func validateTeamMembership(ctx context.Context, repo repository.TeamRepositoryInterface, membership models.TeamMembership) error {
+	if _, err := repo.GetTeam(ctx, membership.TeamID); errors.Is(err, repository.ErrNotFound) {
+		return fmt.Errorf("%w: unknown team %d", ErrInvalidTeamMembership, membership.TeamID)
+	} else if err != nil {
+		return err
+	}
+	if _, err := repo.GetUser(ctx, membership.UserID); errors.Is(err, repository.ErrNotFound) {
+		return fmt.Errorf("%w: unknown user %d", ErrInvalidTeamMembership, membership.UserID)
+	} else if err != nil {
+		return err
+	}
+	return nil
+}
+
+func overlapError(membership models.TeamMembership) error {
+	return fmt.Errorf("%w: overlaps another membership of user %d in team %d", ErrInvalidTeamMembership, membership.UserID, membership.TeamID)
+}
+
+// GetTeamScores rolls quality scores up the team hierarchy, starting from teamID or, when it is 0,
+// from every org. Each team's score combines its categories with CalculateOverallScore;
+// teams without attributed ratings are listed with no score
+func GetTeamScores(ctx context.Context, teams repository.TeamRepositoryInterface, scores repository.GroupedScoresRepositoryInterface, rng models.DateRange, teamID int) (*proto.TeamScoresResponse, error) {
+	list, err := teams.ListTeams(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	// ListTeams orders by name, so children and roots come out sorted
+	var roots []models.Team
+	children := make(map[int][]models.Team)
+	for _, t := range list {
+		switch {
+		case teamID != 0 && t.ID == teamID:
+			roots = append(roots, t)
+		case teamID == 0 && t.ParentID == 0:
+			roots = append(roots, t)
+		}
+		if t.ParentID != 0 {
+			children[t.ParentID] = append(children[t.ParentID], t)
+		}
+	}
+	if teamID != 0 && len(roots) == 0 {
+		return nil, fmt.Errorf("team %d: %w", teamID, repository.ErrNotFound)
+	}
+
+	rows, err := scores.GetTeamCategoryScores(ctx, rng)
+	if err != nil {
+		return nil, err
+	}
+	byTeam := make(map[int][]models.CategoryScore)
+	for _, row := range rows {
+		byTeam[row.TeamID] = append(byTeam[row.TeamID], row.CategoryScore)
+	}
+
+	resp := &proto.TeamScoresResponse{Range: dateRangeToProto(rng)}
+	var visit func(t models.Team, depth int)
+	visit = func(t models.Team, depth int) {
+		categories := byTeam[t.ID]
+		score, total := CalculateOverallScore(categories)
+		teamScore := &proto.TeamScore{
+			Team:        teamToProto(t),
+			Depth:       int32(depth),
+			RatingCount: int32(total),
+		}
+		// A team without ratings has no score rather than a score of 0
+		if total > 0 {
+			teamScore.Score = &score
+		}
+		for _, cs := range categories {
+			teamScore.Categories = append(teamScore.Categories, &proto.TeamCategoryScore{
+				CategoryId:   int32(cs.CategoryID),
+				CategoryName: cs.CategoryName,
+				Score:        CalculateCategoryScore(cs.Score, cs.CategoryWeight),
+				RatingCount:  int32(cs.RatingCount),
+			})
+		}
+		resp.Teams = append(resp.Teams, teamScore)
+
+		for _, child := range children[t.ID] {
+			visit(child, depth+1)
+		}
+	}
+	for _, root := range roots {
+		visit(root, 0)
+	}
+	return resp, nil
+}
+
+var teamKindsToProto = map[models.TeamKind]proto.TeamKind{
+	models.TeamKindOrg:        proto.TeamKind_TEAM_KIND_ORG,
+	models.TeamKindDepartment: proto.TeamKind_TEAM_KIND_DEPARTMENT,
+	models.TeamKindTeam:       proto.TeamKind_TEAM_KIND_TEAM,
+}
+
+// TeamKindFromProto maps a request's team kind; ok is false for UNSPECIFIED and unknown values
+func TeamKindFromProto(kind proto.TeamKind) (k models.TeamKind, ok bool) {
+	for model, p := range teamKindsToProto {
+		if p == kind {
+			return model, true
+		}
+	}
+	return "", false
+}
+
+func teamToProto(t models.Team) *proto.Team {
+	return &proto.Team{
+		Id:        int32(t.ID),
+		Name:      t.Name,
+		Kind:      teamKindsToProto[t.Kind],
+		ParentId:  int32(t.ParentID),
+		CreatedAt: timestamppb.New(t.CreatedAt),
+	}
+}
+
+func teamMembershipToProto(m models.TeamMembership) *proto.TeamMembership {
+	out := &proto.TeamMembership{
+		Id:        int32(m.ID),
+		TeamId:    int32(m.TeamID),
+		UserId:    int32(m.UserID),
+		UserName:  m.UserName,
+		ValidFrom: timestamppb.New(m.ValidFrom),
+	}
+	if !m.ValidTo.IsZero() {
+		out.ValidTo = timestamppb.New(m.ValidTo)
+	}
+	return out
+}
@@ -0,0 +1,286 @@
+package service
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"sort"
+	"testing"
+	"time"
+
+	"go-grpc-backend/internal/models"
+	"go-grpc-backend/internal/repository"
+)
+
+// mockTeamRepository keeps teams and memberships in memory; users 1 and 2 exist
+type mockTeamRepository struct {
+	teams       []models.Team
+	memberships []models.TeamMembership
+}
+
+func (m *mockTeamRepository) ListTeams(ctx context.Context) ([]models.Team, error) {
+	teams := append([]models.Team(nil), m.teams...)
+	sort.Slice(teams, func(i, j int) bool { return teams[i].Name < teams[j].Name })
+	return teams, nil
+}
+
+func (m *mockTeamRepository) GetTeam(ctx context.Context, id int) (models.Team, error) {
+	for _, t := range m.teams {
+		if t.ID == id {
+			return t, nil
+		}
+	}
+	return models.Team{}, fmt.Errorf("team %d: %w", id, repository.ErrNotFound)
+}
+
+func (m *mockTeamRepository) CreateTeam(ctx context.Context, team models.Team) (models.Team, error) {
+	team.ID = len(m.teams) + 1
+	m.teams = append(m.teams, team)
+	return team, nil
+}
+
+func (m *mockTeamRepository) UpdateTeam(ctx context.Context, team models.Team) (models.Team, error) {
+	for i, t := range m.teams {
+		if t.ID == team.ID {
+			m.teams[i] = team
+			return team, nil
+		}
+	}
+	return models.Team{}, fmt.Errorf("team %d: %w", team.ID, repository.ErrNotFound)
+}
+
+func (m *mockTeamRepository) DeleteTeam(ctx context.Context, id int) error {
+	for _, t := range m.teams {
+		if t.ParentID == id {
+			return fmt.Errorf("team %d still has sub-teams: %w", id, repository.ErrConflict)
+		}
+	}
+	return nil
+}
+
+// overlaps reports whether membership overlaps another of the same user in the same team
+func (m *mockTeamRepository) overlaps(membership models.TeamMembership) bool {
+	for _, ms := range m.memberships {
+		if ms.ID != membership.ID && ms.TeamID == membership.TeamID && ms.UserID == membership.UserID && membership.Overlaps(ms) {
+			return true
+		}
+	}
+	return false
+}
+
+func (m *mockTeamRepository) ListTeamMemberships(ctx context.Context, teamID, userID int) ([]models.TeamMembership, error) {
+	var out []models.TeamMembership
+	for _, ms := range m.memberships {
+		if (teamID == 0 || ms.TeamID == teamID) && (userID == 0 || ms.UserID == userID) {
+			out = append(out, ms)
+		}
+	}
+	return out, nil
+}
+
+func (m *mockTeamRepository) GetTeamMembership(ctx context.Context, id int) (models.TeamMembership, error) {
+	for _, ms := range m.memberships {
+		if ms.ID == id {
+			return ms, nil
+		}
+	}
+	return models.TeamMembership{}, fmt.Errorf("team membership %d: %w", id, repository.ErrNotFound)
+}
+
+func (m *mockTeamRepository) CreateTeamMembership(ctx context.Context, membership models.TeamMembership) (models.TeamMembership, error) {
+	if m.overlaps(membership) {
+		return models.TeamMembership{}, fmt.Errorf("overlapping membership: %w", repository.ErrConflict)
+	}
+	membership.ID = len(m.memberships) + 1
+	m.memberships = append(m.memberships, membership)
+	return membership, nil
+}
+
+func (m *mockTeamRepository) UpdateTeamMembership(ctx context.Context, membership models.TeamMembership) (models.TeamMembership, error) {
+	if m.overlaps(membership) {
+		return models.TeamMembership{}, fmt.Errorf("overlapping membership: %w", repository.ErrConflict)
+	}
+	for i, ms := range m.memberships {
+		if ms.ID == membership.ID {
+			m.memberships[i] = membership
+			return membership, nil
+		}
+	}
+	return models.TeamMembership{}, fmt.Errorf("team membership %d: %w", membership.ID, repository.ErrNotFound)
+}
+
+func (m *mockTeamRepository) DeleteTeamMembership(ctx context.Context, id int) error {
+	return nil
+}
+
+func (m *mockTeamRepository) GetUser(ctx context.Context, id int) (models.User, error) {
+	if id != 1 && id != 2 {
+		return models.User{}, fmt.Errorf("user %d: %w", id, repository.ErrNotFound)
+	}
+	return models.User{ID: id}, nil
+}
+
+// teamHierarchy is Acme (1, org) > Support (2, department) > Tier 2 (3) and Tier 1 (4), plus Beta (5, org)
+func teamHierarchy() *mockTeamRepository {
+	return &mockTeamRepository{teams: []models.Team{
+		{ID: 1, Name: "Acme", Kind: models.TeamKindOrg},
+		{ID: 2, Name: "Support", Kind: models.TeamKindDepartment, ParentID: 1},
+		{ID: 3, Name: "Tier 2", Kind: models.TeamKindTeam, ParentID: 2},
+		{ID: 4, Name: "Tier 1", Kind: models.TeamKindTeam, ParentID: 2},
+		{ID: 5, Name: "Beta", Kind: models.TeamKindOrg},
+	}}
+}
+
+func TestCreateTeam_Hierarchy(t *testing.T) {
+	tests := []struct {
+		name    string
+		team    models.Team
+		wantErr bool
+	}{
+		{name: "org", team: models.Team{Name: "Gamma", Kind: models.TeamKindOrg}},
+		{name: "department under org", team: models.Team{Name: "Sales", Kind: models.TeamKindDepartment, ParentID: 1}},
+		{name: "team under department", team: models.Team{Name: "Tier 3", Kind: models.TeamKindTeam, ParentID: 2}},
+		{name: "org with parent", team: models.Team{Name: "Gamma", Kind: models.TeamKindOrg, ParentID: 1}, wantErr: true},
+		{name: "department without parent", team: models.Team{Name: "Sales", Kind: models.TeamKindDepartment}, wantErr: true},
+		{name: "team under org", team: models.Team{Name: "Tier 3", Kind: models.TeamKindTeam, ParentID: 1}, wantErr: true},
+		{name: "unknown parent", team: models.Team{Name: "Tier 3", Kind: models.TeamKindTeam, ParentID: 42}, wantErr: true},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			_, err := CreateTeam(context.Background(), teamHierarchy(), tt.team)
+			if tt.wantErr != errors.Is(err, ErrInvalidTeam) {
+				t.Errorf("CreateTeam() error = %v, wantErr %v", err, tt.wantErr)
+			}
+		})
+	}
+}
+
+func TestUpdateTeam_KindIsFixed(t *testing.T) {
+	repo := teamHierarchy()
+
+	if _, err := UpdateTeam(context.Background(), repo, models.Team{ID: 3, Name: "Tier 2", Kind: models.TeamKindDepartment, ParentID: 1}); !errors.Is(err, ErrInvalidTeam) {
+		t.Errorf("Expected ErrInvalidTeam changing the kind, got %v", err)
+	}
+	updated, err := UpdateTeam(context.Background(), repo, models.Team{ID: 3, Name: "Escalations", Kind: models.TeamKindTeam, ParentID: 2})
+	if err != nil {
+		t.Fatalf("UpdateTeam() error = %v", err)
+	}
+	if updated.Name != "Escalations" {
+		t.Errorf("Expected the team to be renamed, got %v", updated)
+	}
+	if _, err := UpdateTeam(context.Background(), repo, models.Team{ID: 42, Name: "Ghost", Kind: models.TeamKindOrg}); !errors.Is(err, repository.ErrNotFound) {
+		t.Errorf("Expected ErrNotFound for an unknown team, got %v", err)
+	}
+}
+
+func TestDeleteTeam_WithSubTeams(t *testing.T) {
+	if _, err := DeleteTeam(context.Background(), teamHierarchy(), 2); !errors.Is(err, ErrInvalidTeam) {
+		t.Errorf("Expected ErrInvalidTeam deleting a department with teams, got %v", err)
+	}
+	if _, err := DeleteTeam(context.Background(), teamHierarchy(), 3); err != nil {
+		t.Errorf("DeleteTeam() error = %v", err)
+	}
+}
+
+func TestCreateTeamMembership_Validation(t *testing.T) {
+	jan := func(day int) time.Time { return time.Date(2025, 1, day, 0, 0, 0, 0, time.UTC) }
+	// Bob is in Tier 2 from January 1 to 10
+	withMembership := func() *mockTeamRepository {
+		repo := teamHierarchy()
+		repo.memberships = []models.TeamMembership{{ID: 1, TeamID: 3, UserID: 2, ValidFrom: jan(1), ValidTo: jan(10)}}
+		return repo
+	}
+
+	tests := []struct {
+		name       string
+		membership models.TeamMembership
+		wantErr    bool
+	}{
+		{name: "after the previous one", membership: models.TeamMembership{TeamID: 3, UserID: 2, ValidFrom: jan(10)}},
+		{name: "another team at the same time", membership: models.TeamMembership{TeamID: 4, UserID: 2, ValidFrom: jan(1)}},
+		{name: "overlapping", membership: models.TeamMembership{TeamID: 3, UserID: 2, ValidFrom: jan(9)}, wantErr: true},
+		{name: "enclosing", membership: models.TeamMembership{TeamID: 3, UserID: 2, ValidFrom: jan(2), ValidTo: jan(3)}, wantErr: true},
+		{name: "unknown team", membership: models.TeamMembership{TeamID: 42, UserID: 2, ValidFrom: jan(1)}, wantErr: true},
+		{name: "unknown user", membership: models.TeamMembership{TeamID: 3, UserID: 42, ValidFrom: jan(1)}, wantErr: true},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			_, err := CreateTeamMembership(context.Background(), withMembership(), tt.membership)
+			if tt.wantErr != errors.Is(err, ErrInvalidTeamMembership) {
+				t.Errorf("CreateTeamMembership() error = %v, wantErr %v", err, tt.wantErr)
+			}
+		})
+	}
+
+	// A membership doesn't overlap itself when it is updated
+	repo := withMembership()
+	if _, err := UpdateTeamMembership(context.Background(), repo, models.TeamMembership{ID: 1, TeamID: 3, UserID: 2, ValidFrom: jan(1), ValidTo: jan(5)}); err != nil {
+		t.Errorf("UpdateTeamMembership() error = %v", err)
+	}
+	// Moving it onto a later one is refused
+	if _, err := CreateTeamMembership(context.Background(), repo, models.TeamMembership{TeamID: 3, UserID: 2, ValidFrom: jan(5)}); err != nil {
+		t.Fatalf("CreateTeamMembership() error = %v", err)
+	}
+	if _, err := UpdateTeamMembership(context.Background(), repo, models.TeamMembership{ID: 1, TeamID: 3, UserID: 2, ValidFrom: jan(1), ValidTo: jan(6)}); !errors.Is(err, ErrInvalidTeamMembership) {
+		t.Errorf("Expected ErrInvalidTeamMembership extending into the next membership, got %v", err)
+	}
+}
+
+func TestGetTeamScores(t *testing.T) {
+	teams := teamHierarchy()
+	scores := &mockGroupedScoresRepository{teamScores: []models.TeamCategoryScore{
+		{TeamID: 1, CategoryScore: models.CategoryScore{CategoryID: 2, CategoryName: "Grammar", CategoryWeight: 0.5, Score: 2, RatingCount: 2}},
+		{TeamID: 1, CategoryScore: models.CategoryScore{CategoryID: 1, CategoryName: "Spelling", CategoryWeight: 1, Score: 3, RatingCount: 2}},
+		{TeamID: 2, CategoryScore: models.CategoryScore{CategoryID: 1, CategoryName: "Spelling", CategoryWeight: 1, Score: 3, RatingCount: 2}},
+		{TeamID: 4, CategoryScore: models.CategoryScore{CategoryID: 1, CategoryName: "Spelling", CategoryWeight: 1, Score: 3, RatingCount: 2}},
+	}}
+	rng := models.NewDateRange(time.Date(2025, 1, 1, 0, 0, 0, 0, time.UTC), time.Date(2025, 1, 13, 0, 0, 0, 0, time.UTC))
+
+	resp, err := GetTeamScores(context.Background(), teams, scores, rng, 0)
+	if err != nil {
+		t.Fatalf("GetTeamScores() error = %v", err)
+	}
+
+	// Depth-first with siblings by name; Acme averages (2*0.5*20 + 3*1*20) / 2 = 40
+	expected := []struct {
+		id    int32
+		depth int32
+		score float64
+		count int32
+	}{
+		{1, 0, 40, 4},
+		{2, 1, 60, 2},
+		{4, 2, 60, 2},
+		{3, 2, 0, 0},
+		{5, 0, 0, 0},
+	}
+	if len(resp.Teams) != len(expected) {
+		t.Fatalf("Expected %d teams, got %v", len(expected), resp.Teams)
+	}
+	for i, want := range expected {
+		got := resp.Teams[i]
+		if got.Team.Id != want.id || got.Depth != want.depth || got.GetScore() != want.score || got.RatingCount != want.count {
+			t.Errorf("Team %d: expected %+v, got %v", i, want, got)
+		}
+		// Teams without ratings have no score rather than 0
+		if (got.Score != nil) != (want.count > 0) {
+			t.Errorf("Team %d: expected a score to be set only with ratings, got %v", i, got.Score)
+		}
+	}
+	if cats := resp.Teams[0].Categories; len(cats) != 2 || cats[0].CategoryName != "Grammar" || cats[0].Score != 20 {
+		t.Errorf("Unexpected Acme categories %v", cats)
+	}
+
+	resp, err = GetTeamScores(context.Background(), teams, scores, rng, 2)
+	if err != nil {
+		t.Fatalf("GetTeamScores() for one team error = %v", err)
+	}
+	if len(resp.Teams) != 3 || resp.Teams[0].Team.Id != 2 || resp.Teams[0].Depth != 0 {
+		t.Errorf("Expected Support and its teams, got %v", resp.Teams)
+	}
+
+	if _, err := GetTeamScores(context.Background(), teams, scores, rng, 42); !errors.Is(err, repository.ErrNotFound) {
+		t.Errorf("Expected ErrNotFound for an unknown team, got %v", err)
+	}
+}
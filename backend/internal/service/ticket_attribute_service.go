@@ -0,0 +1,47 @@
+package service
+
+import (
+	"context"
+	"go-grpc-backend/internal/models"
+	"go-grpc-backend/internal/repository"
+	"go-grpc-backend/proto"
+
+	"google.golang.org/protobuf/types/known/timestamppb"
+)
+
+// ListTicketAttributes returns every attribute of a ticket
+func ListTicketAttributes(ctx context.Context, repo repository.TicketAttributeRepositoryInterface, ticketID int) (*proto.ListTicketAttributesResponse, error) {
+	attributes, err := repo.GetTicketAttributes(ctx, ticketID)
+	if err != nil {
+		return nil, err
+	}
+	return ticketAttributesToProto(ticketID, attributes), nil
+}
+
+// SetTicketAttributes creates or overwrites the given attributes and returns all of the ticket's
+func SetTicketAttributes(ctx context.Context, repo repository.TicketAttributeRepositoryInterface, ticketID int, attributes []models.TicketAttribute) (*proto.ListTicketAttributesResponse, error) {
+	stored, err := repo.SetTicketAttributes(ctx, ticketID, attributes)
+	if err != nil {
+		return nil, err
+	}
+	return ticketAttributesToProto(ticketID, stored), nil
+}
+
+func DeleteTicketAttribute(ctx context.Context, repo repository.TicketAttributeRepositoryInterface, ticketID int, key string) (*proto.DeleteTicketAttributeResponse, error) {
+	if err := repo.DeleteTicketAttribute(ctx, ticketID, key); err != nil {
+		return nil, err
+	}
+	return &proto.DeleteTicketAttributeResponse{}, nil
+}
+
+func ticketAttributesToProto(ticketID int, attributes []models.TicketAttribute) *proto.ListTicketAttributesResponse {
+	resp := &proto.ListTicketAttributesResponse{TicketId: int32(ticketID)}
+	for _, a := range attributes {
+		resp.Attributes = append(resp.Attributes, &proto.TicketAttribute{
+			Key:       a.Key,
+			Value:     a.Value,
+			UpdatedAt: timestamppb.New(a.UpdatedAt),
+		})
+	}
+	return resp
+}
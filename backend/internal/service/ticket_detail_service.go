@@ -0,0 +1,41 @@
+package service
+
+import (
+	"context"
+	"go-grpc-backend/internal/repository"
+	"go-grpc-backend/proto"
+
+	"google.golang.org/protobuf/types/known/timestamppb"
+)
+
+// GetTicketDetail returns a ticket with every rating it received, whatever its date
+func GetTicketDetail(ctx context.Context, repo repository.TicketRepositoryInterface, ticketID int) (*proto.TicketDetailResponse, error) {
+	ticket, err := repo.GetTicket(ctx, ticketID)
+	if err != nil {
+		return nil, err
+	}
+	ratings, err := repo.GetTicketRatings(ctx, ticketID)
+	if err != nil {
+		return nil, err
+	}
+
+	resp := &proto.TicketDetailResponse{
+		TicketId:  int32(ticket.ID),
+		Subject:   ticket.Subject,
+		CreatedAt: timestamppb.New(ticket.CreatedAt),
+	}
+	for _, r := range ratings {
+		resp.Ratings = append(resp.Ratings, &proto.TicketRating{
+			Id:           int32(r.ID),
+			Rating:       int32(r.Rating),
+			CategoryId:   int32(r.CategoryID),
+			CategoryName: r.CategoryName,
+			ReviewerId:   int32(r.ReviewerID),
+			ReviewerName: r.ReviewerName,
+			RevieweeId:   int32(r.RevieweeID),
+			RevieweeName: r.RevieweeName,
+			CreatedAt:    timestamppb.New(r.CreatedAt),
+		})
+	}
+	return resp, nil
+}
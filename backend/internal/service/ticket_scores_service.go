@@ -1,8 +1,8 @@
 package service
 
 import (
-	"time"
-
+	"context"
+	"go-grpc-backend/internal/models"
 	"go-grpc-backend/internal/repository"
 	"go-grpc-backend/proto"
 
@@ -10,15 +10,18 @@ import (
 )
 
 // GetScoresByTicket retrieves and aggregates category scores by ticket for a given period
-func GetScoresByTicket(repo repository.AnalyticsRepositoryInterface, startDate, endDate time.Time) (*proto.ScoresByTicketResponse, error) {
+// Each ticket also gets an overall score, computed like GetOverallQualityScore over its categories
+func GetScoresByTicket(ctx context.Context, repo repository.AnalyticsRepositoryInterface, rng models.DateRange, conf ConfidenceOptions) (*proto.ScoresByTicketResponse, error) {
 	// Get data from repository
-	scores, err := repo.GetScoresByTicket(startDate, endDate)
+	scores, err := repo.GetScoresByTicket(ctx, rng)
 	if err != nil {
 		return nil, err
 	}
 
 	// Group scores by ticket ID
 	ticketMap := make(map[int32]*proto.TicketScore)
+	// Per ticket: the category averages that feed its overall score
+	categoryScores := make(map[int32][]models.CategoryScore)
 	for _, score := range scores {
 		ticketID := int32(score.TicketID)
 
@@ -28,6 +31,8 @@ func GetScoresByTicket(repo repository.AnalyticsRepositoryInterface, startDate,
 			ticket = &proto.TicketScore{
 				TicketId:       ticketID,
 				CategoryScores: nil,
+				Subject:        score.TicketSubject,
+				CreatedAt:      timestamppb.New(score.TicketCreatedAt),
 			}
 			ticketMap[ticketID] = ticket
 		}
@@ -42,22 +47,40 @@ func GetScoresByTicket(repo repository.AnalyticsRepositoryInterface, startDate,
 			CategoryName: score.CategoryName,
 			Score:        float32(weightedScore),
 			RatingCount:  int32(score.RatingCount),
+			Confidence:   scoreConfidence(score.Score, score.RatingVariance, score.RatingCount, score.CategoryWeight, conf),
 		}
 		ticket.CategoryScores = append(ticket.CategoryScores, categoryScore)
+		categoryScores[ticketID] = append(categoryScores[ticketID], ticketCategoryScore(score))
 	}
 
 	// Convert map to slice
 	tickets := make([]*proto.TicketScore, 0, len(ticketMap))
-	for _, ticket := range ticketMap {
+	for ticketID, ticket := range ticketMap {
+		overall, total := CalculateOverallScore(categoryScores[ticketID])
+		ticket.OverallScore = float32(overall)
+		ticket.TotalRatingCount = int32(total)
 		tickets = append(tickets, ticket)
 	}
 
 	// Create and return response
 	resp := &proto.ScoresByTicketResponse{
 		Tickets:   tickets,
-		StartDate: timestamppb.New(startDate),
-		EndDate:   timestamppb.New(endDate),
+		StartDate: timestamppb.New(rng.Start),
+		EndDate:   timestamppb.New(rng.End),
+		Range:     dateRangeToProto(rng),
 	}
 
 	return resp, nil
 }
+
+// ticketCategoryScore drops the ticket from a per-ticket row so CalculateOverallScore can score it
+func ticketCategoryScore(row models.TicketCategoryScore) models.CategoryScore {
+	return models.CategoryScore{
+		CategoryID:     row.CategoryID,
+		CategoryName:   row.CategoryName,
+		CategoryWeight: row.CategoryWeight,
+		Score:          row.Score,
+		RatingCount:    row.RatingCount,
+		RatingVariance: row.RatingVariance,
+	}
+}
@@ -0,0 +1,36 @@
+package service
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"go-grpc-backend/internal/models"
+)
+
+func TestTicketScoresService_GetScoresByTicket_OverallScore(t *testing.T) {
+	created := time.Date(2024, 12, 30, 9, 0, 0, 0, time.UTC)
+	// Spelling: 4.5 * 0.4 * 20 = 36; Grammar: 4.0 * 0.6 * 20 = 48
+	// Overall = (36 + 48) / 2 = 42, as GetOverallQualityScore would report for the same rows
+	repo := &mockTicketScoresRepository{ticketScores: []models.TicketCategoryScore{
+		{TicketID: 1, TicketSubject: "Refund request", TicketCreatedAt: created, CategoryID: 2, CategoryName: "Grammar", CategoryWeight: 0.6, Score: 4.0, RatingCount: 3},
+		{TicketID: 1, TicketSubject: "Refund request", TicketCreatedAt: created, CategoryID: 1, CategoryName: "Spelling", CategoryWeight: 0.4, Score: 4.5, RatingCount: 2},
+	}}
+	rng := models.NewDateRange(time.Date(2025, 1, 1, 0, 0, 0, 0, time.UTC), time.Date(2025, 2, 1, 0, 0, 0, 0, time.UTC))
+
+	resp, err := GetScoresByTicket(context.Background(), repo, rng, ConfidenceOptions{})
+	if err != nil {
+		t.Fatalf("GetScoresByTicket() error = %v", err)
+	}
+	if len(resp.Tickets) != 1 {
+		t.Fatalf("Expected 1 ticket, got %d", len(resp.Tickets))
+	}
+
+	ticket := resp.Tickets[0]
+	if ticket.OverallScore != 42 || ticket.TotalRatingCount != 5 {
+		t.Errorf("Expected overall score 42 over 5 ratings, got %v over %d", ticket.OverallScore, ticket.TotalRatingCount)
+	}
+	if ticket.Subject != "Refund request" || !ticket.CreatedAt.AsTime().Equal(created) {
+		t.Errorf("Expected the ticket's subject and creation date, got %q and %v", ticket.Subject, ticket.CreatedAt.AsTime())
+	}
+}
@@ -1,40 +1,103 @@
 package main
 
 import (
+	"context"
+	"fmt"
 	"log"
+	"log/slog"
 	"os"
 	"os/signal"
 	"syscall"
+	_ "time/tzdata" // Period presets resolve IANA time zones; the runtime image may have no zoneinfo
 
+	"go-grpc-backend/internal/config"
 	"go-grpc-backend/internal/server"
 )
 
+// Process exit codes reported after shutdown
+const (
+	exitShutdownError  = 1
+	exitShutdownForced = 2
+)
+
 func main() {
-	port := getEnv("GRPC_PORT", "50051")
+	args := os.Args[1:]
+
+	if len(args) >= 2 && args[0] == "config" && args[1] == "print" {
+		if err := printConfig(args[2:]); err != nil {
+			fmt.Fprintln(os.Stderr, err)
+			os.Exit(1)
+		}
+		return
+	}
+
+	cfg, err := config.Load(args, os.Getenv)
+	if err != nil {
+		log.Fatalf("Failed to load configuration: %v", err)
+	}
+
+	slog.SetDefault(newLogger(cfg.Logging))
 
-	server, err := server.NewAnalyticsServer()
+	server, err := server.NewAnalyticsServer(cfg)
 	if err != nil {
 		log.Fatalf("Failed to create server: %v", err)
 	}
 
-	c := make(chan os.Signal, 1)
-	signal.Notify(c, os.Interrupt, syscall.SIGTERM)
+	signals := make(chan os.Signal, 2)
+	signal.Notify(signals, os.Interrupt, syscall.SIGTERM)
 
+	serveErr := make(chan error, 1)
 	go func() {
-		<-c
-		server.Stop()
-		os.Exit(0)
+		log.Printf("Starting server on %s", cfg.Server.ListenAddress)
+		serveErr <- server.Start(cfg.Server.ListenAddress)
 	}()
 
-	log.Printf("Starting server on port %s", port)
-	if err := server.Start(port); err != nil {
+	select {
+	case err := <-serveErr:
 		log.Fatalf("Failed to start server: %v", err)
+	case sig := <-signals:
+		log.Printf("Received %s, shutting down (drain timeout %s)", sig, cfg.Server.ShutdownTimeout)
 	}
+
+	ctx, cancel := context.WithTimeout(context.Background(), cfg.Server.ShutdownTimeout)
+	defer cancel()
+
+	// A second signal skips the remaining drain time
+	go func() {
+		<-signals
+		log.Println("Received second signal, forcing shutdown")
+		cancel()
+	}()
+
+	forced, err := server.Shutdown(ctx)
+	switch {
+	case err != nil:
+		log.Printf("Shutdown finished with errors: %v", err)
+		os.Exit(exitShutdownError)
+	case forced:
+		log.Println("Shutdown forced: in-flight RPCs were cancelled")
+		os.Exit(exitShutdownForced)
+	}
+	log.Println("Shutdown complete")
 }
 
-func getEnv(key, defaultValue string) string {
-	if value := os.Getenv(key); value != "" {
-		return value
+// printConfig implements `config print`: it writes the effective configuration with secrets redacted
+func printConfig(args []string) error {
+	cfg, err := config.Load(args, os.Getenv)
+	if err != nil {
+		return err
+	}
+	return cfg.Redacted().Write(os.Stdout)
+}
+
+// newLogger builds the process logger; Validate has already checked level and format
+func newLogger(cfg config.LoggingConfig) *slog.Logger {
+	var level slog.Level
+	_ = level.UnmarshalText([]byte(cfg.Level))
+
+	opts := &slog.HandlerOptions{Level: level}
+	if cfg.Format == "json" {
+		return slog.New(slog.NewJSONHandler(os.Stderr, opts))
 	}
-	return defaultValue
+	return slog.New(slog.NewTextHandler(os.Stderr, opts))
 }
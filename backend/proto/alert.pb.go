@@ -0,0 +1,776 @@
+// Code generated by protoc-gen-go. DO NOT EDIT.
+// versions:
+// 	protoc-gen-go v1.36.9
+// 	protoc        v5.29.3
+// source: alert.proto
+
+package proto
+
+import (
+	protoreflect "google.golang.org/protobuf/reflect/protoreflect"
+	protoimpl "google.golang.org/protobuf/runtime/protoimpl"
+	durationpb "google.golang.org/protobuf/types/known/durationpb"
+	timestamppb "google.golang.org/protobuf/types/known/timestamppb"
+	reflect "reflect"
+	sync "sync"
+	unsafe "unsafe"
+)
+
+const (
+	// Verify that this generated code is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(20 - protoimpl.MinVersion)
+	// Verify that runtime/protoimpl is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(protoimpl.MaxVersion - 20)
+)
+
+type AlertMetric int32
+
+const (
+	AlertMetric_ALERT_METRIC_UNSPECIFIED   AlertMetric = 0
+	AlertMetric_ALERT_METRIC_QUALITY_SCORE AlertMetric = 1 // Overall quality score of the rule's categories, as in GetOverallQualityScore
+	AlertMetric_ALERT_METRIC_RATING_COUNT  AlertMetric = 2 // Number of ratings in the rule's categories
+)
+
+// Enum value maps for AlertMetric.
+var (
+	AlertMetric_name = map[int32]string{
+		0: "ALERT_METRIC_UNSPECIFIED",
+		1: "ALERT_METRIC_QUALITY_SCORE",
+		2: "ALERT_METRIC_RATING_COUNT",
+	}
+	AlertMetric_value = map[string]int32{
+		"ALERT_METRIC_UNSPECIFIED":   0,
+		"ALERT_METRIC_QUALITY_SCORE": 1,
+		"ALERT_METRIC_RATING_COUNT":  2,
+	}
+)
+
+func (x AlertMetric) Enum() *AlertMetric {
+	p := new(AlertMetric)
+	*p = x
+	return p
+}
+
+func (x AlertMetric) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
+}
+
+func (AlertMetric) Descriptor() protoreflect.EnumDescriptor {
+	return file_alert_proto_enumTypes[0].Descriptor()
+}
+
+func (AlertMetric) Type() protoreflect.EnumType {
+	return &file_alert_proto_enumTypes[0]
+}
+
+func (x AlertMetric) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
+}
+
+// Deprecated: Use AlertMetric.Descriptor instead.
+func (AlertMetric) EnumDescriptor() ([]byte, []int) {
+	return file_alert_proto_rawDescGZIP(), []int{0}
+}
+
+type AlertComparator int32
+
+const (
+	AlertComparator_ALERT_COMPARATOR_UNSPECIFIED      AlertComparator = 0
+	AlertComparator_ALERT_COMPARATOR_LESS_THAN        AlertComparator = 1
+	AlertComparator_ALERT_COMPARATOR_LESS_OR_EQUAL    AlertComparator = 2
+	AlertComparator_ALERT_COMPARATOR_GREATER_THAN     AlertComparator = 3
+	AlertComparator_ALERT_COMPARATOR_GREATER_OR_EQUAL AlertComparator = 4
+)
+
+// Enum value maps for AlertComparator.
+var (
+	AlertComparator_name = map[int32]string{
+		0: "ALERT_COMPARATOR_UNSPECIFIED",
+		1: "ALERT_COMPARATOR_LESS_THAN",
+		2: "ALERT_COMPARATOR_LESS_OR_EQUAL",
+		3: "ALERT_COMPARATOR_GREATER_THAN",
+		4: "ALERT_COMPARATOR_GREATER_OR_EQUAL",
+	}
+	AlertComparator_value = map[string]int32{
+		"ALERT_COMPARATOR_UNSPECIFIED":      0,
+		"ALERT_COMPARATOR_LESS_THAN":        1,
+		"ALERT_COMPARATOR_LESS_OR_EQUAL":    2,
+		"ALERT_COMPARATOR_GREATER_THAN":     3,
+		"ALERT_COMPARATOR_GREATER_OR_EQUAL": 4,
+	}
+)
+
+func (x AlertComparator) Enum() *AlertComparator {
+	p := new(AlertComparator)
+	*p = x
+	return p
+}
+
+func (x AlertComparator) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
+}
+
+func (AlertComparator) Descriptor() protoreflect.EnumDescriptor {
+	return file_alert_proto_enumTypes[1].Descriptor()
+}
+
+func (AlertComparator) Type() protoreflect.EnumType {
+	return &file_alert_proto_enumTypes[1]
+}
+
+func (x AlertComparator) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
+}
+
+// Deprecated: Use AlertComparator.Descriptor instead.
+func (AlertComparator) EnumDescriptor() ([]byte, []int) {
+	return file_alert_proto_rawDescGZIP(), []int{1}
+}
+
+type AlertStatus int32
+
+const (
+	AlertStatus_ALERT_STATUS_UNSPECIFIED AlertStatus = 0 // Not evaluated yet
+	AlertStatus_ALERT_STATUS_OK          AlertStatus = 1
+	AlertStatus_ALERT_STATUS_FIRING      AlertStatus = 2
+)
+
+// Enum value maps for AlertStatus.
+var (
+	AlertStatus_name = map[int32]string{
+		0: "ALERT_STATUS_UNSPECIFIED",
+		1: "ALERT_STATUS_OK",
+		2: "ALERT_STATUS_FIRING",
+	}
+	AlertStatus_value = map[string]int32{
+		"ALERT_STATUS_UNSPECIFIED": 0,
+		"ALERT_STATUS_OK":          1,
+		"ALERT_STATUS_FIRING":      2,
+	}
+)
+
+func (x AlertStatus) Enum() *AlertStatus {
+	p := new(AlertStatus)
+	*p = x
+	return p
+}
+
+func (x AlertStatus) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
+}
+
+func (AlertStatus) Descriptor() protoreflect.EnumDescriptor {
+	return file_alert_proto_enumTypes[2].Descriptor()
+}
+
+func (AlertStatus) Type() protoreflect.EnumType {
+	return &file_alert_proto_enumTypes[2]
+}
+
+func (x AlertStatus) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
+}
+
+// Deprecated: Use AlertStatus.Descriptor instead.
+func (AlertStatus) EnumDescriptor() ([]byte, []int) {
+	return file_alert_proto_rawDescGZIP(), []int{2}
+}
+
+// AlertRule fires when its metric over the trailing window breaches the threshold:
+// metric <comparator> threshold
+type AlertRule struct {
+	state       protoimpl.MessageState `protogen:"open.v1"`
+	Id          int32                  `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"` // Assigned by CreateAlertRule
+	Name        string                 `protobuf:"bytes,2,opt,name=name,proto3" json:"name,omitempty"`
+	Metric      AlertMetric            `protobuf:"varint,3,opt,name=metric,proto3,enum=analytics.AlertMetric" json:"metric,omitempty"`
+	CategoryIds []int32                `protobuf:"varint,4,rep,packed,name=category_ids,json=categoryIds,proto3" json:"category_ids,omitempty"` // Only count these categories; empty means all
+	Window      *durationpb.Duration   `protobuf:"bytes,5,opt,name=window,proto3" json:"window,omitempty"`                                      // Trailing window ending at evaluation time
+	Comparator  AlertComparator        `protobuf:"varint,6,opt,name=comparator,proto3,enum=analytics.AlertComparator" json:"comparator,omitempty"`
+	Threshold   float64                `protobuf:"fixed64,7,opt,name=threshold,proto3" json:"threshold,omitempty"`
+	MinSample   int32                  `protobuf:"varint,8,opt,name=min_sample,json=minSample,proto3" json:"min_sample,omitempty"` // Ratings the window needs before a quality score rule may change state
+	// http(s) URL receiving a Slack-compatible JSON payload. Input only: it usually embeds a credential,
+	// so responses leave it empty. Required by CreateAlertRule; UpdateAlertRule keeps the stored URL when empty
+	WebhookUrl    string                 `protobuf:"bytes,9,opt,name=webhook_url,json=webhookUrl,proto3" json:"webhook_url,omitempty"`
+	Enabled       bool                   `protobuf:"varint,10,opt,name=enabled,proto3" json:"enabled,omitempty"`
+	CreatedAt     *timestamppb.Timestamp `protobuf:"bytes,11,opt,name=created_at,json=createdAt,proto3" json:"created_at,omitempty"` // Output only
+	State         *AlertState            `protobuf:"bytes,12,opt,name=state,proto3" json:"state,omitempty"`                          // Output only; unset until the rule is first evaluated
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *AlertRule) Reset() {
+	*x = AlertRule{}
+	mi := &file_alert_proto_msgTypes[0]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *AlertRule) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*AlertRule) ProtoMessage() {}
+
+func (x *AlertRule) ProtoReflect() protoreflect.Message {
+	mi := &file_alert_proto_msgTypes[0]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use AlertRule.ProtoReflect.Descriptor instead.
+func (*AlertRule) Descriptor() ([]byte, []int) {
+	return file_alert_proto_rawDescGZIP(), []int{0}
+}
+
+func (x *AlertRule) GetId() int32 {
+	if x != nil {
+		return x.Id
+	}
+	return 0
+}
+
+func (x *AlertRule) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+func (x *AlertRule) GetMetric() AlertMetric {
+	if x != nil {
+		return x.Metric
+	}
+	return AlertMetric_ALERT_METRIC_UNSPECIFIED
+}
+
+func (x *AlertRule) GetCategoryIds() []int32 {
+	if x != nil {
+		return x.CategoryIds
+	}
+	return nil
+}
+
+func (x *AlertRule) GetWindow() *durationpb.Duration {
+	if x != nil {
+		return x.Window
+	}
+	return nil
+}
+
+func (x *AlertRule) GetComparator() AlertComparator {
+	if x != nil {
+		return x.Comparator
+	}
+	return AlertComparator_ALERT_COMPARATOR_UNSPECIFIED
+}
+
+func (x *AlertRule) GetThreshold() float64 {
+	if x != nil {
+		return x.Threshold
+	}
+	return 0
+}
+
+func (x *AlertRule) GetMinSample() int32 {
+	if x != nil {
+		return x.MinSample
+	}
+	return 0
+}
+
+func (x *AlertRule) GetWebhookUrl() string {
+	if x != nil {
+		return x.WebhookUrl
+	}
+	return ""
+}
+
+func (x *AlertRule) GetEnabled() bool {
+	if x != nil {
+		return x.Enabled
+	}
+	return false
+}
+
+func (x *AlertRule) GetCreatedAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.CreatedAt
+	}
+	return nil
+}
+
+func (x *AlertRule) GetState() *AlertState {
+	if x != nil {
+		return x.State
+	}
+	return nil
+}
+
+type AlertState struct {
+	state              protoimpl.MessageState `protogen:"open.v1"`
+	Status             AlertStatus            `protobuf:"varint,1,opt,name=status,proto3,enum=analytics.AlertStatus" json:"status,omitempty"`
+	Value              float64                `protobuf:"fixed64,2,opt,name=value,proto3" json:"value,omitempty"`                                                    // Metric value at the latest evaluation
+	Sample             int32                  `protobuf:"varint,3,opt,name=sample,proto3" json:"sample,omitempty"`                                                   // Ratings in the window at the latest evaluation
+	InsufficientSample bool                   `protobuf:"varint,4,opt,name=insufficient_sample,json=insufficientSample,proto3" json:"insufficient_sample,omitempty"` // Fewer than min_sample ratings; status was left unchanged
+	ChangedAt          *timestamppb.Timestamp `protobuf:"bytes,5,opt,name=changed_at,json=changedAt,proto3" json:"changed_at,omitempty"`                             // When status last changed
+	EvaluatedAt        *timestamppb.Timestamp `protobuf:"bytes,6,opt,name=evaluated_at,json=evaluatedAt,proto3" json:"evaluated_at,omitempty"`
+	unknownFields      protoimpl.UnknownFields
+	sizeCache          protoimpl.SizeCache
+}
+
+func (x *AlertState) Reset() {
+	*x = AlertState{}
+	mi := &file_alert_proto_msgTypes[1]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *AlertState) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*AlertState) ProtoMessage() {}
+
+func (x *AlertState) ProtoReflect() protoreflect.Message {
+	mi := &file_alert_proto_msgTypes[1]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use AlertState.ProtoReflect.Descriptor instead.
+func (*AlertState) Descriptor() ([]byte, []int) {
+	return file_alert_proto_rawDescGZIP(), []int{1}
+}
+
+func (x *AlertState) GetStatus() AlertStatus {
+	if x != nil {
+		return x.Status
+	}
+	return AlertStatus_ALERT_STATUS_UNSPECIFIED
+}
+
+func (x *AlertState) GetValue() float64 {
+	if x != nil {
+		return x.Value
+	}
+	return 0
+}
+
+func (x *AlertState) GetSample() int32 {
+	if x != nil {
+		return x.Sample
+	}
+	return 0
+}
+
+func (x *AlertState) GetInsufficientSample() bool {
+	if x != nil {
+		return x.InsufficientSample
+	}
+	return false
+}
+
+func (x *AlertState) GetChangedAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.ChangedAt
+	}
+	return nil
+}
+
+func (x *AlertState) GetEvaluatedAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.EvaluatedAt
+	}
+	return nil
+}
+
+type ListAlertRulesRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ListAlertRulesRequest) Reset() {
+	*x = ListAlertRulesRequest{}
+	mi := &file_alert_proto_msgTypes[2]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListAlertRulesRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListAlertRulesRequest) ProtoMessage() {}
+
+func (x *ListAlertRulesRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_alert_proto_msgTypes[2]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListAlertRulesRequest.ProtoReflect.Descriptor instead.
+func (*ListAlertRulesRequest) Descriptor() ([]byte, []int) {
+	return file_alert_proto_rawDescGZIP(), []int{2}
+}
+
+type ListAlertRulesResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Rules         []*AlertRule           `protobuf:"bytes,1,rep,name=rules,proto3" json:"rules,omitempty"` // Ordered by id
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ListAlertRulesResponse) Reset() {
+	*x = ListAlertRulesResponse{}
+	mi := &file_alert_proto_msgTypes[3]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListAlertRulesResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListAlertRulesResponse) ProtoMessage() {}
+
+func (x *ListAlertRulesResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_alert_proto_msgTypes[3]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListAlertRulesResponse.ProtoReflect.Descriptor instead.
+func (*ListAlertRulesResponse) Descriptor() ([]byte, []int) {
+	return file_alert_proto_rawDescGZIP(), []int{3}
+}
+
+func (x *ListAlertRulesResponse) GetRules() []*AlertRule {
+	if x != nil {
+		return x.Rules
+	}
+	return nil
+}
+
+type CreateAlertRuleRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Rule          *AlertRule             `protobuf:"bytes,1,opt,name=rule,proto3" json:"rule,omitempty"` // id, created_at and state are ignored
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *CreateAlertRuleRequest) Reset() {
+	*x = CreateAlertRuleRequest{}
+	mi := &file_alert_proto_msgTypes[4]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *CreateAlertRuleRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CreateAlertRuleRequest) ProtoMessage() {}
+
+func (x *CreateAlertRuleRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_alert_proto_msgTypes[4]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CreateAlertRuleRequest.ProtoReflect.Descriptor instead.
+func (*CreateAlertRuleRequest) Descriptor() ([]byte, []int) {
+	return file_alert_proto_rawDescGZIP(), []int{4}
+}
+
+func (x *CreateAlertRuleRequest) GetRule() *AlertRule {
+	if x != nil {
+		return x.Rule
+	}
+	return nil
+}
+
+type UpdateAlertRuleRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Rule          *AlertRule             `protobuf:"bytes,1,opt,name=rule,proto3" json:"rule,omitempty"` // Replaces every field of the rule with rule.id; created_at and state are ignored
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *UpdateAlertRuleRequest) Reset() {
+	*x = UpdateAlertRuleRequest{}
+	mi := &file_alert_proto_msgTypes[5]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *UpdateAlertRuleRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*UpdateAlertRuleRequest) ProtoMessage() {}
+
+func (x *UpdateAlertRuleRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_alert_proto_msgTypes[5]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use UpdateAlertRuleRequest.ProtoReflect.Descriptor instead.
+func (*UpdateAlertRuleRequest) Descriptor() ([]byte, []int) {
+	return file_alert_proto_rawDescGZIP(), []int{5}
+}
+
+func (x *UpdateAlertRuleRequest) GetRule() *AlertRule {
+	if x != nil {
+		return x.Rule
+	}
+	return nil
+}
+
+type DeleteAlertRuleRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Id            int32                  `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *DeleteAlertRuleRequest) Reset() {
+	*x = DeleteAlertRuleRequest{}
+	mi := &file_alert_proto_msgTypes[6]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *DeleteAlertRuleRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*DeleteAlertRuleRequest) ProtoMessage() {}
+
+func (x *DeleteAlertRuleRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_alert_proto_msgTypes[6]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use DeleteAlertRuleRequest.ProtoReflect.Descriptor instead.
+func (*DeleteAlertRuleRequest) Descriptor() ([]byte, []int) {
+	return file_alert_proto_rawDescGZIP(), []int{6}
+}
+
+func (x *DeleteAlertRuleRequest) GetId() int32 {
+	if x != nil {
+		return x.Id
+	}
+	return 0
+}
+
+type DeleteAlertRuleResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *DeleteAlertRuleResponse) Reset() {
+	*x = DeleteAlertRuleResponse{}
+	mi := &file_alert_proto_msgTypes[7]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *DeleteAlertRuleResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*DeleteAlertRuleResponse) ProtoMessage() {}
+
+func (x *DeleteAlertRuleResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_alert_proto_msgTypes[7]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use DeleteAlertRuleResponse.ProtoReflect.Descriptor instead.
+func (*DeleteAlertRuleResponse) Descriptor() ([]byte, []int) {
+	return file_alert_proto_rawDescGZIP(), []int{7}
+}
+
+var File_alert_proto protoreflect.FileDescriptor
+
+const file_alert_proto_rawDesc = "" +
+	"\n" +
+	"\valert.proto\x12\tanalytics\x1a\x1egoogle/protobuf/duration.proto\x1a\x1fgoogle/protobuf/timestamp.proto\"\xd1\x03\n" +
+	"\tAlertRule\x12\x0e\n" +
+	"\x02id\x18\x01 \x01(\x05R\x02id\x12\x12\n" +
+	"\x04name\x18\x02 \x01(\tR\x04name\x12.\n" +
+	"\x06metric\x18\x03 \x01(\x0e2\x16.analytics.AlertMetricR\x06metric\x12!\n" +
+	"\fcategory_ids\x18\x04 \x03(\x05R\vcategoryIds\x121\n" +
+	"\x06window\x18\x05 \x01(\v2\x19.google.protobuf.DurationR\x06window\x12:\n" +
+	"\n" +
+	"comparator\x18\x06 \x01(\x0e2\x1a.analytics.AlertComparatorR\n" +
+	"comparator\x12\x1c\n" +
+	"\tthreshold\x18\a \x01(\x01R\tthreshold\x12\x1d\n" +
+	"\n" +
+	"min_sample\x18\b \x01(\x05R\tminSample\x12\x1f\n" +
+	"\vwebhook_url\x18\t \x01(\tR\n" +
+	"webhookUrl\x12\x18\n" +
+	"\aenabled\x18\n" +
+	" \x01(\bR\aenabled\x129\n" +
+	"\n" +
+	"created_at\x18\v \x01(\v2\x1a.google.protobuf.TimestampR\tcreatedAt\x12+\n" +
+	"\x05state\x18\f \x01(\v2\x15.analytics.AlertStateR\x05state\"\x95\x02\n" +
+	"\n" +
+	"AlertState\x12.\n" +
+	"\x06status\x18\x01 \x01(\x0e2\x16.analytics.AlertStatusR\x06status\x12\x14\n" +
+	"\x05value\x18\x02 \x01(\x01R\x05value\x12\x16\n" +
+	"\x06sample\x18\x03 \x01(\x05R\x06sample\x12/\n" +
+	"\x13insufficient_sample\x18\x04 \x01(\bR\x12insufficientSample\x129\n" +
+	"\n" +
+	"changed_at\x18\x05 \x01(\v2\x1a.google.protobuf.TimestampR\tchangedAt\x12=\n" +
+	"\fevaluated_at\x18\x06 \x01(\v2\x1a.google.protobuf.TimestampR\vevaluatedAt\"\x17\n" +
+	"\x15ListAlertRulesRequest\"D\n" +
+	"\x16ListAlertRulesResponse\x12*\n" +
+	"\x05rules\x18\x01 \x03(\v2\x14.analytics.AlertRuleR\x05rules\"B\n" +
+	"\x16CreateAlertRuleRequest\x12(\n" +
+	"\x04rule\x18\x01 \x01(\v2\x14.analytics.AlertRuleR\x04rule\"B\n" +
+	"\x16UpdateAlertRuleRequest\x12(\n" +
+	"\x04rule\x18\x01 \x01(\v2\x14.analytics.AlertRuleR\x04rule\"(\n" +
+	"\x16DeleteAlertRuleRequest\x12\x0e\n" +
+	"\x02id\x18\x01 \x01(\x05R\x02id\"\x19\n" +
+	"\x17DeleteAlertRuleResponse*j\n" +
+	"\vAlertMetric\x12\x1c\n" +
+	"\x18ALERT_METRIC_UNSPECIFIED\x10\x00\x12\x1e\n" +
+	"\x1aALERT_METRIC_QUALITY_SCORE\x10\x01\x12\x1d\n" +
+	"\x19ALERT_METRIC_RATING_COUNT\x10\x02*\xc1\x01\n" +
+	"\x0fAlertComparator\x12 \n" +
+	"\x1cALERT_COMPARATOR_UNSPECIFIED\x10\x00\x12\x1e\n" +
+	"\x1aALERT_COMPARATOR_LESS_THAN\x10\x01\x12\"\n" +
+	"\x1eALERT_COMPARATOR_LESS_OR_EQUAL\x10\x02\x12!\n" +
+	"\x1dALERT_COMPARATOR_GREATER_THAN\x10\x03\x12%\n" +
+	"!ALERT_COMPARATOR_GREATER_OR_EQUAL\x10\x04*Y\n" +
+	"\vAlertStatus\x12\x1c\n" +
+	"\x18ALERT_STATUS_UNSPECIFIED\x10\x00\x12\x13\n" +
+	"\x0fALERT_STATUS_OK\x10\x01\x12\x17\n" +
+	"\x13ALERT_STATUS_FIRING\x10\x02B\x17Z\x15go-grpc-backend/protob\x06proto3"
+
+var (
+	file_alert_proto_rawDescOnce sync.Once
+	file_alert_proto_rawDescData []byte
+)
+
+func file_alert_proto_rawDescGZIP() []byte {
+	file_alert_proto_rawDescOnce.Do(func() {
+		file_alert_proto_rawDescData = protoimpl.X.CompressGZIP(unsafe.Slice(unsafe.StringData(file_alert_proto_rawDesc), len(file_alert_proto_rawDesc)))
+	})
+	return file_alert_proto_rawDescData
+}
+
+var file_alert_proto_enumTypes = make([]protoimpl.EnumInfo, 3)
+var file_alert_proto_msgTypes = make([]protoimpl.MessageInfo, 8)
+var file_alert_proto_goTypes = []any{
+	(AlertMetric)(0),                // 0: analytics.AlertMetric
+	(AlertComparator)(0),            // 1: analytics.AlertComparator
+	(AlertStatus)(0),                // 2: analytics.AlertStatus
+	(*AlertRule)(nil),               // 3: analytics.AlertRule
+	(*AlertState)(nil),              // 4: analytics.AlertState
+	(*ListAlertRulesRequest)(nil),   // 5: analytics.ListAlertRulesRequest
+	(*ListAlertRulesResponse)(nil),  // 6: analytics.ListAlertRulesResponse
+	(*CreateAlertRuleRequest)(nil),  // 7: analytics.CreateAlertRuleRequest
+	(*UpdateAlertRuleRequest)(nil),  // 8: analytics.UpdateAlertRuleRequest
+	(*DeleteAlertRuleRequest)(nil),  // 9: analytics.DeleteAlertRuleRequest
+	(*DeleteAlertRuleResponse)(nil), // 10: analytics.DeleteAlertRuleResponse
+	(*durationpb.Duration)(nil),     // 11: google.protobuf.Duration
+	(*timestamppb.Timestamp)(nil),   // 12: google.protobuf.Timestamp
+}
+var file_alert_proto_depIdxs = []int32{
+	0,  // 0: analytics.AlertRule.metric:type_name -> analytics.AlertMetric
+	11, // 1: analytics.AlertRule.window:type_name -> google.protobuf.Duration
+	1,  // 2: analytics.AlertRule.comparator:type_name -> analytics.AlertComparator
+	12, // 3: analytics.AlertRule.created_at:type_name -> google.protobuf.Timestamp
+	4,  // 4: analytics.AlertRule.state:type_name -> analytics.AlertState
+	2,  // 5: analytics.AlertState.status:type_name -> analytics.AlertStatus
+	12, // 6: analytics.AlertState.changed_at:type_name -> google.protobuf.Timestamp
+	12, // 7: analytics.AlertState.evaluated_at:type_name -> google.protobuf.Timestamp
+	3,  // 8: analytics.ListAlertRulesResponse.rules:type_name -> analytics.AlertRule
+	3,  // 9: analytics.CreateAlertRuleRequest.rule:type_name -> analytics.AlertRule
+	3,  // 10: analytics.UpdateAlertRuleRequest.rule:type_name -> analytics.AlertRule
+	11, // [11:11] is the sub-list for method output_type
+	11, // [11:11] is the sub-list for method input_type
+	11, // [11:11] is the sub-list for extension type_name
+	11, // [11:11] is the sub-list for extension extendee
+	0,  // [0:11] is the sub-list for field type_name
+}
+
+func init() { file_alert_proto_init() }
+func file_alert_proto_init() {
+	if File_alert_proto != nil {
+		return
+	}
+	type x struct{}
+	out := protoimpl.TypeBuilder{
+		File: protoimpl.DescBuilder{
+			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
+			RawDescriptor: unsafe.Slice(unsafe.StringData(file_alert_proto_rawDesc), len(file_alert_proto_rawDesc)),
+			NumEnums:      3,
+			NumMessages:   8,
+			NumExtensions: 0,
+			NumServices:   0,
+		},
+		GoTypes:           file_alert_proto_goTypes,
+		DependencyIndexes: file_alert_proto_depIdxs,
+		EnumInfos:         file_alert_proto_enumTypes,
+		MessageInfos:      file_alert_proto_msgTypes,
+	}.Build()
+	File_alert_proto = out.File
+	file_alert_proto_goTypes = nil
+	file_alert_proto_depIdxs = nil
+}
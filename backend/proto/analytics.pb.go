@@ -22,66 +22,6 @@ const (
 	_ = protoimpl.EnforceVersion(protoimpl.MaxVersion - 20)
 )
 
-type RatingCategory struct {
-	state         protoimpl.MessageState `protogen:"open.v1"`
-	Id            int32                  `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
-	Name          string                 `protobuf:"bytes,2,opt,name=name,proto3" json:"name,omitempty"`
-	Weight        int32                  `protobuf:"varint,3,opt,name=weight,proto3" json:"weight,omitempty"`
-	unknownFields protoimpl.UnknownFields
-	sizeCache     protoimpl.SizeCache
-}
-
-func (x *RatingCategory) Reset() {
-	*x = RatingCategory{}
-	mi := &file_analytics_proto_msgTypes[0]
-	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
-	ms.StoreMessageInfo(mi)
-}
-
-func (x *RatingCategory) String() string {
-	return protoimpl.X.MessageStringOf(x)
-}
-
-func (*RatingCategory) ProtoMessage() {}
-
-func (x *RatingCategory) ProtoReflect() protoreflect.Message {
-	mi := &file_analytics_proto_msgTypes[0]
-	if x != nil {
-		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
-		if ms.LoadMessageInfo() == nil {
-			ms.StoreMessageInfo(mi)
-		}
-		return ms
-	}
-	return mi.MessageOf(x)
-}
-
-// Deprecated: Use RatingCategory.ProtoReflect.Descriptor instead.
-func (*RatingCategory) Descriptor() ([]byte, []int) {
-	return file_analytics_proto_rawDescGZIP(), []int{0}
-}
-
-func (x *RatingCategory) GetId() int32 {
-	if x != nil {
-		return x.Id
-	}
-	return 0
-}
-
-func (x *RatingCategory) GetName() string {
-	if x != nil {
-		return x.Name
-	}
-	return ""
-}
-
-func (x *RatingCategory) GetWeight() int32 {
-	if x != nil {
-		return x.Weight
-	}
-	return 0
-}
-
 type CategoryScore struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
 	CategoryId    int32                  `protobuf:"varint,1,opt,name=category_id,json=categoryId,proto3" json:"category_id,omitempty"`
@@ -95,7 +35,7 @@ type CategoryScore struct {
 
 func (x *CategoryScore) Reset() {
 	*x = CategoryScore{}
-	mi := &file_analytics_proto_msgTypes[1]
+	mi := &file_analytics_proto_msgTypes[0]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -107,7 +47,7 @@ func (x *CategoryScore) String() string {
 func (*CategoryScore) ProtoMessage() {}
 
 func (x *CategoryScore) ProtoReflect() protoreflect.Message {
-	mi := &file_analytics_proto_msgTypes[1]
+	mi := &file_analytics_proto_msgTypes[0]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -120,7 +60,7 @@ func (x *CategoryScore) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use CategoryScore.ProtoReflect.Descriptor instead.
 func (*CategoryScore) Descriptor() ([]byte, []int) {
-	return file_analytics_proto_rawDescGZIP(), []int{1}
+	return file_analytics_proto_rawDescGZIP(), []int{0}
 }
 
 func (x *CategoryScore) GetCategoryId() int32 {
@@ -169,7 +109,7 @@ type DailyAggregatedScoresResponse struct {
 
 func (x *DailyAggregatedScoresResponse) Reset() {
 	*x = DailyAggregatedScoresResponse{}
-	mi := &file_analytics_proto_msgTypes[2]
+	mi := &file_analytics_proto_msgTypes[1]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -181,7 +121,7 @@ func (x *DailyAggregatedScoresResponse) String() string {
 func (*DailyAggregatedScoresResponse) ProtoMessage() {}
 
 func (x *DailyAggregatedScoresResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_analytics_proto_msgTypes[2]
+	mi := &file_analytics_proto_msgTypes[1]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -194,7 +134,7 @@ func (x *DailyAggregatedScoresResponse) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use DailyAggregatedScoresResponse.ProtoReflect.Descriptor instead.
 func (*DailyAggregatedScoresResponse) Descriptor() ([]byte, []int) {
-	return file_analytics_proto_rawDescGZIP(), []int{2}
+	return file_analytics_proto_rawDescGZIP(), []int{1}
 }
 
 func (x *DailyAggregatedScoresResponse) GetScores() []*CategoryScore {
@@ -229,7 +169,7 @@ type WeeklyAggregatedScoresResponse struct {
 
 func (x *WeeklyAggregatedScoresResponse) Reset() {
 	*x = WeeklyAggregatedScoresResponse{}
-	mi := &file_analytics_proto_msgTypes[3]
+	mi := &file_analytics_proto_msgTypes[2]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -241,7 +181,7 @@ func (x *WeeklyAggregatedScoresResponse) String() string {
 func (*WeeklyAggregatedScoresResponse) ProtoMessage() {}
 
 func (x *WeeklyAggregatedScoresResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_analytics_proto_msgTypes[3]
+	mi := &file_analytics_proto_msgTypes[2]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -254,7 +194,7 @@ func (x *WeeklyAggregatedScoresResponse) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use WeeklyAggregatedScoresResponse.ProtoReflect.Descriptor instead.
 func (*WeeklyAggregatedScoresResponse) Descriptor() ([]byte, []int) {
-	return file_analytics_proto_rawDescGZIP(), []int{3}
+	return file_analytics_proto_rawDescGZIP(), []int{2}
 }
 
 func (x *WeeklyAggregatedScoresResponse) GetScores() []*CategoryScore {
@@ -279,16 +219,21 @@ func (x *WeeklyAggregatedScoresResponse) GetEndDate() *timestamppb.Timestamp {
 }
 
 type AggregatedCategoryScoresRequest struct {
-	state         protoimpl.MessageState `protogen:"open.v1"`
-	StartDate     *timestamppb.Timestamp `protobuf:"bytes,1,opt,name=start_date,json=startDate,proto3" json:"start_date,omitempty"`
-	EndDate       *timestamppb.Timestamp `protobuf:"bytes,2,opt,name=end_date,json=endDate,proto3" json:"end_date,omitempty"`
-	unknownFields protoimpl.UnknownFields
-	sizeCache     protoimpl.SizeCache
+	state             protoimpl.MessageState `protogen:"open.v1"`
+	StartDate         *timestamppb.Timestamp `protobuf:"bytes,1,opt,name=start_date,json=startDate,proto3" json:"start_date,omitempty"`
+	EndDate           *timestamppb.Timestamp `protobuf:"bytes,2,opt,name=end_date,json=endDate,proto3" json:"end_date,omitempty"`
+	InclusiveEnd      bool                   `protobuf:"varint,3,opt,name=inclusive_end,json=inclusiveEnd,proto3" json:"inclusive_end,omitempty"`                 // Also count ratings created exactly at end_date
+	FillMode          FillMode               `protobuf:"varint,4,opt,name=fill_mode,json=fillMode,proto3,enum=analytics.FillMode" json:"fill_mode,omitempty"`     // How buckets without ratings are reported; omitted by default
+	IncludeConfidence bool                   `protobuf:"varint,5,opt,name=include_confidence,json=includeConfidence,proto3" json:"include_confidence,omitempty"`  // Attach a ScoreConfidence to every observed category point
+	Smoothing         *Smoothing             `protobuf:"bytes,6,opt,name=smoothing,proto3" json:"smoothing,omitempty"`                                            // Smoothed series to return alongside the raw ones
+	DateBasis         DateBasis              `protobuf:"varint,7,opt,name=date_basis,json=dateBasis,proto3,enum=analytics.DateBasis" json:"date_basis,omitempty"` // Which timestamp the range applies to; defaults to the rating's
+	unknownFields     protoimpl.UnknownFields
+	sizeCache         protoimpl.SizeCache
 }
 
 func (x *AggregatedCategoryScoresRequest) Reset() {
 	*x = AggregatedCategoryScoresRequest{}
-	mi := &file_analytics_proto_msgTypes[4]
+	mi := &file_analytics_proto_msgTypes[3]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -300,7 +245,7 @@ func (x *AggregatedCategoryScoresRequest) String() string {
 func (*AggregatedCategoryScoresRequest) ProtoMessage() {}
 
 func (x *AggregatedCategoryScoresRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_analytics_proto_msgTypes[4]
+	mi := &file_analytics_proto_msgTypes[3]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -313,7 +258,7 @@ func (x *AggregatedCategoryScoresRequest) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use AggregatedCategoryScoresRequest.ProtoReflect.Descriptor instead.
 func (*AggregatedCategoryScoresRequest) Descriptor() ([]byte, []int) {
-	return file_analytics_proto_rawDescGZIP(), []int{4}
+	return file_analytics_proto_rawDescGZIP(), []int{3}
 }
 
 func (x *AggregatedCategoryScoresRequest) GetStartDate() *timestamppb.Timestamp {
@@ -330,17 +275,55 @@ func (x *AggregatedCategoryScoresRequest) GetEndDate() *timestamppb.Timestamp {
 	return nil
 }
 
+func (x *AggregatedCategoryScoresRequest) GetInclusiveEnd() bool {
+	if x != nil {
+		return x.InclusiveEnd
+	}
+	return false
+}
+
+func (x *AggregatedCategoryScoresRequest) GetFillMode() FillMode {
+	if x != nil {
+		return x.FillMode
+	}
+	return FillMode_FILL_MODE_UNSPECIFIED
+}
+
+func (x *AggregatedCategoryScoresRequest) GetIncludeConfidence() bool {
+	if x != nil {
+		return x.IncludeConfidence
+	}
+	return false
+}
+
+func (x *AggregatedCategoryScoresRequest) GetSmoothing() *Smoothing {
+	if x != nil {
+		return x.Smoothing
+	}
+	return nil
+}
+
+func (x *AggregatedCategoryScoresRequest) GetDateBasis() DateBasis {
+	if x != nil {
+		return x.DateBasis
+	}
+	return DateBasis_DATE_BASIS_UNSPECIFIED
+}
+
 type ScoresByTicketRequest struct {
-	state         protoimpl.MessageState `protogen:"open.v1"`
-	StartDate     *timestamppb.Timestamp `protobuf:"bytes,1,opt,name=start_date,json=startDate,proto3" json:"start_date,omitempty"`
-	EndDate       *timestamppb.Timestamp `protobuf:"bytes,2,opt,name=end_date,json=endDate,proto3" json:"end_date,omitempty"`
-	unknownFields protoimpl.UnknownFields
-	sizeCache     protoimpl.SizeCache
+	state             protoimpl.MessageState `protogen:"open.v1"`
+	StartDate         *timestamppb.Timestamp `protobuf:"bytes,1,opt,name=start_date,json=startDate,proto3" json:"start_date,omitempty"`
+	EndDate           *timestamppb.Timestamp `protobuf:"bytes,2,opt,name=end_date,json=endDate,proto3" json:"end_date,omitempty"`
+	InclusiveEnd      bool                   `protobuf:"varint,3,opt,name=inclusive_end,json=inclusiveEnd,proto3" json:"inclusive_end,omitempty"`                 // Also count ratings created exactly at end_date
+	IncludeConfidence bool                   `protobuf:"varint,4,opt,name=include_confidence,json=includeConfidence,proto3" json:"include_confidence,omitempty"`  // Attach a ScoreConfidence to every category score
+	DateBasis         DateBasis              `protobuf:"varint,5,opt,name=date_basis,json=dateBasis,proto3,enum=analytics.DateBasis" json:"date_basis,omitempty"` // Which timestamp the range applies to; defaults to the rating's
+	unknownFields     protoimpl.UnknownFields
+	sizeCache         protoimpl.SizeCache
 }
 
 func (x *ScoresByTicketRequest) Reset() {
 	*x = ScoresByTicketRequest{}
-	mi := &file_analytics_proto_msgTypes[5]
+	mi := &file_analytics_proto_msgTypes[4]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -352,7 +335,7 @@ func (x *ScoresByTicketRequest) String() string {
 func (*ScoresByTicketRequest) ProtoMessage() {}
 
 func (x *ScoresByTicketRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_analytics_proto_msgTypes[5]
+	mi := &file_analytics_proto_msgTypes[4]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -365,7 +348,7 @@ func (x *ScoresByTicketRequest) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use ScoresByTicketRequest.ProtoReflect.Descriptor instead.
 func (*ScoresByTicketRequest) Descriptor() ([]byte, []int) {
-	return file_analytics_proto_rawDescGZIP(), []int{5}
+	return file_analytics_proto_rawDescGZIP(), []int{4}
 }
 
 func (x *ScoresByTicketRequest) GetStartDate() *timestamppb.Timestamp {
@@ -382,15 +365,33 @@ func (x *ScoresByTicketRequest) GetEndDate() *timestamppb.Timestamp {
 	return nil
 }
 
+func (x *ScoresByTicketRequest) GetInclusiveEnd() bool {
+	if x != nil {
+		return x.InclusiveEnd
+	}
+	return false
+}
+
+func (x *ScoresByTicketRequest) GetIncludeConfidence() bool {
+	if x != nil {
+		return x.IncludeConfidence
+	}
+	return false
+}
+
+func (x *ScoresByTicketRequest) GetDateBasis() DateBasis {
+	if x != nil {
+		return x.DateBasis
+	}
+	return DateBasis_DATE_BASIS_UNSPECIFIED
+}
+
 var File_analytics_proto protoreflect.FileDescriptor
 
 const file_analytics_proto_rawDesc = "" +
 	"\n" +
-	"\x0fanalytics.proto\x12\tanalytics\x1a\x1fgoogle/protobuf/timestamp.proto\x1a\x14category_score.proto\x1a\x12ticket_score.proto\x1a\x1boverall_quality_score.proto\x1a\x18period_over_period.proto\"L\n" +
-	"\x0eRatingCategory\x12\x0e\n" +
-	"\x02id\x18\x01 \x01(\x05R\x02id\x12\x12\n" +
-	"\x04name\x18\x02 \x01(\tR\x04name\x12\x16\n" +
-	"\x06weight\x18\x03 \x01(\x05R\x06weight\"\xbe\x01\n" +
+	"\x0fanalytics.proto\x12\tanalytics\x1a\x1fgoogle/protobuf/timestamp.proto\x1a\x14category_score.proto\x1a\x12ticket_score.proto\x1a\x1boverall_quality_score.proto\x1a\x18period_over_period.proto\x1a\x10date_range.proto\x1a\x19rating_distribution.proto\x1a\x13period_series.proto\x1a\ranomaly.proto\x1a\x0eforecast.proto\x1a\valert.proto\x1a\x14quality_target.proto\x1a\x1clowest_scoring_tickets.proto\x1a\x13ticket_detail.proto\x1a\x16ticket_attribute.proto\x1a\x14grouped_scores.proto\x1a\n" +
+	"team.proto\x1a\x15rating_category.proto\"\xbe\x01\n" +
 	"\rCategoryScore\x12\x1f\n" +
 	"\vcategory_id\x18\x01 \x01(\x05R\n" +
 	"categoryId\x12#\n" +
@@ -407,20 +408,65 @@ const file_analytics_proto_rawDesc = "" +
 	"\x06scores\x18\x01 \x03(\v2\x18.analytics.CategoryScoreR\x06scores\x129\n" +
 	"\n" +
 	"start_date\x18\x02 \x01(\v2\x1a.google.protobuf.TimestampR\tstartDate\x125\n" +
-	"\bend_date\x18\x03 \x01(\v2\x1a.google.protobuf.TimestampR\aendDate\"\x93\x01\n" +
+	"\bend_date\x18\x03 \x01(\v2\x1a.google.protobuf.TimestampR\aendDate\"\x82\x03\n" +
 	"\x1fAggregatedCategoryScoresRequest\x129\n" +
 	"\n" +
 	"start_date\x18\x01 \x01(\v2\x1a.google.protobuf.TimestampR\tstartDate\x125\n" +
-	"\bend_date\x18\x02 \x01(\v2\x1a.google.protobuf.TimestampR\aendDate\"\x89\x01\n" +
+	"\bend_date\x18\x02 \x01(\v2\x1a.google.protobuf.TimestampR\aendDate\x12#\n" +
+	"\rinclusive_end\x18\x03 \x01(\bR\finclusiveEnd\x120\n" +
+	"\tfill_mode\x18\x04 \x01(\x0e2\x13.analytics.FillModeR\bfillMode\x12-\n" +
+	"\x12include_confidence\x18\x05 \x01(\bR\x11includeConfidence\x122\n" +
+	"\tsmoothing\x18\x06 \x01(\v2\x14.analytics.SmoothingR\tsmoothing\x123\n" +
+	"\n" +
+	"date_basis\x18\a \x01(\x0e2\x14.analytics.DateBasisR\tdateBasis\"\x92\x02\n" +
 	"\x15ScoresByTicketRequest\x129\n" +
 	"\n" +
 	"start_date\x18\x01 \x01(\v2\x1a.google.protobuf.TimestampR\tstartDate\x125\n" +
-	"\bend_date\x18\x02 \x01(\v2\x1a.google.protobuf.TimestampR\aendDate2\xbf\x03\n" +
+	"\bend_date\x18\x02 \x01(\v2\x1a.google.protobuf.TimestampR\aendDate\x12#\n" +
+	"\rinclusive_end\x18\x03 \x01(\bR\finclusiveEnd\x12-\n" +
+	"\x12include_confidence\x18\x04 \x01(\bR\x11includeConfidence\x123\n" +
+	"\n" +
+	"date_basis\x18\x05 \x01(\x0e2\x14.analytics.DateBasisR\tdateBasis2\xe6\x19\n" +
 	"\x10AnalyticsService\x12v\n" +
 	"\x1bGetAggregatedCategoryScores\x12*.analytics.AggregatedCategoryScoresRequest\x1a+.analytics.AggregatedCategoryScoresResponse\x12X\n" +
 	"\x11GetScoresByTicket\x12 .analytics.ScoresByTicketRequest\x1a!.analytics.ScoresByTicketResponse\x12g\n" +
 	"\x16GetOverallQualityScore\x12%.analytics.OverallQualityScoreRequest\x1a&.analytics.OverallQualityScoreResponse\x12p\n" +
-	"\x19GetPeriodOverPeriodChange\x12(.analytics.PeriodOverPeriodChangeRequest\x1a).analytics.PeriodOverPeriodChangeResponseB\x17Z\x15go-grpc-backend/protob\x06proto3"
+	"\x19GetPeriodOverPeriodChange\x12(.analytics.PeriodOverPeriodChangeRequest\x1a).analytics.PeriodOverPeriodChangeResponse\x12d\n" +
+	"\x15GetRatingDistribution\x12$.analytics.RatingDistributionRequest\x1a%.analytics.RatingDistributionResponse\x12R\n" +
+	"\x0fGetPeriodSeries\x12\x1e.analytics.PeriodSeriesRequest\x1a\x1f.analytics.PeriodSeriesResponse\x12I\n" +
+	"\fGetAnomalies\x12\x1b.analytics.AnomaliesRequest\x1a\x1c.analytics.AnomaliesResponse\x12U\n" +
+	"\x10GetScoreForecast\x12\x1f.analytics.ScoreForecastRequest\x1a .analytics.ScoreForecastResponse\x12U\n" +
+	"\x0eListAlertRules\x12 .analytics.ListAlertRulesRequest\x1a!.analytics.ListAlertRulesResponse\x12J\n" +
+	"\x0fCreateAlertRule\x12!.analytics.CreateAlertRuleRequest\x1a\x14.analytics.AlertRule\x12J\n" +
+	"\x0fUpdateAlertRule\x12!.analytics.UpdateAlertRuleRequest\x1a\x14.analytics.AlertRule\x12X\n" +
+	"\x0fDeleteAlertRule\x12!.analytics.DeleteAlertRuleRequest\x1a\".analytics.DeleteAlertRuleResponse\x12a\n" +
+	"\x12ListQualityTargets\x12$.analytics.ListQualityTargetsRequest\x1a%.analytics.ListQualityTargetsResponse\x12V\n" +
+	"\x13CreateQualityTarget\x12%.analytics.CreateQualityTargetRequest\x1a\x18.analytics.QualityTarget\x12V\n" +
+	"\x13UpdateQualityTarget\x12%.analytics.UpdateQualityTargetRequest\x1a\x18.analytics.QualityTarget\x12d\n" +
+	"\x13DeleteQualityTarget\x12%.analytics.DeleteQualityTargetRequest\x1a&.analytics.DeleteQualityTargetResponse\x12R\n" +
+	"\x0fGetTargetStatus\x12\x1e.analytics.TargetStatusRequest\x1a\x1f.analytics.TargetStatusResponse\x12j\n" +
+	"\x17GetLowestScoringTickets\x12&.analytics.LowestScoringTicketsRequest\x1a'.analytics.LowestScoringTicketsResponse\x12R\n" +
+	"\x0fGetTicketDetail\x12\x1e.analytics.TicketDetailRequest\x1a\x1f.analytics.TicketDetailResponse\x12g\n" +
+	"\x14ListTicketAttributes\x12&.analytics.ListTicketAttributesRequest\x1a'.analytics.ListTicketAttributesResponse\x12e\n" +
+	"\x13SetTicketAttributes\x12%.analytics.SetTicketAttributesRequest\x1a'.analytics.ListTicketAttributesResponse\x12j\n" +
+	"\x15DeleteTicketAttribute\x12'.analytics.DeleteTicketAttributeRequest\x1a(.analytics.DeleteTicketAttributeResponse\x12[\n" +
+	"\x12GetScoresGroupedBy\x12!.analytics.ScoresGroupedByRequest\x1a\".analytics.ScoresGroupedByResponse\x12F\n" +
+	"\tListTeams\x12\x1b.analytics.ListTeamsRequest\x1a\x1c.analytics.ListTeamsResponse\x12;\n" +
+	"\n" +
+	"CreateTeam\x12\x1c.analytics.CreateTeamRequest\x1a\x0f.analytics.Team\x12;\n" +
+	"\n" +
+	"UpdateTeam\x12\x1c.analytics.UpdateTeamRequest\x1a\x0f.analytics.Team\x12I\n" +
+	"\n" +
+	"DeleteTeam\x12\x1c.analytics.DeleteTeamRequest\x1a\x1d.analytics.DeleteTeamResponse\x12d\n" +
+	"\x13ListTeamMemberships\x12%.analytics.ListTeamMembershipsRequest\x1a&.analytics.ListTeamMembershipsResponse\x12Y\n" +
+	"\x14CreateTeamMembership\x12&.analytics.CreateTeamMembershipRequest\x1a\x19.analytics.TeamMembership\x12Y\n" +
+	"\x14UpdateTeamMembership\x12&.analytics.UpdateTeamMembershipRequest\x1a\x19.analytics.TeamMembership\x12g\n" +
+	"\x14DeleteTeamMembership\x12&.analytics.DeleteTeamMembershipRequest\x1a'.analytics.DeleteTeamMembershipResponse\x12L\n" +
+	"\rGetTeamScores\x12\x1c.analytics.TeamScoresRequest\x1a\x1d.analytics.TeamScoresResponse\x12g\n" +
+	"\x14ListRatingCategories\x12&.analytics.ListRatingCategoriesRequest\x1a'.analytics.ListRatingCategoriesResponse\x12Y\n" +
+	"\x14CreateRatingCategory\x12&.analytics.CreateRatingCategoryRequest\x1a\x19.analytics.RatingCategory\x12Y\n" +
+	"\x14UpdateRatingCategory\x12&.analytics.UpdateRatingCategoryRequest\x1a\x19.analytics.RatingCategory\x12[\n" +
+	"\x15ArchiveRatingCategory\x12'.analytics.ArchiveRatingCategoryRequest\x1a\x19.analytics.RatingCategoryB\x17Z\x15go-grpc-backend/protob\x06proto3"
 
 var (
 	file_analytics_proto_rawDescOnce sync.Once
@@ -434,47 +480,174 @@ func file_analytics_proto_rawDescGZIP() []byte {
 	return file_analytics_proto_rawDescData
 }
 
-var file_analytics_proto_msgTypes = make([]protoimpl.MessageInfo, 6)
+var file_analytics_proto_msgTypes = make([]protoimpl.MessageInfo, 5)
 var file_analytics_proto_goTypes = []any{
-	(*RatingCategory)(nil),                   // 0: analytics.RatingCategory
-	(*CategoryScore)(nil),                    // 1: analytics.CategoryScore
-	(*DailyAggregatedScoresResponse)(nil),    // 2: analytics.DailyAggregatedScoresResponse
-	(*WeeklyAggregatedScoresResponse)(nil),   // 3: analytics.WeeklyAggregatedScoresResponse
-	(*AggregatedCategoryScoresRequest)(nil),  // 4: analytics.AggregatedCategoryScoresRequest
-	(*ScoresByTicketRequest)(nil),            // 5: analytics.ScoresByTicketRequest
-	(*timestamppb.Timestamp)(nil),            // 6: google.protobuf.Timestamp
-	(*OverallQualityScoreRequest)(nil),       // 7: analytics.OverallQualityScoreRequest
-	(*PeriodOverPeriodChangeRequest)(nil),    // 8: analytics.PeriodOverPeriodChangeRequest
-	(*AggregatedCategoryScoresResponse)(nil), // 9: analytics.AggregatedCategoryScoresResponse
-	(*ScoresByTicketResponse)(nil),           // 10: analytics.ScoresByTicketResponse
-	(*OverallQualityScoreResponse)(nil),      // 11: analytics.OverallQualityScoreResponse
-	(*PeriodOverPeriodChangeResponse)(nil),   // 12: analytics.PeriodOverPeriodChangeResponse
+	(*CategoryScore)(nil),                    // 0: analytics.CategoryScore
+	(*DailyAggregatedScoresResponse)(nil),    // 1: analytics.DailyAggregatedScoresResponse
+	(*WeeklyAggregatedScoresResponse)(nil),   // 2: analytics.WeeklyAggregatedScoresResponse
+	(*AggregatedCategoryScoresRequest)(nil),  // 3: analytics.AggregatedCategoryScoresRequest
+	(*ScoresByTicketRequest)(nil),            // 4: analytics.ScoresByTicketRequest
+	(*timestamppb.Timestamp)(nil),            // 5: google.protobuf.Timestamp
+	(FillMode)(0),                            // 6: analytics.FillMode
+	(*Smoothing)(nil),                        // 7: analytics.Smoothing
+	(DateBasis)(0),                           // 8: analytics.DateBasis
+	(*OverallQualityScoreRequest)(nil),       // 9: analytics.OverallQualityScoreRequest
+	(*PeriodOverPeriodChangeRequest)(nil),    // 10: analytics.PeriodOverPeriodChangeRequest
+	(*RatingDistributionRequest)(nil),        // 11: analytics.RatingDistributionRequest
+	(*PeriodSeriesRequest)(nil),              // 12: analytics.PeriodSeriesRequest
+	(*AnomaliesRequest)(nil),                 // 13: analytics.AnomaliesRequest
+	(*ScoreForecastRequest)(nil),             // 14: analytics.ScoreForecastRequest
+	(*ListAlertRulesRequest)(nil),            // 15: analytics.ListAlertRulesRequest
+	(*CreateAlertRuleRequest)(nil),           // 16: analytics.CreateAlertRuleRequest
+	(*UpdateAlertRuleRequest)(nil),           // 17: analytics.UpdateAlertRuleRequest
+	(*DeleteAlertRuleRequest)(nil),           // 18: analytics.DeleteAlertRuleRequest
+	(*ListQualityTargetsRequest)(nil),        // 19: analytics.ListQualityTargetsRequest
+	(*CreateQualityTargetRequest)(nil),       // 20: analytics.CreateQualityTargetRequest
+	(*UpdateQualityTargetRequest)(nil),       // 21: analytics.UpdateQualityTargetRequest
+	(*DeleteQualityTargetRequest)(nil),       // 22: analytics.DeleteQualityTargetRequest
+	(*TargetStatusRequest)(nil),              // 23: analytics.TargetStatusRequest
+	(*LowestScoringTicketsRequest)(nil),      // 24: analytics.LowestScoringTicketsRequest
+	(*TicketDetailRequest)(nil),              // 25: analytics.TicketDetailRequest
+	(*ListTicketAttributesRequest)(nil),      // 26: analytics.ListTicketAttributesRequest
+	(*SetTicketAttributesRequest)(nil),       // 27: analytics.SetTicketAttributesRequest
+	(*DeleteTicketAttributeRequest)(nil),     // 28: analytics.DeleteTicketAttributeRequest
+	(*ScoresGroupedByRequest)(nil),           // 29: analytics.ScoresGroupedByRequest
+	(*ListTeamsRequest)(nil),                 // 30: analytics.ListTeamsRequest
+	(*CreateTeamRequest)(nil),                // 31: analytics.CreateTeamRequest
+	(*UpdateTeamRequest)(nil),                // 32: analytics.UpdateTeamRequest
+	(*DeleteTeamRequest)(nil),                // 33: analytics.DeleteTeamRequest
+	(*ListTeamMembershipsRequest)(nil),       // 34: analytics.ListTeamMembershipsRequest
+	(*CreateTeamMembershipRequest)(nil),      // 35: analytics.CreateTeamMembershipRequest
+	(*UpdateTeamMembershipRequest)(nil),      // 36: analytics.UpdateTeamMembershipRequest
+	(*DeleteTeamMembershipRequest)(nil),      // 37: analytics.DeleteTeamMembershipRequest
+	(*TeamScoresRequest)(nil),                // 38: analytics.TeamScoresRequest
+	(*ListRatingCategoriesRequest)(nil),      // 39: analytics.ListRatingCategoriesRequest
+	(*CreateRatingCategoryRequest)(nil),      // 40: analytics.CreateRatingCategoryRequest
+	(*UpdateRatingCategoryRequest)(nil),      // 41: analytics.UpdateRatingCategoryRequest
+	(*ArchiveRatingCategoryRequest)(nil),     // 42: analytics.ArchiveRatingCategoryRequest
+	(*AggregatedCategoryScoresResponse)(nil), // 43: analytics.AggregatedCategoryScoresResponse
+	(*ScoresByTicketResponse)(nil),           // 44: analytics.ScoresByTicketResponse
+	(*OverallQualityScoreResponse)(nil),      // 45: analytics.OverallQualityScoreResponse
+	(*PeriodOverPeriodChangeResponse)(nil),   // 46: analytics.PeriodOverPeriodChangeResponse
+	(*RatingDistributionResponse)(nil),       // 47: analytics.RatingDistributionResponse
+	(*PeriodSeriesResponse)(nil),             // 48: analytics.PeriodSeriesResponse
+	(*AnomaliesResponse)(nil),                // 49: analytics.AnomaliesResponse
+	(*ScoreForecastResponse)(nil),            // 50: analytics.ScoreForecastResponse
+	(*ListAlertRulesResponse)(nil),           // 51: analytics.ListAlertRulesResponse
+	(*AlertRule)(nil),                        // 52: analytics.AlertRule
+	(*DeleteAlertRuleResponse)(nil),          // 53: analytics.DeleteAlertRuleResponse
+	(*ListQualityTargetsResponse)(nil),       // 54: analytics.ListQualityTargetsResponse
+	(*QualityTarget)(nil),                    // 55: analytics.QualityTarget
+	(*DeleteQualityTargetResponse)(nil),      // 56: analytics.DeleteQualityTargetResponse
+	(*TargetStatusResponse)(nil),             // 57: analytics.TargetStatusResponse
+	(*LowestScoringTicketsResponse)(nil),     // 58: analytics.LowestScoringTicketsResponse
+	(*TicketDetailResponse)(nil),             // 59: analytics.TicketDetailResponse
+	(*ListTicketAttributesResponse)(nil),     // 60: analytics.ListTicketAttributesResponse
+	(*DeleteTicketAttributeResponse)(nil),    // 61: analytics.DeleteTicketAttributeResponse
+	(*ScoresGroupedByResponse)(nil),          // 62: analytics.ScoresGroupedByResponse
+	(*ListTeamsResponse)(nil),                // 63: analytics.ListTeamsResponse
+	(*Team)(nil),                             // 64: analytics.Team
+	(*DeleteTeamResponse)(nil),               // 65: analytics.DeleteTeamResponse
+	(*ListTeamMembershipsResponse)(nil),      // 66: analytics.ListTeamMembershipsResponse
+	(*TeamMembership)(nil),                   // 67: analytics.TeamMembership
+	(*DeleteTeamMembershipResponse)(nil),     // 68: analytics.DeleteTeamMembershipResponse
+	(*TeamScoresResponse)(nil),               // 69: analytics.TeamScoresResponse
+	(*ListRatingCategoriesResponse)(nil),     // 70: analytics.ListRatingCategoriesResponse
+	(*RatingCategory)(nil),                   // 71: analytics.RatingCategory
 }
 var file_analytics_proto_depIdxs = []int32{
-	6,  // 0: analytics.CategoryScore.date:type_name -> google.protobuf.Timestamp
-	1,  // 1: analytics.DailyAggregatedScoresResponse.scores:type_name -> analytics.CategoryScore
-	6,  // 2: analytics.DailyAggregatedScoresResponse.start_date:type_name -> google.protobuf.Timestamp
-	6,  // 3: analytics.DailyAggregatedScoresResponse.end_date:type_name -> google.protobuf.Timestamp
-	1,  // 4: analytics.WeeklyAggregatedScoresResponse.scores:type_name -> analytics.CategoryScore
-	6,  // 5: analytics.WeeklyAggregatedScoresResponse.start_date:type_name -> google.protobuf.Timestamp
-	6,  // 6: analytics.WeeklyAggregatedScoresResponse.end_date:type_name -> google.protobuf.Timestamp
-	6,  // 7: analytics.AggregatedCategoryScoresRequest.start_date:type_name -> google.protobuf.Timestamp
-	6,  // 8: analytics.AggregatedCategoryScoresRequest.end_date:type_name -> google.protobuf.Timestamp
-	6,  // 9: analytics.ScoresByTicketRequest.start_date:type_name -> google.protobuf.Timestamp
-	6,  // 10: analytics.ScoresByTicketRequest.end_date:type_name -> google.protobuf.Timestamp
-	4,  // 11: analytics.AnalyticsService.GetAggregatedCategoryScores:input_type -> analytics.AggregatedCategoryScoresRequest
-	5,  // 12: analytics.AnalyticsService.GetScoresByTicket:input_type -> analytics.ScoresByTicketRequest
-	7,  // 13: analytics.AnalyticsService.GetOverallQualityScore:input_type -> analytics.OverallQualityScoreRequest
-	8,  // 14: analytics.AnalyticsService.GetPeriodOverPeriodChange:input_type -> analytics.PeriodOverPeriodChangeRequest
-	9,  // 15: analytics.AnalyticsService.GetAggregatedCategoryScores:output_type -> analytics.AggregatedCategoryScoresResponse
-	10, // 16: analytics.AnalyticsService.GetScoresByTicket:output_type -> analytics.ScoresByTicketResponse
-	11, // 17: analytics.AnalyticsService.GetOverallQualityScore:output_type -> analytics.OverallQualityScoreResponse
-	12, // 18: analytics.AnalyticsService.GetPeriodOverPeriodChange:output_type -> analytics.PeriodOverPeriodChangeResponse
-	15, // [15:19] is the sub-list for method output_type
-	11, // [11:15] is the sub-list for method input_type
-	11, // [11:11] is the sub-list for extension type_name
-	11, // [11:11] is the sub-list for extension extendee
-	0,  // [0:11] is the sub-list for field type_name
+	5,  // 0: analytics.CategoryScore.date:type_name -> google.protobuf.Timestamp
+	0,  // 1: analytics.DailyAggregatedScoresResponse.scores:type_name -> analytics.CategoryScore
+	5,  // 2: analytics.DailyAggregatedScoresResponse.start_date:type_name -> google.protobuf.Timestamp
+	5,  // 3: analytics.DailyAggregatedScoresResponse.end_date:type_name -> google.protobuf.Timestamp
+	0,  // 4: analytics.WeeklyAggregatedScoresResponse.scores:type_name -> analytics.CategoryScore
+	5,  // 5: analytics.WeeklyAggregatedScoresResponse.start_date:type_name -> google.protobuf.Timestamp
+	5,  // 6: analytics.WeeklyAggregatedScoresResponse.end_date:type_name -> google.protobuf.Timestamp
+	5,  // 7: analytics.AggregatedCategoryScoresRequest.start_date:type_name -> google.protobuf.Timestamp
+	5,  // 8: analytics.AggregatedCategoryScoresRequest.end_date:type_name -> google.protobuf.Timestamp
+	6,  // 9: analytics.AggregatedCategoryScoresRequest.fill_mode:type_name -> analytics.FillMode
+	7,  // 10: analytics.AggregatedCategoryScoresRequest.smoothing:type_name -> analytics.Smoothing
+	8,  // 11: analytics.AggregatedCategoryScoresRequest.date_basis:type_name -> analytics.DateBasis
+	5,  // 12: analytics.ScoresByTicketRequest.start_date:type_name -> google.protobuf.Timestamp
+	5,  // 13: analytics.ScoresByTicketRequest.end_date:type_name -> google.protobuf.Timestamp
+	8,  // 14: analytics.ScoresByTicketRequest.date_basis:type_name -> analytics.DateBasis
+	3,  // 15: analytics.AnalyticsService.GetAggregatedCategoryScores:input_type -> analytics.AggregatedCategoryScoresRequest
+	4,  // 16: analytics.AnalyticsService.GetScoresByTicket:input_type -> analytics.ScoresByTicketRequest
+	9,  // 17: analytics.AnalyticsService.GetOverallQualityScore:input_type -> analytics.OverallQualityScoreRequest
+	10, // 18: analytics.AnalyticsService.GetPeriodOverPeriodChange:input_type -> analytics.PeriodOverPeriodChangeRequest
+	11, // 19: analytics.AnalyticsService.GetRatingDistribution:input_type -> analytics.RatingDistributionRequest
+	12, // 20: analytics.AnalyticsService.GetPeriodSeries:input_type -> analytics.PeriodSeriesRequest
+	13, // 21: analytics.AnalyticsService.GetAnomalies:input_type -> analytics.AnomaliesRequest
+	14, // 22: analytics.AnalyticsService.GetScoreForecast:input_type -> analytics.ScoreForecastRequest
+	15, // 23: analytics.AnalyticsService.ListAlertRules:input_type -> analytics.ListAlertRulesRequest
+	16, // 24: analytics.AnalyticsService.CreateAlertRule:input_type -> analytics.CreateAlertRuleRequest
+	17, // 25: analytics.AnalyticsService.UpdateAlertRule:input_type -> analytics.UpdateAlertRuleRequest
+	18, // 26: analytics.AnalyticsService.DeleteAlertRule:input_type -> analytics.DeleteAlertRuleRequest
+	19, // 27: analytics.AnalyticsService.ListQualityTargets:input_type -> analytics.ListQualityTargetsRequest
+	20, // 28: analytics.AnalyticsService.CreateQualityTarget:input_type -> analytics.CreateQualityTargetRequest
+	21, // 29: analytics.AnalyticsService.UpdateQualityTarget:input_type -> analytics.UpdateQualityTargetRequest
+	22, // 30: analytics.AnalyticsService.DeleteQualityTarget:input_type -> analytics.DeleteQualityTargetRequest
+	23, // 31: analytics.AnalyticsService.GetTargetStatus:input_type -> analytics.TargetStatusRequest
+	24, // 32: analytics.AnalyticsService.GetLowestScoringTickets:input_type -> analytics.LowestScoringTicketsRequest
+	25, // 33: analytics.AnalyticsService.GetTicketDetail:input_type -> analytics.TicketDetailRequest
+	26, // 34: analytics.AnalyticsService.ListTicketAttributes:input_type -> analytics.ListTicketAttributesRequest
+	27, // 35: analytics.AnalyticsService.SetTicketAttributes:input_type -> analytics.SetTicketAttributesRequest
+	28, // 36: analytics.AnalyticsService.DeleteTicketAttribute:input_type -> analytics.DeleteTicketAttributeRequest
+	29, // 37: analytics.AnalyticsService.GetScoresGroupedBy:input_type -> analytics.ScoresGroupedByRequest
+	30, // 38: analytics.AnalyticsService.ListTeams:input_type -> analytics.ListTeamsRequest
+	31, // 39: analytics.AnalyticsService.CreateTeam:input_type -> analytics.CreateTeamRequest
+	32, // 40: analytics.AnalyticsService.UpdateTeam:input_type -> analytics.UpdateTeamRequest
+	33, // 41: analytics.AnalyticsService.DeleteTeam:input_type -> analytics.DeleteTeamRequest
+	34, // 42: analytics.AnalyticsService.ListTeamMemberships:input_type -> analytics.ListTeamMembershipsRequest
+	35, // 43: analytics.AnalyticsService.CreateTeamMembership:input_type -> analytics.CreateTeamMembershipRequest
+	36, // 44: analytics.AnalyticsService.UpdateTeamMembership:input_type -> analytics.UpdateTeamMembershipRequest
+	37, // 45: analytics.AnalyticsService.DeleteTeamMembership:input_type -> analytics.DeleteTeamMembershipRequest
+	38, // 46: analytics.AnalyticsService.GetTeamScores:input_type -> analytics.TeamScoresRequest
+	39, // 47: analytics.AnalyticsService.ListRatingCategories:input_type -> analytics.ListRatingCategoriesRequest
+	40, // 48: analytics.AnalyticsService.CreateRatingCategory:input_type -> analytics.CreateRatingCategoryRequest
+	41, // 49: analytics.AnalyticsService.UpdateRatingCategory:input_type -> analytics.UpdateRatingCategoryRequest
+	42, // 50: analytics.AnalyticsService.ArchiveRatingCategory:input_type -> analytics.ArchiveRatingCategoryRequest
+	43, // 51: analytics.AnalyticsService.GetAggregatedCategoryScores:output_type -> analytics.AggregatedCategoryScoresResponse
+	44, // 52: analytics.AnalyticsService.GetScoresByTicket:output_type -> analytics.ScoresByTicketResponse
+	45, // 53: analytics.AnalyticsService.GetOverallQualityScore:output_type -> analytics.OverallQualityScoreResponse
+	46, // 54: analytics.AnalyticsService.GetPeriodOverPeriodChange:output_type -> analytics.PeriodOverPeriodChangeResponse
+	47, // 55: analytics.AnalyticsService.GetRatingDistribution:output_type -> analytics.RatingDistributionResponse
+	48, // 56: analytics.AnalyticsService.GetPeriodSeries:output_type -> analytics.PeriodSeriesResponse
+	49, // 57: analytics.AnalyticsService.GetAnomalies:output_type -> analytics.AnomaliesResponse
+	50, // 58: analytics.AnalyticsService.GetScoreForecast:output_type -> analytics.ScoreForecastResponse
+	51, // 59: analytics.AnalyticsService.ListAlertRules:output_type -> analytics.ListAlertRulesResponse
+	52, // 60: analytics.AnalyticsService.CreateAlertRule:output_type -> analytics.AlertRule
+	52, // 61: analytics.AnalyticsService.UpdateAlertRule:output_type -> analytics.AlertRule
+	53, // 62: analytics.AnalyticsService.DeleteAlertRule:output_type -> analytics.DeleteAlertRuleResponse
+	54, // 63: analytics.AnalyticsService.ListQualityTargets:output_type -> analytics.ListQualityTargetsResponse
+	55, // 64: analytics.AnalyticsService.CreateQualityTarget:output_type -> analytics.QualityTarget
+	55, // 65: analytics.AnalyticsService.UpdateQualityTarget:output_type -> analytics.QualityTarget
+	56, // 66: analytics.AnalyticsService.DeleteQualityTarget:output_type -> analytics.DeleteQualityTargetResponse
+	57, // 67: analytics.AnalyticsService.GetTargetStatus:output_type -> analytics.TargetStatusResponse
+	58, // 68: analytics.AnalyticsService.GetLowestScoringTickets:output_type -> analytics.LowestScoringTicketsResponse
+	59, // 69: analytics.AnalyticsService.GetTicketDetail:output_type -> analytics.TicketDetailResponse
+	60, // 70: analytics.AnalyticsService.ListTicketAttributes:output_type -> analytics.ListTicketAttributesResponse
+	60, // 71: analytics.AnalyticsService.SetTicketAttributes:output_type -> analytics.ListTicketAttributesResponse
+	61, // 72: analytics.AnalyticsService.DeleteTicketAttribute:output_type -> analytics.DeleteTicketAttributeResponse
+	62, // 73: analytics.AnalyticsService.GetScoresGroupedBy:output_type -> analytics.ScoresGroupedByResponse
+	63, // 74: analytics.AnalyticsService.ListTeams:output_type -> analytics.ListTeamsResponse
+	64, // 75: analytics.AnalyticsService.CreateTeam:output_type -> analytics.Team
+	64, // 76: analytics.AnalyticsService.UpdateTeam:output_type -> analytics.Team
+	65, // 77: analytics.AnalyticsService.DeleteTeam:output_type -> analytics.DeleteTeamResponse
+	66, // 78: analytics.AnalyticsService.ListTeamMemberships:output_type -> analytics.ListTeamMembershipsResponse
+	67, // 79: analytics.AnalyticsService.CreateTeamMembership:output_type -> analytics.TeamMembership
+	67, // 80: analytics.AnalyticsService.UpdateTeamMembership:output_type -> analytics.TeamMembership
+	68, // 81: analytics.AnalyticsService.DeleteTeamMembership:output_type -> analytics.DeleteTeamMembershipResponse
+	69, // 82: analytics.AnalyticsService.GetTeamScores:output_type -> analytics.TeamScoresResponse
+	70, // 83: analytics.AnalyticsService.ListRatingCategories:output_type -> analytics.ListRatingCategoriesResponse
+	71, // 84: analytics.AnalyticsService.CreateRatingCategory:output_type -> analytics.RatingCategory
+	71, // 85: analytics.AnalyticsService.UpdateRatingCategory:output_type -> analytics.RatingCategory
+	71, // 86: analytics.AnalyticsService.ArchiveRatingCategory:output_type -> analytics.RatingCategory
+	51, // [51:87] is the sub-list for method output_type
+	15, // [15:51] is the sub-list for method input_type
+	15, // [15:15] is the sub-list for extension type_name
+	15, // [15:15] is the sub-list for extension extendee
+	0,  // [0:15] is the sub-list for field type_name
 }
 
 func init() { file_analytics_proto_init() }
@@ -486,13 +659,26 @@ func file_analytics_proto_init() {
 	file_ticket_score_proto_init()
 	file_overall_quality_score_proto_init()
 	file_period_over_period_proto_init()
+	file_date_range_proto_init()
+	file_rating_distribution_proto_init()
+	file_period_series_proto_init()
+	file_anomaly_proto_init()
+	file_forecast_proto_init()
+	file_alert_proto_init()
+	file_quality_target_proto_init()
+	file_lowest_scoring_tickets_proto_init()
+	file_ticket_detail_proto_init()
+	file_ticket_attribute_proto_init()
+	file_grouped_scores_proto_init()
+	file_team_proto_init()
+	file_rating_category_proto_init()
 	type x struct{}
 	out := protoimpl.TypeBuilder{
 		File: protoimpl.DescBuilder{
 			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
 			RawDescriptor: unsafe.Slice(unsafe.StringData(file_analytics_proto_rawDesc), len(file_analytics_proto_rawDesc)),
 			NumEnums:      0,
-			NumMessages:   6,
+			NumMessages:   5,
 			NumExtensions: 0,
 			NumServices:   1,
 		},
@@ -23,6 +23,38 @@ const (
 	AnalyticsService_GetScoresByTicket_FullMethodName           = "/analytics.AnalyticsService/GetScoresByTicket"
 	AnalyticsService_GetOverallQualityScore_FullMethodName      = "/analytics.AnalyticsService/GetOverallQualityScore"
 	AnalyticsService_GetPeriodOverPeriodChange_FullMethodName   = "/analytics.AnalyticsService/GetPeriodOverPeriodChange"
+	AnalyticsService_GetRatingDistribution_FullMethodName       = "/analytics.AnalyticsService/GetRatingDistribution"
+	AnalyticsService_GetPeriodSeries_FullMethodName             = "/analytics.AnalyticsService/GetPeriodSeries"
+	AnalyticsService_GetAnomalies_FullMethodName                = "/analytics.AnalyticsService/GetAnomalies"
+	AnalyticsService_GetScoreForecast_FullMethodName            = "/analytics.AnalyticsService/GetScoreForecast"
+	AnalyticsService_ListAlertRules_FullMethodName              = "/analytics.AnalyticsService/ListAlertRules"
+	AnalyticsService_CreateAlertRule_FullMethodName             = "/analytics.AnalyticsService/CreateAlertRule"
+	AnalyticsService_UpdateAlertRule_FullMethodName             = "/analytics.AnalyticsService/UpdateAlertRule"
+	AnalyticsService_DeleteAlertRule_FullMethodName             = "/analytics.AnalyticsService/DeleteAlertRule"
+	AnalyticsService_ListQualityTargets_FullMethodName          = "/analytics.AnalyticsService/ListQualityTargets"
+	AnalyticsService_CreateQualityTarget_FullMethodName         = "/analytics.AnalyticsService/CreateQualityTarget"
+	AnalyticsService_UpdateQualityTarget_FullMethodName         = "/analytics.AnalyticsService/UpdateQualityTarget"
+	AnalyticsService_DeleteQualityTarget_FullMethodName         = "/analytics.AnalyticsService/DeleteQualityTarget"
+	AnalyticsService_GetTargetStatus_FullMethodName             = "/analytics.AnalyticsService/GetTargetStatus"
+	AnalyticsService_GetLowestScoringTickets_FullMethodName     = "/analytics.AnalyticsService/GetLowestScoringTickets"
+	AnalyticsService_GetTicketDetail_FullMethodName             = "/analytics.AnalyticsService/GetTicketDetail"
+	AnalyticsService_ListTicketAttributes_FullMethodName        = "/analytics.AnalyticsService/ListTicketAttributes"
+	AnalyticsService_SetTicketAttributes_FullMethodName         = "/analytics.AnalyticsService/SetTicketAttributes"
+	AnalyticsService_DeleteTicketAttribute_FullMethodName       = "/analytics.AnalyticsService/DeleteTicketAttribute"
+	AnalyticsService_GetScoresGroupedBy_FullMethodName          = "/analytics.AnalyticsService/GetScoresGroupedBy"
+	AnalyticsService_ListTeams_FullMethodName                   = "/analytics.AnalyticsService/ListTeams"
+	AnalyticsService_CreateTeam_FullMethodName                  = "/analytics.AnalyticsService/CreateTeam"
+	AnalyticsService_UpdateTeam_FullMethodName                  = "/analytics.AnalyticsService/UpdateTeam"
+	AnalyticsService_DeleteTeam_FullMethodName                  = "/analytics.AnalyticsService/DeleteTeam"
+	AnalyticsService_ListTeamMemberships_FullMethodName         = "/analytics.AnalyticsService/ListTeamMemberships"
+	AnalyticsService_CreateTeamMembership_FullMethodName        = "/analytics.AnalyticsService/CreateTeamMembership"
+	AnalyticsService_UpdateTeamMembership_FullMethodName        = "/analytics.AnalyticsService/UpdateTeamMembership"
+	AnalyticsService_DeleteTeamMembership_FullMethodName        = "/analytics.AnalyticsService/DeleteTeamMembership"
+	AnalyticsService_GetTeamScores_FullMethodName               = "/analytics.AnalyticsService/GetTeamScores"
+	AnalyticsService_ListRatingCategories_FullMethodName        = "/analytics.AnalyticsService/ListRatingCategories"
+	AnalyticsService_CreateRatingCategory_FullMethodName        = "/analytics.AnalyticsService/CreateRatingCategory"
+	AnalyticsService_UpdateRatingCategory_FullMethodName        = "/analytics.AnalyticsService/UpdateRatingCategory"
+	AnalyticsService_ArchiveRatingCategory_FullMethodName       = "/analytics.AnalyticsService/ArchiveRatingCategory"
 )
 
 // AnalyticsServiceClient is the client API for AnalyticsService service.
@@ -33,6 +65,38 @@ type AnalyticsServiceClient interface {
 	GetScoresByTicket(ctx context.Context, in *ScoresByTicketRequest, opts ...grpc.CallOption) (*ScoresByTicketResponse, error)
 	GetOverallQualityScore(ctx context.Context, in *OverallQualityScoreRequest, opts ...grpc.CallOption) (*OverallQualityScoreResponse, error)
 	GetPeriodOverPeriodChange(ctx context.Context, in *PeriodOverPeriodChangeRequest, opts ...grpc.CallOption) (*PeriodOverPeriodChangeResponse, error)
+	GetRatingDistribution(ctx context.Context, in *RatingDistributionRequest, opts ...grpc.CallOption) (*RatingDistributionResponse, error)
+	GetPeriodSeries(ctx context.Context, in *PeriodSeriesRequest, opts ...grpc.CallOption) (*PeriodSeriesResponse, error)
+	GetAnomalies(ctx context.Context, in *AnomaliesRequest, opts ...grpc.CallOption) (*AnomaliesResponse, error)
+	GetScoreForecast(ctx context.Context, in *ScoreForecastRequest, opts ...grpc.CallOption) (*ScoreForecastResponse, error)
+	ListAlertRules(ctx context.Context, in *ListAlertRulesRequest, opts ...grpc.CallOption) (*ListAlertRulesResponse, error)
+	CreateAlertRule(ctx context.Context, in *CreateAlertRuleRequest, opts ...grpc.CallOption) (*AlertRule, error)
+	UpdateAlertRule(ctx context.Context, in *UpdateAlertRuleRequest, opts ...grpc.CallOption) (*AlertRule, error)
+	DeleteAlertRule(ctx context.Context, in *DeleteAlertRuleRequest, opts ...grpc.CallOption) (*DeleteAlertRuleResponse, error)
+	ListQualityTargets(ctx context.Context, in *ListQualityTargetsRequest, opts ...grpc.CallOption) (*ListQualityTargetsResponse, error)
+	CreateQualityTarget(ctx context.Context, in *CreateQualityTargetRequest, opts ...grpc.CallOption) (*QualityTarget, error)
+	UpdateQualityTarget(ctx context.Context, in *UpdateQualityTargetRequest, opts ...grpc.CallOption) (*QualityTarget, error)
+	DeleteQualityTarget(ctx context.Context, in *DeleteQualityTargetRequest, opts ...grpc.CallOption) (*DeleteQualityTargetResponse, error)
+	GetTargetStatus(ctx context.Context, in *TargetStatusRequest, opts ...grpc.CallOption) (*TargetStatusResponse, error)
+	GetLowestScoringTickets(ctx context.Context, in *LowestScoringTicketsRequest, opts ...grpc.CallOption) (*LowestScoringTicketsResponse, error)
+	GetTicketDetail(ctx context.Context, in *TicketDetailRequest, opts ...grpc.CallOption) (*TicketDetailResponse, error)
+	ListTicketAttributes(ctx context.Context, in *ListTicketAttributesRequest, opts ...grpc.CallOption) (*ListTicketAttributesResponse, error)
+	SetTicketAttributes(ctx context.Context, in *SetTicketAttributesRequest, opts ...grpc.CallOption) (*ListTicketAttributesResponse, error)
+	DeleteTicketAttribute(ctx context.Context, in *DeleteTicketAttributeRequest, opts ...grpc.CallOption) (*DeleteTicketAttributeResponse, error)
+	GetScoresGroupedBy(ctx context.Context, in *ScoresGroupedByRequest, opts ...grpc.CallOption) (*ScoresGroupedByResponse, error)
+	ListTeams(ctx context.Context, in *ListTeamsRequest, opts ...grpc.CallOption) (*ListTeamsResponse, error)
+	CreateTeam(ctx context.Context, in *CreateTeamRequest, opts ...grpc.CallOption) (*Team, error)
+	UpdateTeam(ctx context.Context, in *UpdateTeamRequest, opts ...grpc.CallOption) (*Team, error)
+	DeleteTeam(ctx context.Context, in *DeleteTeamRequest, opts ...grpc.CallOption) (*DeleteTeamResponse, error)
+	ListTeamMemberships(ctx context.Context, in *ListTeamMembershipsRequest, opts ...grpc.CallOption) (*ListTeamMembershipsResponse, error)
+	CreateTeamMembership(ctx context.Context, in *CreateTeamMembershipRequest, opts ...grpc.CallOption) (*TeamMembership, error)
+	UpdateTeamMembership(ctx context.Context, in *UpdateTeamMembershipRequest, opts ...grpc.CallOption) (*TeamMembership, error)
+	DeleteTeamMembership(ctx context.Context, in *DeleteTeamMembershipRequest, opts ...grpc.CallOption) (*DeleteTeamMembershipResponse, error)
+	GetTeamScores(ctx context.Context, in *TeamScoresRequest, opts ...grpc.CallOption) (*TeamScoresResponse, error)
+	ListRatingCategories(ctx context.Context, in *ListRatingCategoriesRequest, opts ...grpc.CallOption) (*ListRatingCategoriesResponse, error)
+	CreateRatingCategory(ctx context.Context, in *CreateRatingCategoryRequest, opts ...grpc.CallOption) (*RatingCategory, error)
+	UpdateRatingCategory(ctx context.Context, in *UpdateRatingCategoryRequest, opts ...grpc.CallOption) (*RatingCategory, error)
+	ArchiveRatingCategory(ctx context.Context, in *ArchiveRatingCategoryRequest, opts ...grpc.CallOption) (*RatingCategory, error)
 }
 
 type analyticsServiceClient struct {
@@ -83,6 +147,326 @@ func (c *analyticsServiceClient) GetPeriodOverPeriodChange(ctx context.Context,
 	return out, nil
 }
 
+func (c *analyticsServiceClient) GetRatingDistribution(ctx context.Context, in *RatingDistributionRequest, opts ...grpc.CallOption) (*RatingDistributionResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(RatingDistributionResponse)
+	err := c.cc.Invoke(ctx, AnalyticsService_GetRatingDistribution_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *analyticsServiceClient) GetPeriodSeries(ctx context.Context, in *PeriodSeriesRequest, opts ...grpc.CallOption) (*PeriodSeriesResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(PeriodSeriesResponse)
+	err := c.cc.Invoke(ctx, AnalyticsService_GetPeriodSeries_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *analyticsServiceClient) GetAnomalies(ctx context.Context, in *AnomaliesRequest, opts ...grpc.CallOption) (*AnomaliesResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(AnomaliesResponse)
+	err := c.cc.Invoke(ctx, AnalyticsService_GetAnomalies_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *analyticsServiceClient) GetScoreForecast(ctx context.Context, in *ScoreForecastRequest, opts ...grpc.CallOption) (*ScoreForecastResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(ScoreForecastResponse)
+	err := c.cc.Invoke(ctx, AnalyticsService_GetScoreForecast_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *analyticsServiceClient) ListAlertRules(ctx context.Context, in *ListAlertRulesRequest, opts ...grpc.CallOption) (*ListAlertRulesResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(ListAlertRulesResponse)
+	err := c.cc.Invoke(ctx, AnalyticsService_ListAlertRules_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *analyticsServiceClient) CreateAlertRule(ctx context.Context, in *CreateAlertRuleRequest, opts ...grpc.CallOption) (*AlertRule, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(AlertRule)
+	err := c.cc.Invoke(ctx, AnalyticsService_CreateAlertRule_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *analyticsServiceClient) UpdateAlertRule(ctx context.Context, in *UpdateAlertRuleRequest, opts ...grpc.CallOption) (*AlertRule, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(AlertRule)
+	err := c.cc.Invoke(ctx, AnalyticsService_UpdateAlertRule_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *analyticsServiceClient) DeleteAlertRule(ctx context.Context, in *DeleteAlertRuleRequest, opts ...grpc.CallOption) (*DeleteAlertRuleResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(DeleteAlertRuleResponse)
+	err := c.cc.Invoke(ctx, AnalyticsService_DeleteAlertRule_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *analyticsServiceClient) ListQualityTargets(ctx context.Context, in *ListQualityTargetsRequest, opts ...grpc.CallOption) (*ListQualityTargetsResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(ListQualityTargetsResponse)
+	err := c.cc.Invoke(ctx, AnalyticsService_ListQualityTargets_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *analyticsServiceClient) CreateQualityTarget(ctx context.Context, in *CreateQualityTargetRequest, opts ...grpc.CallOption) (*QualityTarget, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(QualityTarget)
+	err := c.cc.Invoke(ctx, AnalyticsService_CreateQualityTarget_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *analyticsServiceClient) UpdateQualityTarget(ctx context.Context, in *UpdateQualityTargetRequest, opts ...grpc.CallOption) (*QualityTarget, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(QualityTarget)
+	err := c.cc.Invoke(ctx, AnalyticsService_UpdateQualityTarget_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *analyticsServiceClient) DeleteQualityTarget(ctx context.Context, in *DeleteQualityTargetRequest, opts ...grpc.CallOption) (*DeleteQualityTargetResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(DeleteQualityTargetResponse)
+	err := c.cc.Invoke(ctx, AnalyticsService_DeleteQualityTarget_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *analyticsServiceClient) GetTargetStatus(ctx context.Context, in *TargetStatusRequest, opts ...grpc.CallOption) (*TargetStatusResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(TargetStatusResponse)
+	err := c.cc.Invoke(ctx, AnalyticsService_GetTargetStatus_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *analyticsServiceClient) GetLowestScoringTickets(ctx context.Context, in *LowestScoringTicketsRequest, opts ...grpc.CallOption) (*LowestScoringTicketsResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(LowestScoringTicketsResponse)
+	err := c.cc.Invoke(ctx, AnalyticsService_GetLowestScoringTickets_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *analyticsServiceClient) GetTicketDetail(ctx context.Context, in *TicketDetailRequest, opts ...grpc.CallOption) (*TicketDetailResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(TicketDetailResponse)
+	err := c.cc.Invoke(ctx, AnalyticsService_GetTicketDetail_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *analyticsServiceClient) ListTicketAttributes(ctx context.Context, in *ListTicketAttributesRequest, opts ...grpc.CallOption) (*ListTicketAttributesResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(ListTicketAttributesResponse)
+	err := c.cc.Invoke(ctx, AnalyticsService_ListTicketAttributes_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *analyticsServiceClient) SetTicketAttributes(ctx context.Context, in *SetTicketAttributesRequest, opts ...grpc.CallOption) (*ListTicketAttributesResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(ListTicketAttributesResponse)
+	err := c.cc.Invoke(ctx, AnalyticsService_SetTicketAttributes_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *analyticsServiceClient) DeleteTicketAttribute(ctx context.Context, in *DeleteTicketAttributeRequest, opts ...grpc.CallOption) (*DeleteTicketAttributeResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(DeleteTicketAttributeResponse)
+	err := c.cc.Invoke(ctx, AnalyticsService_DeleteTicketAttribute_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *analyticsServiceClient) GetScoresGroupedBy(ctx context.Context, in *ScoresGroupedByRequest, opts ...grpc.CallOption) (*ScoresGroupedByResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(ScoresGroupedByResponse)
+	err := c.cc.Invoke(ctx, AnalyticsService_GetScoresGroupedBy_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *analyticsServiceClient) ListTeams(ctx context.Context, in *ListTeamsRequest, opts ...grpc.CallOption) (*ListTeamsResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(ListTeamsResponse)
+	err := c.cc.Invoke(ctx, AnalyticsService_ListTeams_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *analyticsServiceClient) CreateTeam(ctx context.Context, in *CreateTeamRequest, opts ...grpc.CallOption) (*Team, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(Team)
+	err := c.cc.Invoke(ctx, AnalyticsService_CreateTeam_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *analyticsServiceClient) UpdateTeam(ctx context.Context, in *UpdateTeamRequest, opts ...grpc.CallOption) (*Team, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(Team)
+	err := c.cc.Invoke(ctx, AnalyticsService_UpdateTeam_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *analyticsServiceClient) DeleteTeam(ctx context.Context, in *DeleteTeamRequest, opts ...grpc.CallOption) (*DeleteTeamResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(DeleteTeamResponse)
+	err := c.cc.Invoke(ctx, AnalyticsService_DeleteTeam_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *analyticsServiceClient) ListTeamMemberships(ctx context.Context, in *ListTeamMembershipsRequest, opts ...grpc.CallOption) (*ListTeamMembershipsResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(ListTeamMembershipsResponse)
+	err := c.cc.Invoke(ctx, AnalyticsService_ListTeamMemberships_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *analyticsServiceClient) CreateTeamMembership(ctx context.Context, in *CreateTeamMembershipRequest, opts ...grpc.CallOption) (*TeamMembership, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(TeamMembership)
+	err := c.cc.Invoke(ctx, AnalyticsService_CreateTeamMembership_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *analyticsServiceClient) UpdateTeamMembership(ctx context.Context, in *UpdateTeamMembershipRequest, opts ...grpc.CallOption) (*TeamMembership, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(TeamMembership)
+	err := c.cc.Invoke(ctx, AnalyticsService_UpdateTeamMembership_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *analyticsServiceClient) DeleteTeamMembership(ctx context.Context, in *DeleteTeamMembershipRequest, opts ...grpc.CallOption) (*DeleteTeamMembershipResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(DeleteTeamMembershipResponse)
+	err := c.cc.Invoke(ctx, AnalyticsService_DeleteTeamMembership_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *analyticsServiceClient) GetTeamScores(ctx context.Context, in *TeamScoresRequest, opts ...grpc.CallOption) (*TeamScoresResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(TeamScoresResponse)
+	err := c.cc.Invoke(ctx, AnalyticsService_GetTeamScores_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *analyticsServiceClient) ListRatingCategories(ctx context.Context, in *ListRatingCategoriesRequest, opts ...grpc.CallOption) (*ListRatingCategoriesResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(ListRatingCategoriesResponse)
+	err := c.cc.Invoke(ctx, AnalyticsService_ListRatingCategories_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *analyticsServiceClient) CreateRatingCategory(ctx context.Context, in *CreateRatingCategoryRequest, opts ...grpc.CallOption) (*RatingCategory, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(RatingCategory)
+	err := c.cc.Invoke(ctx, AnalyticsService_CreateRatingCategory_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *analyticsServiceClient) UpdateRatingCategory(ctx context.Context, in *UpdateRatingCategoryRequest, opts ...grpc.CallOption) (*RatingCategory, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(RatingCategory)
+	err := c.cc.Invoke(ctx, AnalyticsService_UpdateRatingCategory_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *analyticsServiceClient) ArchiveRatingCategory(ctx context.Context, in *ArchiveRatingCategoryRequest, opts ...grpc.CallOption) (*RatingCategory, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(RatingCategory)
+	err := c.cc.Invoke(ctx, AnalyticsService_ArchiveRatingCategory_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 // AnalyticsServiceServer is the server API for AnalyticsService service.
 // All implementations must embed UnimplementedAnalyticsServiceServer
 // for forward compatibility.
@@ -91,6 +475,38 @@ type AnalyticsServiceServer interface {
 	GetScoresByTicket(context.Context, *ScoresByTicketRequest) (*ScoresByTicketResponse, error)
 	GetOverallQualityScore(context.Context, *OverallQualityScoreRequest) (*OverallQualityScoreResponse, error)
 	GetPeriodOverPeriodChange(context.Context, *PeriodOverPeriodChangeRequest) (*PeriodOverPeriodChangeResponse, error)
+	GetRatingDistribution(context.Context, *RatingDistributionRequest) (*RatingDistributionResponse, error)
+	GetPeriodSeries(context.Context, *PeriodSeriesRequest) (*PeriodSeriesResponse, error)
+	GetAnomalies(context.Context, *AnomaliesRequest) (*AnomaliesResponse, error)
+	GetScoreForecast(context.Context, *ScoreForecastRequest) (*ScoreForecastResponse, error)
+	ListAlertRules(context.Context, *ListAlertRulesRequest) (*ListAlertRulesResponse, error)
+	CreateAlertRule(context.Context, *CreateAlertRuleRequest) (*AlertRule, error)
+	UpdateAlertRule(context.Context, *UpdateAlertRuleRequest) (*AlertRule, error)
+	DeleteAlertRule(context.Context, *DeleteAlertRuleRequest) (*DeleteAlertRuleResponse, error)
+	ListQualityTargets(context.Context, *ListQualityTargetsRequest) (*ListQualityTargetsResponse, error)
+	CreateQualityTarget(context.Context, *CreateQualityTargetRequest) (*QualityTarget, error)
+	UpdateQualityTarget(context.Context, *UpdateQualityTargetRequest) (*QualityTarget, error)
+	DeleteQualityTarget(context.Context, *DeleteQualityTargetRequest) (*DeleteQualityTargetResponse, error)
+	GetTargetStatus(context.Context, *TargetStatusRequest) (*TargetStatusResponse, error)
+	GetLowestScoringTickets(context.Context, *LowestScoringTicketsRequest) (*LowestScoringTicketsResponse, error)
+	GetTicketDetail(context.Context, *TicketDetailRequest) (*TicketDetailResponse, error)
+	ListTicketAttributes(context.Context, *ListTicketAttributesRequest) (*ListTicketAttributesResponse, error)
+	SetTicketAttributes(context.Context, *SetTicketAttributesRequest) (*ListTicketAttributesResponse, error)
+	DeleteTicketAttribute(context.Context, *DeleteTicketAttributeRequest) (*DeleteTicketAttributeResponse, error)
+	GetScoresGroupedBy(context.Context, *ScoresGroupedByRequest) (*ScoresGroupedByResponse, error)
+	ListTeams(context.Context, *ListTeamsRequest) (*ListTeamsResponse, error)
+	CreateTeam(context.Context, *CreateTeamRequest) (*Team, error)
+	UpdateTeam(context.Context, *UpdateTeamRequest) (*Team, error)
+	DeleteTeam(context.Context, *DeleteTeamRequest) (*DeleteTeamResponse, error)
+	ListTeamMemberships(context.Context, *ListTeamMembershipsRequest) (*ListTeamMembershipsResponse, error)
+	CreateTeamMembership(context.Context, *CreateTeamMembershipRequest) (*TeamMembership, error)
+	UpdateTeamMembership(context.Context, *UpdateTeamMembershipRequest) (*TeamMembership, error)
+	DeleteTeamMembership(context.Context, *DeleteTeamMembershipRequest) (*DeleteTeamMembershipResponse, error)
+	GetTeamScores(context.Context, *TeamScoresRequest) (*TeamScoresResponse, error)
+	ListRatingCategories(context.Context, *ListRatingCategoriesRequest) (*ListRatingCategoriesResponse, error)
+	CreateRatingCategory(context.Context, *CreateRatingCategoryRequest) (*RatingCategory, error)
+	UpdateRatingCategory(context.Context, *UpdateRatingCategoryRequest) (*RatingCategory, error)
+	ArchiveRatingCategory(context.Context, *ArchiveRatingCategoryRequest) (*RatingCategory, error)
 	mustEmbedUnimplementedAnalyticsServiceServer()
 }
 
@@ -113,6 +529,102 @@ func (UnimplementedAnalyticsServiceServer) GetOverallQualityScore(context.Contex
 func (UnimplementedAnalyticsServiceServer) GetPeriodOverPeriodChange(context.Context, *PeriodOverPeriodChangeRequest) (*PeriodOverPeriodChangeResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method GetPeriodOverPeriodChange not implemented")
 }
+func (UnimplementedAnalyticsServiceServer) GetRatingDistribution(context.Context, *RatingDistributionRequest) (*RatingDistributionResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetRatingDistribution not implemented")
+}
+func (UnimplementedAnalyticsServiceServer) GetPeriodSeries(context.Context, *PeriodSeriesRequest) (*PeriodSeriesResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetPeriodSeries not implemented")
+}
+func (UnimplementedAnalyticsServiceServer) GetAnomalies(context.Context, *AnomaliesRequest) (*AnomaliesResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetAnomalies not implemented")
+}
+func (UnimplementedAnalyticsServiceServer) GetScoreForecast(context.Context, *ScoreForecastRequest) (*ScoreForecastResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetScoreForecast not implemented")
+}
+func (UnimplementedAnalyticsServiceServer) ListAlertRules(context.Context, *ListAlertRulesRequest) (*ListAlertRulesResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ListAlertRules not implemented")
+}
+func (UnimplementedAnalyticsServiceServer) CreateAlertRule(context.Context, *CreateAlertRuleRequest) (*AlertRule, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method CreateAlertRule not implemented")
+}
+func (UnimplementedAnalyticsServiceServer) UpdateAlertRule(context.Context, *UpdateAlertRuleRequest) (*AlertRule, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method UpdateAlertRule not implemented")
+}
+func (UnimplementedAnalyticsServiceServer) DeleteAlertRule(context.Context, *DeleteAlertRuleRequest) (*DeleteAlertRuleResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method DeleteAlertRule not implemented")
+}
+func (UnimplementedAnalyticsServiceServer) ListQualityTargets(context.Context, *ListQualityTargetsRequest) (*ListQualityTargetsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ListQualityTargets not implemented")
+}
+func (UnimplementedAnalyticsServiceServer) CreateQualityTarget(context.Context, *CreateQualityTargetRequest) (*QualityTarget, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method CreateQualityTarget not implemented")
+}
+func (UnimplementedAnalyticsServiceServer) UpdateQualityTarget(context.Context, *UpdateQualityTargetRequest) (*QualityTarget, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method UpdateQualityTarget not implemented")
+}
+func (UnimplementedAnalyticsServiceServer) DeleteQualityTarget(context.Context, *DeleteQualityTargetRequest) (*DeleteQualityTargetResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method DeleteQualityTarget not implemented")
+}
+func (UnimplementedAnalyticsServiceServer) GetTargetStatus(context.Context, *TargetStatusRequest) (*TargetStatusResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetTargetStatus not implemented")
+}
+func (UnimplementedAnalyticsServiceServer) GetLowestScoringTickets(context.Context, *LowestScoringTicketsRequest) (*LowestScoringTicketsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetLowestScoringTickets not implemented")
+}
+func (UnimplementedAnalyticsServiceServer) GetTicketDetail(context.Context, *TicketDetailRequest) (*TicketDetailResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetTicketDetail not implemented")
+}
+func (UnimplementedAnalyticsServiceServer) ListTicketAttributes(context.Context, *ListTicketAttributesRequest) (*ListTicketAttributesResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ListTicketAttributes not implemented")
+}
+func (UnimplementedAnalyticsServiceServer) SetTicketAttributes(context.Context, *SetTicketAttributesRequest) (*ListTicketAttributesResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method SetTicketAttributes not implemented")
+}
+func (UnimplementedAnalyticsServiceServer) DeleteTicketAttribute(context.Context, *DeleteTicketAttributeRequest) (*DeleteTicketAttributeResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method DeleteTicketAttribute not implemented")
+}
+func (UnimplementedAnalyticsServiceServer) GetScoresGroupedBy(context.Context, *ScoresGroupedByRequest) (*ScoresGroupedByResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetScoresGroupedBy not implemented")
+}
+func (UnimplementedAnalyticsServiceServer) ListTeams(context.Context, *ListTeamsRequest) (*ListTeamsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ListTeams not implemented")
+}
+func (UnimplementedAnalyticsServiceServer) CreateTeam(context.Context, *CreateTeamRequest) (*Team, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method CreateTeam not implemented")
+}
+func (UnimplementedAnalyticsServiceServer) UpdateTeam(context.Context, *UpdateTeamRequest) (*Team, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method UpdateTeam not implemented")
+}
+func (UnimplementedAnalyticsServiceServer) DeleteTeam(context.Context, *DeleteTeamRequest) (*DeleteTeamResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method DeleteTeam not implemented")
+}
+func (UnimplementedAnalyticsServiceServer) ListTeamMemberships(context.Context, *ListTeamMembershipsRequest) (*ListTeamMembershipsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ListTeamMemberships not implemented")
+}
+func (UnimplementedAnalyticsServiceServer) CreateTeamMembership(context.Context, *CreateTeamMembershipRequest) (*TeamMembership, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method CreateTeamMembership not implemented")
+}
+func (UnimplementedAnalyticsServiceServer) UpdateTeamMembership(context.Context, *UpdateTeamMembershipRequest) (*TeamMembership, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method UpdateTeamMembership not implemented")
+}
+func (UnimplementedAnalyticsServiceServer) DeleteTeamMembership(context.Context, *DeleteTeamMembershipRequest) (*DeleteTeamMembershipResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method DeleteTeamMembership not implemented")
+}
+func (UnimplementedAnalyticsServiceServer) GetTeamScores(context.Context, *TeamScoresRequest) (*TeamScoresResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetTeamScores not implemented")
+}
+func (UnimplementedAnalyticsServiceServer) ListRatingCategories(context.Context, *ListRatingCategoriesRequest) (*ListRatingCategoriesResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ListRatingCategories not implemented")
+}
+func (UnimplementedAnalyticsServiceServer) CreateRatingCategory(context.Context, *CreateRatingCategoryRequest) (*RatingCategory, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method CreateRatingCategory not implemented")
+}
+func (UnimplementedAnalyticsServiceServer) UpdateRatingCategory(context.Context, *UpdateRatingCategoryRequest) (*RatingCategory, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method UpdateRatingCategory not implemented")
+}
+func (UnimplementedAnalyticsServiceServer) ArchiveRatingCategory(context.Context, *ArchiveRatingCategoryRequest) (*RatingCategory, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ArchiveRatingCategory not implemented")
+}
 func (UnimplementedAnalyticsServiceServer) mustEmbedUnimplementedAnalyticsServiceServer() {}
 func (UnimplementedAnalyticsServiceServer) testEmbeddedByValue()                          {}
 
@@ -206,6 +718,582 @@ func _AnalyticsService_GetPeriodOverPeriodChange_Handler(srv interface{}, ctx co
 	return interceptor(ctx, in, info, handler)
 }
 
+func _AnalyticsService_GetRatingDistribution_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(RatingDistributionRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(AnalyticsServiceServer).GetRatingDistribution(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: AnalyticsService_GetRatingDistribution_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(AnalyticsServiceServer).GetRatingDistribution(ctx, req.(*RatingDistributionRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _AnalyticsService_GetPeriodSeries_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(PeriodSeriesRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(AnalyticsServiceServer).GetPeriodSeries(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: AnalyticsService_GetPeriodSeries_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(AnalyticsServiceServer).GetPeriodSeries(ctx, req.(*PeriodSeriesRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _AnalyticsService_GetAnomalies_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(AnomaliesRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(AnalyticsServiceServer).GetAnomalies(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: AnalyticsService_GetAnomalies_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(AnalyticsServiceServer).GetAnomalies(ctx, req.(*AnomaliesRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _AnalyticsService_GetScoreForecast_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ScoreForecastRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(AnalyticsServiceServer).GetScoreForecast(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: AnalyticsService_GetScoreForecast_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(AnalyticsServiceServer).GetScoreForecast(ctx, req.(*ScoreForecastRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _AnalyticsService_ListAlertRules_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ListAlertRulesRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(AnalyticsServiceServer).ListAlertRules(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: AnalyticsService_ListAlertRules_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(AnalyticsServiceServer).ListAlertRules(ctx, req.(*ListAlertRulesRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _AnalyticsService_CreateAlertRule_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(CreateAlertRuleRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(AnalyticsServiceServer).CreateAlertRule(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: AnalyticsService_CreateAlertRule_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(AnalyticsServiceServer).CreateAlertRule(ctx, req.(*CreateAlertRuleRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _AnalyticsService_UpdateAlertRule_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(UpdateAlertRuleRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(AnalyticsServiceServer).UpdateAlertRule(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: AnalyticsService_UpdateAlertRule_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(AnalyticsServiceServer).UpdateAlertRule(ctx, req.(*UpdateAlertRuleRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _AnalyticsService_DeleteAlertRule_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(DeleteAlertRuleRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(AnalyticsServiceServer).DeleteAlertRule(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: AnalyticsService_DeleteAlertRule_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(AnalyticsServiceServer).DeleteAlertRule(ctx, req.(*DeleteAlertRuleRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _AnalyticsService_ListQualityTargets_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ListQualityTargetsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(AnalyticsServiceServer).ListQualityTargets(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: AnalyticsService_ListQualityTargets_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(AnalyticsServiceServer).ListQualityTargets(ctx, req.(*ListQualityTargetsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _AnalyticsService_CreateQualityTarget_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(CreateQualityTargetRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(AnalyticsServiceServer).CreateQualityTarget(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: AnalyticsService_CreateQualityTarget_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(AnalyticsServiceServer).CreateQualityTarget(ctx, req.(*CreateQualityTargetRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _AnalyticsService_UpdateQualityTarget_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(UpdateQualityTargetRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(AnalyticsServiceServer).UpdateQualityTarget(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: AnalyticsService_UpdateQualityTarget_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(AnalyticsServiceServer).UpdateQualityTarget(ctx, req.(*UpdateQualityTargetRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _AnalyticsService_DeleteQualityTarget_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(DeleteQualityTargetRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(AnalyticsServiceServer).DeleteQualityTarget(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: AnalyticsService_DeleteQualityTarget_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(AnalyticsServiceServer).DeleteQualityTarget(ctx, req.(*DeleteQualityTargetRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _AnalyticsService_GetTargetStatus_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(TargetStatusRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(AnalyticsServiceServer).GetTargetStatus(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: AnalyticsService_GetTargetStatus_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(AnalyticsServiceServer).GetTargetStatus(ctx, req.(*TargetStatusRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _AnalyticsService_GetLowestScoringTickets_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(LowestScoringTicketsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(AnalyticsServiceServer).GetLowestScoringTickets(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: AnalyticsService_GetLowestScoringTickets_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(AnalyticsServiceServer).GetLowestScoringTickets(ctx, req.(*LowestScoringTicketsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _AnalyticsService_GetTicketDetail_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(TicketDetailRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(AnalyticsServiceServer).GetTicketDetail(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: AnalyticsService_GetTicketDetail_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(AnalyticsServiceServer).GetTicketDetail(ctx, req.(*TicketDetailRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _AnalyticsService_ListTicketAttributes_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ListTicketAttributesRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(AnalyticsServiceServer).ListTicketAttributes(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: AnalyticsService_ListTicketAttributes_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(AnalyticsServiceServer).ListTicketAttributes(ctx, req.(*ListTicketAttributesRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _AnalyticsService_SetTicketAttributes_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(SetTicketAttributesRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(AnalyticsServiceServer).SetTicketAttributes(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: AnalyticsService_SetTicketAttributes_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(AnalyticsServiceServer).SetTicketAttributes(ctx, req.(*SetTicketAttributesRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _AnalyticsService_DeleteTicketAttribute_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(DeleteTicketAttributeRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(AnalyticsServiceServer).DeleteTicketAttribute(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: AnalyticsService_DeleteTicketAttribute_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(AnalyticsServiceServer).DeleteTicketAttribute(ctx, req.(*DeleteTicketAttributeRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _AnalyticsService_GetScoresGroupedBy_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ScoresGroupedByRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(AnalyticsServiceServer).GetScoresGroupedBy(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: AnalyticsService_GetScoresGroupedBy_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(AnalyticsServiceServer).GetScoresGroupedBy(ctx, req.(*ScoresGroupedByRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _AnalyticsService_ListTeams_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ListTeamsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(AnalyticsServiceServer).ListTeams(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: AnalyticsService_ListTeams_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(AnalyticsServiceServer).ListTeams(ctx, req.(*ListTeamsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _AnalyticsService_CreateTeam_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(CreateTeamRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(AnalyticsServiceServer).CreateTeam(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: AnalyticsService_CreateTeam_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(AnalyticsServiceServer).CreateTeam(ctx, req.(*CreateTeamRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _AnalyticsService_UpdateTeam_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(UpdateTeamRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(AnalyticsServiceServer).UpdateTeam(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: AnalyticsService_UpdateTeam_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(AnalyticsServiceServer).UpdateTeam(ctx, req.(*UpdateTeamRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _AnalyticsService_DeleteTeam_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(DeleteTeamRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(AnalyticsServiceServer).DeleteTeam(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: AnalyticsService_DeleteTeam_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(AnalyticsServiceServer).DeleteTeam(ctx, req.(*DeleteTeamRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _AnalyticsService_ListTeamMemberships_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ListTeamMembershipsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(AnalyticsServiceServer).ListTeamMemberships(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: AnalyticsService_ListTeamMemberships_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(AnalyticsServiceServer).ListTeamMemberships(ctx, req.(*ListTeamMembershipsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _AnalyticsService_CreateTeamMembership_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(CreateTeamMembershipRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(AnalyticsServiceServer).CreateTeamMembership(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: AnalyticsService_CreateTeamMembership_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(AnalyticsServiceServer).CreateTeamMembership(ctx, req.(*CreateTeamMembershipRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _AnalyticsService_UpdateTeamMembership_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(UpdateTeamMembershipRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(AnalyticsServiceServer).UpdateTeamMembership(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: AnalyticsService_UpdateTeamMembership_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(AnalyticsServiceServer).UpdateTeamMembership(ctx, req.(*UpdateTeamMembershipRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _AnalyticsService_DeleteTeamMembership_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(DeleteTeamMembershipRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(AnalyticsServiceServer).DeleteTeamMembership(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: AnalyticsService_DeleteTeamMembership_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(AnalyticsServiceServer).DeleteTeamMembership(ctx, req.(*DeleteTeamMembershipRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _AnalyticsService_GetTeamScores_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(TeamScoresRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(AnalyticsServiceServer).GetTeamScores(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: AnalyticsService_GetTeamScores_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(AnalyticsServiceServer).GetTeamScores(ctx, req.(*TeamScoresRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _AnalyticsService_ListRatingCategories_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ListRatingCategoriesRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(AnalyticsServiceServer).ListRatingCategories(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: AnalyticsService_ListRatingCategories_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(AnalyticsServiceServer).ListRatingCategories(ctx, req.(*ListRatingCategoriesRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _AnalyticsService_CreateRatingCategory_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(CreateRatingCategoryRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(AnalyticsServiceServer).CreateRatingCategory(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: AnalyticsService_CreateRatingCategory_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(AnalyticsServiceServer).CreateRatingCategory(ctx, req.(*CreateRatingCategoryRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _AnalyticsService_UpdateRatingCategory_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(UpdateRatingCategoryRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(AnalyticsServiceServer).UpdateRatingCategory(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: AnalyticsService_UpdateRatingCategory_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(AnalyticsServiceServer).UpdateRatingCategory(ctx, req.(*UpdateRatingCategoryRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _AnalyticsService_ArchiveRatingCategory_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ArchiveRatingCategoryRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(AnalyticsServiceServer).ArchiveRatingCategory(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: AnalyticsService_ArchiveRatingCategory_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(AnalyticsServiceServer).ArchiveRatingCategory(ctx, req.(*ArchiveRatingCategoryRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 // AnalyticsService_ServiceDesc is the grpc.ServiceDesc for AnalyticsService service.
 // It's only intended for direct use with grpc.RegisterService,
 // and not to be introspected or modified (even as a copy)
@@ -229,6 +1317,134 @@ var AnalyticsService_ServiceDesc = grpc.ServiceDesc{
 			MethodName: "GetPeriodOverPeriodChange",
 			Handler:    _AnalyticsService_GetPeriodOverPeriodChange_Handler,
 		},
+		{
+			MethodName: "GetRatingDistribution",
+			Handler:    _AnalyticsService_GetRatingDistribution_Handler,
+		},
+		{
+			MethodName: "GetPeriodSeries",
+			Handler:    _AnalyticsService_GetPeriodSeries_Handler,
+		},
+		{
+			MethodName: "GetAnomalies",
+			Handler:    _AnalyticsService_GetAnomalies_Handler,
+		},
+		{
+			MethodName: "GetScoreForecast",
+			Handler:    _AnalyticsService_GetScoreForecast_Handler,
+		},
+		{
+			MethodName: "ListAlertRules",
+			Handler:    _AnalyticsService_ListAlertRules_Handler,
+		},
+		{
+			MethodName: "CreateAlertRule",
+			Handler:    _AnalyticsService_CreateAlertRule_Handler,
+		},
+		{
+			MethodName: "UpdateAlertRule",
+			Handler:    _AnalyticsService_UpdateAlertRule_Handler,
+		},
+		{
+			MethodName: "DeleteAlertRule",
+			Handler:    _AnalyticsService_DeleteAlertRule_Handler,
+		},
+		{
+			MethodName: "ListQualityTargets",
+			Handler:    _AnalyticsService_ListQualityTargets_Handler,
+		},
+		{
+			MethodName: "CreateQualityTarget",
+			Handler:    _AnalyticsService_CreateQualityTarget_Handler,
+		},
+		{
+			MethodName: "UpdateQualityTarget",
+			Handler:    _AnalyticsService_UpdateQualityTarget_Handler,
+		},
+		{
+			MethodName: "DeleteQualityTarget",
+			Handler:    _AnalyticsService_DeleteQualityTarget_Handler,
+		},
+		{
+			MethodName: "GetTargetStatus",
+			Handler:    _AnalyticsService_GetTargetStatus_Handler,
+		},
+		{
+			MethodName: "GetLowestScoringTickets",
+			Handler:    _AnalyticsService_GetLowestScoringTickets_Handler,
+		},
+		{
+			MethodName: "GetTicketDetail",
+			Handler:    _AnalyticsService_GetTicketDetail_Handler,
+		},
+		{
+			MethodName: "ListTicketAttributes",
+			Handler:    _AnalyticsService_ListTicketAttributes_Handler,
+		},
+		{
+			MethodName: "SetTicketAttributes",
+			Handler:    _AnalyticsService_SetTicketAttributes_Handler,
+		},
+		{
+			MethodName: "DeleteTicketAttribute",
+			Handler:    _AnalyticsService_DeleteTicketAttribute_Handler,
+		},
+		{
+			MethodName: "GetScoresGroupedBy",
+			Handler:    _AnalyticsService_GetScoresGroupedBy_Handler,
+		},
+		{
+			MethodName: "ListTeams",
+			Handler:    _AnalyticsService_ListTeams_Handler,
+		},
+		{
+			MethodName: "CreateTeam",
+			Handler:    _AnalyticsService_CreateTeam_Handler,
+		},
+		{
+			MethodName: "UpdateTeam",
+			Handler:    _AnalyticsService_UpdateTeam_Handler,
+		},
+		{
+			MethodName: "DeleteTeam",
+			Handler:    _AnalyticsService_DeleteTeam_Handler,
+		},
+		{
+			MethodName: "ListTeamMemberships",
+			Handler:    _AnalyticsService_ListTeamMemberships_Handler,
+		},
+		{
+			MethodName: "CreateTeamMembership",
+			Handler:    _AnalyticsService_CreateTeamMembership_Handler,
+		},
+		{
+			MethodName: "UpdateTeamMembership",
+			Handler:    _AnalyticsService_UpdateTeamMembership_Handler,
+		},
+		{
+			MethodName: "DeleteTeamMembership",
+			Handler:    _AnalyticsService_DeleteTeamMembership_Handler,
+		},
+		{
+			MethodName: "GetTeamScores",
+			Handler:    _AnalyticsService_GetTeamScores_Handler,
+		},
+		{
+			MethodName: "ListRatingCategories",
+			Handler:    _AnalyticsService_ListRatingCategories_Handler,
+		},
+		{
+			MethodName: "CreateRatingCategory",
+			Handler:    _AnalyticsService_CreateRatingCategory_Handler,
+		},
+		{
+			MethodName: "UpdateRatingCategory",
+			Handler:    _AnalyticsService_UpdateRatingCategory_Handler,
+		},
+		{
+			MethodName: "ArchiveRatingCategory",
+			Handler:    _AnalyticsService_ArchiveRatingCategory_Handler,
+		},
 	},
 	Streams:  []grpc.StreamDesc{},
 	Metadata: "analytics.proto",
@@ -0,0 +1,493 @@
+// Code generated by protoc-gen-go. DO NOT EDIT.
+// versions:
+// 	protoc-gen-go v1.36.9
+// 	protoc        v5.29.3
+// source: anomaly.proto
+
+package proto
+
+import (
+	protoreflect "google.golang.org/protobuf/reflect/protoreflect"
+	protoimpl "google.golang.org/protobuf/runtime/protoimpl"
+	timestamppb "google.golang.org/protobuf/types/known/timestamppb"
+	reflect "reflect"
+	sync "sync"
+	unsafe "unsafe"
+)
+
+const (
+	// Verify that this generated code is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(20 - protoimpl.MinVersion)
+	// Verify that runtime/protoimpl is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(protoimpl.MaxVersion - 20)
+)
+
+// AnomalySeverity grades a flagged bucket by how far its robust z-score exceeds the threshold
+type AnomalySeverity int32
+
+const (
+	AnomalySeverity_ANOMALY_SEVERITY_UNSPECIFIED AnomalySeverity = 0
+	AnomalySeverity_ANOMALY_SEVERITY_LOW         AnomalySeverity = 1 // |robust_z| at least the threshold
+	AnomalySeverity_ANOMALY_SEVERITY_MEDIUM      AnomalySeverity = 2 // At least 1.5 times the threshold
+	AnomalySeverity_ANOMALY_SEVERITY_HIGH        AnomalySeverity = 3 // At least twice the threshold
+)
+
+// Enum value maps for AnomalySeverity.
+var (
+	AnomalySeverity_name = map[int32]string{
+		0: "ANOMALY_SEVERITY_UNSPECIFIED",
+		1: "ANOMALY_SEVERITY_LOW",
+		2: "ANOMALY_SEVERITY_MEDIUM",
+		3: "ANOMALY_SEVERITY_HIGH",
+	}
+	AnomalySeverity_value = map[string]int32{
+		"ANOMALY_SEVERITY_UNSPECIFIED": 0,
+		"ANOMALY_SEVERITY_LOW":         1,
+		"ANOMALY_SEVERITY_MEDIUM":      2,
+		"ANOMALY_SEVERITY_HIGH":        3,
+	}
+)
+
+func (x AnomalySeverity) Enum() *AnomalySeverity {
+	p := new(AnomalySeverity)
+	*p = x
+	return p
+}
+
+func (x AnomalySeverity) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
+}
+
+func (AnomalySeverity) Descriptor() protoreflect.EnumDescriptor {
+	return file_anomaly_proto_enumTypes[0].Descriptor()
+}
+
+func (AnomalySeverity) Type() protoreflect.EnumType {
+	return &file_anomaly_proto_enumTypes[0]
+}
+
+func (x AnomalySeverity) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
+}
+
+// Deprecated: Use AnomalySeverity.Descriptor instead.
+func (AnomalySeverity) EnumDescriptor() ([]byte, []int) {
+	return file_anomaly_proto_rawDescGZIP(), []int{0}
+}
+
+type AnomalyDirection int32
+
+const (
+	AnomalyDirection_ANOMALY_DIRECTION_UNSPECIFIED AnomalyDirection = 0
+	AnomalyDirection_ANOMALY_DIRECTION_DROP        AnomalyDirection = 1 // Observed score below the expected one
+	AnomalyDirection_ANOMALY_DIRECTION_SPIKE       AnomalyDirection = 2 // Observed score above the expected one
+)
+
+// Enum value maps for AnomalyDirection.
+var (
+	AnomalyDirection_name = map[int32]string{
+		0: "ANOMALY_DIRECTION_UNSPECIFIED",
+		1: "ANOMALY_DIRECTION_DROP",
+		2: "ANOMALY_DIRECTION_SPIKE",
+	}
+	AnomalyDirection_value = map[string]int32{
+		"ANOMALY_DIRECTION_UNSPECIFIED": 0,
+		"ANOMALY_DIRECTION_DROP":        1,
+		"ANOMALY_DIRECTION_SPIKE":       2,
+	}
+)
+
+func (x AnomalyDirection) Enum() *AnomalyDirection {
+	p := new(AnomalyDirection)
+	*p = x
+	return p
+}
+
+func (x AnomalyDirection) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
+}
+
+func (AnomalyDirection) Descriptor() protoreflect.EnumDescriptor {
+	return file_anomaly_proto_enumTypes[1].Descriptor()
+}
+
+func (AnomalyDirection) Type() protoreflect.EnumType {
+	return &file_anomaly_proto_enumTypes[1]
+}
+
+func (x AnomalyDirection) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
+}
+
+// Deprecated: Use AnomalyDirection.Descriptor instead.
+func (AnomalyDirection) EnumDescriptor() ([]byte, []int) {
+	return file_anomaly_proto_rawDescGZIP(), []int{1}
+}
+
+type AnomaliesRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	StartDate     *timestamppb.Timestamp `protobuf:"bytes,1,opt,name=start_date,json=startDate,proto3" json:"start_date,omitempty"`
+	EndDate       *timestamppb.Timestamp `protobuf:"bytes,2,opt,name=end_date,json=endDate,proto3" json:"end_date,omitempty"`
+	InclusiveEnd  bool                   `protobuf:"varint,3,opt,name=inclusive_end,json=inclusiveEnd,proto3" json:"inclusive_end,omitempty"`                 // Also count ratings created exactly at end_date
+	Threshold     float64                `protobuf:"fixed64,4,opt,name=threshold,proto3" json:"threshold,omitempty"`                                          // Robust z-score a day must reach to be flagged; defaults to 3.5
+	MinRatings    int32                  `protobuf:"varint,5,opt,name=min_ratings,json=minRatings,proto3" json:"min_ratings,omitempty"`                       // Days with fewer ratings are never flagged, but still shape the baseline
+	DateBasis     DateBasis              `protobuf:"varint,6,opt,name=date_basis,json=dateBasis,proto3,enum=analytics.DateBasis" json:"date_basis,omitempty"` // Which timestamp the range applies to; defaults to the rating's
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *AnomaliesRequest) Reset() {
+	*x = AnomaliesRequest{}
+	mi := &file_anomaly_proto_msgTypes[0]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *AnomaliesRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*AnomaliesRequest) ProtoMessage() {}
+
+func (x *AnomaliesRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_anomaly_proto_msgTypes[0]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use AnomaliesRequest.ProtoReflect.Descriptor instead.
+func (*AnomaliesRequest) Descriptor() ([]byte, []int) {
+	return file_anomaly_proto_rawDescGZIP(), []int{0}
+}
+
+func (x *AnomaliesRequest) GetStartDate() *timestamppb.Timestamp {
+	if x != nil {
+		return x.StartDate
+	}
+	return nil
+}
+
+func (x *AnomaliesRequest) GetEndDate() *timestamppb.Timestamp {
+	if x != nil {
+		return x.EndDate
+	}
+	return nil
+}
+
+func (x *AnomaliesRequest) GetInclusiveEnd() bool {
+	if x != nil {
+		return x.InclusiveEnd
+	}
+	return false
+}
+
+func (x *AnomaliesRequest) GetThreshold() float64 {
+	if x != nil {
+		return x.Threshold
+	}
+	return 0
+}
+
+func (x *AnomaliesRequest) GetMinRatings() int32 {
+	if x != nil {
+		return x.MinRatings
+	}
+	return 0
+}
+
+func (x *AnomaliesRequest) GetDateBasis() DateBasis {
+	if x != nil {
+		return x.DateBasis
+	}
+	return DateBasis_DATE_BASIS_UNSPECIFIED
+}
+
+type Anomaly struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Date          *timestamppb.Timestamp `protobuf:"bytes,1,opt,name=date,proto3" json:"date,omitempty"`        // Day bucket start, UTC
+	Overall       bool                   `protobuf:"varint,2,opt,name=overall,proto3" json:"overall,omitempty"` // Flagged in the overall quality score series; category fields are unset
+	CategoryId    int32                  `protobuf:"varint,3,opt,name=category_id,json=categoryId,proto3" json:"category_id,omitempty"`
+	CategoryName  string                 `protobuf:"bytes,4,opt,name=category_name,json=categoryName,proto3" json:"category_name,omitempty"`
+	Observed      float32                `protobuf:"fixed32,5,opt,name=observed,proto3" json:"observed,omitempty"`
+	Expected      float32                `protobuf:"fixed32,6,opt,name=expected,proto3" json:"expected,omitempty"`              // Local level plus the weekday's seasonal offset
+	RobustZ       float64                `protobuf:"fixed64,7,opt,name=robust_z,json=robustZ,proto3" json:"robust_z,omitempty"` // Residual scaled by the series' median absolute deviation
+	Severity      AnomalySeverity        `protobuf:"varint,8,opt,name=severity,proto3,enum=analytics.AnomalySeverity" json:"severity,omitempty"`
+	Direction     AnomalyDirection       `protobuf:"varint,9,opt,name=direction,proto3,enum=analytics.AnomalyDirection" json:"direction,omitempty"`
+	RatingCount   int32                  `protobuf:"varint,10,opt,name=rating_count,json=ratingCount,proto3" json:"rating_count,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *Anomaly) Reset() {
+	*x = Anomaly{}
+	mi := &file_anomaly_proto_msgTypes[1]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *Anomaly) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Anomaly) ProtoMessage() {}
+
+func (x *Anomaly) ProtoReflect() protoreflect.Message {
+	mi := &file_anomaly_proto_msgTypes[1]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Anomaly.ProtoReflect.Descriptor instead.
+func (*Anomaly) Descriptor() ([]byte, []int) {
+	return file_anomaly_proto_rawDescGZIP(), []int{1}
+}
+
+func (x *Anomaly) GetDate() *timestamppb.Timestamp {
+	if x != nil {
+		return x.Date
+	}
+	return nil
+}
+
+func (x *Anomaly) GetOverall() bool {
+	if x != nil {
+		return x.Overall
+	}
+	return false
+}
+
+func (x *Anomaly) GetCategoryId() int32 {
+	if x != nil {
+		return x.CategoryId
+	}
+	return 0
+}
+
+func (x *Anomaly) GetCategoryName() string {
+	if x != nil {
+		return x.CategoryName
+	}
+	return ""
+}
+
+func (x *Anomaly) GetObserved() float32 {
+	if x != nil {
+		return x.Observed
+	}
+	return 0
+}
+
+func (x *Anomaly) GetExpected() float32 {
+	if x != nil {
+		return x.Expected
+	}
+	return 0
+}
+
+func (x *Anomaly) GetRobustZ() float64 {
+	if x != nil {
+		return x.RobustZ
+	}
+	return 0
+}
+
+func (x *Anomaly) GetSeverity() AnomalySeverity {
+	if x != nil {
+		return x.Severity
+	}
+	return AnomalySeverity_ANOMALY_SEVERITY_UNSPECIFIED
+}
+
+func (x *Anomaly) GetDirection() AnomalyDirection {
+	if x != nil {
+		return x.Direction
+	}
+	return AnomalyDirection_ANOMALY_DIRECTION_UNSPECIFIED
+}
+
+func (x *Anomaly) GetRatingCount() int32 {
+	if x != nil {
+		return x.RatingCount
+	}
+	return 0
+}
+
+type AnomaliesResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Anomalies     []*Anomaly             `protobuf:"bytes,1,rep,name=anomalies,proto3" json:"anomalies,omitempty"`   // Ordered by date; per day the overall series first, then categories by name
+	Range         *DateRange             `protobuf:"bytes,2,opt,name=range,proto3" json:"range,omitempty"`           // Range the ratings were filtered by
+	Threshold     float64                `protobuf:"fixed64,3,opt,name=threshold,proto3" json:"threshold,omitempty"` // Threshold applied
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *AnomaliesResponse) Reset() {
+	*x = AnomaliesResponse{}
+	mi := &file_anomaly_proto_msgTypes[2]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *AnomaliesResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*AnomaliesResponse) ProtoMessage() {}
+
+func (x *AnomaliesResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_anomaly_proto_msgTypes[2]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use AnomaliesResponse.ProtoReflect.Descriptor instead.
+func (*AnomaliesResponse) Descriptor() ([]byte, []int) {
+	return file_anomaly_proto_rawDescGZIP(), []int{2}
+}
+
+func (x *AnomaliesResponse) GetAnomalies() []*Anomaly {
+	if x != nil {
+		return x.Anomalies
+	}
+	return nil
+}
+
+func (x *AnomaliesResponse) GetRange() *DateRange {
+	if x != nil {
+		return x.Range
+	}
+	return nil
+}
+
+func (x *AnomaliesResponse) GetThreshold() float64 {
+	if x != nil {
+		return x.Threshold
+	}
+	return 0
+}
+
+var File_anomaly_proto protoreflect.FileDescriptor
+
+const file_anomaly_proto_rawDesc = "" +
+	"\n" +
+	"\ranomaly.proto\x12\tanalytics\x1a\x1fgoogle/protobuf/timestamp.proto\x1a\x10date_range.proto\"\x9d\x02\n" +
+	"\x10AnomaliesRequest\x129\n" +
+	"\n" +
+	"start_date\x18\x01 \x01(\v2\x1a.google.protobuf.TimestampR\tstartDate\x125\n" +
+	"\bend_date\x18\x02 \x01(\v2\x1a.google.protobuf.TimestampR\aendDate\x12#\n" +
+	"\rinclusive_end\x18\x03 \x01(\bR\finclusiveEnd\x12\x1c\n" +
+	"\tthreshold\x18\x04 \x01(\x01R\tthreshold\x12\x1f\n" +
+	"\vmin_ratings\x18\x05 \x01(\x05R\n" +
+	"minRatings\x123\n" +
+	"\n" +
+	"date_basis\x18\x06 \x01(\x0e2\x14.analytics.DateBasisR\tdateBasis\"\x82\x03\n" +
+	"\aAnomaly\x12.\n" +
+	"\x04date\x18\x01 \x01(\v2\x1a.google.protobuf.TimestampR\x04date\x12\x18\n" +
+	"\aoverall\x18\x02 \x01(\bR\aoverall\x12\x1f\n" +
+	"\vcategory_id\x18\x03 \x01(\x05R\n" +
+	"categoryId\x12#\n" +
+	"\rcategory_name\x18\x04 \x01(\tR\fcategoryName\x12\x1a\n" +
+	"\bobserved\x18\x05 \x01(\x02R\bobserved\x12\x1a\n" +
+	"\bexpected\x18\x06 \x01(\x02R\bexpected\x12\x19\n" +
+	"\brobust_z\x18\a \x01(\x01R\arobustZ\x126\n" +
+	"\bseverity\x18\b \x01(\x0e2\x1a.analytics.AnomalySeverityR\bseverity\x129\n" +
+	"\tdirection\x18\t \x01(\x0e2\x1b.analytics.AnomalyDirectionR\tdirection\x12!\n" +
+	"\frating_count\x18\n" +
+	" \x01(\x05R\vratingCount\"\x8f\x01\n" +
+	"\x11AnomaliesResponse\x120\n" +
+	"\tanomalies\x18\x01 \x03(\v2\x12.analytics.AnomalyR\tanomalies\x12*\n" +
+	"\x05range\x18\x02 \x01(\v2\x14.analytics.DateRangeR\x05range\x12\x1c\n" +
+	"\tthreshold\x18\x03 \x01(\x01R\tthreshold*\x85\x01\n" +
+	"\x0fAnomalySeverity\x12 \n" +
+	"\x1cANOMALY_SEVERITY_UNSPECIFIED\x10\x00\x12\x18\n" +
+	"\x14ANOMALY_SEVERITY_LOW\x10\x01\x12\x1b\n" +
+	"\x17ANOMALY_SEVERITY_MEDIUM\x10\x02\x12\x19\n" +
+	"\x15ANOMALY_SEVERITY_HIGH\x10\x03*n\n" +
+	"\x10AnomalyDirection\x12!\n" +
+	"\x1dANOMALY_DIRECTION_UNSPECIFIED\x10\x00\x12\x1a\n" +
+	"\x16ANOMALY_DIRECTION_DROP\x10\x01\x12\x1b\n" +
+	"\x17ANOMALY_DIRECTION_SPIKE\x10\x02B\x17Z\x15go-grpc-backend/protob\x06proto3"
+
+var (
+	file_anomaly_proto_rawDescOnce sync.Once
+	file_anomaly_proto_rawDescData []byte
+)
+
+func file_anomaly_proto_rawDescGZIP() []byte {
+	file_anomaly_proto_rawDescOnce.Do(func() {
+		file_anomaly_proto_rawDescData = protoimpl.X.CompressGZIP(unsafe.Slice(unsafe.StringData(file_anomaly_proto_rawDesc), len(file_anomaly_proto_rawDesc)))
+	})
+	return file_anomaly_proto_rawDescData
+}
+
+var file_anomaly_proto_enumTypes = make([]protoimpl.EnumInfo, 2)
+var file_anomaly_proto_msgTypes = make([]protoimpl.MessageInfo, 3)
+var file_anomaly_proto_goTypes = []any{
+	(AnomalySeverity)(0),          // 0: analytics.AnomalySeverity
+	(AnomalyDirection)(0),         // 1: analytics.AnomalyDirection
+	(*AnomaliesRequest)(nil),      // 2: analytics.AnomaliesRequest
+	(*Anomaly)(nil),               // 3: analytics.Anomaly
+	(*AnomaliesResponse)(nil),     // 4: analytics.AnomaliesResponse
+	(*timestamppb.Timestamp)(nil), // 5: google.protobuf.Timestamp
+	(DateBasis)(0),                // 6: analytics.DateBasis
+	(*DateRange)(nil),             // 7: analytics.DateRange
+}
+var file_anomaly_proto_depIdxs = []int32{
+	5, // 0: analytics.AnomaliesRequest.start_date:type_name -> google.protobuf.Timestamp
+	5, // 1: analytics.AnomaliesRequest.end_date:type_name -> google.protobuf.Timestamp
+	6, // 2: analytics.AnomaliesRequest.date_basis:type_name -> analytics.DateBasis
+	5, // 3: analytics.Anomaly.date:type_name -> google.protobuf.Timestamp
+	0, // 4: analytics.Anomaly.severity:type_name -> analytics.AnomalySeverity
+	1, // 5: analytics.Anomaly.direction:type_name -> analytics.AnomalyDirection
+	3, // 6: analytics.AnomaliesResponse.anomalies:type_name -> analytics.Anomaly
+	7, // 7: analytics.AnomaliesResponse.range:type_name -> analytics.DateRange
+	8, // [8:8] is the sub-list for method output_type
+	8, // [8:8] is the sub-list for method input_type
+	8, // [8:8] is the sub-list for extension type_name
+	8, // [8:8] is the sub-list for extension extendee
+	0, // [0:8] is the sub-list for field type_name
+}
+
+func init() { file_anomaly_proto_init() }
+func file_anomaly_proto_init() {
+	if File_anomaly_proto != nil {
+		return
+	}
+	file_date_range_proto_init()
+	type x struct{}
+	out := protoimpl.TypeBuilder{
+		File: protoimpl.DescBuilder{
+			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
+			RawDescriptor: unsafe.Slice(unsafe.StringData(file_anomaly_proto_rawDesc), len(file_anomaly_proto_rawDesc)),
+			NumEnums:      2,
+			NumMessages:   3,
+			NumExtensions: 0,
+			NumServices:   0,
+		},
+		GoTypes:           file_anomaly_proto_goTypes,
+		DependencyIndexes: file_anomaly_proto_depIdxs,
+		EnumInfos:         file_anomaly_proto_enumTypes,
+		MessageInfos:      file_anomaly_proto_msgTypes,
+	}.Build()
+	File_anomaly_proto = out.File
+	file_anomaly_proto_goTypes = nil
+	file_anomaly_proto_depIdxs = nil
+}
@@ -72,18 +72,190 @@ func (Granularity) EnumDescriptor() ([]byte, []int) {
 	return file_category_score_proto_rawDescGZIP(), []int{0}
 }
 
+// FillMode controls how buckets without ratings are reported
+type FillMode int32
+
+const (
+	FillMode_FILL_MODE_UNSPECIFIED   FillMode = 0 // Same as FILL_MODE_OMIT
+	FillMode_FILL_MODE_OMIT          FillMode = 1 // Empty buckets are left out of the series
+	FillMode_FILL_MODE_NULL          FillMode = 2 // Empty buckets are present with count 0 and no score
+	FillMode_FILL_MODE_CARRY_FORWARD FillMode = 3 // Empty buckets repeat the previous bucket's score
+	FillMode_FILL_MODE_LINEAR        FillMode = 4 // Empty buckets interpolate linearly between their neighbours
+)
+
+// Enum value maps for FillMode.
+var (
+	FillMode_name = map[int32]string{
+		0: "FILL_MODE_UNSPECIFIED",
+		1: "FILL_MODE_OMIT",
+		2: "FILL_MODE_NULL",
+		3: "FILL_MODE_CARRY_FORWARD",
+		4: "FILL_MODE_LINEAR",
+	}
+	FillMode_value = map[string]int32{
+		"FILL_MODE_UNSPECIFIED":   0,
+		"FILL_MODE_OMIT":          1,
+		"FILL_MODE_NULL":          2,
+		"FILL_MODE_CARRY_FORWARD": 3,
+		"FILL_MODE_LINEAR":        4,
+	}
+)
+
+func (x FillMode) Enum() *FillMode {
+	p := new(FillMode)
+	*p = x
+	return p
+}
+
+func (x FillMode) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
+}
+
+func (FillMode) Descriptor() protoreflect.EnumDescriptor {
+	return file_category_score_proto_enumTypes[1].Descriptor()
+}
+
+func (FillMode) Type() protoreflect.EnumType {
+	return &file_category_score_proto_enumTypes[1]
+}
+
+func (x FillMode) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
+}
+
+// Deprecated: Use FillMode.Descriptor instead.
+func (FillMode) EnumDescriptor() ([]byte, []int) {
+	return file_category_score_proto_rawDescGZIP(), []int{1}
+}
+
+// ScorePointSource tells observed points apart from the ones produced by a FillMode
+type ScorePointSource int32
+
+const (
+	ScorePointSource_SCORE_POINT_SOURCE_OBSERVED     ScorePointSource = 0
+	ScorePointSource_SCORE_POINT_SOURCE_NULL         ScorePointSource = 1 // No ratings and no score to fill with; score must be ignored
+	ScorePointSource_SCORE_POINT_SOURCE_CARRIED      ScorePointSource = 2
+	ScorePointSource_SCORE_POINT_SOURCE_INTERPOLATED ScorePointSource = 3
+)
+
+// Enum value maps for ScorePointSource.
+var (
+	ScorePointSource_name = map[int32]string{
+		0: "SCORE_POINT_SOURCE_OBSERVED",
+		1: "SCORE_POINT_SOURCE_NULL",
+		2: "SCORE_POINT_SOURCE_CARRIED",
+		3: "SCORE_POINT_SOURCE_INTERPOLATED",
+	}
+	ScorePointSource_value = map[string]int32{
+		"SCORE_POINT_SOURCE_OBSERVED":     0,
+		"SCORE_POINT_SOURCE_NULL":         1,
+		"SCORE_POINT_SOURCE_CARRIED":      2,
+		"SCORE_POINT_SOURCE_INTERPOLATED": 3,
+	}
+)
+
+func (x ScorePointSource) Enum() *ScorePointSource {
+	p := new(ScorePointSource)
+	*p = x
+	return p
+}
+
+func (x ScorePointSource) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
+}
+
+func (ScorePointSource) Descriptor() protoreflect.EnumDescriptor {
+	return file_category_score_proto_enumTypes[2].Descriptor()
+}
+
+func (ScorePointSource) Type() protoreflect.EnumType {
+	return &file_category_score_proto_enumTypes[2]
+}
+
+func (x ScorePointSource) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
+}
+
+// Deprecated: Use ScorePointSource.Descriptor instead.
+func (ScorePointSource) EnumDescriptor() ([]byte, []int) {
+	return file_category_score_proto_rawDescGZIP(), []int{2}
+}
+
+// Smoothing selects the smoothed series returned next to the raw scores; zero values turn a method off
+type Smoothing struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	RollingWindow int32                  `protobuf:"varint,1,opt,name=rolling_window,json=rollingWindow,proto3" json:"rolling_window,omitempty"` // Buckets in the rolling mean ending at each point, weighted by rating count
+	EmaAlpha      float64                `protobuf:"fixed64,2,opt,name=ema_alpha,json=emaAlpha,proto3" json:"ema_alpha,omitempty"`               // Weight of the newest bucket in the exponential moving average, in (0, 1]
+	Cumulative    bool                   `protobuf:"varint,3,opt,name=cumulative,proto3" json:"cumulative,omitempty"`                            // Score of every rating from the start of the range up to each point
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *Smoothing) Reset() {
+	*x = Smoothing{}
+	mi := &file_category_score_proto_msgTypes[0]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *Smoothing) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Smoothing) ProtoMessage() {}
+
+func (x *Smoothing) ProtoReflect() protoreflect.Message {
+	mi := &file_category_score_proto_msgTypes[0]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Smoothing.ProtoReflect.Descriptor instead.
+func (*Smoothing) Descriptor() ([]byte, []int) {
+	return file_category_score_proto_rawDescGZIP(), []int{0}
+}
+
+func (x *Smoothing) GetRollingWindow() int32 {
+	if x != nil {
+		return x.RollingWindow
+	}
+	return 0
+}
+
+func (x *Smoothing) GetEmaAlpha() float64 {
+	if x != nil {
+		return x.EmaAlpha
+	}
+	return 0
+}
+
+func (x *Smoothing) GetCumulative() bool {
+	if x != nil {
+		return x.Cumulative
+	}
+	return false
+}
+
 type ScorePoint struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
 	Date          *timestamppb.Timestamp `protobuf:"bytes,1,opt,name=date,proto3" json:"date,omitempty"`
 	Score         float32                `protobuf:"fixed32,2,opt,name=score,proto3" json:"score,omitempty"`
 	Count         *wrapperspb.Int32Value `protobuf:"bytes,3,opt,name=count,proto3" json:"count,omitempty"`
+	Source        ScorePointSource       `protobuf:"varint,4,opt,name=source,proto3,enum=analytics.ScorePointSource" json:"source,omitempty"`
+	Confidence    *ScoreConfidence       `protobuf:"bytes,5,opt,name=confidence,proto3" json:"confidence,omitempty"` // Set on observed category points when the request asks for it
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
 func (x *ScorePoint) Reset() {
 	*x = ScorePoint{}
-	mi := &file_category_score_proto_msgTypes[0]
+	mi := &file_category_score_proto_msgTypes[1]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -95,7 +267,7 @@ func (x *ScorePoint) String() string {
 func (*ScorePoint) ProtoMessage() {}
 
 func (x *ScorePoint) ProtoReflect() protoreflect.Message {
-	mi := &file_category_score_proto_msgTypes[0]
+	mi := &file_category_score_proto_msgTypes[1]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -108,7 +280,7 @@ func (x *ScorePoint) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use ScorePoint.ProtoReflect.Descriptor instead.
 func (*ScorePoint) Descriptor() ([]byte, []int) {
-	return file_category_score_proto_rawDescGZIP(), []int{0}
+	return file_category_score_proto_rawDescGZIP(), []int{1}
 }
 
 func (x *ScorePoint) GetDate() *timestamppb.Timestamp {
@@ -132,19 +304,35 @@ func (x *ScorePoint) GetCount() *wrapperspb.Int32Value {
 	return nil
 }
 
+func (x *ScorePoint) GetSource() ScorePointSource {
+	if x != nil {
+		return x.Source
+	}
+	return ScorePointSource_SCORE_POINT_SOURCE_OBSERVED
+}
+
+func (x *ScorePoint) GetConfidence() *ScoreConfidence {
+	if x != nil {
+		return x.Confidence
+	}
+	return nil
+}
+
 type CategorySeries struct {
 	state              protoimpl.MessageState `protogen:"open.v1"`
 	CategoryId         int32                  `protobuf:"varint,1,opt,name=category_id,json=categoryId,proto3" json:"category_id,omitempty"`
 	CategoryName       string                 `protobuf:"bytes,2,opt,name=category_name,json=categoryName,proto3" json:"category_name,omitempty"`
 	CategoryTotalCount int32                  `protobuf:"varint,3,opt,name=category_total_count,json=categoryTotalCount,proto3" json:"category_total_count,omitempty"`
 	Scores             []*ScorePoint          `protobuf:"bytes,4,rep,name=scores,proto3" json:"scores,omitempty"`
+	PeriodScore        float32                `protobuf:"fixed32,5,opt,name=period_score,json=periodScore,proto3" json:"period_score,omitempty"` // Category score over the whole range, as in GetOverallQualityScore
+	Smoothed           *SmoothedSeries        `protobuf:"bytes,6,opt,name=smoothed,proto3" json:"smoothed,omitempty"`                            // Set when the request asks for smoothing
 	unknownFields      protoimpl.UnknownFields
 	sizeCache          protoimpl.SizeCache
 }
 
 func (x *CategorySeries) Reset() {
 	*x = CategorySeries{}
-	mi := &file_category_score_proto_msgTypes[1]
+	mi := &file_category_score_proto_msgTypes[2]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -156,7 +344,7 @@ func (x *CategorySeries) String() string {
 func (*CategorySeries) ProtoMessage() {}
 
 func (x *CategorySeries) ProtoReflect() protoreflect.Message {
-	mi := &file_category_score_proto_msgTypes[1]
+	mi := &file_category_score_proto_msgTypes[2]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -169,7 +357,7 @@ func (x *CategorySeries) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use CategorySeries.ProtoReflect.Descriptor instead.
 func (*CategorySeries) Descriptor() ([]byte, []int) {
-	return file_category_score_proto_rawDescGZIP(), []int{1}
+	return file_category_score_proto_rawDescGZIP(), []int{2}
 }
 
 func (x *CategorySeries) GetCategoryId() int32 {
@@ -200,6 +388,83 @@ func (x *CategorySeries) GetScores() []*ScorePoint {
 	return nil
 }
 
+func (x *CategorySeries) GetPeriodScore() float32 {
+	if x != nil {
+		return x.PeriodScore
+	}
+	return 0
+}
+
+func (x *CategorySeries) GetSmoothed() *SmoothedSeries {
+	if x != nil {
+		return x.Smoothed
+	}
+	return nil
+}
+
+// SmoothedSeries has one point per raw ScorePoint, at the same dates, for every requested method.
+// count is the number of ratings behind the smoothed score; points with nothing to smooth yet are
+// SCORE_POINT_SOURCE_NULL
+type SmoothedSeries struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	RollingMean   []*ScorePoint          `protobuf:"bytes,1,rep,name=rolling_mean,json=rollingMean,proto3" json:"rolling_mean,omitempty"`
+	Ema           []*ScorePoint          `protobuf:"bytes,2,rep,name=ema,proto3" json:"ema,omitempty"`
+	Cumulative    []*ScorePoint          `protobuf:"bytes,3,rep,name=cumulative,proto3" json:"cumulative,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *SmoothedSeries) Reset() {
+	*x = SmoothedSeries{}
+	mi := &file_category_score_proto_msgTypes[3]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *SmoothedSeries) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SmoothedSeries) ProtoMessage() {}
+
+func (x *SmoothedSeries) ProtoReflect() protoreflect.Message {
+	mi := &file_category_score_proto_msgTypes[3]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SmoothedSeries.ProtoReflect.Descriptor instead.
+func (*SmoothedSeries) Descriptor() ([]byte, []int) {
+	return file_category_score_proto_rawDescGZIP(), []int{3}
+}
+
+func (x *SmoothedSeries) GetRollingMean() []*ScorePoint {
+	if x != nil {
+		return x.RollingMean
+	}
+	return nil
+}
+
+func (x *SmoothedSeries) GetEma() []*ScorePoint {
+	if x != nil {
+		return x.Ema
+	}
+	return nil
+}
+
+func (x *SmoothedSeries) GetCumulative() []*ScorePoint {
+	if x != nil {
+		return x.Cumulative
+	}
+	return nil
+}
+
 type BucketRange struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
 	Start         *timestamppb.Timestamp `protobuf:"bytes,1,opt,name=start,proto3" json:"start,omitempty"`
@@ -210,7 +475,7 @@ type BucketRange struct {
 
 func (x *BucketRange) Reset() {
 	*x = BucketRange{}
-	mi := &file_category_score_proto_msgTypes[2]
+	mi := &file_category_score_proto_msgTypes[4]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -222,7 +487,7 @@ func (x *BucketRange) String() string {
 func (*BucketRange) ProtoMessage() {}
 
 func (x *BucketRange) ProtoReflect() protoreflect.Message {
-	mi := &file_category_score_proto_msgTypes[2]
+	mi := &file_category_score_proto_msgTypes[4]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -235,7 +500,7 @@ func (x *BucketRange) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use BucketRange.ProtoReflect.Descriptor instead.
 func (*BucketRange) Descriptor() ([]byte, []int) {
-	return file_category_score_proto_rawDescGZIP(), []int{2}
+	return file_category_score_proto_rawDescGZIP(), []int{4}
 }
 
 func (x *BucketRange) GetStart() *timestamppb.Timestamp {
@@ -253,17 +518,20 @@ func (x *BucketRange) GetEnd() *timestamppb.Timestamp {
 }
 
 type AggregatedCategoryScoresResponse struct {
-	state         protoimpl.MessageState `protogen:"open.v1"`
-	Granularity   Granularity            `protobuf:"varint,1,opt,name=granularity,proto3,enum=analytics.Granularity" json:"granularity,omitempty"`
-	BucketRange   *BucketRange           `protobuf:"bytes,2,opt,name=bucket_range,json=bucketRange,proto3" json:"bucket_range,omitempty"`
-	Categories    []*CategorySeries      `protobuf:"bytes,3,rep,name=categories,proto3" json:"categories,omitempty"`
-	unknownFields protoimpl.UnknownFields
-	sizeCache     protoimpl.SizeCache
+	state           protoimpl.MessageState `protogen:"open.v1"`
+	Granularity     Granularity            `protobuf:"varint,1,opt,name=granularity,proto3,enum=analytics.Granularity" json:"granularity,omitempty"`
+	BucketRange     *BucketRange           `protobuf:"bytes,2,opt,name=bucket_range,json=bucketRange,proto3" json:"bucket_range,omitempty"`
+	Categories      []*CategorySeries      `protobuf:"bytes,3,rep,name=categories,proto3" json:"categories,omitempty"`
+	Range           *DateRange             `protobuf:"bytes,4,opt,name=range,proto3" json:"range,omitempty"`                                            // Range the ratings were filtered by
+	OverallScores   []*ScorePoint          `protobuf:"bytes,5,rep,name=overall_scores,json=overallScores,proto3" json:"overall_scores,omitempty"`       // Overall quality score per bucket, same formula as GetOverallQualityScore
+	OverallSmoothed *SmoothedSeries        `protobuf:"bytes,6,opt,name=overall_smoothed,json=overallSmoothed,proto3" json:"overall_smoothed,omitempty"` // Smoothed overall_scores, set when the request asks for smoothing
+	unknownFields   protoimpl.UnknownFields
+	sizeCache       protoimpl.SizeCache
 }
 
 func (x *AggregatedCategoryScoresResponse) Reset() {
 	*x = AggregatedCategoryScoresResponse{}
-	mi := &file_category_score_proto_msgTypes[3]
+	mi := &file_category_score_proto_msgTypes[5]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -275,7 +543,7 @@ func (x *AggregatedCategoryScoresResponse) String() string {
 func (*AggregatedCategoryScoresResponse) ProtoMessage() {}
 
 func (x *AggregatedCategoryScoresResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_category_score_proto_msgTypes[3]
+	mi := &file_category_score_proto_msgTypes[5]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -288,7 +556,7 @@ func (x *AggregatedCategoryScoresResponse) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use AggregatedCategoryScoresResponse.ProtoReflect.Descriptor instead.
 func (*AggregatedCategoryScoresResponse) Descriptor() ([]byte, []int) {
-	return file_category_score_proto_rawDescGZIP(), []int{3}
+	return file_category_score_proto_rawDescGZIP(), []int{5}
 }
 
 func (x *AggregatedCategoryScoresResponse) GetGranularity() Granularity {
@@ -312,35 +580,88 @@ func (x *AggregatedCategoryScoresResponse) GetCategories() []*CategorySeries {
 	return nil
 }
 
+func (x *AggregatedCategoryScoresResponse) GetRange() *DateRange {
+	if x != nil {
+		return x.Range
+	}
+	return nil
+}
+
+func (x *AggregatedCategoryScoresResponse) GetOverallScores() []*ScorePoint {
+	if x != nil {
+		return x.OverallScores
+	}
+	return nil
+}
+
+func (x *AggregatedCategoryScoresResponse) GetOverallSmoothed() *SmoothedSeries {
+	if x != nil {
+		return x.OverallSmoothed
+	}
+	return nil
+}
+
 var File_category_score_proto protoreflect.FileDescriptor
 
 const file_category_score_proto_rawDesc = "" +
 	"\n" +
-	"\x14category_score.proto\x12\tanalytics\x1a\x1fgoogle/protobuf/timestamp.proto\x1a\x1egoogle/protobuf/wrappers.proto\"\x85\x01\n" +
+	"\x14category_score.proto\x12\tanalytics\x1a\x1fgoogle/protobuf/timestamp.proto\x1a\x1egoogle/protobuf/wrappers.proto\x1a\x10date_range.proto\x1a\x16score_confidence.proto\"o\n" +
+	"\tSmoothing\x12%\n" +
+	"\x0erolling_window\x18\x01 \x01(\x05R\rrollingWindow\x12\x1b\n" +
+	"\tema_alpha\x18\x02 \x01(\x01R\bemaAlpha\x12\x1e\n" +
+	"\n" +
+	"cumulative\x18\x03 \x01(\bR\n" +
+	"cumulative\"\xf6\x01\n" +
 	"\n" +
 	"ScorePoint\x12.\n" +
 	"\x04date\x18\x01 \x01(\v2\x1a.google.protobuf.TimestampR\x04date\x12\x14\n" +
 	"\x05score\x18\x02 \x01(\x02R\x05score\x121\n" +
-	"\x05count\x18\x03 \x01(\v2\x1b.google.protobuf.Int32ValueR\x05count\"\xb7\x01\n" +
+	"\x05count\x18\x03 \x01(\v2\x1b.google.protobuf.Int32ValueR\x05count\x123\n" +
+	"\x06source\x18\x04 \x01(\x0e2\x1b.analytics.ScorePointSourceR\x06source\x12:\n" +
+	"\n" +
+	"confidence\x18\x05 \x01(\v2\x1a.analytics.ScoreConfidenceR\n" +
+	"confidence\"\x91\x02\n" +
 	"\x0eCategorySeries\x12\x1f\n" +
 	"\vcategory_id\x18\x01 \x01(\x05R\n" +
 	"categoryId\x12#\n" +
 	"\rcategory_name\x18\x02 \x01(\tR\fcategoryName\x120\n" +
 	"\x14category_total_count\x18\x03 \x01(\x05R\x12categoryTotalCount\x12-\n" +
-	"\x06scores\x18\x04 \x03(\v2\x15.analytics.ScorePointR\x06scores\"m\n" +
+	"\x06scores\x18\x04 \x03(\v2\x15.analytics.ScorePointR\x06scores\x12!\n" +
+	"\fperiod_score\x18\x05 \x01(\x02R\vperiodScore\x125\n" +
+	"\bsmoothed\x18\x06 \x01(\v2\x19.analytics.SmoothedSeriesR\bsmoothed\"\xaa\x01\n" +
+	"\x0eSmoothedSeries\x128\n" +
+	"\frolling_mean\x18\x01 \x03(\v2\x15.analytics.ScorePointR\vrollingMean\x12'\n" +
+	"\x03ema\x18\x02 \x03(\v2\x15.analytics.ScorePointR\x03ema\x125\n" +
+	"\n" +
+	"cumulative\x18\x03 \x03(\v2\x15.analytics.ScorePointR\n" +
+	"cumulative\"m\n" +
 	"\vBucketRange\x120\n" +
 	"\x05start\x18\x01 \x01(\v2\x1a.google.protobuf.TimestampR\x05start\x12,\n" +
-	"\x03end\x18\x02 \x01(\v2\x1a.google.protobuf.TimestampR\x03end\"\xd2\x01\n" +
+	"\x03end\x18\x02 \x01(\v2\x1a.google.protobuf.TimestampR\x03end\"\x82\x03\n" +
 	" AggregatedCategoryScoresResponse\x128\n" +
 	"\vgranularity\x18\x01 \x01(\x0e2\x16.analytics.GranularityR\vgranularity\x129\n" +
 	"\fbucket_range\x18\x02 \x01(\v2\x16.analytics.BucketRangeR\vbucketRange\x129\n" +
 	"\n" +
 	"categories\x18\x03 \x03(\v2\x19.analytics.CategorySeriesR\n" +
-	"categories*U\n" +
+	"categories\x12*\n" +
+	"\x05range\x18\x04 \x01(\v2\x14.analytics.DateRangeR\x05range\x12<\n" +
+	"\x0eoverall_scores\x18\x05 \x03(\v2\x15.analytics.ScorePointR\roverallScores\x12D\n" +
+	"\x10overall_smoothed\x18\x06 \x01(\v2\x19.analytics.SmoothedSeriesR\x0foverallSmoothed*U\n" +
 	"\vGranularity\x12\x1b\n" +
 	"\x17GRANULARITY_UNSPECIFIED\x10\x00\x12\x13\n" +
 	"\x0fGRANULARITY_DAY\x10\x01\x12\x14\n" +
-	"\x10GRANULARITY_WEEK\x10\x02B\x17Z\x15go-grpc-backend/protob\x06proto3"
+	"\x10GRANULARITY_WEEK\x10\x02*\x80\x01\n" +
+	"\bFillMode\x12\x19\n" +
+	"\x15FILL_MODE_UNSPECIFIED\x10\x00\x12\x12\n" +
+	"\x0eFILL_MODE_OMIT\x10\x01\x12\x12\n" +
+	"\x0eFILL_MODE_NULL\x10\x02\x12\x1b\n" +
+	"\x17FILL_MODE_CARRY_FORWARD\x10\x03\x12\x14\n" +
+	"\x10FILL_MODE_LINEAR\x10\x04*\x95\x01\n" +
+	"\x10ScorePointSource\x12\x1f\n" +
+	"\x1bSCORE_POINT_SOURCE_OBSERVED\x10\x00\x12\x1b\n" +
+	"\x17SCORE_POINT_SOURCE_NULL\x10\x01\x12\x1e\n" +
+	"\x1aSCORE_POINT_SOURCE_CARRIED\x10\x02\x12#\n" +
+	"\x1fSCORE_POINT_SOURCE_INTERPOLATED\x10\x03B\x17Z\x15go-grpc-backend/protob\x06proto3"
 
 var (
 	file_category_score_proto_rawDescOnce sync.Once
@@ -354,31 +675,46 @@ func file_category_score_proto_rawDescGZIP() []byte {
 	return file_category_score_proto_rawDescData
 }
 
-var file_category_score_proto_enumTypes = make([]protoimpl.EnumInfo, 1)
-var file_category_score_proto_msgTypes = make([]protoimpl.MessageInfo, 4)
+var file_category_score_proto_enumTypes = make([]protoimpl.EnumInfo, 3)
+var file_category_score_proto_msgTypes = make([]protoimpl.MessageInfo, 6)
 var file_category_score_proto_goTypes = []any{
 	(Granularity)(0),                         // 0: analytics.Granularity
-	(*ScorePoint)(nil),                       // 1: analytics.ScorePoint
-	(*CategorySeries)(nil),                   // 2: analytics.CategorySeries
-	(*BucketRange)(nil),                      // 3: analytics.BucketRange
-	(*AggregatedCategoryScoresResponse)(nil), // 4: analytics.AggregatedCategoryScoresResponse
-	(*timestamppb.Timestamp)(nil),            // 5: google.protobuf.Timestamp
-	(*wrapperspb.Int32Value)(nil),            // 6: google.protobuf.Int32Value
+	(FillMode)(0),                            // 1: analytics.FillMode
+	(ScorePointSource)(0),                    // 2: analytics.ScorePointSource
+	(*Smoothing)(nil),                        // 3: analytics.Smoothing
+	(*ScorePoint)(nil),                       // 4: analytics.ScorePoint
+	(*CategorySeries)(nil),                   // 5: analytics.CategorySeries
+	(*SmoothedSeries)(nil),                   // 6: analytics.SmoothedSeries
+	(*BucketRange)(nil),                      // 7: analytics.BucketRange
+	(*AggregatedCategoryScoresResponse)(nil), // 8: analytics.AggregatedCategoryScoresResponse
+	(*timestamppb.Timestamp)(nil),            // 9: google.protobuf.Timestamp
+	(*wrapperspb.Int32Value)(nil),            // 10: google.protobuf.Int32Value
+	(*ScoreConfidence)(nil),                  // 11: analytics.ScoreConfidence
+	(*DateRange)(nil),                        // 12: analytics.DateRange
 }
 var file_category_score_proto_depIdxs = []int32{
-	5, // 0: analytics.ScorePoint.date:type_name -> google.protobuf.Timestamp
-	6, // 1: analytics.ScorePoint.count:type_name -> google.protobuf.Int32Value
-	1, // 2: analytics.CategorySeries.scores:type_name -> analytics.ScorePoint
-	5, // 3: analytics.BucketRange.start:type_name -> google.protobuf.Timestamp
-	5, // 4: analytics.BucketRange.end:type_name -> google.protobuf.Timestamp
-	0, // 5: analytics.AggregatedCategoryScoresResponse.granularity:type_name -> analytics.Granularity
-	3, // 6: analytics.AggregatedCategoryScoresResponse.bucket_range:type_name -> analytics.BucketRange
-	2, // 7: analytics.AggregatedCategoryScoresResponse.categories:type_name -> analytics.CategorySeries
-	8, // [8:8] is the sub-list for method output_type
-	8, // [8:8] is the sub-list for method input_type
-	8, // [8:8] is the sub-list for extension type_name
-	8, // [8:8] is the sub-list for extension extendee
-	0, // [0:8] is the sub-list for field type_name
+	9,  // 0: analytics.ScorePoint.date:type_name -> google.protobuf.Timestamp
+	10, // 1: analytics.ScorePoint.count:type_name -> google.protobuf.Int32Value
+	2,  // 2: analytics.ScorePoint.source:type_name -> analytics.ScorePointSource
+	11, // 3: analytics.ScorePoint.confidence:type_name -> analytics.ScoreConfidence
+	4,  // 4: analytics.CategorySeries.scores:type_name -> analytics.ScorePoint
+	6,  // 5: analytics.CategorySeries.smoothed:type_name -> analytics.SmoothedSeries
+	4,  // 6: analytics.SmoothedSeries.rolling_mean:type_name -> analytics.ScorePoint
+	4,  // 7: analytics.SmoothedSeries.ema:type_name -> analytics.ScorePoint
+	4,  // 8: analytics.SmoothedSeries.cumulative:type_name -> analytics.ScorePoint
+	9,  // 9: analytics.BucketRange.start:type_name -> google.protobuf.Timestamp
+	9,  // 10: analytics.BucketRange.end:type_name -> google.protobuf.Timestamp
+	0,  // 11: analytics.AggregatedCategoryScoresResponse.granularity:type_name -> analytics.Granularity
+	7,  // 12: analytics.AggregatedCategoryScoresResponse.bucket_range:type_name -> analytics.BucketRange
+	5,  // 13: analytics.AggregatedCategoryScoresResponse.categories:type_name -> analytics.CategorySeries
+	12, // 14: analytics.AggregatedCategoryScoresResponse.range:type_name -> analytics.DateRange
+	4,  // 15: analytics.AggregatedCategoryScoresResponse.overall_scores:type_name -> analytics.ScorePoint
+	6,  // 16: analytics.AggregatedCategoryScoresResponse.overall_smoothed:type_name -> analytics.SmoothedSeries
+	17, // [17:17] is the sub-list for method output_type
+	17, // [17:17] is the sub-list for method input_type
+	17, // [17:17] is the sub-list for extension type_name
+	17, // [17:17] is the sub-list for extension extendee
+	0,  // [0:17] is the sub-list for field type_name
 }
 
 func init() { file_category_score_proto_init() }
@@ -386,13 +722,15 @@ func file_category_score_proto_init() {
 	if File_category_score_proto != nil {
 		return
 	}
+	file_date_range_proto_init()
+	file_score_confidence_proto_init()
 	type x struct{}
 	out := protoimpl.TypeBuilder{
 		File: protoimpl.DescBuilder{
 			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
 			RawDescriptor: unsafe.Slice(unsafe.StringData(file_category_score_proto_rawDesc), len(file_category_score_proto_rawDesc)),
-			NumEnums:      1,
-			NumMessages:   4,
+			NumEnums:      3,
+			NumMessages:   6,
 			NumExtensions: 0,
 			NumServices:   0,
 		},
@@ -0,0 +1,214 @@
+// Code generated by protoc-gen-go. DO NOT EDIT.
+// versions:
+// 	protoc-gen-go v1.36.9
+// 	protoc        v5.29.3
+// source: date_range.proto
+
+package proto
+
+import (
+	protoreflect "google.golang.org/protobuf/reflect/protoreflect"
+	protoimpl "google.golang.org/protobuf/runtime/protoimpl"
+	timestamppb "google.golang.org/protobuf/types/known/timestamppb"
+	reflect "reflect"
+	sync "sync"
+	unsafe "unsafe"
+)
+
+const (
+	// Verify that this generated code is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(20 - protoimpl.MinVersion)
+	// Verify that runtime/protoimpl is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(protoimpl.MaxVersion - 20)
+)
+
+// DateBasis selects the timestamp a range filters and buckets ratings by
+type DateBasis int32
+
+const (
+	DateBasis_DATE_BASIS_UNSPECIFIED    DateBasis = 0 // Same as DATE_BASIS_RATING_CREATED
+	DateBasis_DATE_BASIS_RATING_CREATED DateBasis = 1 // When the rating was created
+	DateBasis_DATE_BASIS_TICKET_CREATED DateBasis = 2 // When the rated ticket was created
+)
+
+// Enum value maps for DateBasis.
+var (
+	DateBasis_name = map[int32]string{
+		0: "DATE_BASIS_UNSPECIFIED",
+		1: "DATE_BASIS_RATING_CREATED",
+		2: "DATE_BASIS_TICKET_CREATED",
+	}
+	DateBasis_value = map[string]int32{
+		"DATE_BASIS_UNSPECIFIED":    0,
+		"DATE_BASIS_RATING_CREATED": 1,
+		"DATE_BASIS_TICKET_CREATED": 2,
+	}
+)
+
+func (x DateBasis) Enum() *DateBasis {
+	p := new(DateBasis)
+	*p = x
+	return p
+}
+
+func (x DateBasis) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
+}
+
+func (DateBasis) Descriptor() protoreflect.EnumDescriptor {
+	return file_date_range_proto_enumTypes[0].Descriptor()
+}
+
+func (DateBasis) Type() protoreflect.EnumType {
+	return &file_date_range_proto_enumTypes[0]
+}
+
+func (x DateBasis) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
+}
+
+// Deprecated: Use DateBasis.Descriptor instead.
+func (DateBasis) EnumDescriptor() ([]byte, []int) {
+	return file_date_range_proto_rawDescGZIP(), []int{0}
+}
+
+// DateRange is the window ratings are filtered by (on the created_at basis selects).
+// It is half-open, [start, end), unless inclusive_end is set, in which case it is [start, end].
+// Every response echoes the range it was computed over.
+type DateRange struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Start         *timestamppb.Timestamp `protobuf:"bytes,1,opt,name=start,proto3" json:"start,omitempty"`
+	End           *timestamppb.Timestamp `protobuf:"bytes,2,opt,name=end,proto3" json:"end,omitempty"`
+	InclusiveEnd  bool                   `protobuf:"varint,3,opt,name=inclusive_end,json=inclusiveEnd,proto3" json:"inclusive_end,omitempty"`
+	Basis         DateBasis              `protobuf:"varint,4,opt,name=basis,proto3,enum=analytics.DateBasis" json:"basis,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *DateRange) Reset() {
+	*x = DateRange{}
+	mi := &file_date_range_proto_msgTypes[0]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *DateRange) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*DateRange) ProtoMessage() {}
+
+func (x *DateRange) ProtoReflect() protoreflect.Message {
+	mi := &file_date_range_proto_msgTypes[0]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use DateRange.ProtoReflect.Descriptor instead.
+func (*DateRange) Descriptor() ([]byte, []int) {
+	return file_date_range_proto_rawDescGZIP(), []int{0}
+}
+
+func (x *DateRange) GetStart() *timestamppb.Timestamp {
+	if x != nil {
+		return x.Start
+	}
+	return nil
+}
+
+func (x *DateRange) GetEnd() *timestamppb.Timestamp {
+	if x != nil {
+		return x.End
+	}
+	return nil
+}
+
+func (x *DateRange) GetInclusiveEnd() bool {
+	if x != nil {
+		return x.InclusiveEnd
+	}
+	return false
+}
+
+func (x *DateRange) GetBasis() DateBasis {
+	if x != nil {
+		return x.Basis
+	}
+	return DateBasis_DATE_BASIS_UNSPECIFIED
+}
+
+var File_date_range_proto protoreflect.FileDescriptor
+
+const file_date_range_proto_rawDesc = "" +
+	"\n" +
+	"\x10date_range.proto\x12\tanalytics\x1a\x1fgoogle/protobuf/timestamp.proto\"\xbc\x01\n" +
+	"\tDateRange\x120\n" +
+	"\x05start\x18\x01 \x01(\v2\x1a.google.protobuf.TimestampR\x05start\x12,\n" +
+	"\x03end\x18\x02 \x01(\v2\x1a.google.protobuf.TimestampR\x03end\x12#\n" +
+	"\rinclusive_end\x18\x03 \x01(\bR\finclusiveEnd\x12*\n" +
+	"\x05basis\x18\x04 \x01(\x0e2\x14.analytics.DateBasisR\x05basis*e\n" +
+	"\tDateBasis\x12\x1a\n" +
+	"\x16DATE_BASIS_UNSPECIFIED\x10\x00\x12\x1d\n" +
+	"\x19DATE_BASIS_RATING_CREATED\x10\x01\x12\x1d\n" +
+	"\x19DATE_BASIS_TICKET_CREATED\x10\x02B\x17Z\x15go-grpc-backend/protob\x06proto3"
+
+var (
+	file_date_range_proto_rawDescOnce sync.Once
+	file_date_range_proto_rawDescData []byte
+)
+
+func file_date_range_proto_rawDescGZIP() []byte {
+	file_date_range_proto_rawDescOnce.Do(func() {
+		file_date_range_proto_rawDescData = protoimpl.X.CompressGZIP(unsafe.Slice(unsafe.StringData(file_date_range_proto_rawDesc), len(file_date_range_proto_rawDesc)))
+	})
+	return file_date_range_proto_rawDescData
+}
+
+var file_date_range_proto_enumTypes = make([]protoimpl.EnumInfo, 1)
+var file_date_range_proto_msgTypes = make([]protoimpl.MessageInfo, 1)
+var file_date_range_proto_goTypes = []any{
+	(DateBasis)(0),                // 0: analytics.DateBasis
+	(*DateRange)(nil),             // 1: analytics.DateRange
+	(*timestamppb.Timestamp)(nil), // 2: google.protobuf.Timestamp
+}
+var file_date_range_proto_depIdxs = []int32{
+	2, // 0: analytics.DateRange.start:type_name -> google.protobuf.Timestamp
+	2, // 1: analytics.DateRange.end:type_name -> google.protobuf.Timestamp
+	0, // 2: analytics.DateRange.basis:type_name -> analytics.DateBasis
+	3, // [3:3] is the sub-list for method output_type
+	3, // [3:3] is the sub-list for method input_type
+	3, // [3:3] is the sub-list for extension type_name
+	3, // [3:3] is the sub-list for extension extendee
+	0, // [0:3] is the sub-list for field type_name
+}
+
+func init() { file_date_range_proto_init() }
+func file_date_range_proto_init() {
+	if File_date_range_proto != nil {
+		return
+	}
+	type x struct{}
+	out := protoimpl.TypeBuilder{
+		File: protoimpl.DescBuilder{
+			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
+			RawDescriptor: unsafe.Slice(unsafe.StringData(file_date_range_proto_rawDesc), len(file_date_range_proto_rawDesc)),
+			NumEnums:      1,
+			NumMessages:   1,
+			NumExtensions: 0,
+			NumServices:   0,
+		},
+		GoTypes:           file_date_range_proto_goTypes,
+		DependencyIndexes: file_date_range_proto_depIdxs,
+		EnumInfos:         file_date_range_proto_enumTypes,
+		MessageInfos:      file_date_range_proto_msgTypes,
+	}.Build()
+	File_date_range_proto = out.File
+	file_date_range_proto_goTypes = nil
+	file_date_range_proto_depIdxs = nil
+}
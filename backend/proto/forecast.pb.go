@@ -0,0 +1,407 @@
+// Code generated by protoc-gen-go. DO NOT EDIT.
+// versions:
+// 	protoc-gen-go v1.36.9
+// 	protoc        v5.29.3
+// source: forecast.proto
+
+package proto
+
+import (
+	protoreflect "google.golang.org/protobuf/reflect/protoreflect"
+	protoimpl "google.golang.org/protobuf/runtime/protoimpl"
+	timestamppb "google.golang.org/protobuf/types/known/timestamppb"
+	reflect "reflect"
+	sync "sync"
+	unsafe "unsafe"
+)
+
+const (
+	// Verify that this generated code is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(20 - protoimpl.MinVersion)
+	// Verify that runtime/protoimpl is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(protoimpl.MaxVersion - 20)
+)
+
+type ScoreForecastRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	StartDate     *timestamppb.Timestamp `protobuf:"bytes,1,opt,name=start_date,json=startDate,proto3" json:"start_date,omitempty"` // History the model is fitted on
+	EndDate       *timestamppb.Timestamp `protobuf:"bytes,2,opt,name=end_date,json=endDate,proto3" json:"end_date,omitempty"`
+	InclusiveEnd  bool                   `protobuf:"varint,3,opt,name=inclusive_end,json=inclusiveEnd,proto3" json:"inclusive_end,omitempty"`                 // Also count ratings created exactly at end_date
+	HorizonDays   int32                  `protobuf:"varint,4,opt,name=horizon_days,json=horizonDays,proto3" json:"horizon_days,omitempty"`                    // Days to forecast after the history, 1-90
+	DateBasis     DateBasis              `protobuf:"varint,5,opt,name=date_basis,json=dateBasis,proto3,enum=analytics.DateBasis" json:"date_basis,omitempty"` // Which timestamp the range applies to; defaults to the rating's
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ScoreForecastRequest) Reset() {
+	*x = ScoreForecastRequest{}
+	mi := &file_forecast_proto_msgTypes[0]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ScoreForecastRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ScoreForecastRequest) ProtoMessage() {}
+
+func (x *ScoreForecastRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_forecast_proto_msgTypes[0]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ScoreForecastRequest.ProtoReflect.Descriptor instead.
+func (*ScoreForecastRequest) Descriptor() ([]byte, []int) {
+	return file_forecast_proto_rawDescGZIP(), []int{0}
+}
+
+func (x *ScoreForecastRequest) GetStartDate() *timestamppb.Timestamp {
+	if x != nil {
+		return x.StartDate
+	}
+	return nil
+}
+
+func (x *ScoreForecastRequest) GetEndDate() *timestamppb.Timestamp {
+	if x != nil {
+		return x.EndDate
+	}
+	return nil
+}
+
+func (x *ScoreForecastRequest) GetInclusiveEnd() bool {
+	if x != nil {
+		return x.InclusiveEnd
+	}
+	return false
+}
+
+func (x *ScoreForecastRequest) GetHorizonDays() int32 {
+	if x != nil {
+		return x.HorizonDays
+	}
+	return 0
+}
+
+func (x *ScoreForecastRequest) GetDateBasis() DateBasis {
+	if x != nil {
+		return x.DateBasis
+	}
+	return DateBasis_DATE_BASIS_UNSPECIFIED
+}
+
+type ForecastPoint struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Date          *timestamppb.Timestamp `protobuf:"bytes,1,opt,name=date,proto3" json:"date,omitempty"` // Day bucket start, UTC
+	Value         float32                `protobuf:"fixed32,2,opt,name=value,proto3" json:"value,omitempty"`
+	Lower         float32                `protobuf:"fixed32,3,opt,name=lower,proto3" json:"lower,omitempty"` // 95% prediction interval
+	Upper         float32                `protobuf:"fixed32,4,opt,name=upper,proto3" json:"upper,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ForecastPoint) Reset() {
+	*x = ForecastPoint{}
+	mi := &file_forecast_proto_msgTypes[1]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ForecastPoint) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ForecastPoint) ProtoMessage() {}
+
+func (x *ForecastPoint) ProtoReflect() protoreflect.Message {
+	mi := &file_forecast_proto_msgTypes[1]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ForecastPoint.ProtoReflect.Descriptor instead.
+func (*ForecastPoint) Descriptor() ([]byte, []int) {
+	return file_forecast_proto_rawDescGZIP(), []int{1}
+}
+
+func (x *ForecastPoint) GetDate() *timestamppb.Timestamp {
+	if x != nil {
+		return x.Date
+	}
+	return nil
+}
+
+func (x *ForecastPoint) GetValue() float32 {
+	if x != nil {
+		return x.Value
+	}
+	return 0
+}
+
+func (x *ForecastPoint) GetLower() float32 {
+	if x != nil {
+		return x.Lower
+	}
+	return 0
+}
+
+func (x *ForecastPoint) GetUpper() float32 {
+	if x != nil {
+		return x.Upper
+	}
+	return 0
+}
+
+type SeriesForecast struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Overall       bool                   `protobuf:"varint,1,opt,name=overall,proto3" json:"overall,omitempty"` // The overall quality score series; category fields are unset
+	CategoryId    int32                  `protobuf:"varint,2,opt,name=category_id,json=categoryId,proto3" json:"category_id,omitempty"`
+	CategoryName  string                 `protobuf:"bytes,3,opt,name=category_name,json=categoryName,proto3" json:"category_name,omitempty"`
+	HistoryDays   int32                  `protobuf:"varint,4,opt,name=history_days,json=historyDays,proto3" json:"history_days,omitempty"`    // Days with ratings the model was fitted on
+	TrendPerDay   float32                `protobuf:"fixed32,5,opt,name=trend_per_day,json=trendPerDay,proto3" json:"trend_per_day,omitempty"` // Fitted trend in score points per day
+	Points        []*ForecastPoint       `protobuf:"bytes,6,rep,name=points,proto3" json:"points,omitempty"`                                  // One per day of the horizon; empty when the history is too short
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *SeriesForecast) Reset() {
+	*x = SeriesForecast{}
+	mi := &file_forecast_proto_msgTypes[2]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *SeriesForecast) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SeriesForecast) ProtoMessage() {}
+
+func (x *SeriesForecast) ProtoReflect() protoreflect.Message {
+	mi := &file_forecast_proto_msgTypes[2]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SeriesForecast.ProtoReflect.Descriptor instead.
+func (*SeriesForecast) Descriptor() ([]byte, []int) {
+	return file_forecast_proto_rawDescGZIP(), []int{2}
+}
+
+func (x *SeriesForecast) GetOverall() bool {
+	if x != nil {
+		return x.Overall
+	}
+	return false
+}
+
+func (x *SeriesForecast) GetCategoryId() int32 {
+	if x != nil {
+		return x.CategoryId
+	}
+	return 0
+}
+
+func (x *SeriesForecast) GetCategoryName() string {
+	if x != nil {
+		return x.CategoryName
+	}
+	return ""
+}
+
+func (x *SeriesForecast) GetHistoryDays() int32 {
+	if x != nil {
+		return x.HistoryDays
+	}
+	return 0
+}
+
+func (x *SeriesForecast) GetTrendPerDay() float32 {
+	if x != nil {
+		return x.TrendPerDay
+	}
+	return 0
+}
+
+func (x *SeriesForecast) GetPoints() []*ForecastPoint {
+	if x != nil {
+		return x.Points
+	}
+	return nil
+}
+
+type ScoreForecastResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Overall       *SeriesForecast        `protobuf:"bytes,1,opt,name=overall,proto3" json:"overall,omitempty"`
+	Categories    []*SeriesForecast      `protobuf:"bytes,2,rep,name=categories,proto3" json:"categories,omitempty"` // Ordered by name
+	Range         *DateRange             `protobuf:"bytes,3,opt,name=range,proto3" json:"range,omitempty"`           // Range the history was filtered by
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ScoreForecastResponse) Reset() {
+	*x = ScoreForecastResponse{}
+	mi := &file_forecast_proto_msgTypes[3]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ScoreForecastResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ScoreForecastResponse) ProtoMessage() {}
+
+func (x *ScoreForecastResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_forecast_proto_msgTypes[3]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ScoreForecastResponse.ProtoReflect.Descriptor instead.
+func (*ScoreForecastResponse) Descriptor() ([]byte, []int) {
+	return file_forecast_proto_rawDescGZIP(), []int{3}
+}
+
+func (x *ScoreForecastResponse) GetOverall() *SeriesForecast {
+	if x != nil {
+		return x.Overall
+	}
+	return nil
+}
+
+func (x *ScoreForecastResponse) GetCategories() []*SeriesForecast {
+	if x != nil {
+		return x.Categories
+	}
+	return nil
+}
+
+func (x *ScoreForecastResponse) GetRange() *DateRange {
+	if x != nil {
+		return x.Range
+	}
+	return nil
+}
+
+var File_forecast_proto protoreflect.FileDescriptor
+
+const file_forecast_proto_rawDesc = "" +
+	"\n" +
+	"\x0eforecast.proto\x12\tanalytics\x1a\x1fgoogle/protobuf/timestamp.proto\x1a\x10date_range.proto\"\x85\x02\n" +
+	"\x14ScoreForecastRequest\x129\n" +
+	"\n" +
+	"start_date\x18\x01 \x01(\v2\x1a.google.protobuf.TimestampR\tstartDate\x125\n" +
+	"\bend_date\x18\x02 \x01(\v2\x1a.google.protobuf.TimestampR\aendDate\x12#\n" +
+	"\rinclusive_end\x18\x03 \x01(\bR\finclusiveEnd\x12!\n" +
+	"\fhorizon_days\x18\x04 \x01(\x05R\vhorizonDays\x123\n" +
+	"\n" +
+	"date_basis\x18\x05 \x01(\x0e2\x14.analytics.DateBasisR\tdateBasis\"\x81\x01\n" +
+	"\rForecastPoint\x12.\n" +
+	"\x04date\x18\x01 \x01(\v2\x1a.google.protobuf.TimestampR\x04date\x12\x14\n" +
+	"\x05value\x18\x02 \x01(\x02R\x05value\x12\x14\n" +
+	"\x05lower\x18\x03 \x01(\x02R\x05lower\x12\x14\n" +
+	"\x05upper\x18\x04 \x01(\x02R\x05upper\"\xe9\x01\n" +
+	"\x0eSeriesForecast\x12\x18\n" +
+	"\aoverall\x18\x01 \x01(\bR\aoverall\x12\x1f\n" +
+	"\vcategory_id\x18\x02 \x01(\x05R\n" +
+	"categoryId\x12#\n" +
+	"\rcategory_name\x18\x03 \x01(\tR\fcategoryName\x12!\n" +
+	"\fhistory_days\x18\x04 \x01(\x05R\vhistoryDays\x12\"\n" +
+	"\rtrend_per_day\x18\x05 \x01(\x02R\vtrendPerDay\x120\n" +
+	"\x06points\x18\x06 \x03(\v2\x18.analytics.ForecastPointR\x06points\"\xb3\x01\n" +
+	"\x15ScoreForecastResponse\x123\n" +
+	"\aoverall\x18\x01 \x01(\v2\x19.analytics.SeriesForecastR\aoverall\x129\n" +
+	"\n" +
+	"categories\x18\x02 \x03(\v2\x19.analytics.SeriesForecastR\n" +
+	"categories\x12*\n" +
+	"\x05range\x18\x03 \x01(\v2\x14.analytics.DateRangeR\x05rangeB\x17Z\x15go-grpc-backend/protob\x06proto3"
+
+var (
+	file_forecast_proto_rawDescOnce sync.Once
+	file_forecast_proto_rawDescData []byte
+)
+
+func file_forecast_proto_rawDescGZIP() []byte {
+	file_forecast_proto_rawDescOnce.Do(func() {
+		file_forecast_proto_rawDescData = protoimpl.X.CompressGZIP(unsafe.Slice(unsafe.StringData(file_forecast_proto_rawDesc), len(file_forecast_proto_rawDesc)))
+	})
+	return file_forecast_proto_rawDescData
+}
+
+var file_forecast_proto_msgTypes = make([]protoimpl.MessageInfo, 4)
+var file_forecast_proto_goTypes = []any{
+	(*ScoreForecastRequest)(nil),  // 0: analytics.ScoreForecastRequest
+	(*ForecastPoint)(nil),         // 1: analytics.ForecastPoint
+	(*SeriesForecast)(nil),        // 2: analytics.SeriesForecast
+	(*ScoreForecastResponse)(nil), // 3: analytics.ScoreForecastResponse
+	(*timestamppb.Timestamp)(nil), // 4: google.protobuf.Timestamp
+	(DateBasis)(0),                // 5: analytics.DateBasis
+	(*DateRange)(nil),             // 6: analytics.DateRange
+}
+var file_forecast_proto_depIdxs = []int32{
+	4, // 0: analytics.ScoreForecastRequest.start_date:type_name -> google.protobuf.Timestamp
+	4, // 1: analytics.ScoreForecastRequest.end_date:type_name -> google.protobuf.Timestamp
+	5, // 2: analytics.ScoreForecastRequest.date_basis:type_name -> analytics.DateBasis
+	4, // 3: analytics.ForecastPoint.date:type_name -> google.protobuf.Timestamp
+	1, // 4: analytics.SeriesForecast.points:type_name -> analytics.ForecastPoint
+	2, // 5: analytics.ScoreForecastResponse.overall:type_name -> analytics.SeriesForecast
+	2, // 6: analytics.ScoreForecastResponse.categories:type_name -> analytics.SeriesForecast
+	6, // 7: analytics.ScoreForecastResponse.range:type_name -> analytics.DateRange
+	8, // [8:8] is the sub-list for method output_type
+	8, // [8:8] is the sub-list for method input_type
+	8, // [8:8] is the sub-list for extension type_name
+	8, // [8:8] is the sub-list for extension extendee
+	0, // [0:8] is the sub-list for field type_name
+}
+
+func init() { file_forecast_proto_init() }
+func file_forecast_proto_init() {
+	if File_forecast_proto != nil {
+		return
+	}
+	file_date_range_proto_init()
+	type x struct{}
+	out := protoimpl.TypeBuilder{
+		File: protoimpl.DescBuilder{
+			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
+			RawDescriptor: unsafe.Slice(unsafe.StringData(file_forecast_proto_rawDesc), len(file_forecast_proto_rawDesc)),
+			NumEnums:      0,
+			NumMessages:   4,
+			NumExtensions: 0,
+			NumServices:   0,
+		},
+		GoTypes:           file_forecast_proto_goTypes,
+		DependencyIndexes: file_forecast_proto_depIdxs,
+		MessageInfos:      file_forecast_proto_msgTypes,
+	}.Build()
+	File_forecast_proto = out.File
+	file_forecast_proto_goTypes = nil
+	file_forecast_proto_depIdxs = nil
+}
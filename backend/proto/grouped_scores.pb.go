@@ -0,0 +1,481 @@
+// Code generated by protoc-gen-go. DO NOT EDIT.
+// versions:
+// 	protoc-gen-go v1.36.9
+// 	protoc        v5.29.3
+// source: grouped_scores.proto
+
+package proto
+
+import (
+	protoreflect "google.golang.org/protobuf/reflect/protoreflect"
+	protoimpl "google.golang.org/protobuf/runtime/protoimpl"
+	timestamppb "google.golang.org/protobuf/types/known/timestamppb"
+	reflect "reflect"
+	sync "sync"
+	unsafe "unsafe"
+)
+
+const (
+	// Verify that this generated code is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(20 - protoimpl.MinVersion)
+	// Verify that runtime/protoimpl is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(protoimpl.MaxVersion - 20)
+)
+
+// GroupBy picks the dimensions scores are grouped by; they combine freely.
+// Leaving every field unset returns a single group, scored like GetOverallQualityScore
+type GroupBy struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	AttributeKeys []string               `protobuf:"bytes,1,rep,name=attribute_keys,json=attributeKeys,proto3" json:"attribute_keys,omitempty"` // Ticket attributes, in order; at most 5
+	Reviewee      bool                   `protobuf:"varint,2,opt,name=reviewee,proto3" json:"reviewee,omitempty"`
+	Category      bool                   `protobuf:"varint,3,opt,name=category,proto3" json:"category,omitempty"`
+	Granularity   Granularity            `protobuf:"varint,4,opt,name=granularity,proto3,enum=analytics.Granularity" json:"granularity,omitempty"` // Time bucket; unspecified means none
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GroupBy) Reset() {
+	*x = GroupBy{}
+	mi := &file_grouped_scores_proto_msgTypes[0]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GroupBy) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GroupBy) ProtoMessage() {}
+
+func (x *GroupBy) ProtoReflect() protoreflect.Message {
+	mi := &file_grouped_scores_proto_msgTypes[0]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GroupBy.ProtoReflect.Descriptor instead.
+func (*GroupBy) Descriptor() ([]byte, []int) {
+	return file_grouped_scores_proto_rawDescGZIP(), []int{0}
+}
+
+func (x *GroupBy) GetAttributeKeys() []string {
+	if x != nil {
+		return x.AttributeKeys
+	}
+	return nil
+}
+
+func (x *GroupBy) GetReviewee() bool {
+	if x != nil {
+		return x.Reviewee
+	}
+	return false
+}
+
+func (x *GroupBy) GetCategory() bool {
+	if x != nil {
+		return x.Category
+	}
+	return false
+}
+
+func (x *GroupBy) GetGranularity() Granularity {
+	if x != nil {
+		return x.Granularity
+	}
+	return Granularity_GRANULARITY_UNSPECIFIED
+}
+
+type ScoresGroupedByRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	StartDate     *timestamppb.Timestamp `protobuf:"bytes,1,opt,name=start_date,json=startDate,proto3" json:"start_date,omitempty"`
+	EndDate       *timestamppb.Timestamp `protobuf:"bytes,2,opt,name=end_date,json=endDate,proto3" json:"end_date,omitempty"`
+	InclusiveEnd  bool                   `protobuf:"varint,3,opt,name=inclusive_end,json=inclusiveEnd,proto3" json:"inclusive_end,omitempty"`                 // Also count ratings created exactly at end_date
+	DateBasis     DateBasis              `protobuf:"varint,4,opt,name=date_basis,json=dateBasis,proto3,enum=analytics.DateBasis" json:"date_basis,omitempty"` // Which timestamp the range applies to; defaults to the rating's
+	GroupBy       *GroupBy               `protobuf:"bytes,5,opt,name=group_by,json=groupBy,proto3" json:"group_by,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ScoresGroupedByRequest) Reset() {
+	*x = ScoresGroupedByRequest{}
+	mi := &file_grouped_scores_proto_msgTypes[1]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ScoresGroupedByRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ScoresGroupedByRequest) ProtoMessage() {}
+
+func (x *ScoresGroupedByRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_grouped_scores_proto_msgTypes[1]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ScoresGroupedByRequest.ProtoReflect.Descriptor instead.
+func (*ScoresGroupedByRequest) Descriptor() ([]byte, []int) {
+	return file_grouped_scores_proto_rawDescGZIP(), []int{1}
+}
+
+func (x *ScoresGroupedByRequest) GetStartDate() *timestamppb.Timestamp {
+	if x != nil {
+		return x.StartDate
+	}
+	return nil
+}
+
+func (x *ScoresGroupedByRequest) GetEndDate() *timestamppb.Timestamp {
+	if x != nil {
+		return x.EndDate
+	}
+	return nil
+}
+
+func (x *ScoresGroupedByRequest) GetInclusiveEnd() bool {
+	if x != nil {
+		return x.InclusiveEnd
+	}
+	return false
+}
+
+func (x *ScoresGroupedByRequest) GetDateBasis() DateBasis {
+	if x != nil {
+		return x.DateBasis
+	}
+	return DateBasis_DATE_BASIS_UNSPECIFIED
+}
+
+func (x *ScoresGroupedByRequest) GetGroupBy() *GroupBy {
+	if x != nil {
+		return x.GroupBy
+	}
+	return nil
+}
+
+type AttributeValue struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Key           string                 `protobuf:"bytes,1,opt,name=key,proto3" json:"key,omitempty"`
+	Value         string                 `protobuf:"bytes,2,opt,name=value,proto3" json:"value,omitempty"` // Empty for tickets without the attribute
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *AttributeValue) Reset() {
+	*x = AttributeValue{}
+	mi := &file_grouped_scores_proto_msgTypes[2]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *AttributeValue) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*AttributeValue) ProtoMessage() {}
+
+func (x *AttributeValue) ProtoReflect() protoreflect.Message {
+	mi := &file_grouped_scores_proto_msgTypes[2]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use AttributeValue.ProtoReflect.Descriptor instead.
+func (*AttributeValue) Descriptor() ([]byte, []int) {
+	return file_grouped_scores_proto_rawDescGZIP(), []int{2}
+}
+
+func (x *AttributeValue) GetKey() string {
+	if x != nil {
+		return x.Key
+	}
+	return ""
+}
+
+func (x *AttributeValue) GetValue() string {
+	if x != nil {
+		return x.Value
+	}
+	return ""
+}
+
+// ScoreGroup is one combination of the requested dimensions; fields of dimensions
+// that weren't requested are left unset
+type ScoreGroup struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Attributes    []*AttributeValue      `protobuf:"bytes,1,rep,name=attributes,proto3" json:"attributes,omitempty"` // In the order of group_by.attribute_keys
+	RevieweeId    int32                  `protobuf:"varint,2,opt,name=reviewee_id,json=revieweeId,proto3" json:"reviewee_id,omitempty"`
+	RevieweeName  string                 `protobuf:"bytes,3,opt,name=reviewee_name,json=revieweeName,proto3" json:"reviewee_name,omitempty"`
+	CategoryId    int32                  `protobuf:"varint,4,opt,name=category_id,json=categoryId,proto3" json:"category_id,omitempty"`
+	CategoryName  string                 `protobuf:"bytes,5,opt,name=category_name,json=categoryName,proto3" json:"category_name,omitempty"`
+	Date          *timestamppb.Timestamp `protobuf:"bytes,6,opt,name=date,proto3" json:"date,omitempty"`     // Bucket start, UTC
+	Score         float64                `protobuf:"fixed64,7,opt,name=score,proto3" json:"score,omitempty"` // The category's score, or the overall score when not grouped by category
+	RatingCount   int32                  `protobuf:"varint,8,opt,name=rating_count,json=ratingCount,proto3" json:"rating_count,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ScoreGroup) Reset() {
+	*x = ScoreGroup{}
+	mi := &file_grouped_scores_proto_msgTypes[3]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ScoreGroup) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ScoreGroup) ProtoMessage() {}
+
+func (x *ScoreGroup) ProtoReflect() protoreflect.Message {
+	mi := &file_grouped_scores_proto_msgTypes[3]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ScoreGroup.ProtoReflect.Descriptor instead.
+func (*ScoreGroup) Descriptor() ([]byte, []int) {
+	return file_grouped_scores_proto_rawDescGZIP(), []int{3}
+}
+
+func (x *ScoreGroup) GetAttributes() []*AttributeValue {
+	if x != nil {
+		return x.Attributes
+	}
+	return nil
+}
+
+func (x *ScoreGroup) GetRevieweeId() int32 {
+	if x != nil {
+		return x.RevieweeId
+	}
+	return 0
+}
+
+func (x *ScoreGroup) GetRevieweeName() string {
+	if x != nil {
+		return x.RevieweeName
+	}
+	return ""
+}
+
+func (x *ScoreGroup) GetCategoryId() int32 {
+	if x != nil {
+		return x.CategoryId
+	}
+	return 0
+}
+
+func (x *ScoreGroup) GetCategoryName() string {
+	if x != nil {
+		return x.CategoryName
+	}
+	return ""
+}
+
+func (x *ScoreGroup) GetDate() *timestamppb.Timestamp {
+	if x != nil {
+		return x.Date
+	}
+	return nil
+}
+
+func (x *ScoreGroup) GetScore() float64 {
+	if x != nil {
+		return x.Score
+	}
+	return 0
+}
+
+func (x *ScoreGroup) GetRatingCount() int32 {
+	if x != nil {
+		return x.RatingCount
+	}
+	return 0
+}
+
+type ScoresGroupedByResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Range         *DateRange             `protobuf:"bytes,1,opt,name=range,proto3" json:"range,omitempty"`
+	Groups        []*ScoreGroup          `protobuf:"bytes,2,rep,name=groups,proto3" json:"groups,omitempty"` // Ordered by attribute values, reviewee name, date and category name
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ScoresGroupedByResponse) Reset() {
+	*x = ScoresGroupedByResponse{}
+	mi := &file_grouped_scores_proto_msgTypes[4]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ScoresGroupedByResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ScoresGroupedByResponse) ProtoMessage() {}
+
+func (x *ScoresGroupedByResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_grouped_scores_proto_msgTypes[4]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ScoresGroupedByResponse.ProtoReflect.Descriptor instead.
+func (*ScoresGroupedByResponse) Descriptor() ([]byte, []int) {
+	return file_grouped_scores_proto_rawDescGZIP(), []int{4}
+}
+
+func (x *ScoresGroupedByResponse) GetRange() *DateRange {
+	if x != nil {
+		return x.Range
+	}
+	return nil
+}
+
+func (x *ScoresGroupedByResponse) GetGroups() []*ScoreGroup {
+	if x != nil {
+		return x.Groups
+	}
+	return nil
+}
+
+var File_grouped_scores_proto protoreflect.FileDescriptor
+
+const file_grouped_scores_proto_rawDesc = "" +
+	"\n" +
+	"\x14grouped_scores.proto\x12\tanalytics\x1a\x1fgoogle/protobuf/timestamp.proto\x1a\x14category_score.proto\x1a\x10date_range.proto\"\xa2\x01\n" +
+	"\aGroupBy\x12%\n" +
+	"\x0eattribute_keys\x18\x01 \x03(\tR\rattributeKeys\x12\x1a\n" +
+	"\breviewee\x18\x02 \x01(\bR\breviewee\x12\x1a\n" +
+	"\bcategory\x18\x03 \x01(\bR\bcategory\x128\n" +
+	"\vgranularity\x18\x04 \x01(\x0e2\x16.analytics.GranularityR\vgranularity\"\x93\x02\n" +
+	"\x16ScoresGroupedByRequest\x129\n" +
+	"\n" +
+	"start_date\x18\x01 \x01(\v2\x1a.google.protobuf.TimestampR\tstartDate\x125\n" +
+	"\bend_date\x18\x02 \x01(\v2\x1a.google.protobuf.TimestampR\aendDate\x12#\n" +
+	"\rinclusive_end\x18\x03 \x01(\bR\finclusiveEnd\x123\n" +
+	"\n" +
+	"date_basis\x18\x04 \x01(\x0e2\x14.analytics.DateBasisR\tdateBasis\x12-\n" +
+	"\bgroup_by\x18\x05 \x01(\v2\x12.analytics.GroupByR\agroupBy\"8\n" +
+	"\x0eAttributeValue\x12\x10\n" +
+	"\x03key\x18\x01 \x01(\tR\x03key\x12\x14\n" +
+	"\x05value\x18\x02 \x01(\tR\x05value\"\xbc\x02\n" +
+	"\n" +
+	"ScoreGroup\x129\n" +
+	"\n" +
+	"attributes\x18\x01 \x03(\v2\x19.analytics.AttributeValueR\n" +
+	"attributes\x12\x1f\n" +
+	"\vreviewee_id\x18\x02 \x01(\x05R\n" +
+	"revieweeId\x12#\n" +
+	"\rreviewee_name\x18\x03 \x01(\tR\frevieweeName\x12\x1f\n" +
+	"\vcategory_id\x18\x04 \x01(\x05R\n" +
+	"categoryId\x12#\n" +
+	"\rcategory_name\x18\x05 \x01(\tR\fcategoryName\x12.\n" +
+	"\x04date\x18\x06 \x01(\v2\x1a.google.protobuf.TimestampR\x04date\x12\x14\n" +
+	"\x05score\x18\a \x01(\x01R\x05score\x12!\n" +
+	"\frating_count\x18\b \x01(\x05R\vratingCount\"t\n" +
+	"\x17ScoresGroupedByResponse\x12*\n" +
+	"\x05range\x18\x01 \x01(\v2\x14.analytics.DateRangeR\x05range\x12-\n" +
+	"\x06groups\x18\x02 \x03(\v2\x15.analytics.ScoreGroupR\x06groupsB\x17Z\x15go-grpc-backend/protob\x06proto3"
+
+var (
+	file_grouped_scores_proto_rawDescOnce sync.Once
+	file_grouped_scores_proto_rawDescData []byte
+)
+
+func file_grouped_scores_proto_rawDescGZIP() []byte {
+	file_grouped_scores_proto_rawDescOnce.Do(func() {
+		file_grouped_scores_proto_rawDescData = protoimpl.X.CompressGZIP(unsafe.Slice(unsafe.StringData(file_grouped_scores_proto_rawDesc), len(file_grouped_scores_proto_rawDesc)))
+	})
+	return file_grouped_scores_proto_rawDescData
+}
+
+var file_grouped_scores_proto_msgTypes = make([]protoimpl.MessageInfo, 5)
+var file_grouped_scores_proto_goTypes = []any{
+	(*GroupBy)(nil),                 // 0: analytics.GroupBy
+	(*ScoresGroupedByRequest)(nil),  // 1: analytics.ScoresGroupedByRequest
+	(*AttributeValue)(nil),          // 2: analytics.AttributeValue
+	(*ScoreGroup)(nil),              // 3: analytics.ScoreGroup
+	(*ScoresGroupedByResponse)(nil), // 4: analytics.ScoresGroupedByResponse
+	(Granularity)(0),                // 5: analytics.Granularity
+	(*timestamppb.Timestamp)(nil),   // 6: google.protobuf.Timestamp
+	(DateBasis)(0),                  // 7: analytics.DateBasis
+	(*DateRange)(nil),               // 8: analytics.DateRange
+}
+var file_grouped_scores_proto_depIdxs = []int32{
+	5, // 0: analytics.GroupBy.granularity:type_name -> analytics.Granularity
+	6, // 1: analytics.ScoresGroupedByRequest.start_date:type_name -> google.protobuf.Timestamp
+	6, // 2: analytics.ScoresGroupedByRequest.end_date:type_name -> google.protobuf.Timestamp
+	7, // 3: analytics.ScoresGroupedByRequest.date_basis:type_name -> analytics.DateBasis
+	0, // 4: analytics.ScoresGroupedByRequest.group_by:type_name -> analytics.GroupBy
+	2, // 5: analytics.ScoreGroup.attributes:type_name -> analytics.AttributeValue
+	6, // 6: analytics.ScoreGroup.date:type_name -> google.protobuf.Timestamp
+	8, // 7: analytics.ScoresGroupedByResponse.range:type_name -> analytics.DateRange
+	3, // 8: analytics.ScoresGroupedByResponse.groups:type_name -> analytics.ScoreGroup
+	9, // [9:9] is the sub-list for method output_type
+	9, // [9:9] is the sub-list for method input_type
+	9, // [9:9] is the sub-list for extension type_name
+	9, // [9:9] is the sub-list for extension extendee
+	0, // [0:9] is the sub-list for field type_name
+}
+
+func init() { file_grouped_scores_proto_init() }
+func file_grouped_scores_proto_init() {
+	if File_grouped_scores_proto != nil {
+		return
+	}
+	file_category_score_proto_init()
+	file_date_range_proto_init()
+	type x struct{}
+	out := protoimpl.TypeBuilder{
+		File: protoimpl.DescBuilder{
+			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
+			RawDescriptor: unsafe.Slice(unsafe.StringData(file_grouped_scores_proto_rawDesc), len(file_grouped_scores_proto_rawDesc)),
+			NumEnums:      0,
+			NumMessages:   5,
+			NumExtensions: 0,
+			NumServices:   0,
+		},
+		GoTypes:           file_grouped_scores_proto_goTypes,
+		DependencyIndexes: file_grouped_scores_proto_depIdxs,
+		MessageInfos:      file_grouped_scores_proto_msgTypes,
+	}.Build()
+	File_grouped_scores_proto = out.File
+	file_grouped_scores_proto_goTypes = nil
+	file_grouped_scores_proto_depIdxs = nil
+}
@@ -0,0 +1,342 @@
+// Code generated by protoc-gen-go. DO NOT EDIT.
+// versions:
+// 	protoc-gen-go v1.36.9
+// 	protoc        v5.29.3
+// source: lowest_scoring_tickets.proto
+
+package proto
+
+import (
+	protoreflect "google.golang.org/protobuf/reflect/protoreflect"
+	protoimpl "google.golang.org/protobuf/runtime/protoimpl"
+	timestamppb "google.golang.org/protobuf/types/known/timestamppb"
+	reflect "reflect"
+	sync "sync"
+	unsafe "unsafe"
+)
+
+const (
+	// Verify that this generated code is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(20 - protoimpl.MinVersion)
+	// Verify that runtime/protoimpl is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(protoimpl.MaxVersion - 20)
+)
+
+type LowestScoringTicketsRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	StartDate     *timestamppb.Timestamp `protobuf:"bytes,1,opt,name=start_date,json=startDate,proto3" json:"start_date,omitempty"`
+	EndDate       *timestamppb.Timestamp `protobuf:"bytes,2,opt,name=end_date,json=endDate,proto3" json:"end_date,omitempty"`
+	InclusiveEnd  bool                   `protobuf:"varint,3,opt,name=inclusive_end,json=inclusiveEnd,proto3" json:"inclusive_end,omitempty"`                 // Also count ratings created exactly at end_date
+	Limit         int32                  `protobuf:"varint,4,opt,name=limit,proto3" json:"limit,omitempty"`                                                   // Tickets to return, 1-1000; 0 or unset returns 10
+	MinRatings    int32                  `protobuf:"varint,5,opt,name=min_ratings,json=minRatings,proto3" json:"min_ratings,omitempty"`                       // Skip tickets with fewer ratings than this in the range
+	CategoryIds   []int32                `protobuf:"varint,6,rep,packed,name=category_ids,json=categoryIds,proto3" json:"category_ids,omitempty"`             // Only score these categories; empty means all
+	DateBasis     DateBasis              `protobuf:"varint,7,opt,name=date_basis,json=dateBasis,proto3,enum=analytics.DateBasis" json:"date_basis,omitempty"` // Which timestamp the range applies to; defaults to the rating's
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *LowestScoringTicketsRequest) Reset() {
+	*x = LowestScoringTicketsRequest{}
+	mi := &file_lowest_scoring_tickets_proto_msgTypes[0]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *LowestScoringTicketsRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*LowestScoringTicketsRequest) ProtoMessage() {}
+
+func (x *LowestScoringTicketsRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_lowest_scoring_tickets_proto_msgTypes[0]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use LowestScoringTicketsRequest.ProtoReflect.Descriptor instead.
+func (*LowestScoringTicketsRequest) Descriptor() ([]byte, []int) {
+	return file_lowest_scoring_tickets_proto_rawDescGZIP(), []int{0}
+}
+
+func (x *LowestScoringTicketsRequest) GetStartDate() *timestamppb.Timestamp {
+	if x != nil {
+		return x.StartDate
+	}
+	return nil
+}
+
+func (x *LowestScoringTicketsRequest) GetEndDate() *timestamppb.Timestamp {
+	if x != nil {
+		return x.EndDate
+	}
+	return nil
+}
+
+func (x *LowestScoringTicketsRequest) GetInclusiveEnd() bool {
+	if x != nil {
+		return x.InclusiveEnd
+	}
+	return false
+}
+
+func (x *LowestScoringTicketsRequest) GetLimit() int32 {
+	if x != nil {
+		return x.Limit
+	}
+	return 0
+}
+
+func (x *LowestScoringTicketsRequest) GetMinRatings() int32 {
+	if x != nil {
+		return x.MinRatings
+	}
+	return 0
+}
+
+func (x *LowestScoringTicketsRequest) GetCategoryIds() []int32 {
+	if x != nil {
+		return x.CategoryIds
+	}
+	return nil
+}
+
+func (x *LowestScoringTicketsRequest) GetDateBasis() DateBasis {
+	if x != nil {
+		return x.DateBasis
+	}
+	return DateBasis_DATE_BASIS_UNSPECIFIED
+}
+
+type RankedTicket struct {
+	state          protoimpl.MessageState    `protogen:"open.v1"`
+	TicketId       int32                     `protobuf:"varint,1,opt,name=ticket_id,json=ticketId,proto3" json:"ticket_id,omitempty"`
+	Subject        string                    `protobuf:"bytes,2,opt,name=subject,proto3" json:"subject,omitempty"`
+	CreatedAt      *timestamppb.Timestamp    `protobuf:"bytes,3,opt,name=created_at,json=createdAt,proto3" json:"created_at,omitempty"` // When the ticket was created
+	Score          float32                   `protobuf:"fixed32,4,opt,name=score,proto3" json:"score,omitempty"`                        // Overall score of the ticket's categories, as in GetOverallQualityScore
+	RatingCount    int32                     `protobuf:"varint,5,opt,name=rating_count,json=ratingCount,proto3" json:"rating_count,omitempty"`
+	CategoryScores []*CategoryScoreForTicket `protobuf:"bytes,6,rep,name=category_scores,json=categoryScores,proto3" json:"category_scores,omitempty"` // Ordered by category name
+	unknownFields  protoimpl.UnknownFields
+	sizeCache      protoimpl.SizeCache
+}
+
+func (x *RankedTicket) Reset() {
+	*x = RankedTicket{}
+	mi := &file_lowest_scoring_tickets_proto_msgTypes[1]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *RankedTicket) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*RankedTicket) ProtoMessage() {}
+
+func (x *RankedTicket) ProtoReflect() protoreflect.Message {
+	mi := &file_lowest_scoring_tickets_proto_msgTypes[1]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use RankedTicket.ProtoReflect.Descriptor instead.
+func (*RankedTicket) Descriptor() ([]byte, []int) {
+	return file_lowest_scoring_tickets_proto_rawDescGZIP(), []int{1}
+}
+
+func (x *RankedTicket) GetTicketId() int32 {
+	if x != nil {
+		return x.TicketId
+	}
+	return 0
+}
+
+func (x *RankedTicket) GetSubject() string {
+	if x != nil {
+		return x.Subject
+	}
+	return ""
+}
+
+func (x *RankedTicket) GetCreatedAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.CreatedAt
+	}
+	return nil
+}
+
+func (x *RankedTicket) GetScore() float32 {
+	if x != nil {
+		return x.Score
+	}
+	return 0
+}
+
+func (x *RankedTicket) GetRatingCount() int32 {
+	if x != nil {
+		return x.RatingCount
+	}
+	return 0
+}
+
+func (x *RankedTicket) GetCategoryScores() []*CategoryScoreForTicket {
+	if x != nil {
+		return x.CategoryScores
+	}
+	return nil
+}
+
+type LowestScoringTicketsResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Tickets       []*RankedTicket        `protobuf:"bytes,1,rep,name=tickets,proto3" json:"tickets,omitempty"` // Lowest score first; ties go to the ticket with more ratings, then the lower id
+	Range         *DateRange             `protobuf:"bytes,2,opt,name=range,proto3" json:"range,omitempty"`     // Range the ratings were filtered by
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *LowestScoringTicketsResponse) Reset() {
+	*x = LowestScoringTicketsResponse{}
+	mi := &file_lowest_scoring_tickets_proto_msgTypes[2]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *LowestScoringTicketsResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*LowestScoringTicketsResponse) ProtoMessage() {}
+
+func (x *LowestScoringTicketsResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_lowest_scoring_tickets_proto_msgTypes[2]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use LowestScoringTicketsResponse.ProtoReflect.Descriptor instead.
+func (*LowestScoringTicketsResponse) Descriptor() ([]byte, []int) {
+	return file_lowest_scoring_tickets_proto_rawDescGZIP(), []int{2}
+}
+
+func (x *LowestScoringTicketsResponse) GetTickets() []*RankedTicket {
+	if x != nil {
+		return x.Tickets
+	}
+	return nil
+}
+
+func (x *LowestScoringTicketsResponse) GetRange() *DateRange {
+	if x != nil {
+		return x.Range
+	}
+	return nil
+}
+
+var File_lowest_scoring_tickets_proto protoreflect.FileDescriptor
+
+const file_lowest_scoring_tickets_proto_rawDesc = "" +
+	"\n" +
+	"\x1clowest_scoring_tickets.proto\x12\tanalytics\x1a\x1fgoogle/protobuf/timestamp.proto\x1a\x10date_range.proto\x1a\x12ticket_score.proto\"\xc3\x02\n" +
+	"\x1bLowestScoringTicketsRequest\x129\n" +
+	"\n" +
+	"start_date\x18\x01 \x01(\v2\x1a.google.protobuf.TimestampR\tstartDate\x125\n" +
+	"\bend_date\x18\x02 \x01(\v2\x1a.google.protobuf.TimestampR\aendDate\x12#\n" +
+	"\rinclusive_end\x18\x03 \x01(\bR\finclusiveEnd\x12\x14\n" +
+	"\x05limit\x18\x04 \x01(\x05R\x05limit\x12\x1f\n" +
+	"\vmin_ratings\x18\x05 \x01(\x05R\n" +
+	"minRatings\x12!\n" +
+	"\fcategory_ids\x18\x06 \x03(\x05R\vcategoryIds\x123\n" +
+	"\n" +
+	"date_basis\x18\a \x01(\x0e2\x14.analytics.DateBasisR\tdateBasis\"\x85\x02\n" +
+	"\fRankedTicket\x12\x1b\n" +
+	"\tticket_id\x18\x01 \x01(\x05R\bticketId\x12\x18\n" +
+	"\asubject\x18\x02 \x01(\tR\asubject\x129\n" +
+	"\n" +
+	"created_at\x18\x03 \x01(\v2\x1a.google.protobuf.TimestampR\tcreatedAt\x12\x14\n" +
+	"\x05score\x18\x04 \x01(\x02R\x05score\x12!\n" +
+	"\frating_count\x18\x05 \x01(\x05R\vratingCount\x12J\n" +
+	"\x0fcategory_scores\x18\x06 \x03(\v2!.analytics.CategoryScoreForTicketR\x0ecategoryScores\"}\n" +
+	"\x1cLowestScoringTicketsResponse\x121\n" +
+	"\atickets\x18\x01 \x03(\v2\x17.analytics.RankedTicketR\atickets\x12*\n" +
+	"\x05range\x18\x02 \x01(\v2\x14.analytics.DateRangeR\x05rangeB\x17Z\x15go-grpc-backend/protob\x06proto3"
+
+var (
+	file_lowest_scoring_tickets_proto_rawDescOnce sync.Once
+	file_lowest_scoring_tickets_proto_rawDescData []byte
+)
+
+func file_lowest_scoring_tickets_proto_rawDescGZIP() []byte {
+	file_lowest_scoring_tickets_proto_rawDescOnce.Do(func() {
+		file_lowest_scoring_tickets_proto_rawDescData = protoimpl.X.CompressGZIP(unsafe.Slice(unsafe.StringData(file_lowest_scoring_tickets_proto_rawDesc), len(file_lowest_scoring_tickets_proto_rawDesc)))
+	})
+	return file_lowest_scoring_tickets_proto_rawDescData
+}
+
+var file_lowest_scoring_tickets_proto_msgTypes = make([]protoimpl.MessageInfo, 3)
+var file_lowest_scoring_tickets_proto_goTypes = []any{
+	(*LowestScoringTicketsRequest)(nil),  // 0: analytics.LowestScoringTicketsRequest
+	(*RankedTicket)(nil),                 // 1: analytics.RankedTicket
+	(*LowestScoringTicketsResponse)(nil), // 2: analytics.LowestScoringTicketsResponse
+	(*timestamppb.Timestamp)(nil),        // 3: google.protobuf.Timestamp
+	(DateBasis)(0),                       // 4: analytics.DateBasis
+	(*CategoryScoreForTicket)(nil),       // 5: analytics.CategoryScoreForTicket
+	(*DateRange)(nil),                    // 6: analytics.DateRange
+}
+var file_lowest_scoring_tickets_proto_depIdxs = []int32{
+	3, // 0: analytics.LowestScoringTicketsRequest.start_date:type_name -> google.protobuf.Timestamp
+	3, // 1: analytics.LowestScoringTicketsRequest.end_date:type_name -> google.protobuf.Timestamp
+	4, // 2: analytics.LowestScoringTicketsRequest.date_basis:type_name -> analytics.DateBasis
+	3, // 3: analytics.RankedTicket.created_at:type_name -> google.protobuf.Timestamp
+	5, // 4: analytics.RankedTicket.category_scores:type_name -> analytics.CategoryScoreForTicket
+	1, // 5: analytics.LowestScoringTicketsResponse.tickets:type_name -> analytics.RankedTicket
+	6, // 6: analytics.LowestScoringTicketsResponse.range:type_name -> analytics.DateRange
+	7, // [7:7] is the sub-list for method output_type
+	7, // [7:7] is the sub-list for method input_type
+	7, // [7:7] is the sub-list for extension type_name
+	7, // [7:7] is the sub-list for extension extendee
+	0, // [0:7] is the sub-list for field type_name
+}
+
+func init() { file_lowest_scoring_tickets_proto_init() }
+func file_lowest_scoring_tickets_proto_init() {
+	if File_lowest_scoring_tickets_proto != nil {
+		return
+	}
+	file_date_range_proto_init()
+	file_ticket_score_proto_init()
+	type x struct{}
+	out := protoimpl.TypeBuilder{
+		File: protoimpl.DescBuilder{
+			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
+			RawDescriptor: unsafe.Slice(unsafe.StringData(file_lowest_scoring_tickets_proto_rawDesc), len(file_lowest_scoring_tickets_proto_rawDesc)),
+			NumEnums:      0,
+			NumMessages:   3,
+			NumExtensions: 0,
+			NumServices:   0,
+		},
+		GoTypes:           file_lowest_scoring_tickets_proto_goTypes,
+		DependencyIndexes: file_lowest_scoring_tickets_proto_depIdxs,
+		MessageInfos:      file_lowest_scoring_tickets_proto_msgTypes,
+	}.Build()
+	File_lowest_scoring_tickets_proto = out.File
+	file_lowest_scoring_tickets_proto_goTypes = nil
+	file_lowest_scoring_tickets_proto_depIdxs = nil
+}
@@ -26,6 +26,8 @@ type OverallQualityScoreRequest struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
 	StartDate     *timestamppb.Timestamp `protobuf:"bytes,1,opt,name=start_date,json=startDate,proto3" json:"start_date,omitempty"`
 	EndDate       *timestamppb.Timestamp `protobuf:"bytes,2,opt,name=end_date,json=endDate,proto3" json:"end_date,omitempty"`
+	InclusiveEnd  bool                   `protobuf:"varint,3,opt,name=inclusive_end,json=inclusiveEnd,proto3" json:"inclusive_end,omitempty"`                 // Also count ratings created exactly at end_date
+	DateBasis     DateBasis              `protobuf:"varint,4,opt,name=date_basis,json=dateBasis,proto3,enum=analytics.DateBasis" json:"date_basis,omitempty"` // Which timestamp the range applies to; defaults to the rating's
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
@@ -74,12 +76,27 @@ func (x *OverallQualityScoreRequest) GetEndDate() *timestamppb.Timestamp {
 	return nil
 }
 
+func (x *OverallQualityScoreRequest) GetInclusiveEnd() bool {
+	if x != nil {
+		return x.InclusiveEnd
+	}
+	return false
+}
+
+func (x *OverallQualityScoreRequest) GetDateBasis() DateBasis {
+	if x != nil {
+		return x.DateBasis
+	}
+	return DateBasis_DATE_BASIS_UNSPECIFIED
+}
+
 type OverallQualityScoreResponse struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
 	OverallScore  float32                `protobuf:"fixed32,1,opt,name=overall_score,json=overallScore,proto3" json:"overall_score,omitempty"` // Overall score as percentage (0-100)
 	TotalRatings  int32                  `protobuf:"varint,2,opt,name=total_ratings,json=totalRatings,proto3" json:"total_ratings,omitempty"`  // Total number of ratings in the period
 	StartDate     *timestamppb.Timestamp `protobuf:"bytes,3,opt,name=start_date,json=startDate,proto3" json:"start_date,omitempty"`
 	EndDate       *timestamppb.Timestamp `protobuf:"bytes,4,opt,name=end_date,json=endDate,proto3" json:"end_date,omitempty"`
+	Range         *DateRange             `protobuf:"bytes,5,opt,name=range,proto3" json:"range,omitempty"` // Range the ratings were filtered by
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
@@ -142,21 +159,32 @@ func (x *OverallQualityScoreResponse) GetEndDate() *timestamppb.Timestamp {
 	return nil
 }
 
+func (x *OverallQualityScoreResponse) GetRange() *DateRange {
+	if x != nil {
+		return x.Range
+	}
+	return nil
+}
+
 var File_overall_quality_score_proto protoreflect.FileDescriptor
 
 const file_overall_quality_score_proto_rawDesc = "" +
 	"\n" +
-	"\x1boverall_quality_score.proto\x12\tanalytics\x1a\x1fgoogle/protobuf/timestamp.proto\"\x8e\x01\n" +
+	"\x1boverall_quality_score.proto\x12\tanalytics\x1a\x1fgoogle/protobuf/timestamp.proto\x1a\x10date_range.proto\"\xe8\x01\n" +
 	"\x1aOverallQualityScoreRequest\x129\n" +
 	"\n" +
 	"start_date\x18\x01 \x01(\v2\x1a.google.protobuf.TimestampR\tstartDate\x125\n" +
-	"\bend_date\x18\x02 \x01(\v2\x1a.google.protobuf.TimestampR\aendDate\"\xd9\x01\n" +
+	"\bend_date\x18\x02 \x01(\v2\x1a.google.protobuf.TimestampR\aendDate\x12#\n" +
+	"\rinclusive_end\x18\x03 \x01(\bR\finclusiveEnd\x123\n" +
+	"\n" +
+	"date_basis\x18\x04 \x01(\x0e2\x14.analytics.DateBasisR\tdateBasis\"\x85\x02\n" +
 	"\x1bOverallQualityScoreResponse\x12#\n" +
 	"\roverall_score\x18\x01 \x01(\x02R\foverallScore\x12#\n" +
 	"\rtotal_ratings\x18\x02 \x01(\x05R\ftotalRatings\x129\n" +
 	"\n" +
 	"start_date\x18\x03 \x01(\v2\x1a.google.protobuf.TimestampR\tstartDate\x125\n" +
-	"\bend_date\x18\x04 \x01(\v2\x1a.google.protobuf.TimestampR\aendDateB\x17Z\x15go-grpc-backend/protob\x06proto3"
+	"\bend_date\x18\x04 \x01(\v2\x1a.google.protobuf.TimestampR\aendDate\x12*\n" +
+	"\x05range\x18\x05 \x01(\v2\x14.analytics.DateRangeR\x05rangeB\x17Z\x15go-grpc-backend/protob\x06proto3"
 
 var (
 	file_overall_quality_score_proto_rawDescOnce sync.Once
@@ -175,17 +203,21 @@ var file_overall_quality_score_proto_goTypes = []any{
 	(*OverallQualityScoreRequest)(nil),  // 0: analytics.OverallQualityScoreRequest
 	(*OverallQualityScoreResponse)(nil), // 1: analytics.OverallQualityScoreResponse
 	(*timestamppb.Timestamp)(nil),       // 2: google.protobuf.Timestamp
+	(DateBasis)(0),                      // 3: analytics.DateBasis
+	(*DateRange)(nil),                   // 4: analytics.DateRange
 }
 var file_overall_quality_score_proto_depIdxs = []int32{
 	2, // 0: analytics.OverallQualityScoreRequest.start_date:type_name -> google.protobuf.Timestamp
 	2, // 1: analytics.OverallQualityScoreRequest.end_date:type_name -> google.protobuf.Timestamp
-	2, // 2: analytics.OverallQualityScoreResponse.start_date:type_name -> google.protobuf.Timestamp
-	2, // 3: analytics.OverallQualityScoreResponse.end_date:type_name -> google.protobuf.Timestamp
-	4, // [4:4] is the sub-list for method output_type
-	4, // [4:4] is the sub-list for method input_type
-	4, // [4:4] is the sub-list for extension type_name
-	4, // [4:4] is the sub-list for extension extendee
-	0, // [0:4] is the sub-list for field type_name
+	3, // 2: analytics.OverallQualityScoreRequest.date_basis:type_name -> analytics.DateBasis
+	2, // 3: analytics.OverallQualityScoreResponse.start_date:type_name -> google.protobuf.Timestamp
+	2, // 4: analytics.OverallQualityScoreResponse.end_date:type_name -> google.protobuf.Timestamp
+	4, // 5: analytics.OverallQualityScoreResponse.range:type_name -> analytics.DateRange
+	6, // [6:6] is the sub-list for method output_type
+	6, // [6:6] is the sub-list for method input_type
+	6, // [6:6] is the sub-list for extension type_name
+	6, // [6:6] is the sub-list for extension extendee
+	0, // [0:6] is the sub-list for field type_name
 }
 
 func init() { file_overall_quality_score_proto_init() }
@@ -193,6 +225,7 @@ func file_overall_quality_score_proto_init() {
 	if File_overall_quality_score_proto != nil {
 		return
 	}
+	file_date_range_proto_init()
 	type x struct{}
 	out := protoimpl.TypeBuilder{
 		File: protoimpl.DescBuilder{
@@ -22,12 +22,185 @@ const (
 	_ = protoimpl.EnforceVersion(protoimpl.MaxVersion - 20)
 )
 
+// ChangeStatus says whether the two periods could be compared at all
+type ChangeStatus int32
+
+const (
+	ChangeStatus_CHANGE_STATUS_UNSPECIFIED      ChangeStatus = 0
+	ChangeStatus_CHANGE_STATUS_COMPARABLE       ChangeStatus = 1 // Both periods have ratings
+	ChangeStatus_CHANGE_STATUS_NO_PREVIOUS_DATA ChangeStatus = 2 // Only the current period has ratings
+	ChangeStatus_CHANGE_STATUS_NO_CURRENT_DATA  ChangeStatus = 3 // Only the previous period has ratings
+	ChangeStatus_CHANGE_STATUS_NO_DATA          ChangeStatus = 4 // Neither period has ratings
+)
+
+// Enum value maps for ChangeStatus.
+var (
+	ChangeStatus_name = map[int32]string{
+		0: "CHANGE_STATUS_UNSPECIFIED",
+		1: "CHANGE_STATUS_COMPARABLE",
+		2: "CHANGE_STATUS_NO_PREVIOUS_DATA",
+		3: "CHANGE_STATUS_NO_CURRENT_DATA",
+		4: "CHANGE_STATUS_NO_DATA",
+	}
+	ChangeStatus_value = map[string]int32{
+		"CHANGE_STATUS_UNSPECIFIED":      0,
+		"CHANGE_STATUS_COMPARABLE":       1,
+		"CHANGE_STATUS_NO_PREVIOUS_DATA": 2,
+		"CHANGE_STATUS_NO_CURRENT_DATA":  3,
+		"CHANGE_STATUS_NO_DATA":          4,
+	}
+)
+
+func (x ChangeStatus) Enum() *ChangeStatus {
+	p := new(ChangeStatus)
+	*p = x
+	return p
+}
+
+func (x ChangeStatus) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
+}
+
+func (ChangeStatus) Descriptor() protoreflect.EnumDescriptor {
+	return file_period_over_period_proto_enumTypes[0].Descriptor()
+}
+
+func (ChangeStatus) Type() protoreflect.EnumType {
+	return &file_period_over_period_proto_enumTypes[0]
+}
+
+func (x ChangeStatus) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
+}
+
+// Deprecated: Use ChangeStatus.Descriptor instead.
+func (ChangeStatus) EnumDescriptor() ([]byte, []int) {
+	return file_period_over_period_proto_rawDescGZIP(), []int{0}
+}
+
+// ChangeUnit selects what change_percentage is expressed in
+type ChangeUnit int32
+
+const (
+	ChangeUnit_CHANGE_UNIT_UNSPECIFIED       ChangeUnit = 0 // Same as CHANGE_UNIT_RELATIVE_PERCENT
+	ChangeUnit_CHANGE_UNIT_RELATIVE_PERCENT  ChangeUnit = 1 // (current - previous) / previous * 100
+	ChangeUnit_CHANGE_UNIT_PERCENTAGE_POINTS ChangeUnit = 2 // current - previous
+)
+
+// Enum value maps for ChangeUnit.
+var (
+	ChangeUnit_name = map[int32]string{
+		0: "CHANGE_UNIT_UNSPECIFIED",
+		1: "CHANGE_UNIT_RELATIVE_PERCENT",
+		2: "CHANGE_UNIT_PERCENTAGE_POINTS",
+	}
+	ChangeUnit_value = map[string]int32{
+		"CHANGE_UNIT_UNSPECIFIED":       0,
+		"CHANGE_UNIT_RELATIVE_PERCENT":  1,
+		"CHANGE_UNIT_PERCENTAGE_POINTS": 2,
+	}
+)
+
+func (x ChangeUnit) Enum() *ChangeUnit {
+	p := new(ChangeUnit)
+	*p = x
+	return p
+}
+
+func (x ChangeUnit) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
+}
+
+func (ChangeUnit) Descriptor() protoreflect.EnumDescriptor {
+	return file_period_over_period_proto_enumTypes[1].Descriptor()
+}
+
+func (ChangeUnit) Type() protoreflect.EnumType {
+	return &file_period_over_period_proto_enumTypes[1]
+}
+
+func (x ChangeUnit) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
+}
+
+// Deprecated: Use ChangeUnit.Descriptor instead.
+func (ChangeUnit) EnumDescriptor() ([]byte, []int) {
+	return file_period_over_period_proto_rawDescGZIP(), []int{1}
+}
+
+// PeriodPreset picks both periods relative to an anchor date. The current period runs from the
+// start of the calendar period containing the anchor to the end of the anchor day; the previous
+// period covers the same stretch of the period before (or of the same period a year earlier)
+type PeriodPreset int32
+
+const (
+	PeriodPreset_PERIOD_PRESET_UNSPECIFIED           PeriodPreset = 0 // Use the explicit timestamps
+	PeriodPreset_PERIOD_PRESET_WEEK_OVER_WEEK        PeriodPreset = 1 // Weeks start on Monday
+	PeriodPreset_PERIOD_PRESET_MONTH_OVER_MONTH      PeriodPreset = 2
+	PeriodPreset_PERIOD_PRESET_QUARTER_OVER_QUARTER  PeriodPreset = 3
+	PeriodPreset_PERIOD_PRESET_YEAR_OVER_YEAR        PeriodPreset = 4
+	PeriodPreset_PERIOD_PRESET_SAME_PERIOD_LAST_YEAR PeriodPreset = 5 // Month to date against the same dates a year earlier
+)
+
+// Enum value maps for PeriodPreset.
+var (
+	PeriodPreset_name = map[int32]string{
+		0: "PERIOD_PRESET_UNSPECIFIED",
+		1: "PERIOD_PRESET_WEEK_OVER_WEEK",
+		2: "PERIOD_PRESET_MONTH_OVER_MONTH",
+		3: "PERIOD_PRESET_QUARTER_OVER_QUARTER",
+		4: "PERIOD_PRESET_YEAR_OVER_YEAR",
+		5: "PERIOD_PRESET_SAME_PERIOD_LAST_YEAR",
+	}
+	PeriodPreset_value = map[string]int32{
+		"PERIOD_PRESET_UNSPECIFIED":           0,
+		"PERIOD_PRESET_WEEK_OVER_WEEK":        1,
+		"PERIOD_PRESET_MONTH_OVER_MONTH":      2,
+		"PERIOD_PRESET_QUARTER_OVER_QUARTER":  3,
+		"PERIOD_PRESET_YEAR_OVER_YEAR":        4,
+		"PERIOD_PRESET_SAME_PERIOD_LAST_YEAR": 5,
+	}
+)
+
+func (x PeriodPreset) Enum() *PeriodPreset {
+	p := new(PeriodPreset)
+	*p = x
+	return p
+}
+
+func (x PeriodPreset) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
+}
+
+func (PeriodPreset) Descriptor() protoreflect.EnumDescriptor {
+	return file_period_over_period_proto_enumTypes[2].Descriptor()
+}
+
+func (PeriodPreset) Type() protoreflect.EnumType {
+	return &file_period_over_period_proto_enumTypes[2]
+}
+
+func (x PeriodPreset) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
+}
+
+// Deprecated: Use PeriodPreset.Descriptor instead.
+func (PeriodPreset) EnumDescriptor() ([]byte, []int) {
+	return file_period_over_period_proto_rawDescGZIP(), []int{2}
+}
+
 type PeriodOverPeriodChangeRequest struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
 	CurrentStart  *timestamppb.Timestamp `protobuf:"bytes,1,opt,name=current_start,json=currentStart,proto3" json:"current_start,omitempty"`
 	CurrentEnd    *timestamppb.Timestamp `protobuf:"bytes,2,opt,name=current_end,json=currentEnd,proto3" json:"current_end,omitempty"`
 	PreviousStart *timestamppb.Timestamp `protobuf:"bytes,3,opt,name=previous_start,json=previousStart,proto3" json:"previous_start,omitempty"`
 	PreviousEnd   *timestamppb.Timestamp `protobuf:"bytes,4,opt,name=previous_end,json=previousEnd,proto3" json:"previous_end,omitempty"`
+	InclusiveEnd  bool                   `protobuf:"varint,5,opt,name=inclusive_end,json=inclusiveEnd,proto3" json:"inclusive_end,omitempty"` // Applies to both periods; must be left unset with a preset
+	ChangeUnit    ChangeUnit             `protobuf:"varint,6,opt,name=change_unit,json=changeUnit,proto3,enum=analytics.ChangeUnit" json:"change_unit,omitempty"`
+	Preset        PeriodPreset           `protobuf:"varint,7,opt,name=preset,proto3,enum=analytics.PeriodPreset" json:"preset,omitempty"`                      // When set, the four timestamps must be left unset
+	Anchor        *timestamppb.Timestamp `protobuf:"bytes,8,opt,name=anchor,proto3" json:"anchor,omitempty"`                                                   // Date the preset is resolved against; defaults to now, and such requests are never cached
+	TimeZone      string                 `protobuf:"bytes,9,opt,name=time_zone,json=timeZone,proto3" json:"time_zone,omitempty"`                               // IANA name for calendar boundaries, e.g. "Europe/Tallinn"; defaults to UTC
+	DateBasis     DateBasis              `protobuf:"varint,10,opt,name=date_basis,json=dateBasis,proto3,enum=analytics.DateBasis" json:"date_basis,omitempty"` // Which timestamp the range applies to; defaults to the rating's
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
@@ -90,17 +263,66 @@ func (x *PeriodOverPeriodChangeRequest) GetPreviousEnd() *timestamppb.Timestamp
 	return nil
 }
 
+func (x *PeriodOverPeriodChangeRequest) GetInclusiveEnd() bool {
+	if x != nil {
+		return x.InclusiveEnd
+	}
+	return false
+}
+
+func (x *PeriodOverPeriodChangeRequest) GetChangeUnit() ChangeUnit {
+	if x != nil {
+		return x.ChangeUnit
+	}
+	return ChangeUnit_CHANGE_UNIT_UNSPECIFIED
+}
+
+func (x *PeriodOverPeriodChangeRequest) GetPreset() PeriodPreset {
+	if x != nil {
+		return x.Preset
+	}
+	return PeriodPreset_PERIOD_PRESET_UNSPECIFIED
+}
+
+func (x *PeriodOverPeriodChangeRequest) GetAnchor() *timestamppb.Timestamp {
+	if x != nil {
+		return x.Anchor
+	}
+	return nil
+}
+
+func (x *PeriodOverPeriodChangeRequest) GetTimeZone() string {
+	if x != nil {
+		return x.TimeZone
+	}
+	return ""
+}
+
+func (x *PeriodOverPeriodChangeRequest) GetDateBasis() DateBasis {
+	if x != nil {
+		return x.DateBasis
+	}
+	return DateBasis_DATE_BASIS_UNSPECIFIED
+}
+
 type PeriodOverPeriodChangeResponse struct {
 	state                protoimpl.MessageState `protogen:"open.v1"`
 	CurrentPeriodScore   float32                `protobuf:"fixed32,1,opt,name=current_period_score,json=currentPeriodScore,proto3" json:"current_period_score,omitempty"`      // Overall score for current period as percentage (0-100)
 	PreviousPeriodScore  float32                `protobuf:"fixed32,2,opt,name=previous_period_score,json=previousPeriodScore,proto3" json:"previous_period_score,omitempty"`   // Overall score for previous period as percentage (0-100)
-	ChangePercentage     float32                `protobuf:"fixed32,3,opt,name=change_percentage,json=changePercentage,proto3" json:"change_percentage,omitempty"`              // Percentage change from previous to current period
+	ChangePercentage     float32                `protobuf:"fixed32,3,opt,name=change_percentage,json=changePercentage,proto3" json:"change_percentage,omitempty"`              // Change from previous to current period in change_unit; 0 unless status is COMPARABLE
 	CurrentTotalRatings  int32                  `protobuf:"varint,4,opt,name=current_total_ratings,json=currentTotalRatings,proto3" json:"current_total_ratings,omitempty"`    // Total ratings in current period
 	PreviousTotalRatings int32                  `protobuf:"varint,5,opt,name=previous_total_ratings,json=previousTotalRatings,proto3" json:"previous_total_ratings,omitempty"` // Total ratings in previous period
 	CurrentStart         *timestamppb.Timestamp `protobuf:"bytes,6,opt,name=current_start,json=currentStart,proto3" json:"current_start,omitempty"`
 	CurrentEnd           *timestamppb.Timestamp `protobuf:"bytes,7,opt,name=current_end,json=currentEnd,proto3" json:"current_end,omitempty"`
 	PreviousStart        *timestamppb.Timestamp `protobuf:"bytes,8,opt,name=previous_start,json=previousStart,proto3" json:"previous_start,omitempty"`
 	PreviousEnd          *timestamppb.Timestamp `protobuf:"bytes,9,opt,name=previous_end,json=previousEnd,proto3" json:"previous_end,omitempty"`
+	CurrentRange         *DateRange             `protobuf:"bytes,10,opt,name=current_range,json=currentRange,proto3" json:"current_range,omitempty"`          // Range the current period was filtered by
+	PreviousRange        *DateRange             `protobuf:"bytes,11,opt,name=previous_range,json=previousRange,proto3" json:"previous_range,omitempty"`       // Range the previous period was filtered by
+	Significance         *SignificanceTest      `protobuf:"bytes,12,opt,name=significance,proto3" json:"significance,omitempty"`                              // Unset when either period has too few ratings to test
+	CategoryChanges      []*CategoryChange      `protobuf:"bytes,13,rep,name=category_changes,json=categoryChanges,proto3" json:"category_changes,omitempty"` // Every category rated in either period, by name
+	Status               ChangeStatus           `protobuf:"varint,14,opt,name=status,proto3,enum=analytics.ChangeStatus" json:"status,omitempty"`
+	PointDifference      float32                `protobuf:"fixed32,15,opt,name=point_difference,json=pointDifference,proto3" json:"point_difference,omitempty"`           // current_period_score - previous_period_score; 0 unless status is COMPARABLE
+	ChangeUnit           ChangeUnit             `protobuf:"varint,16,opt,name=change_unit,json=changeUnit,proto3,enum=analytics.ChangeUnit" json:"change_unit,omitempty"` // Unit of change_percentage
 	unknownFields        protoimpl.UnknownFields
 	sizeCache            protoimpl.SizeCache
 }
@@ -198,17 +420,255 @@ func (x *PeriodOverPeriodChangeResponse) GetPreviousEnd() *timestamppb.Timestamp
 	return nil
 }
 
+func (x *PeriodOverPeriodChangeResponse) GetCurrentRange() *DateRange {
+	if x != nil {
+		return x.CurrentRange
+	}
+	return nil
+}
+
+func (x *PeriodOverPeriodChangeResponse) GetPreviousRange() *DateRange {
+	if x != nil {
+		return x.PreviousRange
+	}
+	return nil
+}
+
+func (x *PeriodOverPeriodChangeResponse) GetSignificance() *SignificanceTest {
+	if x != nil {
+		return x.Significance
+	}
+	return nil
+}
+
+func (x *PeriodOverPeriodChangeResponse) GetCategoryChanges() []*CategoryChange {
+	if x != nil {
+		return x.CategoryChanges
+	}
+	return nil
+}
+
+func (x *PeriodOverPeriodChangeResponse) GetStatus() ChangeStatus {
+	if x != nil {
+		return x.Status
+	}
+	return ChangeStatus_CHANGE_STATUS_UNSPECIFIED
+}
+
+func (x *PeriodOverPeriodChangeResponse) GetPointDifference() float32 {
+	if x != nil {
+		return x.PointDifference
+	}
+	return 0
+}
+
+func (x *PeriodOverPeriodChangeResponse) GetChangeUnit() ChangeUnit {
+	if x != nil {
+		return x.ChangeUnit
+	}
+	return ChangeUnit_CHANGE_UNIT_UNSPECIFIED
+}
+
+// SignificanceTest is Welch's t-test between the per-rating weighted scores
+// (rating * category weight * 20) of the two periods
+type SignificanceTest struct {
+	state            protoimpl.MessageState `protogen:"open.v1"`
+	TStatistic       float64                `protobuf:"fixed64,1,opt,name=t_statistic,json=tStatistic,proto3" json:"t_statistic,omitempty"`
+	DegreesOfFreedom float64                `protobuf:"fixed64,2,opt,name=degrees_of_freedom,json=degreesOfFreedom,proto3" json:"degrees_of_freedom,omitempty"`
+	PValue           float64                `protobuf:"fixed64,3,opt,name=p_value,json=pValue,proto3" json:"p_value,omitempty"`             // Two-sided
+	EffectSize       float64                `protobuf:"fixed64,4,opt,name=effect_size,json=effectSize,proto3" json:"effect_size,omitempty"` // Cohen's d, positive when the current period is higher
+	Significant      bool                   `protobuf:"varint,5,opt,name=significant,proto3" json:"significant,omitempty"`                  // p_value below 0.05
+	unknownFields    protoimpl.UnknownFields
+	sizeCache        protoimpl.SizeCache
+}
+
+func (x *SignificanceTest) Reset() {
+	*x = SignificanceTest{}
+	mi := &file_period_over_period_proto_msgTypes[2]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *SignificanceTest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SignificanceTest) ProtoMessage() {}
+
+func (x *SignificanceTest) ProtoReflect() protoreflect.Message {
+	mi := &file_period_over_period_proto_msgTypes[2]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SignificanceTest.ProtoReflect.Descriptor instead.
+func (*SignificanceTest) Descriptor() ([]byte, []int) {
+	return file_period_over_period_proto_rawDescGZIP(), []int{2}
+}
+
+func (x *SignificanceTest) GetTStatistic() float64 {
+	if x != nil {
+		return x.TStatistic
+	}
+	return 0
+}
+
+func (x *SignificanceTest) GetDegreesOfFreedom() float64 {
+	if x != nil {
+		return x.DegreesOfFreedom
+	}
+	return 0
+}
+
+func (x *SignificanceTest) GetPValue() float64 {
+	if x != nil {
+		return x.PValue
+	}
+	return 0
+}
+
+func (x *SignificanceTest) GetEffectSize() float64 {
+	if x != nil {
+		return x.EffectSize
+	}
+	return 0
+}
+
+func (x *SignificanceTest) GetSignificant() bool {
+	if x != nil {
+		return x.Significant
+	}
+	return false
+}
+
+type CategoryChange struct {
+	state           protoimpl.MessageState `protogen:"open.v1"`
+	CategoryId      int32                  `protobuf:"varint,1,opt,name=category_id,json=categoryId,proto3" json:"category_id,omitempty"`
+	CategoryName    string                 `protobuf:"bytes,2,opt,name=category_name,json=categoryName,proto3" json:"category_name,omitempty"`
+	CurrentScore    float32                `protobuf:"fixed32,3,opt,name=current_score,json=currentScore,proto3" json:"current_score,omitempty"`    // 0 when the category has no ratings in the current period
+	PreviousScore   float32                `protobuf:"fixed32,4,opt,name=previous_score,json=previousScore,proto3" json:"previous_score,omitempty"` // 0 when the category has no ratings in the previous period
+	CurrentRatings  int32                  `protobuf:"varint,5,opt,name=current_ratings,json=currentRatings,proto3" json:"current_ratings,omitempty"`
+	PreviousRatings int32                  `protobuf:"varint,6,opt,name=previous_ratings,json=previousRatings,proto3" json:"previous_ratings,omitempty"`
+	// Change in this category's share of the overall score, in points.
+	// Contributions of all categories add up to current_period_score - previous_period_score
+	Contribution  float32           `protobuf:"fixed32,7,opt,name=contribution,proto3" json:"contribution,omitempty"`
+	Significance  *SignificanceTest `protobuf:"bytes,8,opt,name=significance,proto3" json:"significance,omitempty"` // Same test on this category's ratings only
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *CategoryChange) Reset() {
+	*x = CategoryChange{}
+	mi := &file_period_over_period_proto_msgTypes[3]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *CategoryChange) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CategoryChange) ProtoMessage() {}
+
+func (x *CategoryChange) ProtoReflect() protoreflect.Message {
+	mi := &file_period_over_period_proto_msgTypes[3]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CategoryChange.ProtoReflect.Descriptor instead.
+func (*CategoryChange) Descriptor() ([]byte, []int) {
+	return file_period_over_period_proto_rawDescGZIP(), []int{3}
+}
+
+func (x *CategoryChange) GetCategoryId() int32 {
+	if x != nil {
+		return x.CategoryId
+	}
+	return 0
+}
+
+func (x *CategoryChange) GetCategoryName() string {
+	if x != nil {
+		return x.CategoryName
+	}
+	return ""
+}
+
+func (x *CategoryChange) GetCurrentScore() float32 {
+	if x != nil {
+		return x.CurrentScore
+	}
+	return 0
+}
+
+func (x *CategoryChange) GetPreviousScore() float32 {
+	if x != nil {
+		return x.PreviousScore
+	}
+	return 0
+}
+
+func (x *CategoryChange) GetCurrentRatings() int32 {
+	if x != nil {
+		return x.CurrentRatings
+	}
+	return 0
+}
+
+func (x *CategoryChange) GetPreviousRatings() int32 {
+	if x != nil {
+		return x.PreviousRatings
+	}
+	return 0
+}
+
+func (x *CategoryChange) GetContribution() float32 {
+	if x != nil {
+		return x.Contribution
+	}
+	return 0
+}
+
+func (x *CategoryChange) GetSignificance() *SignificanceTest {
+	if x != nil {
+		return x.Significance
+	}
+	return nil
+}
+
 var File_period_over_period_proto protoreflect.FileDescriptor
 
 const file_period_over_period_proto_rawDesc = "" +
 	"\n" +
-	"\x18period_over_period.proto\x12\tanalytics\x1a\x1fgoogle/protobuf/timestamp.proto\"\x9f\x02\n" +
+	"\x18period_over_period.proto\x12\tanalytics\x1a\x1fgoogle/protobuf/timestamp.proto\x1a\x10date_range.proto\"\xb3\x04\n" +
 	"\x1dPeriodOverPeriodChangeRequest\x12?\n" +
 	"\rcurrent_start\x18\x01 \x01(\v2\x1a.google.protobuf.TimestampR\fcurrentStart\x12;\n" +
 	"\vcurrent_end\x18\x02 \x01(\v2\x1a.google.protobuf.TimestampR\n" +
 	"currentEnd\x12A\n" +
 	"\x0eprevious_start\x18\x03 \x01(\v2\x1a.google.protobuf.TimestampR\rpreviousStart\x12=\n" +
-	"\fprevious_end\x18\x04 \x01(\v2\x1a.google.protobuf.TimestampR\vpreviousEnd\"\x9d\x04\n" +
+	"\fprevious_end\x18\x04 \x01(\v2\x1a.google.protobuf.TimestampR\vpreviousEnd\x12#\n" +
+	"\rinclusive_end\x18\x05 \x01(\bR\finclusiveEnd\x126\n" +
+	"\vchange_unit\x18\x06 \x01(\x0e2\x15.analytics.ChangeUnitR\n" +
+	"changeUnit\x12/\n" +
+	"\x06preset\x18\a \x01(\x0e2\x17.analytics.PeriodPresetR\x06preset\x122\n" +
+	"\x06anchor\x18\b \x01(\v2\x1a.google.protobuf.TimestampR\x06anchor\x12\x1b\n" +
+	"\ttime_zone\x18\t \x01(\tR\btimeZone\x123\n" +
+	"\n" +
+	"date_basis\x18\n" +
+	" \x01(\x0e2\x14.analytics.DateBasisR\tdateBasis\"\xb0\a\n" +
 	"\x1ePeriodOverPeriodChangeResponse\x120\n" +
 	"\x14current_period_score\x18\x01 \x01(\x02R\x12currentPeriodScore\x122\n" +
 	"\x15previous_period_score\x18\x02 \x01(\x02R\x13previousPeriodScore\x12+\n" +
@@ -219,7 +679,52 @@ const file_period_over_period_proto_rawDesc = "" +
 	"\vcurrent_end\x18\a \x01(\v2\x1a.google.protobuf.TimestampR\n" +
 	"currentEnd\x12A\n" +
 	"\x0eprevious_start\x18\b \x01(\v2\x1a.google.protobuf.TimestampR\rpreviousStart\x12=\n" +
-	"\fprevious_end\x18\t \x01(\v2\x1a.google.protobuf.TimestampR\vpreviousEndB\x17Z\x15go-grpc-backend/protob\x06proto3"
+	"\fprevious_end\x18\t \x01(\v2\x1a.google.protobuf.TimestampR\vpreviousEnd\x129\n" +
+	"\rcurrent_range\x18\n" +
+	" \x01(\v2\x14.analytics.DateRangeR\fcurrentRange\x12;\n" +
+	"\x0eprevious_range\x18\v \x01(\v2\x14.analytics.DateRangeR\rpreviousRange\x12?\n" +
+	"\fsignificance\x18\f \x01(\v2\x1b.analytics.SignificanceTestR\fsignificance\x12D\n" +
+	"\x10category_changes\x18\r \x03(\v2\x19.analytics.CategoryChangeR\x0fcategoryChanges\x12/\n" +
+	"\x06status\x18\x0e \x01(\x0e2\x17.analytics.ChangeStatusR\x06status\x12)\n" +
+	"\x10point_difference\x18\x0f \x01(\x02R\x0fpointDifference\x126\n" +
+	"\vchange_unit\x18\x10 \x01(\x0e2\x15.analytics.ChangeUnitR\n" +
+	"changeUnit\"\xbd\x01\n" +
+	"\x10SignificanceTest\x12\x1f\n" +
+	"\vt_statistic\x18\x01 \x01(\x01R\n" +
+	"tStatistic\x12,\n" +
+	"\x12degrees_of_freedom\x18\x02 \x01(\x01R\x10degreesOfFreedom\x12\x17\n" +
+	"\ap_value\x18\x03 \x01(\x01R\x06pValue\x12\x1f\n" +
+	"\veffect_size\x18\x04 \x01(\x01R\n" +
+	"effectSize\x12 \n" +
+	"\vsignificant\x18\x05 \x01(\bR\vsignificant\"\xdb\x02\n" +
+	"\x0eCategoryChange\x12\x1f\n" +
+	"\vcategory_id\x18\x01 \x01(\x05R\n" +
+	"categoryId\x12#\n" +
+	"\rcategory_name\x18\x02 \x01(\tR\fcategoryName\x12#\n" +
+	"\rcurrent_score\x18\x03 \x01(\x02R\fcurrentScore\x12%\n" +
+	"\x0eprevious_score\x18\x04 \x01(\x02R\rpreviousScore\x12'\n" +
+	"\x0fcurrent_ratings\x18\x05 \x01(\x05R\x0ecurrentRatings\x12)\n" +
+	"\x10previous_ratings\x18\x06 \x01(\x05R\x0fpreviousRatings\x12\"\n" +
+	"\fcontribution\x18\a \x01(\x02R\fcontribution\x12?\n" +
+	"\fsignificance\x18\b \x01(\v2\x1b.analytics.SignificanceTestR\fsignificance*\xad\x01\n" +
+	"\fChangeStatus\x12\x1d\n" +
+	"\x19CHANGE_STATUS_UNSPECIFIED\x10\x00\x12\x1c\n" +
+	"\x18CHANGE_STATUS_COMPARABLE\x10\x01\x12\"\n" +
+	"\x1eCHANGE_STATUS_NO_PREVIOUS_DATA\x10\x02\x12!\n" +
+	"\x1dCHANGE_STATUS_NO_CURRENT_DATA\x10\x03\x12\x19\n" +
+	"\x15CHANGE_STATUS_NO_DATA\x10\x04*n\n" +
+	"\n" +
+	"ChangeUnit\x12\x1b\n" +
+	"\x17CHANGE_UNIT_UNSPECIFIED\x10\x00\x12 \n" +
+	"\x1cCHANGE_UNIT_RELATIVE_PERCENT\x10\x01\x12!\n" +
+	"\x1dCHANGE_UNIT_PERCENTAGE_POINTS\x10\x02*\xe6\x01\n" +
+	"\fPeriodPreset\x12\x1d\n" +
+	"\x19PERIOD_PRESET_UNSPECIFIED\x10\x00\x12 \n" +
+	"\x1cPERIOD_PRESET_WEEK_OVER_WEEK\x10\x01\x12\"\n" +
+	"\x1ePERIOD_PRESET_MONTH_OVER_MONTH\x10\x02\x12&\n" +
+	"\"PERIOD_PRESET_QUARTER_OVER_QUARTER\x10\x03\x12 \n" +
+	"\x1cPERIOD_PRESET_YEAR_OVER_YEAR\x10\x04\x12'\n" +
+	"#PERIOD_PRESET_SAME_PERIOD_LAST_YEAR\x10\x05B\x17Z\x15go-grpc-backend/protob\x06proto3"
 
 var (
 	file_period_over_period_proto_rawDescOnce sync.Once
@@ -233,26 +738,45 @@ func file_period_over_period_proto_rawDescGZIP() []byte {
 	return file_period_over_period_proto_rawDescData
 }
 
-var file_period_over_period_proto_msgTypes = make([]protoimpl.MessageInfo, 2)
+var file_period_over_period_proto_enumTypes = make([]protoimpl.EnumInfo, 3)
+var file_period_over_period_proto_msgTypes = make([]protoimpl.MessageInfo, 4)
 var file_period_over_period_proto_goTypes = []any{
-	(*PeriodOverPeriodChangeRequest)(nil),  // 0: analytics.PeriodOverPeriodChangeRequest
-	(*PeriodOverPeriodChangeResponse)(nil), // 1: analytics.PeriodOverPeriodChangeResponse
-	(*timestamppb.Timestamp)(nil),          // 2: google.protobuf.Timestamp
+	(ChangeStatus)(0),                      // 0: analytics.ChangeStatus
+	(ChangeUnit)(0),                        // 1: analytics.ChangeUnit
+	(PeriodPreset)(0),                      // 2: analytics.PeriodPreset
+	(*PeriodOverPeriodChangeRequest)(nil),  // 3: analytics.PeriodOverPeriodChangeRequest
+	(*PeriodOverPeriodChangeResponse)(nil), // 4: analytics.PeriodOverPeriodChangeResponse
+	(*SignificanceTest)(nil),               // 5: analytics.SignificanceTest
+	(*CategoryChange)(nil),                 // 6: analytics.CategoryChange
+	(*timestamppb.Timestamp)(nil),          // 7: google.protobuf.Timestamp
+	(DateBasis)(0),                         // 8: analytics.DateBasis
+	(*DateRange)(nil),                      // 9: analytics.DateRange
 }
 var file_period_over_period_proto_depIdxs = []int32{
-	2, // 0: analytics.PeriodOverPeriodChangeRequest.current_start:type_name -> google.protobuf.Timestamp
-	2, // 1: analytics.PeriodOverPeriodChangeRequest.current_end:type_name -> google.protobuf.Timestamp
-	2, // 2: analytics.PeriodOverPeriodChangeRequest.previous_start:type_name -> google.protobuf.Timestamp
-	2, // 3: analytics.PeriodOverPeriodChangeRequest.previous_end:type_name -> google.protobuf.Timestamp
-	2, // 4: analytics.PeriodOverPeriodChangeResponse.current_start:type_name -> google.protobuf.Timestamp
-	2, // 5: analytics.PeriodOverPeriodChangeResponse.current_end:type_name -> google.protobuf.Timestamp
-	2, // 6: analytics.PeriodOverPeriodChangeResponse.previous_start:type_name -> google.protobuf.Timestamp
-	2, // 7: analytics.PeriodOverPeriodChangeResponse.previous_end:type_name -> google.protobuf.Timestamp
-	8, // [8:8] is the sub-list for method output_type
-	8, // [8:8] is the sub-list for method input_type
-	8, // [8:8] is the sub-list for extension type_name
-	8, // [8:8] is the sub-list for extension extendee
-	0, // [0:8] is the sub-list for field type_name
+	7,  // 0: analytics.PeriodOverPeriodChangeRequest.current_start:type_name -> google.protobuf.Timestamp
+	7,  // 1: analytics.PeriodOverPeriodChangeRequest.current_end:type_name -> google.protobuf.Timestamp
+	7,  // 2: analytics.PeriodOverPeriodChangeRequest.previous_start:type_name -> google.protobuf.Timestamp
+	7,  // 3: analytics.PeriodOverPeriodChangeRequest.previous_end:type_name -> google.protobuf.Timestamp
+	1,  // 4: analytics.PeriodOverPeriodChangeRequest.change_unit:type_name -> analytics.ChangeUnit
+	2,  // 5: analytics.PeriodOverPeriodChangeRequest.preset:type_name -> analytics.PeriodPreset
+	7,  // 6: analytics.PeriodOverPeriodChangeRequest.anchor:type_name -> google.protobuf.Timestamp
+	8,  // 7: analytics.PeriodOverPeriodChangeRequest.date_basis:type_name -> analytics.DateBasis
+	7,  // 8: analytics.PeriodOverPeriodChangeResponse.current_start:type_name -> google.protobuf.Timestamp
+	7,  // 9: analytics.PeriodOverPeriodChangeResponse.current_end:type_name -> google.protobuf.Timestamp
+	7,  // 10: analytics.PeriodOverPeriodChangeResponse.previous_start:type_name -> google.protobuf.Timestamp
+	7,  // 11: analytics.PeriodOverPeriodChangeResponse.previous_end:type_name -> google.protobuf.Timestamp
+	9,  // 12: analytics.PeriodOverPeriodChangeResponse.current_range:type_name -> analytics.DateRange
+	9,  // 13: analytics.PeriodOverPeriodChangeResponse.previous_range:type_name -> analytics.DateRange
+	5,  // 14: analytics.PeriodOverPeriodChangeResponse.significance:type_name -> analytics.SignificanceTest
+	6,  // 15: analytics.PeriodOverPeriodChangeResponse.category_changes:type_name -> analytics.CategoryChange
+	0,  // 16: analytics.PeriodOverPeriodChangeResponse.status:type_name -> analytics.ChangeStatus
+	1,  // 17: analytics.PeriodOverPeriodChangeResponse.change_unit:type_name -> analytics.ChangeUnit
+	5,  // 18: analytics.CategoryChange.significance:type_name -> analytics.SignificanceTest
+	19, // [19:19] is the sub-list for method output_type
+	19, // [19:19] is the sub-list for method input_type
+	19, // [19:19] is the sub-list for extension type_name
+	19, // [19:19] is the sub-list for extension extendee
+	0,  // [0:19] is the sub-list for field type_name
 }
 
 func init() { file_period_over_period_proto_init() }
@@ -260,18 +784,20 @@ func file_period_over_period_proto_init() {
 	if File_period_over_period_proto != nil {
 		return
 	}
+	file_date_range_proto_init()
 	type x struct{}
 	out := protoimpl.TypeBuilder{
 		File: protoimpl.DescBuilder{
 			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
 			RawDescriptor: unsafe.Slice(unsafe.StringData(file_period_over_period_proto_rawDesc), len(file_period_over_period_proto_rawDesc)),
-			NumEnums:      0,
-			NumMessages:   2,
+			NumEnums:      3,
+			NumMessages:   4,
 			NumExtensions: 0,
 			NumServices:   0,
 		},
 		GoTypes:           file_period_over_period_proto_goTypes,
 		DependencyIndexes: file_period_over_period_proto_depIdxs,
+		EnumInfos:         file_period_over_period_proto_enumTypes,
 		MessageInfos:      file_period_over_period_proto_msgTypes,
 	}.Build()
 	File_period_over_period_proto = out.File
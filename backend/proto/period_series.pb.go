@@ -0,0 +1,418 @@
+// Code generated by protoc-gen-go. DO NOT EDIT.
+// versions:
+// 	protoc-gen-go v1.36.9
+// 	protoc        v5.29.3
+// source: period_series.proto
+
+package proto
+
+import (
+	protoreflect "google.golang.org/protobuf/reflect/protoreflect"
+	protoimpl "google.golang.org/protobuf/runtime/protoimpl"
+	timestamppb "google.golang.org/protobuf/types/known/timestamppb"
+	reflect "reflect"
+	sync "sync"
+	unsafe "unsafe"
+)
+
+const (
+	// Verify that this generated code is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(20 - protoimpl.MinVersion)
+	// Verify that runtime/protoimpl is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(protoimpl.MaxVersion - 20)
+)
+
+// PeriodUnit is the calendar length of each period in a series
+type PeriodUnit int32
+
+const (
+	PeriodUnit_PERIOD_UNIT_UNSPECIFIED PeriodUnit = 0
+	PeriodUnit_PERIOD_UNIT_DAY         PeriodUnit = 1
+	PeriodUnit_PERIOD_UNIT_WEEK        PeriodUnit = 2 // Weeks start on Monday
+	PeriodUnit_PERIOD_UNIT_MONTH       PeriodUnit = 3
+	PeriodUnit_PERIOD_UNIT_QUARTER     PeriodUnit = 4
+	PeriodUnit_PERIOD_UNIT_YEAR        PeriodUnit = 5
+)
+
+// Enum value maps for PeriodUnit.
+var (
+	PeriodUnit_name = map[int32]string{
+		0: "PERIOD_UNIT_UNSPECIFIED",
+		1: "PERIOD_UNIT_DAY",
+		2: "PERIOD_UNIT_WEEK",
+		3: "PERIOD_UNIT_MONTH",
+		4: "PERIOD_UNIT_QUARTER",
+		5: "PERIOD_UNIT_YEAR",
+	}
+	PeriodUnit_value = map[string]int32{
+		"PERIOD_UNIT_UNSPECIFIED": 0,
+		"PERIOD_UNIT_DAY":         1,
+		"PERIOD_UNIT_WEEK":        2,
+		"PERIOD_UNIT_MONTH":       3,
+		"PERIOD_UNIT_QUARTER":     4,
+		"PERIOD_UNIT_YEAR":        5,
+	}
+)
+
+func (x PeriodUnit) Enum() *PeriodUnit {
+	p := new(PeriodUnit)
+	*p = x
+	return p
+}
+
+func (x PeriodUnit) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
+}
+
+func (PeriodUnit) Descriptor() protoreflect.EnumDescriptor {
+	return file_period_series_proto_enumTypes[0].Descriptor()
+}
+
+func (PeriodUnit) Type() protoreflect.EnumType {
+	return &file_period_series_proto_enumTypes[0]
+}
+
+func (x PeriodUnit) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
+}
+
+// Deprecated: Use PeriodUnit.Descriptor instead.
+func (PeriodUnit) EnumDescriptor() ([]byte, []int) {
+	return file_period_series_proto_rawDescGZIP(), []int{0}
+}
+
+// PeriodSeriesRequest asks for the last count calendar periods up to the anchor.
+// The last period ends at the end of the anchor's day, so it may be partial
+type PeriodSeriesRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Unit          PeriodUnit             `protobuf:"varint,1,opt,name=unit,proto3,enum=analytics.PeriodUnit" json:"unit,omitempty"`
+	Count         int32                  `protobuf:"varint,2,opt,name=count,proto3" json:"count,omitempty"`                      // Number of periods, 1-366
+	Anchor        *timestamppb.Timestamp `protobuf:"bytes,3,opt,name=anchor,proto3" json:"anchor,omitempty"`                     // Defaults to now, and such requests are never cached
+	TimeZone      string                 `protobuf:"bytes,4,opt,name=time_zone,json=timeZone,proto3" json:"time_zone,omitempty"` // IANA name for calendar boundaries; defaults to UTC
+	ChangeUnit    ChangeUnit             `protobuf:"varint,5,opt,name=change_unit,json=changeUnit,proto3,enum=analytics.ChangeUnit" json:"change_unit,omitempty"`
+	DateBasis     DateBasis              `protobuf:"varint,6,opt,name=date_basis,json=dateBasis,proto3,enum=analytics.DateBasis" json:"date_basis,omitempty"` // Which timestamp the range applies to; defaults to the rating's
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *PeriodSeriesRequest) Reset() {
+	*x = PeriodSeriesRequest{}
+	mi := &file_period_series_proto_msgTypes[0]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *PeriodSeriesRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*PeriodSeriesRequest) ProtoMessage() {}
+
+func (x *PeriodSeriesRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_period_series_proto_msgTypes[0]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use PeriodSeriesRequest.ProtoReflect.Descriptor instead.
+func (*PeriodSeriesRequest) Descriptor() ([]byte, []int) {
+	return file_period_series_proto_rawDescGZIP(), []int{0}
+}
+
+func (x *PeriodSeriesRequest) GetUnit() PeriodUnit {
+	if x != nil {
+		return x.Unit
+	}
+	return PeriodUnit_PERIOD_UNIT_UNSPECIFIED
+}
+
+func (x *PeriodSeriesRequest) GetCount() int32 {
+	if x != nil {
+		return x.Count
+	}
+	return 0
+}
+
+func (x *PeriodSeriesRequest) GetAnchor() *timestamppb.Timestamp {
+	if x != nil {
+		return x.Anchor
+	}
+	return nil
+}
+
+func (x *PeriodSeriesRequest) GetTimeZone() string {
+	if x != nil {
+		return x.TimeZone
+	}
+	return ""
+}
+
+func (x *PeriodSeriesRequest) GetChangeUnit() ChangeUnit {
+	if x != nil {
+		return x.ChangeUnit
+	}
+	return ChangeUnit_CHANGE_UNIT_UNSPECIFIED
+}
+
+func (x *PeriodSeriesRequest) GetDateBasis() DateBasis {
+	if x != nil {
+		return x.DateBasis
+	}
+	return DateBasis_DATE_BASIS_UNSPECIFIED
+}
+
+type PeriodSeriesPoint struct {
+	state        protoimpl.MessageState `protogen:"open.v1"`
+	Range        *DateRange             `protobuf:"bytes,1,opt,name=range,proto3" json:"range,omitempty"`
+	OverallScore float32                `protobuf:"fixed32,2,opt,name=overall_score,json=overallScore,proto3" json:"overall_score,omitempty"` // Same formula as GetOverallQualityScore
+	TotalRatings int32                  `protobuf:"varint,3,opt,name=total_ratings,json=totalRatings,proto3" json:"total_ratings,omitempty"`
+	// Change against the period before, with the same semantics as GetPeriodOverPeriodChange.
+	// The first period is compared with the period preceding the series
+	Status          ChangeStatus `protobuf:"varint,4,opt,name=status,proto3,enum=analytics.ChangeStatus" json:"status,omitempty"`
+	Change          float32      `protobuf:"fixed32,5,opt,name=change,proto3" json:"change,omitempty"`                                          // In change_unit; 0 unless status is COMPARABLE
+	PointDifference float32      `protobuf:"fixed32,6,opt,name=point_difference,json=pointDifference,proto3" json:"point_difference,omitempty"` // 0 unless status is COMPARABLE
+	unknownFields   protoimpl.UnknownFields
+	sizeCache       protoimpl.SizeCache
+}
+
+func (x *PeriodSeriesPoint) Reset() {
+	*x = PeriodSeriesPoint{}
+	mi := &file_period_series_proto_msgTypes[1]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *PeriodSeriesPoint) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*PeriodSeriesPoint) ProtoMessage() {}
+
+func (x *PeriodSeriesPoint) ProtoReflect() protoreflect.Message {
+	mi := &file_period_series_proto_msgTypes[1]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use PeriodSeriesPoint.ProtoReflect.Descriptor instead.
+func (*PeriodSeriesPoint) Descriptor() ([]byte, []int) {
+	return file_period_series_proto_rawDescGZIP(), []int{1}
+}
+
+func (x *PeriodSeriesPoint) GetRange() *DateRange {
+	if x != nil {
+		return x.Range
+	}
+	return nil
+}
+
+func (x *PeriodSeriesPoint) GetOverallScore() float32 {
+	if x != nil {
+		return x.OverallScore
+	}
+	return 0
+}
+
+func (x *PeriodSeriesPoint) GetTotalRatings() int32 {
+	if x != nil {
+		return x.TotalRatings
+	}
+	return 0
+}
+
+func (x *PeriodSeriesPoint) GetStatus() ChangeStatus {
+	if x != nil {
+		return x.Status
+	}
+	return ChangeStatus_CHANGE_STATUS_UNSPECIFIED
+}
+
+func (x *PeriodSeriesPoint) GetChange() float32 {
+	if x != nil {
+		return x.Change
+	}
+	return 0
+}
+
+func (x *PeriodSeriesPoint) GetPointDifference() float32 {
+	if x != nil {
+		return x.PointDifference
+	}
+	return 0
+}
+
+type PeriodSeriesResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Unit          PeriodUnit             `protobuf:"varint,1,opt,name=unit,proto3,enum=analytics.PeriodUnit" json:"unit,omitempty"`
+	Periods       []*PeriodSeriesPoint   `protobuf:"bytes,2,rep,name=periods,proto3" json:"periods,omitempty"` // Oldest first
+	ChangeUnit    ChangeUnit             `protobuf:"varint,3,opt,name=change_unit,json=changeUnit,proto3,enum=analytics.ChangeUnit" json:"change_unit,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *PeriodSeriesResponse) Reset() {
+	*x = PeriodSeriesResponse{}
+	mi := &file_period_series_proto_msgTypes[2]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *PeriodSeriesResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*PeriodSeriesResponse) ProtoMessage() {}
+
+func (x *PeriodSeriesResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_period_series_proto_msgTypes[2]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use PeriodSeriesResponse.ProtoReflect.Descriptor instead.
+func (*PeriodSeriesResponse) Descriptor() ([]byte, []int) {
+	return file_period_series_proto_rawDescGZIP(), []int{2}
+}
+
+func (x *PeriodSeriesResponse) GetUnit() PeriodUnit {
+	if x != nil {
+		return x.Unit
+	}
+	return PeriodUnit_PERIOD_UNIT_UNSPECIFIED
+}
+
+func (x *PeriodSeriesResponse) GetPeriods() []*PeriodSeriesPoint {
+	if x != nil {
+		return x.Periods
+	}
+	return nil
+}
+
+func (x *PeriodSeriesResponse) GetChangeUnit() ChangeUnit {
+	if x != nil {
+		return x.ChangeUnit
+	}
+	return ChangeUnit_CHANGE_UNIT_UNSPECIFIED
+}
+
+var File_period_series_proto protoreflect.FileDescriptor
+
+const file_period_series_proto_rawDesc = "" +
+	"\n" +
+	"\x13period_series.proto\x12\tanalytics\x1a\x1fgoogle/protobuf/timestamp.proto\x1a\x10date_range.proto\x1a\x18period_over_period.proto\"\x94\x02\n" +
+	"\x13PeriodSeriesRequest\x12)\n" +
+	"\x04unit\x18\x01 \x01(\x0e2\x15.analytics.PeriodUnitR\x04unit\x12\x14\n" +
+	"\x05count\x18\x02 \x01(\x05R\x05count\x122\n" +
+	"\x06anchor\x18\x03 \x01(\v2\x1a.google.protobuf.TimestampR\x06anchor\x12\x1b\n" +
+	"\ttime_zone\x18\x04 \x01(\tR\btimeZone\x126\n" +
+	"\vchange_unit\x18\x05 \x01(\x0e2\x15.analytics.ChangeUnitR\n" +
+	"changeUnit\x123\n" +
+	"\n" +
+	"date_basis\x18\x06 \x01(\x0e2\x14.analytics.DateBasisR\tdateBasis\"\xfd\x01\n" +
+	"\x11PeriodSeriesPoint\x12*\n" +
+	"\x05range\x18\x01 \x01(\v2\x14.analytics.DateRangeR\x05range\x12#\n" +
+	"\roverall_score\x18\x02 \x01(\x02R\foverallScore\x12#\n" +
+	"\rtotal_ratings\x18\x03 \x01(\x05R\ftotalRatings\x12/\n" +
+	"\x06status\x18\x04 \x01(\x0e2\x17.analytics.ChangeStatusR\x06status\x12\x16\n" +
+	"\x06change\x18\x05 \x01(\x02R\x06change\x12)\n" +
+	"\x10point_difference\x18\x06 \x01(\x02R\x0fpointDifference\"\xb1\x01\n" +
+	"\x14PeriodSeriesResponse\x12)\n" +
+	"\x04unit\x18\x01 \x01(\x0e2\x15.analytics.PeriodUnitR\x04unit\x126\n" +
+	"\aperiods\x18\x02 \x03(\v2\x1c.analytics.PeriodSeriesPointR\aperiods\x126\n" +
+	"\vchange_unit\x18\x03 \x01(\x0e2\x15.analytics.ChangeUnitR\n" +
+	"changeUnit*\x9a\x01\n" +
+	"\n" +
+	"PeriodUnit\x12\x1b\n" +
+	"\x17PERIOD_UNIT_UNSPECIFIED\x10\x00\x12\x13\n" +
+	"\x0fPERIOD_UNIT_DAY\x10\x01\x12\x14\n" +
+	"\x10PERIOD_UNIT_WEEK\x10\x02\x12\x15\n" +
+	"\x11PERIOD_UNIT_MONTH\x10\x03\x12\x17\n" +
+	"\x13PERIOD_UNIT_QUARTER\x10\x04\x12\x14\n" +
+	"\x10PERIOD_UNIT_YEAR\x10\x05B\x17Z\x15go-grpc-backend/protob\x06proto3"
+
+var (
+	file_period_series_proto_rawDescOnce sync.Once
+	file_period_series_proto_rawDescData []byte
+)
+
+func file_period_series_proto_rawDescGZIP() []byte {
+	file_period_series_proto_rawDescOnce.Do(func() {
+		file_period_series_proto_rawDescData = protoimpl.X.CompressGZIP(unsafe.Slice(unsafe.StringData(file_period_series_proto_rawDesc), len(file_period_series_proto_rawDesc)))
+	})
+	return file_period_series_proto_rawDescData
+}
+
+var file_period_series_proto_enumTypes = make([]protoimpl.EnumInfo, 1)
+var file_period_series_proto_msgTypes = make([]protoimpl.MessageInfo, 3)
+var file_period_series_proto_goTypes = []any{
+	(PeriodUnit)(0),               // 0: analytics.PeriodUnit
+	(*PeriodSeriesRequest)(nil),   // 1: analytics.PeriodSeriesRequest
+	(*PeriodSeriesPoint)(nil),     // 2: analytics.PeriodSeriesPoint
+	(*PeriodSeriesResponse)(nil),  // 3: analytics.PeriodSeriesResponse
+	(*timestamppb.Timestamp)(nil), // 4: google.protobuf.Timestamp
+	(ChangeUnit)(0),               // 5: analytics.ChangeUnit
+	(DateBasis)(0),                // 6: analytics.DateBasis
+	(*DateRange)(nil),             // 7: analytics.DateRange
+	(ChangeStatus)(0),             // 8: analytics.ChangeStatus
+}
+var file_period_series_proto_depIdxs = []int32{
+	0, // 0: analytics.PeriodSeriesRequest.unit:type_name -> analytics.PeriodUnit
+	4, // 1: analytics.PeriodSeriesRequest.anchor:type_name -> google.protobuf.Timestamp
+	5, // 2: analytics.PeriodSeriesRequest.change_unit:type_name -> analytics.ChangeUnit
+	6, // 3: analytics.PeriodSeriesRequest.date_basis:type_name -> analytics.DateBasis
+	7, // 4: analytics.PeriodSeriesPoint.range:type_name -> analytics.DateRange
+	8, // 5: analytics.PeriodSeriesPoint.status:type_name -> analytics.ChangeStatus
+	0, // 6: analytics.PeriodSeriesResponse.unit:type_name -> analytics.PeriodUnit
+	2, // 7: analytics.PeriodSeriesResponse.periods:type_name -> analytics.PeriodSeriesPoint
+	5, // 8: analytics.PeriodSeriesResponse.change_unit:type_name -> analytics.ChangeUnit
+	9, // [9:9] is the sub-list for method output_type
+	9, // [9:9] is the sub-list for method input_type
+	9, // [9:9] is the sub-list for extension type_name
+	9, // [9:9] is the sub-list for extension extendee
+	0, // [0:9] is the sub-list for field type_name
+}
+
+func init() { file_period_series_proto_init() }
+func file_period_series_proto_init() {
+	if File_period_series_proto != nil {
+		return
+	}
+	file_date_range_proto_init()
+	file_period_over_period_proto_init()
+	type x struct{}
+	out := protoimpl.TypeBuilder{
+		File: protoimpl.DescBuilder{
+			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
+			RawDescriptor: unsafe.Slice(unsafe.StringData(file_period_series_proto_rawDesc), len(file_period_series_proto_rawDesc)),
+			NumEnums:      1,
+			NumMessages:   3,
+			NumExtensions: 0,
+			NumServices:   0,
+		},
+		GoTypes:           file_period_series_proto_goTypes,
+		DependencyIndexes: file_period_series_proto_depIdxs,
+		EnumInfos:         file_period_series_proto_enumTypes,
+		MessageInfos:      file_period_series_proto_msgTypes,
+	}.Build()
+	File_period_series_proto = out.File
+	file_period_series_proto_goTypes = nil
+	file_period_series_proto_depIdxs = nil
+}
@@ -0,0 +1,719 @@
+// Code generated by protoc-gen-go. DO NOT EDIT.
+// versions:
+// 	protoc-gen-go v1.36.9
+// 	protoc        v5.29.3
+// source: quality_target.proto
+
+package proto
+
+import (
+	protoreflect "google.golang.org/protobuf/reflect/protoreflect"
+	protoimpl "google.golang.org/protobuf/runtime/protoimpl"
+	durationpb "google.golang.org/protobuf/types/known/durationpb"
+	timestamppb "google.golang.org/protobuf/types/known/timestamppb"
+	reflect "reflect"
+	sync "sync"
+	unsafe "unsafe"
+)
+
+const (
+	// Verify that this generated code is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(20 - protoimpl.MinVersion)
+	// Verify that runtime/protoimpl is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(protoimpl.MaxVersion - 20)
+)
+
+// QualityTarget is the score a rating category should hold over a trailing window.
+// Scores use the category score scale of the other RPCs, so a category's maximum is
+// 100 × its weight
+type QualityTarget struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Id            int32                  `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"` // Assigned by CreateQualityTarget
+	CategoryId    int32                  `protobuf:"varint,2,opt,name=category_id,json=categoryId,proto3" json:"category_id,omitempty"`
+	CategoryName  string                 `protobuf:"bytes,3,opt,name=category_name,json=categoryName,proto3" json:"category_name,omitempty"` // Output only
+	TargetScore   float64                `protobuf:"fixed64,4,opt,name=target_score,json=targetScore,proto3" json:"target_score,omitempty"`  // Must be above 0 and below the category's maximum score
+	Window        *durationpb.Duration   `protobuf:"bytes,5,opt,name=window,proto3" json:"window,omitempty"`                                 // Trailing window attainment is measured over
+	CreatedAt     *timestamppb.Timestamp `protobuf:"bytes,6,opt,name=created_at,json=createdAt,proto3" json:"created_at,omitempty"`          // Output only
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *QualityTarget) Reset() {
+	*x = QualityTarget{}
+	mi := &file_quality_target_proto_msgTypes[0]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *QualityTarget) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*QualityTarget) ProtoMessage() {}
+
+func (x *QualityTarget) ProtoReflect() protoreflect.Message {
+	mi := &file_quality_target_proto_msgTypes[0]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use QualityTarget.ProtoReflect.Descriptor instead.
+func (*QualityTarget) Descriptor() ([]byte, []int) {
+	return file_quality_target_proto_rawDescGZIP(), []int{0}
+}
+
+func (x *QualityTarget) GetId() int32 {
+	if x != nil {
+		return x.Id
+	}
+	return 0
+}
+
+func (x *QualityTarget) GetCategoryId() int32 {
+	if x != nil {
+		return x.CategoryId
+	}
+	return 0
+}
+
+func (x *QualityTarget) GetCategoryName() string {
+	if x != nil {
+		return x.CategoryName
+	}
+	return ""
+}
+
+func (x *QualityTarget) GetTargetScore() float64 {
+	if x != nil {
+		return x.TargetScore
+	}
+	return 0
+}
+
+func (x *QualityTarget) GetWindow() *durationpb.Duration {
+	if x != nil {
+		return x.Window
+	}
+	return nil
+}
+
+func (x *QualityTarget) GetCreatedAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.CreatedAt
+	}
+	return nil
+}
+
+type ListQualityTargetsRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ListQualityTargetsRequest) Reset() {
+	*x = ListQualityTargetsRequest{}
+	mi := &file_quality_target_proto_msgTypes[1]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListQualityTargetsRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListQualityTargetsRequest) ProtoMessage() {}
+
+func (x *ListQualityTargetsRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_quality_target_proto_msgTypes[1]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListQualityTargetsRequest.ProtoReflect.Descriptor instead.
+func (*ListQualityTargetsRequest) Descriptor() ([]byte, []int) {
+	return file_quality_target_proto_rawDescGZIP(), []int{1}
+}
+
+type ListQualityTargetsResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Targets       []*QualityTarget       `protobuf:"bytes,1,rep,name=targets,proto3" json:"targets,omitempty"` // Ordered by id
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ListQualityTargetsResponse) Reset() {
+	*x = ListQualityTargetsResponse{}
+	mi := &file_quality_target_proto_msgTypes[2]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListQualityTargetsResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListQualityTargetsResponse) ProtoMessage() {}
+
+func (x *ListQualityTargetsResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_quality_target_proto_msgTypes[2]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListQualityTargetsResponse.ProtoReflect.Descriptor instead.
+func (*ListQualityTargetsResponse) Descriptor() ([]byte, []int) {
+	return file_quality_target_proto_rawDescGZIP(), []int{2}
+}
+
+func (x *ListQualityTargetsResponse) GetTargets() []*QualityTarget {
+	if x != nil {
+		return x.Targets
+	}
+	return nil
+}
+
+type CreateQualityTargetRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Target        *QualityTarget         `protobuf:"bytes,1,opt,name=target,proto3" json:"target,omitempty"` // id, category_name and created_at are ignored
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *CreateQualityTargetRequest) Reset() {
+	*x = CreateQualityTargetRequest{}
+	mi := &file_quality_target_proto_msgTypes[3]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *CreateQualityTargetRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CreateQualityTargetRequest) ProtoMessage() {}
+
+func (x *CreateQualityTargetRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_quality_target_proto_msgTypes[3]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CreateQualityTargetRequest.ProtoReflect.Descriptor instead.
+func (*CreateQualityTargetRequest) Descriptor() ([]byte, []int) {
+	return file_quality_target_proto_rawDescGZIP(), []int{3}
+}
+
+func (x *CreateQualityTargetRequest) GetTarget() *QualityTarget {
+	if x != nil {
+		return x.Target
+	}
+	return nil
+}
+
+type UpdateQualityTargetRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Target        *QualityTarget         `protobuf:"bytes,1,opt,name=target,proto3" json:"target,omitempty"` // Replaces category_id, target_score and window of the target with target.id
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *UpdateQualityTargetRequest) Reset() {
+	*x = UpdateQualityTargetRequest{}
+	mi := &file_quality_target_proto_msgTypes[4]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *UpdateQualityTargetRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*UpdateQualityTargetRequest) ProtoMessage() {}
+
+func (x *UpdateQualityTargetRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_quality_target_proto_msgTypes[4]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use UpdateQualityTargetRequest.ProtoReflect.Descriptor instead.
+func (*UpdateQualityTargetRequest) Descriptor() ([]byte, []int) {
+	return file_quality_target_proto_rawDescGZIP(), []int{4}
+}
+
+func (x *UpdateQualityTargetRequest) GetTarget() *QualityTarget {
+	if x != nil {
+		return x.Target
+	}
+	return nil
+}
+
+type DeleteQualityTargetRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Id            int32                  `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *DeleteQualityTargetRequest) Reset() {
+	*x = DeleteQualityTargetRequest{}
+	mi := &file_quality_target_proto_msgTypes[5]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *DeleteQualityTargetRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*DeleteQualityTargetRequest) ProtoMessage() {}
+
+func (x *DeleteQualityTargetRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_quality_target_proto_msgTypes[5]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use DeleteQualityTargetRequest.ProtoReflect.Descriptor instead.
+func (*DeleteQualityTargetRequest) Descriptor() ([]byte, []int) {
+	return file_quality_target_proto_rawDescGZIP(), []int{5}
+}
+
+func (x *DeleteQualityTargetRequest) GetId() int32 {
+	if x != nil {
+		return x.Id
+	}
+	return 0
+}
+
+type DeleteQualityTargetResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *DeleteQualityTargetResponse) Reset() {
+	*x = DeleteQualityTargetResponse{}
+	mi := &file_quality_target_proto_msgTypes[6]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *DeleteQualityTargetResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*DeleteQualityTargetResponse) ProtoMessage() {}
+
+func (x *DeleteQualityTargetResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_quality_target_proto_msgTypes[6]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use DeleteQualityTargetResponse.ProtoReflect.Descriptor instead.
+func (*DeleteQualityTargetResponse) Descriptor() ([]byte, []int) {
+	return file_quality_target_proto_rawDescGZIP(), []int{6}
+}
+
+type TargetStatusRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	AsOf          *timestamppb.Timestamp `protobuf:"bytes,1,opt,name=as_of,json=asOf,proto3" json:"as_of,omitempty"`                   // End of every window; defaults to now
+	BurnWindow    *durationpb.Duration   `protobuf:"bytes,2,opt,name=burn_window,json=burnWindow,proto3" json:"burn_window,omitempty"` // Recent window the burn rate is measured over; defaults to 24h, capped at the target's window
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *TargetStatusRequest) Reset() {
+	*x = TargetStatusRequest{}
+	mi := &file_quality_target_proto_msgTypes[7]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *TargetStatusRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*TargetStatusRequest) ProtoMessage() {}
+
+func (x *TargetStatusRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_quality_target_proto_msgTypes[7]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use TargetStatusRequest.ProtoReflect.Descriptor instead.
+func (*TargetStatusRequest) Descriptor() ([]byte, []int) {
+	return file_quality_target_proto_rawDescGZIP(), []int{7}
+}
+
+func (x *TargetStatusRequest) GetAsOf() *timestamppb.Timestamp {
+	if x != nil {
+		return x.AsOf
+	}
+	return nil
+}
+
+func (x *TargetStatusRequest) GetBurnWindow() *durationpb.Duration {
+	if x != nil {
+		return x.BurnWindow
+	}
+	return nil
+}
+
+// TargetStatus treats the gap between the category's maximum score and its target as the
+// error budget: a score at the maximum spends none of it, a score exactly on target spends all of it
+type TargetStatus struct {
+	state                protoimpl.MessageState `protogen:"open.v1"`
+	Target               *QualityTarget         `protobuf:"bytes,1,opt,name=target,proto3" json:"target,omitempty"`
+	Window               *DateRange             `protobuf:"bytes,2,opt,name=window,proto3" json:"window,omitempty"`                                                             // The target's window ending at as_of
+	Score                float64                `protobuf:"fixed64,3,opt,name=score,proto3" json:"score,omitempty"`                                                             // Category score over window
+	MaxScore             float64                `protobuf:"fixed64,4,opt,name=max_score,json=maxScore,proto3" json:"max_score,omitempty"`                                       // Highest score the category can reach under the weights its ratings in window were given
+	RatingCount          int32                  `protobuf:"varint,5,opt,name=rating_count,json=ratingCount,proto3" json:"rating_count,omitempty"`                               // Ratings in window; when 0 the score and budget fields are unset
+	Met                  bool                   `protobuf:"varint,6,opt,name=met,proto3" json:"met,omitempty"`                                                                  // score >= target_score
+	Attainment           float64                `protobuf:"fixed64,7,opt,name=attainment,proto3" json:"attainment,omitempty"`                                                   // score / target_score; 1 is exactly on target
+	ErrorBudget          float64                `protobuf:"fixed64,8,opt,name=error_budget,json=errorBudget,proto3" json:"error_budget,omitempty"`                              // max_score - target_score
+	RemainingErrorBudget float64                `protobuf:"fixed64,9,opt,name=remaining_error_budget,json=remainingErrorBudget,proto3" json:"remaining_error_budget,omitempty"` // Fraction of the budget left: 1 untouched, 0 spent, negative overspent
+	BurnWindow           *DateRange             `protobuf:"bytes,10,opt,name=burn_window,json=burnWindow,proto3" json:"burn_window,omitempty"`
+	BurnRatingCount      int32                  `protobuf:"varint,11,opt,name=burn_rating_count,json=burnRatingCount,proto3" json:"burn_rating_count,omitempty"` // Ratings in burn_window
+	BurnRate             float64                `protobuf:"fixed64,12,opt,name=burn_rate,json=burnRate,proto3" json:"burn_rate,omitempty"`                       // Budget spent over burn_window relative to spending exactly on target; above 1 runs the budget down
+	unknownFields        protoimpl.UnknownFields
+	sizeCache            protoimpl.SizeCache
+}
+
+func (x *TargetStatus) Reset() {
+	*x = TargetStatus{}
+	mi := &file_quality_target_proto_msgTypes[8]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *TargetStatus) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*TargetStatus) ProtoMessage() {}
+
+func (x *TargetStatus) ProtoReflect() protoreflect.Message {
+	mi := &file_quality_target_proto_msgTypes[8]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use TargetStatus.ProtoReflect.Descriptor instead.
+func (*TargetStatus) Descriptor() ([]byte, []int) {
+	return file_quality_target_proto_rawDescGZIP(), []int{8}
+}
+
+func (x *TargetStatus) GetTarget() *QualityTarget {
+	if x != nil {
+		return x.Target
+	}
+	return nil
+}
+
+func (x *TargetStatus) GetWindow() *DateRange {
+	if x != nil {
+		return x.Window
+	}
+	return nil
+}
+
+func (x *TargetStatus) GetScore() float64 {
+	if x != nil {
+		return x.Score
+	}
+	return 0
+}
+
+func (x *TargetStatus) GetMaxScore() float64 {
+	if x != nil {
+		return x.MaxScore
+	}
+	return 0
+}
+
+func (x *TargetStatus) GetRatingCount() int32 {
+	if x != nil {
+		return x.RatingCount
+	}
+	return 0
+}
+
+func (x *TargetStatus) GetMet() bool {
+	if x != nil {
+		return x.Met
+	}
+	return false
+}
+
+func (x *TargetStatus) GetAttainment() float64 {
+	if x != nil {
+		return x.Attainment
+	}
+	return 0
+}
+
+func (x *TargetStatus) GetErrorBudget() float64 {
+	if x != nil {
+		return x.ErrorBudget
+	}
+	return 0
+}
+
+func (x *TargetStatus) GetRemainingErrorBudget() float64 {
+	if x != nil {
+		return x.RemainingErrorBudget
+	}
+	return 0
+}
+
+func (x *TargetStatus) GetBurnWindow() *DateRange {
+	if x != nil {
+		return x.BurnWindow
+	}
+	return nil
+}
+
+func (x *TargetStatus) GetBurnRatingCount() int32 {
+	if x != nil {
+		return x.BurnRatingCount
+	}
+	return 0
+}
+
+func (x *TargetStatus) GetBurnRate() float64 {
+	if x != nil {
+		return x.BurnRate
+	}
+	return 0
+}
+
+type TargetStatusResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Statuses      []*TargetStatus        `protobuf:"bytes,1,rep,name=statuses,proto3" json:"statuses,omitempty"` // One per target, ordered by target id
+	AsOf          *timestamppb.Timestamp `protobuf:"bytes,2,opt,name=as_of,json=asOf,proto3" json:"as_of,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *TargetStatusResponse) Reset() {
+	*x = TargetStatusResponse{}
+	mi := &file_quality_target_proto_msgTypes[9]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *TargetStatusResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*TargetStatusResponse) ProtoMessage() {}
+
+func (x *TargetStatusResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_quality_target_proto_msgTypes[9]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use TargetStatusResponse.ProtoReflect.Descriptor instead.
+func (*TargetStatusResponse) Descriptor() ([]byte, []int) {
+	return file_quality_target_proto_rawDescGZIP(), []int{9}
+}
+
+func (x *TargetStatusResponse) GetStatuses() []*TargetStatus {
+	if x != nil {
+		return x.Statuses
+	}
+	return nil
+}
+
+func (x *TargetStatusResponse) GetAsOf() *timestamppb.Timestamp {
+	if x != nil {
+		return x.AsOf
+	}
+	return nil
+}
+
+var File_quality_target_proto protoreflect.FileDescriptor
+
+const file_quality_target_proto_rawDesc = "" +
+	"\n" +
+	"\x14quality_target.proto\x12\tanalytics\x1a\x1egoogle/protobuf/duration.proto\x1a\x1fgoogle/protobuf/timestamp.proto\x1a\x10date_range.proto\"\xf6\x01\n" +
+	"\rQualityTarget\x12\x0e\n" +
+	"\x02id\x18\x01 \x01(\x05R\x02id\x12\x1f\n" +
+	"\vcategory_id\x18\x02 \x01(\x05R\n" +
+	"categoryId\x12#\n" +
+	"\rcategory_name\x18\x03 \x01(\tR\fcategoryName\x12!\n" +
+	"\ftarget_score\x18\x04 \x01(\x01R\vtargetScore\x121\n" +
+	"\x06window\x18\x05 \x01(\v2\x19.google.protobuf.DurationR\x06window\x129\n" +
+	"\n" +
+	"created_at\x18\x06 \x01(\v2\x1a.google.protobuf.TimestampR\tcreatedAt\"\x1b\n" +
+	"\x19ListQualityTargetsRequest\"P\n" +
+	"\x1aListQualityTargetsResponse\x122\n" +
+	"\atargets\x18\x01 \x03(\v2\x18.analytics.QualityTargetR\atargets\"N\n" +
+	"\x1aCreateQualityTargetRequest\x120\n" +
+	"\x06target\x18\x01 \x01(\v2\x18.analytics.QualityTargetR\x06target\"N\n" +
+	"\x1aUpdateQualityTargetRequest\x120\n" +
+	"\x06target\x18\x01 \x01(\v2\x18.analytics.QualityTargetR\x06target\",\n" +
+	"\x1aDeleteQualityTargetRequest\x12\x0e\n" +
+	"\x02id\x18\x01 \x01(\x05R\x02id\"\x1d\n" +
+	"\x1bDeleteQualityTargetResponse\"\x82\x01\n" +
+	"\x13TargetStatusRequest\x12/\n" +
+	"\x05as_of\x18\x01 \x01(\v2\x1a.google.protobuf.TimestampR\x04asOf\x12:\n" +
+	"\vburn_window\x18\x02 \x01(\v2\x19.google.protobuf.DurationR\n" +
+	"burnWindow\"\xcf\x03\n" +
+	"\fTargetStatus\x120\n" +
+	"\x06target\x18\x01 \x01(\v2\x18.analytics.QualityTargetR\x06target\x12,\n" +
+	"\x06window\x18\x02 \x01(\v2\x14.analytics.DateRangeR\x06window\x12\x14\n" +
+	"\x05score\x18\x03 \x01(\x01R\x05score\x12\x1b\n" +
+	"\tmax_score\x18\x04 \x01(\x01R\bmaxScore\x12!\n" +
+	"\frating_count\x18\x05 \x01(\x05R\vratingCount\x12\x10\n" +
+	"\x03met\x18\x06 \x01(\bR\x03met\x12\x1e\n" +
+	"\n" +
+	"attainment\x18\a \x01(\x01R\n" +
+	"attainment\x12!\n" +
+	"\ferror_budget\x18\b \x01(\x01R\verrorBudget\x124\n" +
+	"\x16remaining_error_budget\x18\t \x01(\x01R\x14remainingErrorBudget\x125\n" +
+	"\vburn_window\x18\n" +
+	" \x01(\v2\x14.analytics.DateRangeR\n" +
+	"burnWindow\x12*\n" +
+	"\x11burn_rating_count\x18\v \x01(\x05R\x0fburnRatingCount\x12\x1b\n" +
+	"\tburn_rate\x18\f \x01(\x01R\bburnRate\"|\n" +
+	"\x14TargetStatusResponse\x123\n" +
+	"\bstatuses\x18\x01 \x03(\v2\x17.analytics.TargetStatusR\bstatuses\x12/\n" +
+	"\x05as_of\x18\x02 \x01(\v2\x1a.google.protobuf.TimestampR\x04asOfB\x17Z\x15go-grpc-backend/protob\x06proto3"
+
+var (
+	file_quality_target_proto_rawDescOnce sync.Once
+	file_quality_target_proto_rawDescData []byte
+)
+
+func file_quality_target_proto_rawDescGZIP() []byte {
+	file_quality_target_proto_rawDescOnce.Do(func() {
+		file_quality_target_proto_rawDescData = protoimpl.X.CompressGZIP(unsafe.Slice(unsafe.StringData(file_quality_target_proto_rawDesc), len(file_quality_target_proto_rawDesc)))
+	})
+	return file_quality_target_proto_rawDescData
+}
+
+var file_quality_target_proto_msgTypes = make([]protoimpl.MessageInfo, 10)
+var file_quality_target_proto_goTypes = []any{
+	(*QualityTarget)(nil),               // 0: analytics.QualityTarget
+	(*ListQualityTargetsRequest)(nil),   // 1: analytics.ListQualityTargetsRequest
+	(*ListQualityTargetsResponse)(nil),  // 2: analytics.ListQualityTargetsResponse
+	(*CreateQualityTargetRequest)(nil),  // 3: analytics.CreateQualityTargetRequest
+	(*UpdateQualityTargetRequest)(nil),  // 4: analytics.UpdateQualityTargetRequest
+	(*DeleteQualityTargetRequest)(nil),  // 5: analytics.DeleteQualityTargetRequest
+	(*DeleteQualityTargetResponse)(nil), // 6: analytics.DeleteQualityTargetResponse
+	(*TargetStatusRequest)(nil),         // 7: analytics.TargetStatusRequest
+	(*TargetStatus)(nil),                // 8: analytics.TargetStatus
+	(*TargetStatusResponse)(nil),        // 9: analytics.TargetStatusResponse
+	(*durationpb.Duration)(nil),         // 10: google.protobuf.Duration
+	(*timestamppb.Timestamp)(nil),       // 11: google.protobuf.Timestamp
+	(*DateRange)(nil),                   // 12: analytics.DateRange
+}
+var file_quality_target_proto_depIdxs = []int32{
+	10, // 0: analytics.QualityTarget.window:type_name -> google.protobuf.Duration
+	11, // 1: analytics.QualityTarget.created_at:type_name -> google.protobuf.Timestamp
+	0,  // 2: analytics.ListQualityTargetsResponse.targets:type_name -> analytics.QualityTarget
+	0,  // 3: analytics.CreateQualityTargetRequest.target:type_name -> analytics.QualityTarget
+	0,  // 4: analytics.UpdateQualityTargetRequest.target:type_name -> analytics.QualityTarget
+	11, // 5: analytics.TargetStatusRequest.as_of:type_name -> google.protobuf.Timestamp
+	10, // 6: analytics.TargetStatusRequest.burn_window:type_name -> google.protobuf.Duration
+	0,  // 7: analytics.TargetStatus.target:type_name -> analytics.QualityTarget
+	12, // 8: analytics.TargetStatus.window:type_name -> analytics.DateRange
+	12, // 9: analytics.TargetStatus.burn_window:type_name -> analytics.DateRange
+	8,  // 10: analytics.TargetStatusResponse.statuses:type_name -> analytics.TargetStatus
+	11, // 11: analytics.TargetStatusResponse.as_of:type_name -> google.protobuf.Timestamp
+	12, // [12:12] is the sub-list for method output_type
+	12, // [12:12] is the sub-list for method input_type
+	12, // [12:12] is the sub-list for extension type_name
+	12, // [12:12] is the sub-list for extension extendee
+	0,  // [0:12] is the sub-list for field type_name
+}
+
+func init() { file_quality_target_proto_init() }
+func file_quality_target_proto_init() {
+	if File_quality_target_proto != nil {
+		return
+	}
+	file_date_range_proto_init()
+	type x struct{}
+	out := protoimpl.TypeBuilder{
+		File: protoimpl.DescBuilder{
+			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
+			RawDescriptor: unsafe.Slice(unsafe.StringData(file_quality_target_proto_rawDesc), len(file_quality_target_proto_rawDesc)),
+			NumEnums:      0,
+			NumMessages:   10,
+			NumExtensions: 0,
+			NumServices:   0,
+		},
+		GoTypes:           file_quality_target_proto_goTypes,
+		DependencyIndexes: file_quality_target_proto_depIdxs,
+		MessageInfos:      file_quality_target_proto_msgTypes,
+	}.Build()
+	File_quality_target_proto = out.File
+	file_quality_target_proto_goTypes = nil
+	file_quality_target_proto_depIdxs = nil
+}
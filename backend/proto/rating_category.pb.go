@@ -0,0 +1,474 @@
+// Code generated by protoc-gen-go. DO NOT EDIT.
+// versions:
+// 	protoc-gen-go v1.36.9
+// 	protoc        v5.29.3
+// source: rating_category.proto
+
+package proto
+
+import (
+	protoreflect "google.golang.org/protobuf/reflect/protoreflect"
+	protoimpl "google.golang.org/protobuf/runtime/protoimpl"
+	timestamppb "google.golang.org/protobuf/types/known/timestamppb"
+	reflect "reflect"
+	sync "sync"
+	unsafe "unsafe"
+)
+
+const (
+	// Verify that this generated code is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(20 - protoimpl.MinVersion)
+	// Verify that runtime/protoimpl is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(protoimpl.MaxVersion - 20)
+)
+
+type RatingCategory struct {
+	state         protoimpl.MessageState  `protogen:"open.v1"`
+	Id            int32                   `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"` // Assigned by CreateRatingCategory
+	Name          string                  `protobuf:"bytes,2,opt,name=name,proto3" json:"name,omitempty"`
+	Weight        float64                 `protobuf:"fixed64,3,opt,name=weight,proto3" json:"weight,omitempty"`                                  // The weight that applies now
+	ArchivedAt    *timestamppb.Timestamp  `protobuf:"bytes,4,opt,name=archived_at,json=archivedAt,proto3" json:"archived_at,omitempty"`          // Output only; unset while the category is active
+	WeightHistory []*RatingCategoryWeight `protobuf:"bytes,5,rep,name=weight_history,json=weightHistory,proto3" json:"weight_history,omitempty"` // Output only; oldest first
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *RatingCategory) Reset() {
+	*x = RatingCategory{}
+	mi := &file_rating_category_proto_msgTypes[0]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *RatingCategory) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*RatingCategory) ProtoMessage() {}
+
+func (x *RatingCategory) ProtoReflect() protoreflect.Message {
+	mi := &file_rating_category_proto_msgTypes[0]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use RatingCategory.ProtoReflect.Descriptor instead.
+func (*RatingCategory) Descriptor() ([]byte, []int) {
+	return file_rating_category_proto_rawDescGZIP(), []int{0}
+}
+
+func (x *RatingCategory) GetId() int32 {
+	if x != nil {
+		return x.Id
+	}
+	return 0
+}
+
+func (x *RatingCategory) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+func (x *RatingCategory) GetWeight() float64 {
+	if x != nil {
+		return x.Weight
+	}
+	return 0
+}
+
+func (x *RatingCategory) GetArchivedAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.ArchivedAt
+	}
+	return nil
+}
+
+func (x *RatingCategory) GetWeightHistory() []*RatingCategoryWeight {
+	if x != nil {
+		return x.WeightHistory
+	}
+	return nil
+}
+
+// RatingCategoryWeight is a weight a category had from effective_from until the next entry
+type RatingCategoryWeight struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Weight        float64                `protobuf:"fixed64,1,opt,name=weight,proto3" json:"weight,omitempty"`
+	EffectiveFrom *timestamppb.Timestamp `protobuf:"bytes,2,opt,name=effective_from,json=effectiveFrom,proto3" json:"effective_from,omitempty"` // Unset for the weight the category started with
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *RatingCategoryWeight) Reset() {
+	*x = RatingCategoryWeight{}
+	mi := &file_rating_category_proto_msgTypes[1]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *RatingCategoryWeight) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*RatingCategoryWeight) ProtoMessage() {}
+
+func (x *RatingCategoryWeight) ProtoReflect() protoreflect.Message {
+	mi := &file_rating_category_proto_msgTypes[1]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use RatingCategoryWeight.ProtoReflect.Descriptor instead.
+func (*RatingCategoryWeight) Descriptor() ([]byte, []int) {
+	return file_rating_category_proto_rawDescGZIP(), []int{1}
+}
+
+func (x *RatingCategoryWeight) GetWeight() float64 {
+	if x != nil {
+		return x.Weight
+	}
+	return 0
+}
+
+func (x *RatingCategoryWeight) GetEffectiveFrom() *timestamppb.Timestamp {
+	if x != nil {
+		return x.EffectiveFrom
+	}
+	return nil
+}
+
+type ListRatingCategoriesRequest struct {
+	state           protoimpl.MessageState `protogen:"open.v1"`
+	IncludeArchived bool                   `protobuf:"varint,1,opt,name=include_archived,json=includeArchived,proto3" json:"include_archived,omitempty"`
+	unknownFields   protoimpl.UnknownFields
+	sizeCache       protoimpl.SizeCache
+}
+
+func (x *ListRatingCategoriesRequest) Reset() {
+	*x = ListRatingCategoriesRequest{}
+	mi := &file_rating_category_proto_msgTypes[2]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListRatingCategoriesRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListRatingCategoriesRequest) ProtoMessage() {}
+
+func (x *ListRatingCategoriesRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_rating_category_proto_msgTypes[2]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListRatingCategoriesRequest.ProtoReflect.Descriptor instead.
+func (*ListRatingCategoriesRequest) Descriptor() ([]byte, []int) {
+	return file_rating_category_proto_rawDescGZIP(), []int{2}
+}
+
+func (x *ListRatingCategoriesRequest) GetIncludeArchived() bool {
+	if x != nil {
+		return x.IncludeArchived
+	}
+	return false
+}
+
+type ListRatingCategoriesResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Categories    []*RatingCategory      `protobuf:"bytes,1,rep,name=categories,proto3" json:"categories,omitempty"` // Ordered by name
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ListRatingCategoriesResponse) Reset() {
+	*x = ListRatingCategoriesResponse{}
+	mi := &file_rating_category_proto_msgTypes[3]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListRatingCategoriesResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListRatingCategoriesResponse) ProtoMessage() {}
+
+func (x *ListRatingCategoriesResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_rating_category_proto_msgTypes[3]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListRatingCategoriesResponse.ProtoReflect.Descriptor instead.
+func (*ListRatingCategoriesResponse) Descriptor() ([]byte, []int) {
+	return file_rating_category_proto_rawDescGZIP(), []int{3}
+}
+
+func (x *ListRatingCategoriesResponse) GetCategories() []*RatingCategory {
+	if x != nil {
+		return x.Categories
+	}
+	return nil
+}
+
+type CreateRatingCategoryRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Category      *RatingCategory        `protobuf:"bytes,1,opt,name=category,proto3" json:"category,omitempty"` // Only name and weight are read
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *CreateRatingCategoryRequest) Reset() {
+	*x = CreateRatingCategoryRequest{}
+	mi := &file_rating_category_proto_msgTypes[4]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *CreateRatingCategoryRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CreateRatingCategoryRequest) ProtoMessage() {}
+
+func (x *CreateRatingCategoryRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_rating_category_proto_msgTypes[4]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CreateRatingCategoryRequest.ProtoReflect.Descriptor instead.
+func (*CreateRatingCategoryRequest) Descriptor() ([]byte, []int) {
+	return file_rating_category_proto_rawDescGZIP(), []int{4}
+}
+
+func (x *CreateRatingCategoryRequest) GetCategory() *RatingCategory {
+	if x != nil {
+		return x.Category
+	}
+	return nil
+}
+
+type UpdateRatingCategoryRequest struct {
+	state    protoimpl.MessageState `protogen:"open.v1"`
+	Category *RatingCategory        `protobuf:"bytes,1,opt,name=category,proto3" json:"category,omitempty"` // Replaces name and weight of the category with this id
+	// When a new weight starts to apply, so ratings created from then on are scored with it;
+	// defaults to now and can't be in the future. A back-dated weight only applies until the
+	// next change already in weight_history
+	WeightEffectiveFrom *timestamppb.Timestamp `protobuf:"bytes,2,opt,name=weight_effective_from,json=weightEffectiveFrom,proto3" json:"weight_effective_from,omitempty"`
+	unknownFields       protoimpl.UnknownFields
+	sizeCache           protoimpl.SizeCache
+}
+
+func (x *UpdateRatingCategoryRequest) Reset() {
+	*x = UpdateRatingCategoryRequest{}
+	mi := &file_rating_category_proto_msgTypes[5]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *UpdateRatingCategoryRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*UpdateRatingCategoryRequest) ProtoMessage() {}
+
+func (x *UpdateRatingCategoryRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_rating_category_proto_msgTypes[5]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use UpdateRatingCategoryRequest.ProtoReflect.Descriptor instead.
+func (*UpdateRatingCategoryRequest) Descriptor() ([]byte, []int) {
+	return file_rating_category_proto_rawDescGZIP(), []int{5}
+}
+
+func (x *UpdateRatingCategoryRequest) GetCategory() *RatingCategory {
+	if x != nil {
+		return x.Category
+	}
+	return nil
+}
+
+func (x *UpdateRatingCategoryRequest) GetWeightEffectiveFrom() *timestamppb.Timestamp {
+	if x != nil {
+		return x.WeightEffectiveFrom
+	}
+	return nil
+}
+
+type ArchiveRatingCategoryRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Id            int32                  `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ArchiveRatingCategoryRequest) Reset() {
+	*x = ArchiveRatingCategoryRequest{}
+	mi := &file_rating_category_proto_msgTypes[6]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ArchiveRatingCategoryRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ArchiveRatingCategoryRequest) ProtoMessage() {}
+
+func (x *ArchiveRatingCategoryRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_rating_category_proto_msgTypes[6]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ArchiveRatingCategoryRequest.ProtoReflect.Descriptor instead.
+func (*ArchiveRatingCategoryRequest) Descriptor() ([]byte, []int) {
+	return file_rating_category_proto_rawDescGZIP(), []int{6}
+}
+
+func (x *ArchiveRatingCategoryRequest) GetId() int32 {
+	if x != nil {
+		return x.Id
+	}
+	return 0
+}
+
+var File_rating_category_proto protoreflect.FileDescriptor
+
+const file_rating_category_proto_rawDesc = "" +
+	"\n" +
+	"\x15rating_category.proto\x12\tanalytics\x1a\x1fgoogle/protobuf/timestamp.proto\"\xd1\x01\n" +
+	"\x0eRatingCategory\x12\x0e\n" +
+	"\x02id\x18\x01 \x01(\x05R\x02id\x12\x12\n" +
+	"\x04name\x18\x02 \x01(\tR\x04name\x12\x16\n" +
+	"\x06weight\x18\x03 \x01(\x01R\x06weight\x12;\n" +
+	"\varchived_at\x18\x04 \x01(\v2\x1a.google.protobuf.TimestampR\n" +
+	"archivedAt\x12F\n" +
+	"\x0eweight_history\x18\x05 \x03(\v2\x1f.analytics.RatingCategoryWeightR\rweightHistory\"q\n" +
+	"\x14RatingCategoryWeight\x12\x16\n" +
+	"\x06weight\x18\x01 \x01(\x01R\x06weight\x12A\n" +
+	"\x0eeffective_from\x18\x02 \x01(\v2\x1a.google.protobuf.TimestampR\reffectiveFrom\"H\n" +
+	"\x1bListRatingCategoriesRequest\x12)\n" +
+	"\x10include_archived\x18\x01 \x01(\bR\x0fincludeArchived\"Y\n" +
+	"\x1cListRatingCategoriesResponse\x129\n" +
+	"\n" +
+	"categories\x18\x01 \x03(\v2\x19.analytics.RatingCategoryR\n" +
+	"categories\"T\n" +
+	"\x1bCreateRatingCategoryRequest\x125\n" +
+	"\bcategory\x18\x01 \x01(\v2\x19.analytics.RatingCategoryR\bcategory\"\xa4\x01\n" +
+	"\x1bUpdateRatingCategoryRequest\x125\n" +
+	"\bcategory\x18\x01 \x01(\v2\x19.analytics.RatingCategoryR\bcategory\x12N\n" +
+	"\x15weight_effective_from\x18\x02 \x01(\v2\x1a.google.protobuf.TimestampR\x13weightEffectiveFrom\".\n" +
+	"\x1cArchiveRatingCategoryRequest\x12\x0e\n" +
+	"\x02id\x18\x01 \x01(\x05R\x02idB\x17Z\x15go-grpc-backend/protob\x06proto3"
+
+var (
+	file_rating_category_proto_rawDescOnce sync.Once
+	file_rating_category_proto_rawDescData []byte
+)
+
+func file_rating_category_proto_rawDescGZIP() []byte {
+	file_rating_category_proto_rawDescOnce.Do(func() {
+		file_rating_category_proto_rawDescData = protoimpl.X.CompressGZIP(unsafe.Slice(unsafe.StringData(file_rating_category_proto_rawDesc), len(file_rating_category_proto_rawDesc)))
+	})
+	return file_rating_category_proto_rawDescData
+}
+
+var file_rating_category_proto_msgTypes = make([]protoimpl.MessageInfo, 7)
+var file_rating_category_proto_goTypes = []any{
+	(*RatingCategory)(nil),               // 0: analytics.RatingCategory
+	(*RatingCategoryWeight)(nil),         // 1: analytics.RatingCategoryWeight
+	(*ListRatingCategoriesRequest)(nil),  // 2: analytics.ListRatingCategoriesRequest
+	(*ListRatingCategoriesResponse)(nil), // 3: analytics.ListRatingCategoriesResponse
+	(*CreateRatingCategoryRequest)(nil),  // 4: analytics.CreateRatingCategoryRequest
+	(*UpdateRatingCategoryRequest)(nil),  // 5: analytics.UpdateRatingCategoryRequest
+	(*ArchiveRatingCategoryRequest)(nil), // 6: analytics.ArchiveRatingCategoryRequest
+	(*timestamppb.Timestamp)(nil),        // 7: google.protobuf.Timestamp
+}
+var file_rating_category_proto_depIdxs = []int32{
+	7, // 0: analytics.RatingCategory.archived_at:type_name -> google.protobuf.Timestamp
+	1, // 1: analytics.RatingCategory.weight_history:type_name -> analytics.RatingCategoryWeight
+	7, // 2: analytics.RatingCategoryWeight.effective_from:type_name -> google.protobuf.Timestamp
+	0, // 3: analytics.ListRatingCategoriesResponse.categories:type_name -> analytics.RatingCategory
+	0, // 4: analytics.CreateRatingCategoryRequest.category:type_name -> analytics.RatingCategory
+	0, // 5: analytics.UpdateRatingCategoryRequest.category:type_name -> analytics.RatingCategory
+	7, // 6: analytics.UpdateRatingCategoryRequest.weight_effective_from:type_name -> google.protobuf.Timestamp
+	7, // [7:7] is the sub-list for method output_type
+	7, // [7:7] is the sub-list for method input_type
+	7, // [7:7] is the sub-list for extension type_name
+	7, // [7:7] is the sub-list for extension extendee
+	0, // [0:7] is the sub-list for field type_name
+}
+
+func init() { file_rating_category_proto_init() }
+func file_rating_category_proto_init() {
+	if File_rating_category_proto != nil {
+		return
+	}
+	type x struct{}
+	out := protoimpl.TypeBuilder{
+		File: protoimpl.DescBuilder{
+			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
+			RawDescriptor: unsafe.Slice(unsafe.StringData(file_rating_category_proto_rawDesc), len(file_rating_category_proto_rawDesc)),
+			NumEnums:      0,
+			NumMessages:   7,
+			NumExtensions: 0,
+			NumServices:   0,
+		},
+		GoTypes:           file_rating_category_proto_goTypes,
+		DependencyIndexes: file_rating_category_proto_depIdxs,
+		MessageInfos:      file_rating_category_proto_msgTypes,
+	}.Build()
+	File_rating_category_proto = out.File
+	file_rating_category_proto_goTypes = nil
+	file_rating_category_proto_depIdxs = nil
+}
@@ -0,0 +1,489 @@
+// Code generated by protoc-gen-go. DO NOT EDIT.
+// versions:
+// 	protoc-gen-go v1.36.9
+// 	protoc        v5.29.3
+// source: rating_distribution.proto
+
+package proto
+
+import (
+	protoreflect "google.golang.org/protobuf/reflect/protoreflect"
+	protoimpl "google.golang.org/protobuf/runtime/protoimpl"
+	timestamppb "google.golang.org/protobuf/types/known/timestamppb"
+	reflect "reflect"
+	sync "sync"
+	unsafe "unsafe"
+)
+
+const (
+	// Verify that this generated code is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(20 - protoimpl.MinVersion)
+	// Verify that runtime/protoimpl is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(protoimpl.MaxVersion - 20)
+)
+
+type RatingDistributionRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	StartDate     *timestamppb.Timestamp `protobuf:"bytes,1,opt,name=start_date,json=startDate,proto3" json:"start_date,omitempty"`
+	EndDate       *timestamppb.Timestamp `protobuf:"bytes,2,opt,name=end_date,json=endDate,proto3" json:"end_date,omitempty"`
+	InclusiveEnd  bool                   `protobuf:"varint,3,opt,name=inclusive_end,json=inclusiveEnd,proto3" json:"inclusive_end,omitempty"`                 // Also count ratings created exactly at end_date
+	Granularity   Granularity            `protobuf:"varint,4,opt,name=granularity,proto3,enum=analytics.Granularity" json:"granularity,omitempty"`            // Also break each category down by day or week; unspecified returns totals only
+	Percentiles   []float64              `protobuf:"fixed64,5,rep,packed,name=percentiles,proto3" json:"percentiles,omitempty"`                               // Percentiles to report, 0-100; defaults to 10, 25, 50, 75, 90
+	DateBasis     DateBasis              `protobuf:"varint,6,opt,name=date_basis,json=dateBasis,proto3,enum=analytics.DateBasis" json:"date_basis,omitempty"` // Which timestamp the range applies to; defaults to the rating's
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *RatingDistributionRequest) Reset() {
+	*x = RatingDistributionRequest{}
+	mi := &file_rating_distribution_proto_msgTypes[0]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *RatingDistributionRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*RatingDistributionRequest) ProtoMessage() {}
+
+func (x *RatingDistributionRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_rating_distribution_proto_msgTypes[0]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use RatingDistributionRequest.ProtoReflect.Descriptor instead.
+func (*RatingDistributionRequest) Descriptor() ([]byte, []int) {
+	return file_rating_distribution_proto_rawDescGZIP(), []int{0}
+}
+
+func (x *RatingDistributionRequest) GetStartDate() *timestamppb.Timestamp {
+	if x != nil {
+		return x.StartDate
+	}
+	return nil
+}
+
+func (x *RatingDistributionRequest) GetEndDate() *timestamppb.Timestamp {
+	if x != nil {
+		return x.EndDate
+	}
+	return nil
+}
+
+func (x *RatingDistributionRequest) GetInclusiveEnd() bool {
+	if x != nil {
+		return x.InclusiveEnd
+	}
+	return false
+}
+
+func (x *RatingDistributionRequest) GetGranularity() Granularity {
+	if x != nil {
+		return x.Granularity
+	}
+	return Granularity_GRANULARITY_UNSPECIFIED
+}
+
+func (x *RatingDistributionRequest) GetPercentiles() []float64 {
+	if x != nil {
+		return x.Percentiles
+	}
+	return nil
+}
+
+func (x *RatingDistributionRequest) GetDateBasis() DateBasis {
+	if x != nil {
+		return x.DateBasis
+	}
+	return DateBasis_DATE_BASIS_UNSPECIFIED
+}
+
+type Percentile struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Percentile    float64                `protobuf:"fixed64,1,opt,name=percentile,proto3" json:"percentile,omitempty"` // Requested percentile, 0-100
+	Value         float64                `protobuf:"fixed64,2,opt,name=value,proto3" json:"value,omitempty"`           // Rating value on the 0-5 scale
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *Percentile) Reset() {
+	*x = Percentile{}
+	mi := &file_rating_distribution_proto_msgTypes[1]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *Percentile) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Percentile) ProtoMessage() {}
+
+func (x *Percentile) ProtoReflect() protoreflect.Message {
+	mi := &file_rating_distribution_proto_msgTypes[1]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Percentile.ProtoReflect.Descriptor instead.
+func (*Percentile) Descriptor() ([]byte, []int) {
+	return file_rating_distribution_proto_rawDescGZIP(), []int{1}
+}
+
+func (x *Percentile) GetPercentile() float64 {
+	if x != nil {
+		return x.Percentile
+	}
+	return 0
+}
+
+func (x *Percentile) GetValue() float64 {
+	if x != nil {
+		return x.Value
+	}
+	return 0
+}
+
+type RatingDistribution struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Date          *timestamppb.Timestamp `protobuf:"bytes,1,opt,name=date,proto3" json:"date,omitempty"`             // Bucket start; unset for the category total
+	Counts        []int32                `protobuf:"varint,2,rep,packed,name=counts,proto3" json:"counts,omitempty"` // counts[v] is the number of ratings with value v, for v in 0-5
+	Total         int32                  `protobuf:"varint,3,opt,name=total,proto3" json:"total,omitempty"`
+	Mean          float64                `protobuf:"fixed64,4,opt,name=mean,proto3" json:"mean,omitempty"`
+	Median        float64                `protobuf:"fixed64,5,opt,name=median,proto3" json:"median,omitempty"`
+	StdDev        float64                `protobuf:"fixed64,6,opt,name=std_dev,json=stdDev,proto3" json:"std_dev,omitempty"` // Population standard deviation
+	Percentiles   []*Percentile          `protobuf:"bytes,7,rep,name=percentiles,proto3" json:"percentiles,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *RatingDistribution) Reset() {
+	*x = RatingDistribution{}
+	mi := &file_rating_distribution_proto_msgTypes[2]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *RatingDistribution) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*RatingDistribution) ProtoMessage() {}
+
+func (x *RatingDistribution) ProtoReflect() protoreflect.Message {
+	mi := &file_rating_distribution_proto_msgTypes[2]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use RatingDistribution.ProtoReflect.Descriptor instead.
+func (*RatingDistribution) Descriptor() ([]byte, []int) {
+	return file_rating_distribution_proto_rawDescGZIP(), []int{2}
+}
+
+func (x *RatingDistribution) GetDate() *timestamppb.Timestamp {
+	if x != nil {
+		return x.Date
+	}
+	return nil
+}
+
+func (x *RatingDistribution) GetCounts() []int32 {
+	if x != nil {
+		return x.Counts
+	}
+	return nil
+}
+
+func (x *RatingDistribution) GetTotal() int32 {
+	if x != nil {
+		return x.Total
+	}
+	return 0
+}
+
+func (x *RatingDistribution) GetMean() float64 {
+	if x != nil {
+		return x.Mean
+	}
+	return 0
+}
+
+func (x *RatingDistribution) GetMedian() float64 {
+	if x != nil {
+		return x.Median
+	}
+	return 0
+}
+
+func (x *RatingDistribution) GetStdDev() float64 {
+	if x != nil {
+		return x.StdDev
+	}
+	return 0
+}
+
+func (x *RatingDistribution) GetPercentiles() []*Percentile {
+	if x != nil {
+		return x.Percentiles
+	}
+	return nil
+}
+
+type CategoryDistribution struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	CategoryId    int32                  `protobuf:"varint,1,opt,name=category_id,json=categoryId,proto3" json:"category_id,omitempty"`
+	CategoryName  string                 `protobuf:"bytes,2,opt,name=category_name,json=categoryName,proto3" json:"category_name,omitempty"`
+	Total         *RatingDistribution    `protobuf:"bytes,3,opt,name=total,proto3" json:"total,omitempty"`     // Whole range
+	Buckets       []*RatingDistribution  `protobuf:"bytes,4,rep,name=buckets,proto3" json:"buckets,omitempty"` // Per bucket, ordered by date; empty unless a granularity was requested
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *CategoryDistribution) Reset() {
+	*x = CategoryDistribution{}
+	mi := &file_rating_distribution_proto_msgTypes[3]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *CategoryDistribution) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CategoryDistribution) ProtoMessage() {}
+
+func (x *CategoryDistribution) ProtoReflect() protoreflect.Message {
+	mi := &file_rating_distribution_proto_msgTypes[3]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CategoryDistribution.ProtoReflect.Descriptor instead.
+func (*CategoryDistribution) Descriptor() ([]byte, []int) {
+	return file_rating_distribution_proto_rawDescGZIP(), []int{3}
+}
+
+func (x *CategoryDistribution) GetCategoryId() int32 {
+	if x != nil {
+		return x.CategoryId
+	}
+	return 0
+}
+
+func (x *CategoryDistribution) GetCategoryName() string {
+	if x != nil {
+		return x.CategoryName
+	}
+	return ""
+}
+
+func (x *CategoryDistribution) GetTotal() *RatingDistribution {
+	if x != nil {
+		return x.Total
+	}
+	return nil
+}
+
+func (x *CategoryDistribution) GetBuckets() []*RatingDistribution {
+	if x != nil {
+		return x.Buckets
+	}
+	return nil
+}
+
+type RatingDistributionResponse struct {
+	state         protoimpl.MessageState  `protogen:"open.v1"`
+	Granularity   Granularity             `protobuf:"varint,1,opt,name=granularity,proto3,enum=analytics.Granularity" json:"granularity,omitempty"`
+	Categories    []*CategoryDistribution `protobuf:"bytes,2,rep,name=categories,proto3" json:"categories,omitempty"`
+	Range         *DateRange              `protobuf:"bytes,3,opt,name=range,proto3" json:"range,omitempty"` // Range the ratings were filtered by
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *RatingDistributionResponse) Reset() {
+	*x = RatingDistributionResponse{}
+	mi := &file_rating_distribution_proto_msgTypes[4]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *RatingDistributionResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*RatingDistributionResponse) ProtoMessage() {}
+
+func (x *RatingDistributionResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_rating_distribution_proto_msgTypes[4]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use RatingDistributionResponse.ProtoReflect.Descriptor instead.
+func (*RatingDistributionResponse) Descriptor() ([]byte, []int) {
+	return file_rating_distribution_proto_rawDescGZIP(), []int{4}
+}
+
+func (x *RatingDistributionResponse) GetGranularity() Granularity {
+	if x != nil {
+		return x.Granularity
+	}
+	return Granularity_GRANULARITY_UNSPECIFIED
+}
+
+func (x *RatingDistributionResponse) GetCategories() []*CategoryDistribution {
+	if x != nil {
+		return x.Categories
+	}
+	return nil
+}
+
+func (x *RatingDistributionResponse) GetRange() *DateRange {
+	if x != nil {
+		return x.Range
+	}
+	return nil
+}
+
+var File_rating_distribution_proto protoreflect.FileDescriptor
+
+const file_rating_distribution_proto_rawDesc = "" +
+	"\n" +
+	"\x19rating_distribution.proto\x12\tanalytics\x1a\x1fgoogle/protobuf/timestamp.proto\x1a\x14category_score.proto\x1a\x10date_range.proto\"\xc3\x02\n" +
+	"\x19RatingDistributionRequest\x129\n" +
+	"\n" +
+	"start_date\x18\x01 \x01(\v2\x1a.google.protobuf.TimestampR\tstartDate\x125\n" +
+	"\bend_date\x18\x02 \x01(\v2\x1a.google.protobuf.TimestampR\aendDate\x12#\n" +
+	"\rinclusive_end\x18\x03 \x01(\bR\finclusiveEnd\x128\n" +
+	"\vgranularity\x18\x04 \x01(\x0e2\x16.analytics.GranularityR\vgranularity\x12 \n" +
+	"\vpercentiles\x18\x05 \x03(\x01R\vpercentiles\x123\n" +
+	"\n" +
+	"date_basis\x18\x06 \x01(\x0e2\x14.analytics.DateBasisR\tdateBasis\"B\n" +
+	"\n" +
+	"Percentile\x12\x1e\n" +
+	"\n" +
+	"percentile\x18\x01 \x01(\x01R\n" +
+	"percentile\x12\x14\n" +
+	"\x05value\x18\x02 \x01(\x01R\x05value\"\xf0\x01\n" +
+	"\x12RatingDistribution\x12.\n" +
+	"\x04date\x18\x01 \x01(\v2\x1a.google.protobuf.TimestampR\x04date\x12\x16\n" +
+	"\x06counts\x18\x02 \x03(\x05R\x06counts\x12\x14\n" +
+	"\x05total\x18\x03 \x01(\x05R\x05total\x12\x12\n" +
+	"\x04mean\x18\x04 \x01(\x01R\x04mean\x12\x16\n" +
+	"\x06median\x18\x05 \x01(\x01R\x06median\x12\x17\n" +
+	"\astd_dev\x18\x06 \x01(\x01R\x06stdDev\x127\n" +
+	"\vpercentiles\x18\a \x03(\v2\x15.analytics.PercentileR\vpercentiles\"\xca\x01\n" +
+	"\x14CategoryDistribution\x12\x1f\n" +
+	"\vcategory_id\x18\x01 \x01(\x05R\n" +
+	"categoryId\x12#\n" +
+	"\rcategory_name\x18\x02 \x01(\tR\fcategoryName\x123\n" +
+	"\x05total\x18\x03 \x01(\v2\x1d.analytics.RatingDistributionR\x05total\x127\n" +
+	"\abuckets\x18\x04 \x03(\v2\x1d.analytics.RatingDistributionR\abuckets\"\xc3\x01\n" +
+	"\x1aRatingDistributionResponse\x128\n" +
+	"\vgranularity\x18\x01 \x01(\x0e2\x16.analytics.GranularityR\vgranularity\x12?\n" +
+	"\n" +
+	"categories\x18\x02 \x03(\v2\x1f.analytics.CategoryDistributionR\n" +
+	"categories\x12*\n" +
+	"\x05range\x18\x03 \x01(\v2\x14.analytics.DateRangeR\x05rangeB\x17Z\x15go-grpc-backend/protob\x06proto3"
+
+var (
+	file_rating_distribution_proto_rawDescOnce sync.Once
+	file_rating_distribution_proto_rawDescData []byte
+)
+
+func file_rating_distribution_proto_rawDescGZIP() []byte {
+	file_rating_distribution_proto_rawDescOnce.Do(func() {
+		file_rating_distribution_proto_rawDescData = protoimpl.X.CompressGZIP(unsafe.Slice(unsafe.StringData(file_rating_distribution_proto_rawDesc), len(file_rating_distribution_proto_rawDesc)))
+	})
+	return file_rating_distribution_proto_rawDescData
+}
+
+var file_rating_distribution_proto_msgTypes = make([]protoimpl.MessageInfo, 5)
+var file_rating_distribution_proto_goTypes = []any{
+	(*RatingDistributionRequest)(nil),  // 0: analytics.RatingDistributionRequest
+	(*Percentile)(nil),                 // 1: analytics.Percentile
+	(*RatingDistribution)(nil),         // 2: analytics.RatingDistribution
+	(*CategoryDistribution)(nil),       // 3: analytics.CategoryDistribution
+	(*RatingDistributionResponse)(nil), // 4: analytics.RatingDistributionResponse
+	(*timestamppb.Timestamp)(nil),      // 5: google.protobuf.Timestamp
+	(Granularity)(0),                   // 6: analytics.Granularity
+	(DateBasis)(0),                     // 7: analytics.DateBasis
+	(*DateRange)(nil),                  // 8: analytics.DateRange
+}
+var file_rating_distribution_proto_depIdxs = []int32{
+	5,  // 0: analytics.RatingDistributionRequest.start_date:type_name -> google.protobuf.Timestamp
+	5,  // 1: analytics.RatingDistributionRequest.end_date:type_name -> google.protobuf.Timestamp
+	6,  // 2: analytics.RatingDistributionRequest.granularity:type_name -> analytics.Granularity
+	7,  // 3: analytics.RatingDistributionRequest.date_basis:type_name -> analytics.DateBasis
+	5,  // 4: analytics.RatingDistribution.date:type_name -> google.protobuf.Timestamp
+	1,  // 5: analytics.RatingDistribution.percentiles:type_name -> analytics.Percentile
+	2,  // 6: analytics.CategoryDistribution.total:type_name -> analytics.RatingDistribution
+	2,  // 7: analytics.CategoryDistribution.buckets:type_name -> analytics.RatingDistribution
+	6,  // 8: analytics.RatingDistributionResponse.granularity:type_name -> analytics.Granularity
+	3,  // 9: analytics.RatingDistributionResponse.categories:type_name -> analytics.CategoryDistribution
+	8,  // 10: analytics.RatingDistributionResponse.range:type_name -> analytics.DateRange
+	11, // [11:11] is the sub-list for method output_type
+	11, // [11:11] is the sub-list for method input_type
+	11, // [11:11] is the sub-list for extension type_name
+	11, // [11:11] is the sub-list for extension extendee
+	0,  // [0:11] is the sub-list for field type_name
+}
+
+func init() { file_rating_distribution_proto_init() }
+func file_rating_distribution_proto_init() {
+	if File_rating_distribution_proto != nil {
+		return
+	}
+	file_category_score_proto_init()
+	file_date_range_proto_init()
+	type x struct{}
+	out := protoimpl.TypeBuilder{
+		File: protoimpl.DescBuilder{
+			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
+			RawDescriptor: unsafe.Slice(unsafe.StringData(file_rating_distribution_proto_rawDesc), len(file_rating_distribution_proto_rawDesc)),
+			NumEnums:      0,
+			NumMessages:   5,
+			NumExtensions: 0,
+			NumServices:   0,
+		},
+		GoTypes:           file_rating_distribution_proto_goTypes,
+		DependencyIndexes: file_rating_distribution_proto_depIdxs,
+		MessageInfos:      file_rating_distribution_proto_msgTypes,
+	}.Build()
+	File_rating_distribution_proto = out.File
+	file_rating_distribution_proto_goTypes = nil
+	file_rating_distribution_proto_depIdxs = nil
+}
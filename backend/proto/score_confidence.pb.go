@@ -0,0 +1,152 @@
+// Code generated by protoc-gen-go. DO NOT EDIT.
+// versions:
+// 	protoc-gen-go v1.36.9
+// 	protoc        v5.29.3
+// source: score_confidence.proto
+
+package proto
+
+import (
+	protoreflect "google.golang.org/protobuf/reflect/protoreflect"
+	protoimpl "google.golang.org/protobuf/runtime/protoimpl"
+	reflect "reflect"
+	sync "sync"
+	unsafe "unsafe"
+)
+
+const (
+	// Verify that this generated code is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(20 - protoimpl.MinVersion)
+	// Verify that runtime/protoimpl is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(protoimpl.MaxVersion - 20)
+)
+
+// ScoreConfidence says how far a score can be trusted given the ratings behind it.
+// Bounds are a 95% Wilson score interval on the 0-5 rating scale, converted to the score's units.
+type ScoreConfidence struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Lower         float32                `protobuf:"fixed32,1,opt,name=lower,proto3" json:"lower,omitempty"`
+	Upper         float32                `protobuf:"fixed32,2,opt,name=upper,proto3" json:"upper,omitempty"`
+	StandardError float32                `protobuf:"fixed32,3,opt,name=standard_error,json=standardError,proto3" json:"standard_error,omitempty"` // Standard error of the score; 0 when fewer than 2 ratings
+	LowSample     bool                   `protobuf:"varint,4,opt,name=low_sample,json=lowSample,proto3" json:"low_sample,omitempty"`              // Fewer ratings than the server's minimum sample size
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ScoreConfidence) Reset() {
+	*x = ScoreConfidence{}
+	mi := &file_score_confidence_proto_msgTypes[0]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ScoreConfidence) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ScoreConfidence) ProtoMessage() {}
+
+func (x *ScoreConfidence) ProtoReflect() protoreflect.Message {
+	mi := &file_score_confidence_proto_msgTypes[0]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ScoreConfidence.ProtoReflect.Descriptor instead.
+func (*ScoreConfidence) Descriptor() ([]byte, []int) {
+	return file_score_confidence_proto_rawDescGZIP(), []int{0}
+}
+
+func (x *ScoreConfidence) GetLower() float32 {
+	if x != nil {
+		return x.Lower
+	}
+	return 0
+}
+
+func (x *ScoreConfidence) GetUpper() float32 {
+	if x != nil {
+		return x.Upper
+	}
+	return 0
+}
+
+func (x *ScoreConfidence) GetStandardError() float32 {
+	if x != nil {
+		return x.StandardError
+	}
+	return 0
+}
+
+func (x *ScoreConfidence) GetLowSample() bool {
+	if x != nil {
+		return x.LowSample
+	}
+	return false
+}
+
+var File_score_confidence_proto protoreflect.FileDescriptor
+
+const file_score_confidence_proto_rawDesc = "" +
+	"\n" +
+	"\x16score_confidence.proto\x12\tanalytics\"\x83\x01\n" +
+	"\x0fScoreConfidence\x12\x14\n" +
+	"\x05lower\x18\x01 \x01(\x02R\x05lower\x12\x14\n" +
+	"\x05upper\x18\x02 \x01(\x02R\x05upper\x12%\n" +
+	"\x0estandard_error\x18\x03 \x01(\x02R\rstandardError\x12\x1d\n" +
+	"\n" +
+	"low_sample\x18\x04 \x01(\bR\tlowSampleB\x17Z\x15go-grpc-backend/protob\x06proto3"
+
+var (
+	file_score_confidence_proto_rawDescOnce sync.Once
+	file_score_confidence_proto_rawDescData []byte
+)
+
+func file_score_confidence_proto_rawDescGZIP() []byte {
+	file_score_confidence_proto_rawDescOnce.Do(func() {
+		file_score_confidence_proto_rawDescData = protoimpl.X.CompressGZIP(unsafe.Slice(unsafe.StringData(file_score_confidence_proto_rawDesc), len(file_score_confidence_proto_rawDesc)))
+	})
+	return file_score_confidence_proto_rawDescData
+}
+
+var file_score_confidence_proto_msgTypes = make([]protoimpl.MessageInfo, 1)
+var file_score_confidence_proto_goTypes = []any{
+	(*ScoreConfidence)(nil), // 0: analytics.ScoreConfidence
+}
+var file_score_confidence_proto_depIdxs = []int32{
+	0, // [0:0] is the sub-list for method output_type
+	0, // [0:0] is the sub-list for method input_type
+	0, // [0:0] is the sub-list for extension type_name
+	0, // [0:0] is the sub-list for extension extendee
+	0, // [0:0] is the sub-list for field type_name
+}
+
+func init() { file_score_confidence_proto_init() }
+func file_score_confidence_proto_init() {
+	if File_score_confidence_proto != nil {
+		return
+	}
+	type x struct{}
+	out := protoimpl.TypeBuilder{
+		File: protoimpl.DescBuilder{
+			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
+			RawDescriptor: unsafe.Slice(unsafe.StringData(file_score_confidence_proto_rawDesc), len(file_score_confidence_proto_rawDesc)),
+			NumEnums:      0,
+			NumMessages:   1,
+			NumExtensions: 0,
+			NumServices:   0,
+		},
+		GoTypes:           file_score_confidence_proto_goTypes,
+		DependencyIndexes: file_score_confidence_proto_depIdxs,
+		MessageInfos:      file_score_confidence_proto_msgTypes,
+	}.Build()
+	File_score_confidence_proto = out.File
+	file_score_confidence_proto_goTypes = nil
+	file_score_confidence_proto_depIdxs = nil
+}
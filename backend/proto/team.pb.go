@@ -0,0 +1,1194 @@
+// Code generated by protoc-gen-go. DO NOT EDIT.
+// versions:
+// 	protoc-gen-go v1.36.9
+// 	protoc        v5.29.3
+// source: team.proto
+
+package proto
+
+import (
+	protoreflect "google.golang.org/protobuf/reflect/protoreflect"
+	protoimpl "google.golang.org/protobuf/runtime/protoimpl"
+	timestamppb "google.golang.org/protobuf/types/known/timestamppb"
+	reflect "reflect"
+	sync "sync"
+	unsafe "unsafe"
+)
+
+const (
+	// Verify that this generated code is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(20 - protoimpl.MinVersion)
+	// Verify that runtime/protoimpl is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(protoimpl.MaxVersion - 20)
+)
+
+// TeamKind is a team's level in the org > department > team hierarchy
+type TeamKind int32
+
+const (
+	TeamKind_TEAM_KIND_UNSPECIFIED TeamKind = 0
+	TeamKind_TEAM_KIND_ORG         TeamKind = 1 // Top level; has no parent
+	TeamKind_TEAM_KIND_DEPARTMENT  TeamKind = 2 // Parent must be an org
+	TeamKind_TEAM_KIND_TEAM        TeamKind = 3 // Parent must be a department
+)
+
+// Enum value maps for TeamKind.
+var (
+	TeamKind_name = map[int32]string{
+		0: "TEAM_KIND_UNSPECIFIED",
+		1: "TEAM_KIND_ORG",
+		2: "TEAM_KIND_DEPARTMENT",
+		3: "TEAM_KIND_TEAM",
+	}
+	TeamKind_value = map[string]int32{
+		"TEAM_KIND_UNSPECIFIED": 0,
+		"TEAM_KIND_ORG":         1,
+		"TEAM_KIND_DEPARTMENT":  2,
+		"TEAM_KIND_TEAM":        3,
+	}
+)
+
+func (x TeamKind) Enum() *TeamKind {
+	p := new(TeamKind)
+	*p = x
+	return p
+}
+
+func (x TeamKind) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
+}
+
+func (TeamKind) Descriptor() protoreflect.EnumDescriptor {
+	return file_team_proto_enumTypes[0].Descriptor()
+}
+
+func (TeamKind) Type() protoreflect.EnumType {
+	return &file_team_proto_enumTypes[0]
+}
+
+func (x TeamKind) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
+}
+
+// Deprecated: Use TeamKind.Descriptor instead.
+func (TeamKind) EnumDescriptor() ([]byte, []int) {
+	return file_team_proto_rawDescGZIP(), []int{0}
+}
+
+type Team struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Id            int32                  `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"` // Assigned by CreateTeam
+	Name          string                 `protobuf:"bytes,2,opt,name=name,proto3" json:"name,omitempty"`
+	Kind          TeamKind               `protobuf:"varint,3,opt,name=kind,proto3,enum=analytics.TeamKind" json:"kind,omitempty"`   // Set on create; can't be changed
+	ParentId      int32                  `protobuf:"varint,4,opt,name=parent_id,json=parentId,proto3" json:"parent_id,omitempty"`   // 0 for orgs
+	CreatedAt     *timestamppb.Timestamp `protobuf:"bytes,5,opt,name=created_at,json=createdAt,proto3" json:"created_at,omitempty"` // Output only
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *Team) Reset() {
+	*x = Team{}
+	mi := &file_team_proto_msgTypes[0]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *Team) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Team) ProtoMessage() {}
+
+func (x *Team) ProtoReflect() protoreflect.Message {
+	mi := &file_team_proto_msgTypes[0]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Team.ProtoReflect.Descriptor instead.
+func (*Team) Descriptor() ([]byte, []int) {
+	return file_team_proto_rawDescGZIP(), []int{0}
+}
+
+func (x *Team) GetId() int32 {
+	if x != nil {
+		return x.Id
+	}
+	return 0
+}
+
+func (x *Team) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+func (x *Team) GetKind() TeamKind {
+	if x != nil {
+		return x.Kind
+	}
+	return TeamKind_TEAM_KIND_UNSPECIFIED
+}
+
+func (x *Team) GetParentId() int32 {
+	if x != nil {
+		return x.ParentId
+	}
+	return 0
+}
+
+func (x *Team) GetCreatedAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.CreatedAt
+	}
+	return nil
+}
+
+type ListTeamsRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ListTeamsRequest) Reset() {
+	*x = ListTeamsRequest{}
+	mi := &file_team_proto_msgTypes[1]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListTeamsRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListTeamsRequest) ProtoMessage() {}
+
+func (x *ListTeamsRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_team_proto_msgTypes[1]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListTeamsRequest.ProtoReflect.Descriptor instead.
+func (*ListTeamsRequest) Descriptor() ([]byte, []int) {
+	return file_team_proto_rawDescGZIP(), []int{1}
+}
+
+type ListTeamsResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Teams         []*Team                `protobuf:"bytes,1,rep,name=teams,proto3" json:"teams,omitempty"` // Ordered by name
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ListTeamsResponse) Reset() {
+	*x = ListTeamsResponse{}
+	mi := &file_team_proto_msgTypes[2]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListTeamsResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListTeamsResponse) ProtoMessage() {}
+
+func (x *ListTeamsResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_team_proto_msgTypes[2]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListTeamsResponse.ProtoReflect.Descriptor instead.
+func (*ListTeamsResponse) Descriptor() ([]byte, []int) {
+	return file_team_proto_rawDescGZIP(), []int{2}
+}
+
+func (x *ListTeamsResponse) GetTeams() []*Team {
+	if x != nil {
+		return x.Teams
+	}
+	return nil
+}
+
+type CreateTeamRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Team          *Team                  `protobuf:"bytes,1,opt,name=team,proto3" json:"team,omitempty"` // id and created_at are ignored
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *CreateTeamRequest) Reset() {
+	*x = CreateTeamRequest{}
+	mi := &file_team_proto_msgTypes[3]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *CreateTeamRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CreateTeamRequest) ProtoMessage() {}
+
+func (x *CreateTeamRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_team_proto_msgTypes[3]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CreateTeamRequest.ProtoReflect.Descriptor instead.
+func (*CreateTeamRequest) Descriptor() ([]byte, []int) {
+	return file_team_proto_rawDescGZIP(), []int{3}
+}
+
+func (x *CreateTeamRequest) GetTeam() *Team {
+	if x != nil {
+		return x.Team
+	}
+	return nil
+}
+
+type UpdateTeamRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Team          *Team                  `protobuf:"bytes,1,opt,name=team,proto3" json:"team,omitempty"` // Replaces name and parent_id of the team with this id
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *UpdateTeamRequest) Reset() {
+	*x = UpdateTeamRequest{}
+	mi := &file_team_proto_msgTypes[4]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *UpdateTeamRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*UpdateTeamRequest) ProtoMessage() {}
+
+func (x *UpdateTeamRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_team_proto_msgTypes[4]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use UpdateTeamRequest.ProtoReflect.Descriptor instead.
+func (*UpdateTeamRequest) Descriptor() ([]byte, []int) {
+	return file_team_proto_rawDescGZIP(), []int{4}
+}
+
+func (x *UpdateTeamRequest) GetTeam() *Team {
+	if x != nil {
+		return x.Team
+	}
+	return nil
+}
+
+// DeleteTeamRequest deletes a team and its memberships. Teams with sub-teams can't be deleted
+type DeleteTeamRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Id            int32                  `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *DeleteTeamRequest) Reset() {
+	*x = DeleteTeamRequest{}
+	mi := &file_team_proto_msgTypes[5]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *DeleteTeamRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*DeleteTeamRequest) ProtoMessage() {}
+
+func (x *DeleteTeamRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_team_proto_msgTypes[5]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use DeleteTeamRequest.ProtoReflect.Descriptor instead.
+func (*DeleteTeamRequest) Descriptor() ([]byte, []int) {
+	return file_team_proto_rawDescGZIP(), []int{5}
+}
+
+func (x *DeleteTeamRequest) GetId() int32 {
+	if x != nil {
+		return x.Id
+	}
+	return 0
+}
+
+type DeleteTeamResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *DeleteTeamResponse) Reset() {
+	*x = DeleteTeamResponse{}
+	mi := &file_team_proto_msgTypes[6]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *DeleteTeamResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*DeleteTeamResponse) ProtoMessage() {}
+
+func (x *DeleteTeamResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_team_proto_msgTypes[6]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use DeleteTeamResponse.ProtoReflect.Descriptor instead.
+func (*DeleteTeamResponse) Descriptor() ([]byte, []int) {
+	return file_team_proto_rawDescGZIP(), []int{6}
+}
+
+// TeamMembership places a user in a team over [valid_from, valid_to).
+// A user's memberships of the same team must not overlap
+type TeamMembership struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Id            int32                  `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"` // Assigned by CreateTeamMembership
+	TeamId        int32                  `protobuf:"varint,2,opt,name=team_id,json=teamId,proto3" json:"team_id,omitempty"`
+	UserId        int32                  `protobuf:"varint,3,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`
+	UserName      string                 `protobuf:"bytes,4,opt,name=user_name,json=userName,proto3" json:"user_name,omitempty"` // Output only
+	ValidFrom     *timestamppb.Timestamp `protobuf:"bytes,5,opt,name=valid_from,json=validFrom,proto3" json:"valid_from,omitempty"`
+	ValidTo       *timestamppb.Timestamp `protobuf:"bytes,6,opt,name=valid_to,json=validTo,proto3" json:"valid_to,omitempty"` // Unset while the membership is ongoing
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *TeamMembership) Reset() {
+	*x = TeamMembership{}
+	mi := &file_team_proto_msgTypes[7]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *TeamMembership) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*TeamMembership) ProtoMessage() {}
+
+func (x *TeamMembership) ProtoReflect() protoreflect.Message {
+	mi := &file_team_proto_msgTypes[7]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use TeamMembership.ProtoReflect.Descriptor instead.
+func (*TeamMembership) Descriptor() ([]byte, []int) {
+	return file_team_proto_rawDescGZIP(), []int{7}
+}
+
+func (x *TeamMembership) GetId() int32 {
+	if x != nil {
+		return x.Id
+	}
+	return 0
+}
+
+func (x *TeamMembership) GetTeamId() int32 {
+	if x != nil {
+		return x.TeamId
+	}
+	return 0
+}
+
+func (x *TeamMembership) GetUserId() int32 {
+	if x != nil {
+		return x.UserId
+	}
+	return 0
+}
+
+func (x *TeamMembership) GetUserName() string {
+	if x != nil {
+		return x.UserName
+	}
+	return ""
+}
+
+func (x *TeamMembership) GetValidFrom() *timestamppb.Timestamp {
+	if x != nil {
+		return x.ValidFrom
+	}
+	return nil
+}
+
+func (x *TeamMembership) GetValidTo() *timestamppb.Timestamp {
+	if x != nil {
+		return x.ValidTo
+	}
+	return nil
+}
+
+type ListTeamMembershipsRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	TeamId        int32                  `protobuf:"varint,1,opt,name=team_id,json=teamId,proto3" json:"team_id,omitempty"` // Only memberships of this team; 0 for all teams
+	UserId        int32                  `protobuf:"varint,2,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"` // Only memberships of this user; 0 for all users
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ListTeamMembershipsRequest) Reset() {
+	*x = ListTeamMembershipsRequest{}
+	mi := &file_team_proto_msgTypes[8]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListTeamMembershipsRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListTeamMembershipsRequest) ProtoMessage() {}
+
+func (x *ListTeamMembershipsRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_team_proto_msgTypes[8]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListTeamMembershipsRequest.ProtoReflect.Descriptor instead.
+func (*ListTeamMembershipsRequest) Descriptor() ([]byte, []int) {
+	return file_team_proto_rawDescGZIP(), []int{8}
+}
+
+func (x *ListTeamMembershipsRequest) GetTeamId() int32 {
+	if x != nil {
+		return x.TeamId
+	}
+	return 0
+}
+
+func (x *ListTeamMembershipsRequest) GetUserId() int32 {
+	if x != nil {
+		return x.UserId
+	}
+	return 0
+}
+
+type ListTeamMembershipsResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Memberships   []*TeamMembership      `protobuf:"bytes,1,rep,name=memberships,proto3" json:"memberships,omitempty"` // Ordered by team, user and valid_from
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ListTeamMembershipsResponse) Reset() {
+	*x = ListTeamMembershipsResponse{}
+	mi := &file_team_proto_msgTypes[9]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListTeamMembershipsResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListTeamMembershipsResponse) ProtoMessage() {}
+
+func (x *ListTeamMembershipsResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_team_proto_msgTypes[9]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListTeamMembershipsResponse.ProtoReflect.Descriptor instead.
+func (*ListTeamMembershipsResponse) Descriptor() ([]byte, []int) {
+	return file_team_proto_rawDescGZIP(), []int{9}
+}
+
+func (x *ListTeamMembershipsResponse) GetMemberships() []*TeamMembership {
+	if x != nil {
+		return x.Memberships
+	}
+	return nil
+}
+
+type CreateTeamMembershipRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Membership    *TeamMembership        `protobuf:"bytes,1,opt,name=membership,proto3" json:"membership,omitempty"` // id and user_name are ignored
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *CreateTeamMembershipRequest) Reset() {
+	*x = CreateTeamMembershipRequest{}
+	mi := &file_team_proto_msgTypes[10]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *CreateTeamMembershipRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CreateTeamMembershipRequest) ProtoMessage() {}
+
+func (x *CreateTeamMembershipRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_team_proto_msgTypes[10]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CreateTeamMembershipRequest.ProtoReflect.Descriptor instead.
+func (*CreateTeamMembershipRequest) Descriptor() ([]byte, []int) {
+	return file_team_proto_rawDescGZIP(), []int{10}
+}
+
+func (x *CreateTeamMembershipRequest) GetMembership() *TeamMembership {
+	if x != nil {
+		return x.Membership
+	}
+	return nil
+}
+
+type UpdateTeamMembershipRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Membership    *TeamMembership        `protobuf:"bytes,1,opt,name=membership,proto3" json:"membership,omitempty"` // Replaces the membership with this id, e.g. to set valid_to
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *UpdateTeamMembershipRequest) Reset() {
+	*x = UpdateTeamMembershipRequest{}
+	mi := &file_team_proto_msgTypes[11]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *UpdateTeamMembershipRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*UpdateTeamMembershipRequest) ProtoMessage() {}
+
+func (x *UpdateTeamMembershipRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_team_proto_msgTypes[11]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use UpdateTeamMembershipRequest.ProtoReflect.Descriptor instead.
+func (*UpdateTeamMembershipRequest) Descriptor() ([]byte, []int) {
+	return file_team_proto_rawDescGZIP(), []int{11}
+}
+
+func (x *UpdateTeamMembershipRequest) GetMembership() *TeamMembership {
+	if x != nil {
+		return x.Membership
+	}
+	return nil
+}
+
+type DeleteTeamMembershipRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Id            int32                  `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *DeleteTeamMembershipRequest) Reset() {
+	*x = DeleteTeamMembershipRequest{}
+	mi := &file_team_proto_msgTypes[12]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *DeleteTeamMembershipRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*DeleteTeamMembershipRequest) ProtoMessage() {}
+
+func (x *DeleteTeamMembershipRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_team_proto_msgTypes[12]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use DeleteTeamMembershipRequest.ProtoReflect.Descriptor instead.
+func (*DeleteTeamMembershipRequest) Descriptor() ([]byte, []int) {
+	return file_team_proto_rawDescGZIP(), []int{12}
+}
+
+func (x *DeleteTeamMembershipRequest) GetId() int32 {
+	if x != nil {
+		return x.Id
+	}
+	return 0
+}
+
+type DeleteTeamMembershipResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *DeleteTeamMembershipResponse) Reset() {
+	*x = DeleteTeamMembershipResponse{}
+	mi := &file_team_proto_msgTypes[13]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *DeleteTeamMembershipResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*DeleteTeamMembershipResponse) ProtoMessage() {}
+
+func (x *DeleteTeamMembershipResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_team_proto_msgTypes[13]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use DeleteTeamMembershipResponse.ProtoReflect.Descriptor instead.
+func (*DeleteTeamMembershipResponse) Descriptor() ([]byte, []int) {
+	return file_team_proto_rawDescGZIP(), []int{13}
+}
+
+type TeamScoresRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	StartDate     *timestamppb.Timestamp `protobuf:"bytes,1,opt,name=start_date,json=startDate,proto3" json:"start_date,omitempty"`
+	EndDate       *timestamppb.Timestamp `protobuf:"bytes,2,opt,name=end_date,json=endDate,proto3" json:"end_date,omitempty"`
+	InclusiveEnd  bool                   `protobuf:"varint,3,opt,name=inclusive_end,json=inclusiveEnd,proto3" json:"inclusive_end,omitempty"`                 // Also count ratings created exactly at end_date
+	DateBasis     DateBasis              `protobuf:"varint,4,opt,name=date_basis,json=dateBasis,proto3,enum=analytics.DateBasis" json:"date_basis,omitempty"` // Which timestamp the range applies to; defaults to the rating's
+	TeamId        int32                  `protobuf:"varint,5,opt,name=team_id,json=teamId,proto3" json:"team_id,omitempty"`                                   // Only this team and the teams below it; 0 for every team
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *TeamScoresRequest) Reset() {
+	*x = TeamScoresRequest{}
+	mi := &file_team_proto_msgTypes[14]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *TeamScoresRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*TeamScoresRequest) ProtoMessage() {}
+
+func (x *TeamScoresRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_team_proto_msgTypes[14]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use TeamScoresRequest.ProtoReflect.Descriptor instead.
+func (*TeamScoresRequest) Descriptor() ([]byte, []int) {
+	return file_team_proto_rawDescGZIP(), []int{14}
+}
+
+func (x *TeamScoresRequest) GetStartDate() *timestamppb.Timestamp {
+	if x != nil {
+		return x.StartDate
+	}
+	return nil
+}
+
+func (x *TeamScoresRequest) GetEndDate() *timestamppb.Timestamp {
+	if x != nil {
+		return x.EndDate
+	}
+	return nil
+}
+
+func (x *TeamScoresRequest) GetInclusiveEnd() bool {
+	if x != nil {
+		return x.InclusiveEnd
+	}
+	return false
+}
+
+func (x *TeamScoresRequest) GetDateBasis() DateBasis {
+	if x != nil {
+		return x.DateBasis
+	}
+	return DateBasis_DATE_BASIS_UNSPECIFIED
+}
+
+func (x *TeamScoresRequest) GetTeamId() int32 {
+	if x != nil {
+		return x.TeamId
+	}
+	return 0
+}
+
+type TeamCategoryScore struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	CategoryId    int32                  `protobuf:"varint,1,opt,name=category_id,json=categoryId,proto3" json:"category_id,omitempty"`
+	CategoryName  string                 `protobuf:"bytes,2,opt,name=category_name,json=categoryName,proto3" json:"category_name,omitempty"`
+	Score         float64                `protobuf:"fixed64,3,opt,name=score,proto3" json:"score,omitempty"`
+	RatingCount   int32                  `protobuf:"varint,4,opt,name=rating_count,json=ratingCount,proto3" json:"rating_count,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *TeamCategoryScore) Reset() {
+	*x = TeamCategoryScore{}
+	mi := &file_team_proto_msgTypes[15]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *TeamCategoryScore) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*TeamCategoryScore) ProtoMessage() {}
+
+func (x *TeamCategoryScore) ProtoReflect() protoreflect.Message {
+	mi := &file_team_proto_msgTypes[15]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use TeamCategoryScore.ProtoReflect.Descriptor instead.
+func (*TeamCategoryScore) Descriptor() ([]byte, []int) {
+	return file_team_proto_rawDescGZIP(), []int{15}
+}
+
+func (x *TeamCategoryScore) GetCategoryId() int32 {
+	if x != nil {
+		return x.CategoryId
+	}
+	return 0
+}
+
+func (x *TeamCategoryScore) GetCategoryName() string {
+	if x != nil {
+		return x.CategoryName
+	}
+	return ""
+}
+
+func (x *TeamCategoryScore) GetScore() float64 {
+	if x != nil {
+		return x.Score
+	}
+	return 0
+}
+
+func (x *TeamCategoryScore) GetRatingCount() int32 {
+	if x != nil {
+		return x.RatingCount
+	}
+	return 0
+}
+
+// TeamScore rolls up the ratings whose reviewee was a member of the team, or of a team
+// below it, when the rating was created. Each rating counts once per team
+type TeamScore struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Team          *Team                  `protobuf:"bytes,1,opt,name=team,proto3" json:"team,omitempty"`
+	Depth         int32                  `protobuf:"varint,2,opt,name=depth,proto3" json:"depth,omitempty"`        // 0 for the requested team or for orgs, 1 for the level below, ...
+	Score         *float64               `protobuf:"fixed64,3,opt,name=score,proto3,oneof" json:"score,omitempty"` // Overall score of the team's categories, like GetOverallQualityScore; unset without ratings
+	RatingCount   int32                  `protobuf:"varint,4,opt,name=rating_count,json=ratingCount,proto3" json:"rating_count,omitempty"`
+	Categories    []*TeamCategoryScore   `protobuf:"bytes,5,rep,name=categories,proto3" json:"categories,omitempty"` // Ordered by category name
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *TeamScore) Reset() {
+	*x = TeamScore{}
+	mi := &file_team_proto_msgTypes[16]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *TeamScore) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*TeamScore) ProtoMessage() {}
+
+func (x *TeamScore) ProtoReflect() protoreflect.Message {
+	mi := &file_team_proto_msgTypes[16]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use TeamScore.ProtoReflect.Descriptor instead.
+func (*TeamScore) Descriptor() ([]byte, []int) {
+	return file_team_proto_rawDescGZIP(), []int{16}
+}
+
+func (x *TeamScore) GetTeam() *Team {
+	if x != nil {
+		return x.Team
+	}
+	return nil
+}
+
+func (x *TeamScore) GetDepth() int32 {
+	if x != nil {
+		return x.Depth
+	}
+	return 0
+}
+
+func (x *TeamScore) GetScore() float64 {
+	if x != nil && x.Score != nil {
+		return *x.Score
+	}
+	return 0
+}
+
+func (x *TeamScore) GetRatingCount() int32 {
+	if x != nil {
+		return x.RatingCount
+	}
+	return 0
+}
+
+func (x *TeamScore) GetCategories() []*TeamCategoryScore {
+	if x != nil {
+		return x.Categories
+	}
+	return nil
+}
+
+type TeamScoresResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Range         *DateRange             `protobuf:"bytes,1,opt,name=range,proto3" json:"range,omitempty"`
+	Teams         []*TeamScore           `protobuf:"bytes,2,rep,name=teams,proto3" json:"teams,omitempty"` // Depth-first: each team is followed by its sub-teams, siblings by name
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *TeamScoresResponse) Reset() {
+	*x = TeamScoresResponse{}
+	mi := &file_team_proto_msgTypes[17]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *TeamScoresResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*TeamScoresResponse) ProtoMessage() {}
+
+func (x *TeamScoresResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_team_proto_msgTypes[17]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use TeamScoresResponse.ProtoReflect.Descriptor instead.
+func (*TeamScoresResponse) Descriptor() ([]byte, []int) {
+	return file_team_proto_rawDescGZIP(), []int{17}
+}
+
+func (x *TeamScoresResponse) GetRange() *DateRange {
+	if x != nil {
+		return x.Range
+	}
+	return nil
+}
+
+func (x *TeamScoresResponse) GetTeams() []*TeamScore {
+	if x != nil {
+		return x.Teams
+	}
+	return nil
+}
+
+var File_team_proto protoreflect.FileDescriptor
+
+const file_team_proto_rawDesc = "" +
+	"\n" +
+	"\n" +
+	"team.proto\x12\tanalytics\x1a\x1fgoogle/protobuf/timestamp.proto\x1a\x10date_range.proto\"\xab\x01\n" +
+	"\x04Team\x12\x0e\n" +
+	"\x02id\x18\x01 \x01(\x05R\x02id\x12\x12\n" +
+	"\x04name\x18\x02 \x01(\tR\x04name\x12'\n" +
+	"\x04kind\x18\x03 \x01(\x0e2\x13.analytics.TeamKindR\x04kind\x12\x1b\n" +
+	"\tparent_id\x18\x04 \x01(\x05R\bparentId\x129\n" +
+	"\n" +
+	"created_at\x18\x05 \x01(\v2\x1a.google.protobuf.TimestampR\tcreatedAt\"\x12\n" +
+	"\x10ListTeamsRequest\":\n" +
+	"\x11ListTeamsResponse\x12%\n" +
+	"\x05teams\x18\x01 \x03(\v2\x0f.analytics.TeamR\x05teams\"8\n" +
+	"\x11CreateTeamRequest\x12#\n" +
+	"\x04team\x18\x01 \x01(\v2\x0f.analytics.TeamR\x04team\"8\n" +
+	"\x11UpdateTeamRequest\x12#\n" +
+	"\x04team\x18\x01 \x01(\v2\x0f.analytics.TeamR\x04team\"#\n" +
+	"\x11DeleteTeamRequest\x12\x0e\n" +
+	"\x02id\x18\x01 \x01(\x05R\x02id\"\x14\n" +
+	"\x12DeleteTeamResponse\"\xe1\x01\n" +
+	"\x0eTeamMembership\x12\x0e\n" +
+	"\x02id\x18\x01 \x01(\x05R\x02id\x12\x17\n" +
+	"\ateam_id\x18\x02 \x01(\x05R\x06teamId\x12\x17\n" +
+	"\auser_id\x18\x03 \x01(\x05R\x06userId\x12\x1b\n" +
+	"\tuser_name\x18\x04 \x01(\tR\buserName\x129\n" +
+	"\n" +
+	"valid_from\x18\x05 \x01(\v2\x1a.google.protobuf.TimestampR\tvalidFrom\x125\n" +
+	"\bvalid_to\x18\x06 \x01(\v2\x1a.google.protobuf.TimestampR\avalidTo\"N\n" +
+	"\x1aListTeamMembershipsRequest\x12\x17\n" +
+	"\ateam_id\x18\x01 \x01(\x05R\x06teamId\x12\x17\n" +
+	"\auser_id\x18\x02 \x01(\x05R\x06userId\"Z\n" +
+	"\x1bListTeamMembershipsResponse\x12;\n" +
+	"\vmemberships\x18\x01 \x03(\v2\x19.analytics.TeamMembershipR\vmemberships\"X\n" +
+	"\x1bCreateTeamMembershipRequest\x129\n" +
+	"\n" +
+	"membership\x18\x01 \x01(\v2\x19.analytics.TeamMembershipR\n" +
+	"membership\"X\n" +
+	"\x1bUpdateTeamMembershipRequest\x129\n" +
+	"\n" +
+	"membership\x18\x01 \x01(\v2\x19.analytics.TeamMembershipR\n" +
+	"membership\"-\n" +
+	"\x1bDeleteTeamMembershipRequest\x12\x0e\n" +
+	"\x02id\x18\x01 \x01(\x05R\x02id\"\x1e\n" +
+	"\x1cDeleteTeamMembershipResponse\"\xf8\x01\n" +
+	"\x11TeamScoresRequest\x129\n" +
+	"\n" +
+	"start_date\x18\x01 \x01(\v2\x1a.google.protobuf.TimestampR\tstartDate\x125\n" +
+	"\bend_date\x18\x02 \x01(\v2\x1a.google.protobuf.TimestampR\aendDate\x12#\n" +
+	"\rinclusive_end\x18\x03 \x01(\bR\finclusiveEnd\x123\n" +
+	"\n" +
+	"date_basis\x18\x04 \x01(\x0e2\x14.analytics.DateBasisR\tdateBasis\x12\x17\n" +
+	"\ateam_id\x18\x05 \x01(\x05R\x06teamId\"\x92\x01\n" +
+	"\x11TeamCategoryScore\x12\x1f\n" +
+	"\vcategory_id\x18\x01 \x01(\x05R\n" +
+	"categoryId\x12#\n" +
+	"\rcategory_name\x18\x02 \x01(\tR\fcategoryName\x12\x14\n" +
+	"\x05score\x18\x03 \x01(\x01R\x05score\x12!\n" +
+	"\frating_count\x18\x04 \x01(\x05R\vratingCount\"\xcc\x01\n" +
+	"\tTeamScore\x12#\n" +
+	"\x04team\x18\x01 \x01(\v2\x0f.analytics.TeamR\x04team\x12\x14\n" +
+	"\x05depth\x18\x02 \x01(\x05R\x05depth\x12\x19\n" +
+	"\x05score\x18\x03 \x01(\x01H\x00R\x05score\x88\x01\x01\x12!\n" +
+	"\frating_count\x18\x04 \x01(\x05R\vratingCount\x12<\n" +
+	"\n" +
+	"categories\x18\x05 \x03(\v2\x1c.analytics.TeamCategoryScoreR\n" +
+	"categoriesB\b\n" +
+	"\x06_score\"l\n" +
+	"\x12TeamScoresResponse\x12*\n" +
+	"\x05range\x18\x01 \x01(\v2\x14.analytics.DateRangeR\x05range\x12*\n" +
+	"\x05teams\x18\x02 \x03(\v2\x14.analytics.TeamScoreR\x05teams*f\n" +
+	"\bTeamKind\x12\x19\n" +
+	"\x15TEAM_KIND_UNSPECIFIED\x10\x00\x12\x11\n" +
+	"\rTEAM_KIND_ORG\x10\x01\x12\x18\n" +
+	"\x14TEAM_KIND_DEPARTMENT\x10\x02\x12\x12\n" +
+	"\x0eTEAM_KIND_TEAM\x10\x03B\x17Z\x15go-grpc-backend/protob\x06proto3"
+
+var (
+	file_team_proto_rawDescOnce sync.Once
+	file_team_proto_rawDescData []byte
+)
+
+func file_team_proto_rawDescGZIP() []byte {
+	file_team_proto_rawDescOnce.Do(func() {
+		file_team_proto_rawDescData = protoimpl.X.CompressGZIP(unsafe.Slice(unsafe.StringData(file_team_proto_rawDesc), len(file_team_proto_rawDesc)))
+	})
+	return file_team_proto_rawDescData
+}
+
+var file_team_proto_enumTypes = make([]protoimpl.EnumInfo, 1)
+var file_team_proto_msgTypes = make([]protoimpl.MessageInfo, 18)
+var file_team_proto_goTypes = []any{
+	(TeamKind)(0),                        // 0: analytics.TeamKind
+	(*Team)(nil),                         // 1: analytics.Team
+	(*ListTeamsRequest)(nil),             // 2: analytics.ListTeamsRequest
+	(*ListTeamsResponse)(nil),            // 3: analytics.ListTeamsResponse
+	(*CreateTeamRequest)(nil),            // 4: analytics.CreateTeamRequest
+	(*UpdateTeamRequest)(nil),            // 5: analytics.UpdateTeamRequest
+	(*DeleteTeamRequest)(nil),            // 6: analytics.DeleteTeamRequest
+	(*DeleteTeamResponse)(nil),           // 7: analytics.DeleteTeamResponse
+	(*TeamMembership)(nil),               // 8: analytics.TeamMembership
+	(*ListTeamMembershipsRequest)(nil),   // 9: analytics.ListTeamMembershipsRequest
+	(*ListTeamMembershipsResponse)(nil),  // 10: analytics.ListTeamMembershipsResponse
+	(*CreateTeamMembershipRequest)(nil),  // 11: analytics.CreateTeamMembershipRequest
+	(*UpdateTeamMembershipRequest)(nil),  // 12: analytics.UpdateTeamMembershipRequest
+	(*DeleteTeamMembershipRequest)(nil),  // 13: analytics.DeleteTeamMembershipRequest
+	(*DeleteTeamMembershipResponse)(nil), // 14: analytics.DeleteTeamMembershipResponse
+	(*TeamScoresRequest)(nil),            // 15: analytics.TeamScoresRequest
+	(*TeamCategoryScore)(nil),            // 16: analytics.TeamCategoryScore
+	(*TeamScore)(nil),                    // 17: analytics.TeamScore
+	(*TeamScoresResponse)(nil),           // 18: analytics.TeamScoresResponse
+	(*timestamppb.Timestamp)(nil),        // 19: google.protobuf.Timestamp
+	(DateBasis)(0),                       // 20: analytics.DateBasis
+	(*DateRange)(nil),                    // 21: analytics.DateRange
+}
+var file_team_proto_depIdxs = []int32{
+	0,  // 0: analytics.Team.kind:type_name -> analytics.TeamKind
+	19, // 1: analytics.Team.created_at:type_name -> google.protobuf.Timestamp
+	1,  // 2: analytics.ListTeamsResponse.teams:type_name -> analytics.Team
+	1,  // 3: analytics.CreateTeamRequest.team:type_name -> analytics.Team
+	1,  // 4: analytics.UpdateTeamRequest.team:type_name -> analytics.Team
+	19, // 5: analytics.TeamMembership.valid_from:type_name -> google.protobuf.Timestamp
+	19, // 6: analytics.TeamMembership.valid_to:type_name -> google.protobuf.Timestamp
+	8,  // 7: analytics.ListTeamMembershipsResponse.memberships:type_name -> analytics.TeamMembership
+	8,  // 8: analytics.CreateTeamMembershipRequest.membership:type_name -> analytics.TeamMembership
+	8,  // 9: analytics.UpdateTeamMembershipRequest.membership:type_name -> analytics.TeamMembership
+	19, // 10: analytics.TeamScoresRequest.start_date:type_name -> google.protobuf.Timestamp
+	19, // 11: analytics.TeamScoresRequest.end_date:type_name -> google.protobuf.Timestamp
+	20, // 12: analytics.TeamScoresRequest.date_basis:type_name -> analytics.DateBasis
+	1,  // 13: analytics.TeamScore.team:type_name -> analytics.Team
+	16, // 14: analytics.TeamScore.categories:type_name -> analytics.TeamCategoryScore
+	21, // 15: analytics.TeamScoresResponse.range:type_name -> analytics.DateRange
+	17, // 16: analytics.TeamScoresResponse.teams:type_name -> analytics.TeamScore
+	17, // [17:17] is the sub-list for method output_type
+	17, // [17:17] is the sub-list for method input_type
+	17, // [17:17] is the sub-list for extension type_name
+	17, // [17:17] is the sub-list for extension extendee
+	0,  // [0:17] is the sub-list for field type_name
+}
+
+func init() { file_team_proto_init() }
+func file_team_proto_init() {
+	if File_team_proto != nil {
+		return
+	}
+	file_date_range_proto_init()
+	file_team_proto_msgTypes[16].OneofWrappers = []any{}
+	type x struct{}
+	out := protoimpl.TypeBuilder{
+		File: protoimpl.DescBuilder{
+			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
+			RawDescriptor: unsafe.Slice(unsafe.StringData(file_team_proto_rawDesc), len(file_team_proto_rawDesc)),
+			NumEnums:      1,
+			NumMessages:   18,
+			NumExtensions: 0,
+			NumServices:   0,
+		},
+		GoTypes:           file_team_proto_goTypes,
+		DependencyIndexes: file_team_proto_depIdxs,
+		EnumInfos:         file_team_proto_enumTypes,
+		MessageInfos:      file_team_proto_msgTypes,
+	}.Build()
+	File_team_proto = out.File
+	file_team_proto_goTypes = nil
+	file_team_proto_depIdxs = nil
+}
@@ -0,0 +1,406 @@
+// Code generated by protoc-gen-go. DO NOT EDIT.
+// versions:
+// 	protoc-gen-go v1.36.9
+// 	protoc        v5.29.3
+// source: ticket_attribute.proto
+
+package proto
+
+import (
+	protoreflect "google.golang.org/protobuf/reflect/protoreflect"
+	protoimpl "google.golang.org/protobuf/runtime/protoimpl"
+	timestamppb "google.golang.org/protobuf/types/known/timestamppb"
+	reflect "reflect"
+	sync "sync"
+	unsafe "unsafe"
+)
+
+const (
+	// Verify that this generated code is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(20 - protoimpl.MinVersion)
+	// Verify that runtime/protoimpl is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(protoimpl.MaxVersion - 20)
+)
+
+// TicketAttribute is a key/value label on a ticket, such as channel=email or priority=high.
+// GetScoresGroupedBy can group ratings by any key
+type TicketAttribute struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Key           string                 `protobuf:"bytes,1,opt,name=key,proto3" json:"key,omitempty"`                              // 1-64 letters, digits, '_', '-' or '.'
+	Value         string                 `protobuf:"bytes,2,opt,name=value,proto3" json:"value,omitempty"`                          // 1-256 characters
+	UpdatedAt     *timestamppb.Timestamp `protobuf:"bytes,3,opt,name=updated_at,json=updatedAt,proto3" json:"updated_at,omitempty"` // Output only
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *TicketAttribute) Reset() {
+	*x = TicketAttribute{}
+	mi := &file_ticket_attribute_proto_msgTypes[0]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *TicketAttribute) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*TicketAttribute) ProtoMessage() {}
+
+func (x *TicketAttribute) ProtoReflect() protoreflect.Message {
+	mi := &file_ticket_attribute_proto_msgTypes[0]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use TicketAttribute.ProtoReflect.Descriptor instead.
+func (*TicketAttribute) Descriptor() ([]byte, []int) {
+	return file_ticket_attribute_proto_rawDescGZIP(), []int{0}
+}
+
+func (x *TicketAttribute) GetKey() string {
+	if x != nil {
+		return x.Key
+	}
+	return ""
+}
+
+func (x *TicketAttribute) GetValue() string {
+	if x != nil {
+		return x.Value
+	}
+	return ""
+}
+
+func (x *TicketAttribute) GetUpdatedAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.UpdatedAt
+	}
+	return nil
+}
+
+type ListTicketAttributesRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	TicketId      int32                  `protobuf:"varint,1,opt,name=ticket_id,json=ticketId,proto3" json:"ticket_id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ListTicketAttributesRequest) Reset() {
+	*x = ListTicketAttributesRequest{}
+	mi := &file_ticket_attribute_proto_msgTypes[1]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListTicketAttributesRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListTicketAttributesRequest) ProtoMessage() {}
+
+func (x *ListTicketAttributesRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_ticket_attribute_proto_msgTypes[1]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListTicketAttributesRequest.ProtoReflect.Descriptor instead.
+func (*ListTicketAttributesRequest) Descriptor() ([]byte, []int) {
+	return file_ticket_attribute_proto_rawDescGZIP(), []int{1}
+}
+
+func (x *ListTicketAttributesRequest) GetTicketId() int32 {
+	if x != nil {
+		return x.TicketId
+	}
+	return 0
+}
+
+type ListTicketAttributesResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	TicketId      int32                  `protobuf:"varint,1,opt,name=ticket_id,json=ticketId,proto3" json:"ticket_id,omitempty"`
+	Attributes    []*TicketAttribute     `protobuf:"bytes,2,rep,name=attributes,proto3" json:"attributes,omitempty"` // Ordered by key
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ListTicketAttributesResponse) Reset() {
+	*x = ListTicketAttributesResponse{}
+	mi := &file_ticket_attribute_proto_msgTypes[2]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListTicketAttributesResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListTicketAttributesResponse) ProtoMessage() {}
+
+func (x *ListTicketAttributesResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_ticket_attribute_proto_msgTypes[2]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListTicketAttributesResponse.ProtoReflect.Descriptor instead.
+func (*ListTicketAttributesResponse) Descriptor() ([]byte, []int) {
+	return file_ticket_attribute_proto_rawDescGZIP(), []int{2}
+}
+
+func (x *ListTicketAttributesResponse) GetTicketId() int32 {
+	if x != nil {
+		return x.TicketId
+	}
+	return 0
+}
+
+func (x *ListTicketAttributesResponse) GetAttributes() []*TicketAttribute {
+	if x != nil {
+		return x.Attributes
+	}
+	return nil
+}
+
+// SetTicketAttributesRequest creates or overwrites the given keys; other keys are kept
+type SetTicketAttributesRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	TicketId      int32                  `protobuf:"varint,1,opt,name=ticket_id,json=ticketId,proto3" json:"ticket_id,omitempty"`
+	Attributes    []*TicketAttribute     `protobuf:"bytes,2,rep,name=attributes,proto3" json:"attributes,omitempty"` // Keys must be unique
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *SetTicketAttributesRequest) Reset() {
+	*x = SetTicketAttributesRequest{}
+	mi := &file_ticket_attribute_proto_msgTypes[3]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *SetTicketAttributesRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SetTicketAttributesRequest) ProtoMessage() {}
+
+func (x *SetTicketAttributesRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_ticket_attribute_proto_msgTypes[3]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SetTicketAttributesRequest.ProtoReflect.Descriptor instead.
+func (*SetTicketAttributesRequest) Descriptor() ([]byte, []int) {
+	return file_ticket_attribute_proto_rawDescGZIP(), []int{3}
+}
+
+func (x *SetTicketAttributesRequest) GetTicketId() int32 {
+	if x != nil {
+		return x.TicketId
+	}
+	return 0
+}
+
+func (x *SetTicketAttributesRequest) GetAttributes() []*TicketAttribute {
+	if x != nil {
+		return x.Attributes
+	}
+	return nil
+}
+
+type DeleteTicketAttributeRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	TicketId      int32                  `protobuf:"varint,1,opt,name=ticket_id,json=ticketId,proto3" json:"ticket_id,omitempty"`
+	Key           string                 `protobuf:"bytes,2,opt,name=key,proto3" json:"key,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *DeleteTicketAttributeRequest) Reset() {
+	*x = DeleteTicketAttributeRequest{}
+	mi := &file_ticket_attribute_proto_msgTypes[4]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *DeleteTicketAttributeRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*DeleteTicketAttributeRequest) ProtoMessage() {}
+
+func (x *DeleteTicketAttributeRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_ticket_attribute_proto_msgTypes[4]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use DeleteTicketAttributeRequest.ProtoReflect.Descriptor instead.
+func (*DeleteTicketAttributeRequest) Descriptor() ([]byte, []int) {
+	return file_ticket_attribute_proto_rawDescGZIP(), []int{4}
+}
+
+func (x *DeleteTicketAttributeRequest) GetTicketId() int32 {
+	if x != nil {
+		return x.TicketId
+	}
+	return 0
+}
+
+func (x *DeleteTicketAttributeRequest) GetKey() string {
+	if x != nil {
+		return x.Key
+	}
+	return ""
+}
+
+type DeleteTicketAttributeResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *DeleteTicketAttributeResponse) Reset() {
+	*x = DeleteTicketAttributeResponse{}
+	mi := &file_ticket_attribute_proto_msgTypes[5]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *DeleteTicketAttributeResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*DeleteTicketAttributeResponse) ProtoMessage() {}
+
+func (x *DeleteTicketAttributeResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_ticket_attribute_proto_msgTypes[5]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use DeleteTicketAttributeResponse.ProtoReflect.Descriptor instead.
+func (*DeleteTicketAttributeResponse) Descriptor() ([]byte, []int) {
+	return file_ticket_attribute_proto_rawDescGZIP(), []int{5}
+}
+
+var File_ticket_attribute_proto protoreflect.FileDescriptor
+
+const file_ticket_attribute_proto_rawDesc = "" +
+	"\n" +
+	"\x16ticket_attribute.proto\x12\tanalytics\x1a\x1fgoogle/protobuf/timestamp.proto\"t\n" +
+	"\x0fTicketAttribute\x12\x10\n" +
+	"\x03key\x18\x01 \x01(\tR\x03key\x12\x14\n" +
+	"\x05value\x18\x02 \x01(\tR\x05value\x129\n" +
+	"\n" +
+	"updated_at\x18\x03 \x01(\v2\x1a.google.protobuf.TimestampR\tupdatedAt\":\n" +
+	"\x1bListTicketAttributesRequest\x12\x1b\n" +
+	"\tticket_id\x18\x01 \x01(\x05R\bticketId\"w\n" +
+	"\x1cListTicketAttributesResponse\x12\x1b\n" +
+	"\tticket_id\x18\x01 \x01(\x05R\bticketId\x12:\n" +
+	"\n" +
+	"attributes\x18\x02 \x03(\v2\x1a.analytics.TicketAttributeR\n" +
+	"attributes\"u\n" +
+	"\x1aSetTicketAttributesRequest\x12\x1b\n" +
+	"\tticket_id\x18\x01 \x01(\x05R\bticketId\x12:\n" +
+	"\n" +
+	"attributes\x18\x02 \x03(\v2\x1a.analytics.TicketAttributeR\n" +
+	"attributes\"M\n" +
+	"\x1cDeleteTicketAttributeRequest\x12\x1b\n" +
+	"\tticket_id\x18\x01 \x01(\x05R\bticketId\x12\x10\n" +
+	"\x03key\x18\x02 \x01(\tR\x03key\"\x1f\n" +
+	"\x1dDeleteTicketAttributeResponseB\x17Z\x15go-grpc-backend/protob\x06proto3"
+
+var (
+	file_ticket_attribute_proto_rawDescOnce sync.Once
+	file_ticket_attribute_proto_rawDescData []byte
+)
+
+func file_ticket_attribute_proto_rawDescGZIP() []byte {
+	file_ticket_attribute_proto_rawDescOnce.Do(func() {
+		file_ticket_attribute_proto_rawDescData = protoimpl.X.CompressGZIP(unsafe.Slice(unsafe.StringData(file_ticket_attribute_proto_rawDesc), len(file_ticket_attribute_proto_rawDesc)))
+	})
+	return file_ticket_attribute_proto_rawDescData
+}
+
+var file_ticket_attribute_proto_msgTypes = make([]protoimpl.MessageInfo, 6)
+var file_ticket_attribute_proto_goTypes = []any{
+	(*TicketAttribute)(nil),               // 0: analytics.TicketAttribute
+	(*ListTicketAttributesRequest)(nil),   // 1: analytics.ListTicketAttributesRequest
+	(*ListTicketAttributesResponse)(nil),  // 2: analytics.ListTicketAttributesResponse
+	(*SetTicketAttributesRequest)(nil),    // 3: analytics.SetTicketAttributesRequest
+	(*DeleteTicketAttributeRequest)(nil),  // 4: analytics.DeleteTicketAttributeRequest
+	(*DeleteTicketAttributeResponse)(nil), // 5: analytics.DeleteTicketAttributeResponse
+	(*timestamppb.Timestamp)(nil),         // 6: google.protobuf.Timestamp
+}
+var file_ticket_attribute_proto_depIdxs = []int32{
+	6, // 0: analytics.TicketAttribute.updated_at:type_name -> google.protobuf.Timestamp
+	0, // 1: analytics.ListTicketAttributesResponse.attributes:type_name -> analytics.TicketAttribute
+	0, // 2: analytics.SetTicketAttributesRequest.attributes:type_name -> analytics.TicketAttribute
+	3, // [3:3] is the sub-list for method output_type
+	3, // [3:3] is the sub-list for method input_type
+	3, // [3:3] is the sub-list for extension type_name
+	3, // [3:3] is the sub-list for extension extendee
+	0, // [0:3] is the sub-list for field type_name
+}
+
+func init() { file_ticket_attribute_proto_init() }
+func file_ticket_attribute_proto_init() {
+	if File_ticket_attribute_proto != nil {
+		return
+	}
+	type x struct{}
+	out := protoimpl.TypeBuilder{
+		File: protoimpl.DescBuilder{
+			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
+			RawDescriptor: unsafe.Slice(unsafe.StringData(file_ticket_attribute_proto_rawDesc), len(file_ticket_attribute_proto_rawDesc)),
+			NumEnums:      0,
+			NumMessages:   6,
+			NumExtensions: 0,
+			NumServices:   0,
+		},
+		GoTypes:           file_ticket_attribute_proto_goTypes,
+		DependencyIndexes: file_ticket_attribute_proto_depIdxs,
+		MessageInfos:      file_ticket_attribute_proto_msgTypes,
+	}.Build()
+	File_ticket_attribute_proto = out.File
+	file_ticket_attribute_proto_goTypes = nil
+	file_ticket_attribute_proto_depIdxs = nil
+}
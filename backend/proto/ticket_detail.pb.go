@@ -0,0 +1,325 @@
+// Code generated by protoc-gen-go. DO NOT EDIT.
+// versions:
+// 	protoc-gen-go v1.36.9
+// 	protoc        v5.29.3
+// source: ticket_detail.proto
+
+package proto
+
+import (
+	protoreflect "google.golang.org/protobuf/reflect/protoreflect"
+	protoimpl "google.golang.org/protobuf/runtime/protoimpl"
+	timestamppb "google.golang.org/protobuf/types/known/timestamppb"
+	reflect "reflect"
+	sync "sync"
+	unsafe "unsafe"
+)
+
+const (
+	// Verify that this generated code is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(20 - protoimpl.MinVersion)
+	// Verify that runtime/protoimpl is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(protoimpl.MaxVersion - 20)
+)
+
+type TicketDetailRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	TicketId      int32                  `protobuf:"varint,1,opt,name=ticket_id,json=ticketId,proto3" json:"ticket_id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *TicketDetailRequest) Reset() {
+	*x = TicketDetailRequest{}
+	mi := &file_ticket_detail_proto_msgTypes[0]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *TicketDetailRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*TicketDetailRequest) ProtoMessage() {}
+
+func (x *TicketDetailRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_ticket_detail_proto_msgTypes[0]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use TicketDetailRequest.ProtoReflect.Descriptor instead.
+func (*TicketDetailRequest) Descriptor() ([]byte, []int) {
+	return file_ticket_detail_proto_rawDescGZIP(), []int{0}
+}
+
+func (x *TicketDetailRequest) GetTicketId() int32 {
+	if x != nil {
+		return x.TicketId
+	}
+	return 0
+}
+
+type TicketRating struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Id            int32                  `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
+	Rating        int32                  `protobuf:"varint,2,opt,name=rating,proto3" json:"rating,omitempty"` // Raw rating, 0-5
+	CategoryId    int32                  `protobuf:"varint,3,opt,name=category_id,json=categoryId,proto3" json:"category_id,omitempty"`
+	CategoryName  string                 `protobuf:"bytes,4,opt,name=category_name,json=categoryName,proto3" json:"category_name,omitempty"`
+	ReviewerId    int32                  `protobuf:"varint,5,opt,name=reviewer_id,json=reviewerId,proto3" json:"reviewer_id,omitempty"`
+	ReviewerName  string                 `protobuf:"bytes,6,opt,name=reviewer_name,json=reviewerName,proto3" json:"reviewer_name,omitempty"`
+	RevieweeId    int32                  `protobuf:"varint,7,opt,name=reviewee_id,json=revieweeId,proto3" json:"reviewee_id,omitempty"`
+	RevieweeName  string                 `protobuf:"bytes,8,opt,name=reviewee_name,json=revieweeName,proto3" json:"reviewee_name,omitempty"`
+	CreatedAt     *timestamppb.Timestamp `protobuf:"bytes,9,opt,name=created_at,json=createdAt,proto3" json:"created_at,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *TicketRating) Reset() {
+	*x = TicketRating{}
+	mi := &file_ticket_detail_proto_msgTypes[1]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *TicketRating) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*TicketRating) ProtoMessage() {}
+
+func (x *TicketRating) ProtoReflect() protoreflect.Message {
+	mi := &file_ticket_detail_proto_msgTypes[1]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use TicketRating.ProtoReflect.Descriptor instead.
+func (*TicketRating) Descriptor() ([]byte, []int) {
+	return file_ticket_detail_proto_rawDescGZIP(), []int{1}
+}
+
+func (x *TicketRating) GetId() int32 {
+	if x != nil {
+		return x.Id
+	}
+	return 0
+}
+
+func (x *TicketRating) GetRating() int32 {
+	if x != nil {
+		return x.Rating
+	}
+	return 0
+}
+
+func (x *TicketRating) GetCategoryId() int32 {
+	if x != nil {
+		return x.CategoryId
+	}
+	return 0
+}
+
+func (x *TicketRating) GetCategoryName() string {
+	if x != nil {
+		return x.CategoryName
+	}
+	return ""
+}
+
+func (x *TicketRating) GetReviewerId() int32 {
+	if x != nil {
+		return x.ReviewerId
+	}
+	return 0
+}
+
+func (x *TicketRating) GetReviewerName() string {
+	if x != nil {
+		return x.ReviewerName
+	}
+	return ""
+}
+
+func (x *TicketRating) GetRevieweeId() int32 {
+	if x != nil {
+		return x.RevieweeId
+	}
+	return 0
+}
+
+func (x *TicketRating) GetRevieweeName() string {
+	if x != nil {
+		return x.RevieweeName
+	}
+	return ""
+}
+
+func (x *TicketRating) GetCreatedAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.CreatedAt
+	}
+	return nil
+}
+
+type TicketDetailResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	TicketId      int32                  `protobuf:"varint,1,opt,name=ticket_id,json=ticketId,proto3" json:"ticket_id,omitempty"`
+	Subject       string                 `protobuf:"bytes,2,opt,name=subject,proto3" json:"subject,omitempty"`
+	CreatedAt     *timestamppb.Timestamp `protobuf:"bytes,3,opt,name=created_at,json=createdAt,proto3" json:"created_at,omitempty"` // When the ticket was created
+	Ratings       []*TicketRating        `protobuf:"bytes,4,rep,name=ratings,proto3" json:"ratings,omitempty"`                      // Every rating on the ticket, oldest first
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *TicketDetailResponse) Reset() {
+	*x = TicketDetailResponse{}
+	mi := &file_ticket_detail_proto_msgTypes[2]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *TicketDetailResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*TicketDetailResponse) ProtoMessage() {}
+
+func (x *TicketDetailResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_ticket_detail_proto_msgTypes[2]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use TicketDetailResponse.ProtoReflect.Descriptor instead.
+func (*TicketDetailResponse) Descriptor() ([]byte, []int) {
+	return file_ticket_detail_proto_rawDescGZIP(), []int{2}
+}
+
+func (x *TicketDetailResponse) GetTicketId() int32 {
+	if x != nil {
+		return x.TicketId
+	}
+	return 0
+}
+
+func (x *TicketDetailResponse) GetSubject() string {
+	if x != nil {
+		return x.Subject
+	}
+	return ""
+}
+
+func (x *TicketDetailResponse) GetCreatedAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.CreatedAt
+	}
+	return nil
+}
+
+func (x *TicketDetailResponse) GetRatings() []*TicketRating {
+	if x != nil {
+		return x.Ratings
+	}
+	return nil
+}
+
+var File_ticket_detail_proto protoreflect.FileDescriptor
+
+const file_ticket_detail_proto_rawDesc = "" +
+	"\n" +
+	"\x13ticket_detail.proto\x12\tanalytics\x1a\x1fgoogle/protobuf/timestamp.proto\"2\n" +
+	"\x13TicketDetailRequest\x12\x1b\n" +
+	"\tticket_id\x18\x01 \x01(\x05R\bticketId\"\xc3\x02\n" +
+	"\fTicketRating\x12\x0e\n" +
+	"\x02id\x18\x01 \x01(\x05R\x02id\x12\x16\n" +
+	"\x06rating\x18\x02 \x01(\x05R\x06rating\x12\x1f\n" +
+	"\vcategory_id\x18\x03 \x01(\x05R\n" +
+	"categoryId\x12#\n" +
+	"\rcategory_name\x18\x04 \x01(\tR\fcategoryName\x12\x1f\n" +
+	"\vreviewer_id\x18\x05 \x01(\x05R\n" +
+	"reviewerId\x12#\n" +
+	"\rreviewer_name\x18\x06 \x01(\tR\freviewerName\x12\x1f\n" +
+	"\vreviewee_id\x18\a \x01(\x05R\n" +
+	"revieweeId\x12#\n" +
+	"\rreviewee_name\x18\b \x01(\tR\frevieweeName\x129\n" +
+	"\n" +
+	"created_at\x18\t \x01(\v2\x1a.google.protobuf.TimestampR\tcreatedAt\"\xbb\x01\n" +
+	"\x14TicketDetailResponse\x12\x1b\n" +
+	"\tticket_id\x18\x01 \x01(\x05R\bticketId\x12\x18\n" +
+	"\asubject\x18\x02 \x01(\tR\asubject\x129\n" +
+	"\n" +
+	"created_at\x18\x03 \x01(\v2\x1a.google.protobuf.TimestampR\tcreatedAt\x121\n" +
+	"\aratings\x18\x04 \x03(\v2\x17.analytics.TicketRatingR\aratingsB\x17Z\x15go-grpc-backend/protob\x06proto3"
+
+var (
+	file_ticket_detail_proto_rawDescOnce sync.Once
+	file_ticket_detail_proto_rawDescData []byte
+)
+
+func file_ticket_detail_proto_rawDescGZIP() []byte {
+	file_ticket_detail_proto_rawDescOnce.Do(func() {
+		file_ticket_detail_proto_rawDescData = protoimpl.X.CompressGZIP(unsafe.Slice(unsafe.StringData(file_ticket_detail_proto_rawDesc), len(file_ticket_detail_proto_rawDesc)))
+	})
+	return file_ticket_detail_proto_rawDescData
+}
+
+var file_ticket_detail_proto_msgTypes = make([]protoimpl.MessageInfo, 3)
+var file_ticket_detail_proto_goTypes = []any{
+	(*TicketDetailRequest)(nil),   // 0: analytics.TicketDetailRequest
+	(*TicketRating)(nil),          // 1: analytics.TicketRating
+	(*TicketDetailResponse)(nil),  // 2: analytics.TicketDetailResponse
+	(*timestamppb.Timestamp)(nil), // 3: google.protobuf.Timestamp
+}
+var file_ticket_detail_proto_depIdxs = []int32{
+	3, // 0: analytics.TicketRating.created_at:type_name -> google.protobuf.Timestamp
+	3, // 1: analytics.TicketDetailResponse.created_at:type_name -> google.protobuf.Timestamp
+	1, // 2: analytics.TicketDetailResponse.ratings:type_name -> analytics.TicketRating
+	3, // [3:3] is the sub-list for method output_type
+	3, // [3:3] is the sub-list for method input_type
+	3, // [3:3] is the sub-list for extension type_name
+	3, // [3:3] is the sub-list for extension extendee
+	0, // [0:3] is the sub-list for field type_name
+}
+
+func init() { file_ticket_detail_proto_init() }
+func file_ticket_detail_proto_init() {
+	if File_ticket_detail_proto != nil {
+		return
+	}
+	type x struct{}
+	out := protoimpl.TypeBuilder{
+		File: protoimpl.DescBuilder{
+			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
+			RawDescriptor: unsafe.Slice(unsafe.StringData(file_ticket_detail_proto_rawDesc), len(file_ticket_detail_proto_rawDesc)),
+			NumEnums:      0,
+			NumMessages:   3,
+			NumExtensions: 0,
+			NumServices:   0,
+		},
+		GoTypes:           file_ticket_detail_proto_goTypes,
+		DependencyIndexes: file_ticket_detail_proto_depIdxs,
+		MessageInfos:      file_ticket_detail_proto_msgTypes,
+	}.Build()
+	File_ticket_detail_proto = out.File
+	file_ticket_detail_proto_goTypes = nil
+	file_ticket_detail_proto_depIdxs = nil
+}
@@ -23,11 +23,15 @@ const (
 )
 
 type TicketScore struct {
-	state          protoimpl.MessageState    `protogen:"open.v1"`
-	TicketId       int32                     `protobuf:"varint,1,opt,name=ticket_id,json=ticketId,proto3" json:"ticket_id,omitempty"`
-	CategoryScores []*CategoryScoreForTicket `protobuf:"bytes,2,rep,name=category_scores,json=categoryScores,proto3" json:"category_scores,omitempty"`
-	unknownFields  protoimpl.UnknownFields
-	sizeCache      protoimpl.SizeCache
+	state            protoimpl.MessageState    `protogen:"open.v1"`
+	TicketId         int32                     `protobuf:"varint,1,opt,name=ticket_id,json=ticketId,proto3" json:"ticket_id,omitempty"`
+	CategoryScores   []*CategoryScoreForTicket `protobuf:"bytes,2,rep,name=category_scores,json=categoryScores,proto3" json:"category_scores,omitempty"`
+	OverallScore     float32                   `protobuf:"fixed32,3,opt,name=overall_score,json=overallScore,proto3" json:"overall_score,omitempty"`              // GetOverallQualityScore formula over category_scores
+	TotalRatingCount int32                     `protobuf:"varint,4,opt,name=total_rating_count,json=totalRatingCount,proto3" json:"total_rating_count,omitempty"` // Ratings across category_scores
+	Subject          string                    `protobuf:"bytes,5,opt,name=subject,proto3" json:"subject,omitempty"`
+	CreatedAt        *timestamppb.Timestamp    `protobuf:"bytes,6,opt,name=created_at,json=createdAt,proto3" json:"created_at,omitempty"` // When the ticket was created
+	unknownFields    protoimpl.UnknownFields
+	sizeCache        protoimpl.SizeCache
 }
 
 func (x *TicketScore) Reset() {
@@ -74,12 +78,41 @@ func (x *TicketScore) GetCategoryScores() []*CategoryScoreForTicket {
 	return nil
 }
 
+func (x *TicketScore) GetOverallScore() float32 {
+	if x != nil {
+		return x.OverallScore
+	}
+	return 0
+}
+
+func (x *TicketScore) GetTotalRatingCount() int32 {
+	if x != nil {
+		return x.TotalRatingCount
+	}
+	return 0
+}
+
+func (x *TicketScore) GetSubject() string {
+	if x != nil {
+		return x.Subject
+	}
+	return ""
+}
+
+func (x *TicketScore) GetCreatedAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.CreatedAt
+	}
+	return nil
+}
+
 type CategoryScoreForTicket struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
 	CategoryId    int32                  `protobuf:"varint,1,opt,name=category_id,json=categoryId,proto3" json:"category_id,omitempty"`
 	CategoryName  string                 `protobuf:"bytes,2,opt,name=category_name,json=categoryName,proto3" json:"category_name,omitempty"`
 	Score         float32                `protobuf:"fixed32,3,opt,name=score,proto3" json:"score,omitempty"`
 	RatingCount   int32                  `protobuf:"varint,4,opt,name=rating_count,json=ratingCount,proto3" json:"rating_count,omitempty"`
+	Confidence    *ScoreConfidence       `protobuf:"bytes,5,opt,name=confidence,proto3" json:"confidence,omitempty"` // Set when the request asks for it
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
@@ -142,11 +175,19 @@ func (x *CategoryScoreForTicket) GetRatingCount() int32 {
 	return 0
 }
 
+func (x *CategoryScoreForTicket) GetConfidence() *ScoreConfidence {
+	if x != nil {
+		return x.Confidence
+	}
+	return nil
+}
+
 type ScoresByTicketResponse struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
 	Tickets       []*TicketScore         `protobuf:"bytes,1,rep,name=tickets,proto3" json:"tickets,omitempty"`
 	StartDate     *timestamppb.Timestamp `protobuf:"bytes,2,opt,name=start_date,json=startDate,proto3" json:"start_date,omitempty"`
 	EndDate       *timestamppb.Timestamp `protobuf:"bytes,3,opt,name=end_date,json=endDate,proto3" json:"end_date,omitempty"`
+	Range         *DateRange             `protobuf:"bytes,4,opt,name=range,proto3" json:"range,omitempty"` // Range the ratings were filtered by
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
@@ -202,25 +243,41 @@ func (x *ScoresByTicketResponse) GetEndDate() *timestamppb.Timestamp {
 	return nil
 }
 
+func (x *ScoresByTicketResponse) GetRange() *DateRange {
+	if x != nil {
+		return x.Range
+	}
+	return nil
+}
+
 var File_ticket_score_proto protoreflect.FileDescriptor
 
 const file_ticket_score_proto_rawDesc = "" +
 	"\n" +
-	"\x12ticket_score.proto\x12\tanalytics\x1a\x1fgoogle/protobuf/timestamp.proto\"v\n" +
+	"\x12ticket_score.proto\x12\tanalytics\x1a\x1fgoogle/protobuf/timestamp.proto\x1a\x10date_range.proto\x1a\x16score_confidence.proto\"\x9e\x02\n" +
 	"\vTicketScore\x12\x1b\n" +
 	"\tticket_id\x18\x01 \x01(\x05R\bticketId\x12J\n" +
-	"\x0fcategory_scores\x18\x02 \x03(\v2!.analytics.CategoryScoreForTicketR\x0ecategoryScores\"\x97\x01\n" +
+	"\x0fcategory_scores\x18\x02 \x03(\v2!.analytics.CategoryScoreForTicketR\x0ecategoryScores\x12#\n" +
+	"\roverall_score\x18\x03 \x01(\x02R\foverallScore\x12,\n" +
+	"\x12total_rating_count\x18\x04 \x01(\x05R\x10totalRatingCount\x12\x18\n" +
+	"\asubject\x18\x05 \x01(\tR\asubject\x129\n" +
+	"\n" +
+	"created_at\x18\x06 \x01(\v2\x1a.google.protobuf.TimestampR\tcreatedAt\"\xd3\x01\n" +
 	"\x16CategoryScoreForTicket\x12\x1f\n" +
 	"\vcategory_id\x18\x01 \x01(\x05R\n" +
 	"categoryId\x12#\n" +
 	"\rcategory_name\x18\x02 \x01(\tR\fcategoryName\x12\x14\n" +
 	"\x05score\x18\x03 \x01(\x02R\x05score\x12!\n" +
-	"\frating_count\x18\x04 \x01(\x05R\vratingCount\"\xbc\x01\n" +
+	"\frating_count\x18\x04 \x01(\x05R\vratingCount\x12:\n" +
+	"\n" +
+	"confidence\x18\x05 \x01(\v2\x1a.analytics.ScoreConfidenceR\n" +
+	"confidence\"\xe8\x01\n" +
 	"\x16ScoresByTicketResponse\x120\n" +
 	"\atickets\x18\x01 \x03(\v2\x16.analytics.TicketScoreR\atickets\x129\n" +
 	"\n" +
 	"start_date\x18\x02 \x01(\v2\x1a.google.protobuf.TimestampR\tstartDate\x125\n" +
-	"\bend_date\x18\x03 \x01(\v2\x1a.google.protobuf.TimestampR\aendDateB\x17Z\x15go-grpc-backend/protob\x06proto3"
+	"\bend_date\x18\x03 \x01(\v2\x1a.google.protobuf.TimestampR\aendDate\x12*\n" +
+	"\x05range\x18\x04 \x01(\v2\x14.analytics.DateRangeR\x05rangeB\x17Z\x15go-grpc-backend/protob\x06proto3"
 
 var (
 	file_ticket_score_proto_rawDescOnce sync.Once
@@ -240,17 +297,22 @@ var file_ticket_score_proto_goTypes = []any{
 	(*CategoryScoreForTicket)(nil), // 1: analytics.CategoryScoreForTicket
 	(*ScoresByTicketResponse)(nil), // 2: analytics.ScoresByTicketResponse
 	(*timestamppb.Timestamp)(nil),  // 3: google.protobuf.Timestamp
+	(*ScoreConfidence)(nil),        // 4: analytics.ScoreConfidence
+	(*DateRange)(nil),              // 5: analytics.DateRange
 }
 var file_ticket_score_proto_depIdxs = []int32{
 	1, // 0: analytics.TicketScore.category_scores:type_name -> analytics.CategoryScoreForTicket
-	0, // 1: analytics.ScoresByTicketResponse.tickets:type_name -> analytics.TicketScore
-	3, // 2: analytics.ScoresByTicketResponse.start_date:type_name -> google.protobuf.Timestamp
-	3, // 3: analytics.ScoresByTicketResponse.end_date:type_name -> google.protobuf.Timestamp
-	4, // [4:4] is the sub-list for method output_type
-	4, // [4:4] is the sub-list for method input_type
-	4, // [4:4] is the sub-list for extension type_name
-	4, // [4:4] is the sub-list for extension extendee
-	0, // [0:4] is the sub-list for field type_name
+	3, // 1: analytics.TicketScore.created_at:type_name -> google.protobuf.Timestamp
+	4, // 2: analytics.CategoryScoreForTicket.confidence:type_name -> analytics.ScoreConfidence
+	0, // 3: analytics.ScoresByTicketResponse.tickets:type_name -> analytics.TicketScore
+	3, // 4: analytics.ScoresByTicketResponse.start_date:type_name -> google.protobuf.Timestamp
+	3, // 5: analytics.ScoresByTicketResponse.end_date:type_name -> google.protobuf.Timestamp
+	5, // 6: analytics.ScoresByTicketResponse.range:type_name -> analytics.DateRange
+	7, // [7:7] is the sub-list for method output_type
+	7, // [7:7] is the sub-list for method input_type
+	7, // [7:7] is the sub-list for extension type_name
+	7, // [7:7] is the sub-list for extension extendee
+	0, // [0:7] is the sub-list for field type_name
 }
 
 func init() { file_ticket_score_proto_init() }
@@ -258,6 +320,8 @@ func file_ticket_score_proto_init() {
 	if File_ticket_score_proto != nil {
 		return
 	}
+	file_date_range_proto_init()
+	file_score_confidence_proto_init()
 	type x struct{}
 	out := protoimpl.TypeBuilder{
 		File: protoimpl.DescBuilder{